@@ -37,7 +37,7 @@ func (a Action) createAction(builder *Builder, menu *walk.Menu) (*walk.Action, e
 		*a.AssignTo = action
 	}
 
-	if err := action.SetText(a.Text); err != nil {
+	if err := builder.translate(builder.owner(), a.Text, action.Text, action.SetText); err != nil {
 		return nil, err
 	}
 	if err := setActionImage(action, a.Image, builder.dpi); err != nil {
@@ -114,7 +114,7 @@ func (m Menu) createAction(builder *Builder, menu *walk.Menu) (*walk.Action, err
 		return nil, err
 	}
 
-	if err := action.SetText(m.Text); err != nil {
+	if err := builder.translate(builder.owner(), m.Text, action.Text, action.SetText); err != nil {
 		return nil, err
 	}
 	if err := setActionImage(action, m.Image, builder.dpi); err != nil {
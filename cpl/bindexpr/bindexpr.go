@@ -0,0 +1,240 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bindexpr parses the data binding expressions of package cpl, as
+// passed to cpl.Bind, and checks paths against the types of data sources,
+// without creating any windows. It is used by cpl.Validate and by the
+// analyzer of package cplcheck.
+//
+// An expression is either a path, like "Name" or "Address.City", or an
+// expression of package expr, like "Age >= 18 && !nameEdit.ReadOnly". A path
+// is resolved against the DataSource of the nearest DataBinder, unless its
+// first part is the name of a widget, a DataBinder, an expression or a
+// condition.
+// The variables of other expressions must be known by such a name.
+package bindexpr
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/xackery/wlk/cpl/expr"
+	"gopkg.in/Knetic/govaluate.v3"
+)
+
+// Expr is a parsed data binding expression.
+type Expr struct {
+	// Text is the expression as passed to cpl.Bind.
+	Text string
+
+	// IsPath reports whether the expression is a single path, like "Name"
+	// or "Address.City".
+	IsPath bool
+
+	// Vars are the variables of the expression, like "Age" or
+	// "nameEdit.ReadOnly", in order of appearance and without duplicates.
+	// For a path it holds just the path.
+	Vars []string
+}
+
+// Parse parses expression the way cpl.Builder does, with functions as the
+// functions that may be called, in addition to the builtins of package expr.
+func Parse(expression string, functions map[string]govaluate.ExpressionFunction) (*Expr, error) {
+	info, err := expr.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %s", expression, err.Error())
+	}
+
+	for _, f := range info.Funcs {
+		if _, ok := functions[f.Name]; !ok && !expr.IsBuiltin(f.Name) {
+			return nil, fmt.Errorf("invalid expression %q: column %d: unknown function %q", expression, f.Pos+1, f.Name)
+		}
+	}
+
+	e := &Expr{Text: expression, IsPath: info.IsPath}
+	for _, path := range info.Paths {
+		e.Vars = append(e.Vars, path.Name)
+	}
+
+	return e, nil
+}
+
+// SplitPath returns the first part of path and the rest, which is empty if
+// path has a single part.
+func SplitPath(path string) (first, rest string) {
+	if i := strings.IndexByte(path, '.'); i >= 0 {
+		return path[:i], path[i+1:]
+	}
+
+	return path, ""
+}
+
+// CheckPath checks that path may be resolved in values of type t the way
+// walk.DataBinder resolves the paths of bound properties: by field first,
+// then by method, calling functions and methods without arguments. Parts
+// below values of interface types, and the keys of maps, are not checked.
+//
+// It returns the type at path, or nil if that is not known statically.
+func CheckPath(t reflect.Type, path string) (reflect.Type, error) {
+	fullPath := path
+
+	for path != "" && t != nil {
+		var name string
+		name, path = SplitPath(path)
+
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		switch t.Kind() {
+		case reflect.Interface:
+			return nil, nil
+
+		case reflect.Map:
+			if t.Key().Kind() != reflect.String {
+				return nil, fmt.Errorf("cannot resolve %q in %q, %s has no string keys", name, fullPath, t)
+			}
+			t = t.Elem()
+
+		case reflect.Struct:
+			var fun reflect.Type
+
+			if f, ok := t.FieldByName(name); ok {
+				if f.Type.Kind() == reflect.Func {
+					fun = f.Type
+				} else {
+					t = f.Type
+				}
+			} else if m, ok := reflect.PtrTo(t).MethodByName(name); ok {
+				// Drop the receiver.
+				fun = m.Func.Type()
+				if fun.NumIn() > 1 {
+					return nil, fmt.Errorf("cannot call method %s of %s in %q, it takes arguments", name, t, fullPath)
+				}
+			} else {
+				return nil, fmt.Errorf("unknown field or method %q in %s%s", name, t, Suggestion(name, structMembers(t)))
+			}
+
+			if fun != nil {
+				var err error
+				if t, err = resultType(fun, name); err != nil {
+					return nil, err
+				}
+			}
+
+		default:
+			return nil, fmt.Errorf("cannot resolve %q in %q, %s is not a struct or map", name, fullPath, t)
+		}
+	}
+
+	return t, nil
+}
+
+// resultType returns the type of the value that walk.DataBinder takes from
+// calls of functions of type fun.
+func resultType(fun reflect.Type, name string) (reflect.Type, error) {
+	switch fun.NumOut() {
+	case 1:
+		return fun.Out(0), nil
+
+	case 2:
+		if fun.Out(1) != reflect.TypeOf((*error)(nil)).Elem() {
+			return nil, fmt.Errorf("second result of %s must be an error", name)
+		}
+		return fun.Out(0), nil
+	}
+
+	return nil, fmt.Errorf("%s must return a value plus optionally an error", name)
+}
+
+func structMembers(t reflect.Type) []string {
+	var names []string
+
+	var addFields func(t reflect.Type)
+	addFields = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			names = append(names, f.Name)
+			if f.Anonymous && f.Type.Kind() == reflect.Struct {
+				addFields(f.Type)
+			}
+		}
+	}
+	addFields(t)
+
+	pt := reflect.PtrTo(t)
+	for i := 0; i < pt.NumMethod(); i++ {
+		names = append(names, pt.Method(i).Name)
+	}
+
+	return names
+}
+
+// Suggestion returns a hint like `, did you mean "Name"?` for the candidate
+// that is most similar to name, or "" if there is none that is similar
+// enough.
+func Suggestion(name string, candidates []string) string {
+	best, bestDist := "", len(name)/2+1
+
+	for _, c := range candidates {
+		if c == name {
+			continue
+		}
+
+		if strings.EqualFold(c, name) {
+			return fmt.Sprintf(", did you mean %q?", c)
+		}
+
+		if d := distance(strings.ToLower(name), strings.ToLower(c)); d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+
+	if best == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(", did you mean %q?", best)
+}
+
+// distance returns the edit distance of a and b, with transpositions of
+// adjacent characters counting as one edit, so "Nmae" is close to "Name".
+func distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = minInt(d[i-1][j]+1, minInt(d[i][j-1]+1, d[i-1][j-1]+cost))
+
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(ra)][len(rb)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bindexpr
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/Knetic/govaluate.v3"
+)
+
+func TestParse(t *testing.T) {
+	functions := map[string]govaluate.ExpressionFunction{
+		"len": func(args ...interface{}) (interface{}, error) { return 0, nil },
+	}
+
+	testCases := []struct {
+		expression string
+		isPath     bool
+		vars       []string
+	}{
+		{"Name", true, []string{"Name"}},
+		{"Address.City", true, []string{"Address.City"}},
+		{" Name ", true, []string{"Name"}},
+		{"Age >= 18", false, []string{"Age"}},
+		{"!nameEdit.ReadOnly && Age > Min && Age < Max && Min > 0", false, []string{"nameEdit.ReadOnly", "Age", "Min", "Max"}},
+		{"len(Name) > 0", false, []string{"Name"}},
+		{"'a' == 'b'", false, nil},
+	}
+
+	for _, c := range testCases {
+		e, err := Parse(c.expression, functions)
+		if err != nil {
+			t.Errorf("%q: %v", c.expression, err)
+			continue
+		}
+
+		if e.IsPath != c.isPath {
+			t.Errorf("%q: got IsPath %v, want %v", c.expression, e.IsPath, c.isPath)
+		}
+		if !reflect.DeepEqual(e.Vars, c.vars) {
+			t.Errorf("%q: got Vars %q, want %q", c.expression, e.Vars, c.vars)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expression := range []string{
+		"Age >",
+		"(Age > 18",
+		"Name == 'x",
+		"size(Name) > 0",
+	} {
+		if _, err := Parse(expression, nil); err == nil {
+			t.Errorf("%q: got no error", expression)
+		} else if !strings.HasPrefix(err.Error(), `invalid expression "`) {
+			t.Errorf("%q: got error %q", expression, err)
+		}
+	}
+}
+
+func TestSplitPath(t *testing.T) {
+	testCases := []struct {
+		path, first, rest string
+	}{
+		{"Name", "Name", ""},
+		{"Address.City", "Address", "City"},
+		{"a.b.c", "a", "b.c"},
+	}
+
+	for _, c := range testCases {
+		if first, rest := SplitPath(c.path); first != c.first || rest != c.rest {
+			t.Errorf("%q: got %q, %q, want %q, %q", c.path, first, rest, c.first, c.rest)
+		}
+	}
+}
+
+type Address struct {
+	City string
+}
+
+type Base struct {
+	ID int
+}
+
+type Person struct {
+	Base
+	Name     string
+	Address  *Address
+	Tags     map[string]string
+	Extra    interface{}
+	Computed func() bool
+}
+
+func (p *Person) Adult() bool { return true }
+
+func (p Person) Lookup() (*Address, error) { return nil, nil }
+
+func (p *Person) Find(name string) bool { return false }
+
+func (p *Person) Bad() (int, int) { return 0, 0 }
+
+func TestCheckPath(t *testing.T) {
+	pt := reflect.TypeOf(&Person{})
+	stringType := reflect.TypeOf("")
+
+	testCases := []struct {
+		path string
+		want reflect.Type
+	}{
+		{"Name", stringType},
+		{"ID", reflect.TypeOf(0)},
+		{"Address.City", stringType},
+		{"Tags.anything", stringType},
+		{"Extra.Whatever.Goes", nil},
+		{"Computed", reflect.TypeOf(true)},
+		{"Adult", reflect.TypeOf(true)},
+		{"Lookup.City", stringType},
+	}
+
+	for _, c := range testCases {
+		got, err := CheckPath(pt, c.path)
+		if err != nil {
+			t.Errorf("%q: %v", c.path, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("%q: got %v, want %v", c.path, got, c.want)
+		}
+	}
+}
+
+func TestCheckPathErrors(t *testing.T) {
+	pt := reflect.TypeOf(&Person{})
+
+	testCases := []struct {
+		path string
+		want string
+	}{
+		{"Nmae", `unknown field or method "Nmae" in bindexpr.Person, did you mean "Name"?`},
+		{"name", `unknown field or method "name" in bindexpr.Person, did you mean "Name"?`},
+		{"Address.Town", `unknown field or method "Town" in bindexpr.Address`},
+		{"Name.Length", `cannot resolve "Length" in "Name.Length", string is not a struct or map`},
+		{"Find", `cannot call method Find of bindexpr.Person in "Find", it takes arguments`},
+		{"Bad", `second result of Bad must be an error`},
+	}
+
+	for _, c := range testCases {
+		_, err := CheckPath(pt, c.path)
+		if err == nil {
+			t.Errorf("%q: got no error, want %q", c.path, c.want)
+			continue
+		}
+		if err.Error() != c.want {
+			t.Errorf("%q: got error %q, want %q", c.path, err, c.want)
+		}
+	}
+
+	if _, err := CheckPath(reflect.TypeOf(map[int]string{}), "x"); err == nil {
+		t.Errorf("map[int]string: got no error")
+	}
+}
+
+func TestSuggestion(t *testing.T) {
+	candidates := []string{"Name", "Address", "Age"}
+
+	testCases := []struct {
+		name, want string
+	}{
+		{"Nmae", `, did you mean "Name"?`},
+		{"address", `, did you mean "Address"?`},
+		{"Ag", `, did you mean "Age"?`},
+		{"Zip", ""},
+	}
+
+	for _, c := range testCases {
+		if got := Suggestion(c.name, candidates); got != c.want {
+			t.Errorf("%q: got %q, want %q", c.name, got, c.want)
+		}
+	}
+}
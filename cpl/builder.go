@@ -11,23 +11,14 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"regexp"
 	"strings"
 
+	"github.com/xackery/wlk/cpl/expr"
 	"github.com/xackery/wlk/walk"
 	"gopkg.in/Knetic/govaluate.v3"
 )
 
-var (
-	conditionsByName = make(map[string]walk.Condition)
-	propertyRE       *regexp.Regexp
-)
-
-func init() {
-	walk.AppendToWalkInit(func() {
-		propertyRE = regexp.MustCompile(`[A-Za-z]+[0-9A-Za-z]*(\.[A-Za-z]+[0-9A-Za-z]*)+`)
-	})
-}
+var conditionsByName = make(map[string]walk.Condition)
 
 func MustRegisterCondition(name string, condition walk.Condition) {
 	if name == "" {
@@ -44,8 +35,9 @@ func MustRegisterCondition(name string, condition walk.Condition) {
 }
 
 type declWidget struct {
-	d Widget
-	w walk.Window
+	d     Widget
+	w     walk.Window
+	scope *scope
 }
 
 type Builder struct {
@@ -58,12 +50,13 @@ type Builder struct {
 	widgetValue              reflect.Value
 	parent                   walk.Container
 	declWidgets              []declWidget
-	name2Window              map[string]walk.Window
-	name2DataBinder          map[string]*walk.DataBinder
+	scope                    *scope
 	deferredFuncs            []func() error
 	knownCompositeConditions map[string]walk.Condition
 	expressions              map[string]walk.Expression
 	functions                map[string]govaluate.ExpressionFunction
+	target                   walk.Window // existing window that is rebuilt, not disposed on errors
+	translations             []func()    // detach the LanguageChanged handlers of the widgets
 }
 
 func NewBuilder(parent walk.Container) *Builder {
@@ -76,14 +69,31 @@ func NewBuilder(parent walk.Container) *Builder {
 	return &Builder{
 		dpi:                      dpi,
 		parent:                   parent,
-		name2Window:              make(map[string]walk.Window),
-		name2DataBinder:          make(map[string]*walk.DataBinder),
+		scope:                    newScope(nil, ""),
 		knownCompositeConditions: make(map[string]walk.Condition),
 		expressions:              make(map[string]walk.Expression),
 		functions:                make(map[string]govaluate.ExpressionFunction),
 	}
 }
 
+// itemBuilder returns a Builder for widgets that are created in parent after
+// b has finished, like the items of a Repeater. The names in s, and the
+// expressions and functions known to b, are known to it, but not the other way
+// around.
+func (b *Builder) itemBuilder(parent walk.Container, s *scope) *Builder {
+	ib := NewBuilder(parent)
+
+	ib.scope = newScope(s, "")
+	for name, expr := range b.expressions {
+		ib.expressions[name] = expr
+	}
+	for name, fn := range b.functions {
+		ib.functions[name] = fn
+	}
+
+	return ib
+}
+
 func (b *Builder) Parent() walk.Container {
 	return b.parent
 }
@@ -138,20 +148,20 @@ func (b *Builder) InitWidget(d Widget, w walk.Window, customInit func() error) e
 
 	var succeeded bool
 	defer func() {
-		if !succeeded {
+		if !succeeded && w != b.target {
 			w.Dispose()
 		}
 	}()
 
-	b.declWidgets = append(b.declWidgets, declWidget{d, w})
+	b.declWidgets = append(b.declWidgets, declWidget{d, w, b.scope})
 
 	// Window
 	b.initAccessibility(d, w)
 
 	// Widget
 	if name := b.string("Name"); name != "" {
-		w.SetName(name)
-		b.name2Window[name] = w
+		w.SetName(b.scope.qualifiedName(name))
+		b.scope.addWindow(name, w)
 	}
 
 	if val := b.widgetValue.FieldByName("Background"); val.IsValid() {
@@ -247,6 +257,10 @@ func (b *Builder) InitWidget(d Widget, w walk.Window, customInit func() error) e
 			return err
 		}
 
+		if group := b.string("ArrowGroup"); group != "" {
+			widget.SetArrowGroup(group)
+		}
+
 		if field := b.widgetValue.FieldByName("GraphicsEffects"); field.IsValid() {
 			for _, effect := range field.Interface().([]walk.WidgetGraphicsEffect) {
 				widget.GraphicsEffects().Add(effect)
@@ -333,6 +347,14 @@ func (b *Builder) InitWidget(d Widget, w walk.Window, customInit func() error) e
 			layout, _ = val.Interface().(Layout)
 		}
 
+		if val := b.widgetValue.FieldByName("TabNavigation"); val.IsValid() {
+			if cb := wc.AsContainerBase(); cb != nil {
+				if err := cb.SetTabNavigation(val.Interface().(walk.TabNavigation)); err != nil {
+					return err
+				}
+			}
+		}
+
 		if layout != nil {
 			l, err := layout.Create()
 			if err != nil {
@@ -395,7 +417,9 @@ func (b *Builder) InitWidget(d Widget, w walk.Window, customInit func() error) e
 			} else {
 				db = dataB
 
-				b.name2DataBinder[dataBinder.Name] = db
+				if dataBinder.Name != "" {
+					b.scope.addDataBinder(dataBinder.Name, db)
+				}
 
 				if ep := db.ErrorPresenter(); ep != nil {
 					if dep, ok := ep.(walk.Disposable); ok {
@@ -592,9 +616,17 @@ func (b *Builder) string(fieldName string) string {
 }
 
 func (b *Builder) initProperties() error {
+	oldScope := b.scope
+	defer func() {
+		b.scope = oldScope
+	}()
+
 	for _, dw := range b.declWidgets {
 		d, w := dw.d, dw.w
 
+		// Expressions refer to the names of the Component they are declared in.
+		b.scope = dw.scope
+
 		sv := reflect.ValueOf(d)
 		st := sv.Type()
 		if st.Kind() != reflect.Struct {
@@ -649,6 +681,25 @@ func (b *Builder) initProperties() error {
 					return err
 				}
 
+			case string:
+				if prop == nil {
+					continue
+				}
+
+				if !isTranslatable(w, sf.Name) {
+					if err := prop.Set(val); err != nil {
+						return err
+					}
+					continue
+				}
+
+				if err := b.translate(w, val, func() string {
+					text, _ := prop.Get().(string)
+					return text
+				}, func(text string) error { return prop.Set(text) }); err != nil {
+					return err
+				}
+
 			default:
 				if prop == nil {
 					continue
@@ -671,72 +722,93 @@ func (b *Builder) conditionOrProperty(data Property) interface{} {
 			return nil
 		}
 
-		e := &expression{
-			text:           val.expression,
-			subExprsByPath: subExpressions(make(map[string]walk.Expression)),
+		if prop := b.widgetProperty(strings.TrimSpace(val.expression)); prop != nil {
+			return prop
 		}
 
-		var singleExpr walk.Expression
+		vars := make(map[string]walk.Expression)
+		var missingWindow, missingProperty string
 
-		text := propertyRE.ReplaceAllStringFunc(val.expression, func(s string) string {
-			if _, ok := e.subExprsByPath[s]; !ok {
-				parts := strings.Split(s, ".")
+		env := &expr.Env{
+			Lookup: func(name string) (reflect.Type, bool) {
+				if c, ok := conditionsByName[name]; ok {
+					vars[name] = c
+					return reflect.TypeOf(false), true
+				}
 
-				if w, ok := b.name2Window[parts[0]]; ok {
-					if prop := w.AsWindowBase().Property(parts[1]); prop != nil {
-						if len(s) == len(val.expression) {
-							singleExpr = prop
-							return ""
-						}
+				if x, ok := b.expressions[name]; ok {
+					vars[name] = x
+					return valueType(x.Value()), true
+				}
 
-						if len(parts) == 2 {
-							e.addSubExpression(s, prop)
-						} else {
-							e.addSubExpression(s, walk.NewReflectExpression(prop, s[len(parts[0])+len(parts[1])+2:]))
+				// Names of widgets in Components are qualified, like
+				// "billing.street", so the property is the last part.
+				if i := strings.LastIndexByte(name, '.'); i > 0 {
+					if w, ok := b.scope.window(name[:i]); ok {
+						prop := w.AsWindowBase().Property(name[i+1:])
+						if prop == nil {
+							if missingProperty == "" {
+								missingWindow, missingProperty = name[:i], name[i+1:]
+							}
+							return nil, false
 						}
-					} else {
-						panic(fmt.Errorf(`invalid sub expression: "%s"`, s))
+
+						vars[name] = prop
+						return valueType(prop.Get()), true
 					}
-				} else if db, ok := b.name2DataBinder[parts[0]]; ok {
-					e.addSubExpression(s, db.Expression(s[len(parts[0])+1:]))
-				} else if expr, ok := b.expressions[parts[0]]; ok {
-					e.addSubExpression(s, walk.NewReflectExpression(expr, s[len(parts[0])+1:]))
 				}
-			}
 
-			return strings.Replace(s, ".", "\\.", -1)
-		})
+				if db, ok := b.scope.dataBinder(name); ok {
+					vars[name] = db.Expression("")
+					if ds := db.DataSource(); ds != nil {
+						return reflect.TypeOf(ds), true
+					}
+					return nil, true
+				}
 
-		if singleExpr != nil {
-			return singleExpr
+				return nil, false
+			},
+			Functions: make(map[string]interface{}, len(b.functions)),
 		}
 
-		expr, err := govaluate.NewEvaluableExpressionWithFunctions(text, b.functions)
-		if err != nil {
-			panic(fmt.Errorf(`invalid expression "%s": %s`, e.text, err.Error()))
+		for name, fn := range b.functions {
+			env.Functions[name] = govaluateFunction(fn)
 		}
 
-		for _, token := range expr.Tokens() {
-			if token.Kind == govaluate.VARIABLE {
-				name := token.Value.(string)
-				if c, ok := conditionsByName[name]; ok {
-					e.addSubExpression(name, c)
+		program, err := expr.Compile(val.expression, env)
+		if err != nil {
+			if e, ok := err.(*expr.Error); ok && e.Unknown != "" {
+				if missingProperty != "" {
+					panic(fmt.Errorf(`invalid expression "%s": column %d: %s has no property %s`, val.expression, e.Pos+1, missingWindow, missingProperty))
 				}
-				if x, ok := b.expressions[name]; ok {
-					e.addSubExpression(name, x)
+
+				if info, _ := expr.Parse(val.expression); info != nil && info.IsPath {
+					// We hope for the best and leave it to a DataBinder...
+					return nil
 				}
+
+				// Unresolved names used to evaluate to nil, so they leave the
+				// property unbound instead of failing Create.
+				log.Printf(`walk - unresolved name in expression "%s": %s`, val.expression, err.Error())
+				return nil
 			}
-		}
 
-		e.expr = expr
+			panic(fmt.Errorf(`invalid expression "%s": %s`, val.expression, err.Error()))
+		}
 
-		if _, err := e.expr.Eval(e.subExprsByPath); err != nil {
-			// We hope for the best and leave it to a DataBinder...
-			return nil
+		e := &expression{program: program}
+		for _, name := range program.Vars() {
+			e.addSubExpression(vars[name])
 		}
 
-		if _, ok := e.Value().(bool); ok {
+		switch program.Type() {
+		case reflect.TypeOf(false):
 			return &boolExpression{expression: e}
+
+		case reflect.TypeOf((*interface{})(nil)).Elem():
+			if _, ok := e.Value().(bool); ok {
+				return &boolExpression{expression: e}
+			}
 		}
 
 		return e
@@ -748,33 +820,84 @@ func (b *Builder) conditionOrProperty(data Property) interface{} {
 	return nil
 }
 
-type expression struct {
-	expr                   *govaluate.EvaluableExpression
-	text                   string
-	subExprsByPath         subExpressions
-	subExprsChangedHandles []int
-	changedPublisher       walk.EventPublisher
-	lastReportedValue      interface{}
+// govaluateFunction adapts fn to the expressions of package expr, which pass
+// numbers by their own type, where govaluate passed them as float64.
+func govaluateFunction(fn govaluate.ExpressionFunction) func(args ...interface{}) (interface{}, error) {
+	return func(args ...interface{}) (interface{}, error) {
+		for i, arg := range args {
+			switch v := reflect.ValueOf(arg); v.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				args[i] = float64(v.Int())
+
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				args[i] = float64(v.Uint())
+
+			case reflect.Float32:
+				args[i] = v.Float()
+			}
+		}
+
+		return fn(args...)
+	}
+}
+
+// widgetProperty returns the property of a path like "nameEdit.Text" or
+// "billing.street.Text", or nil if path is something else.
+func (b *Builder) widgetProperty(path string) walk.Property {
+	i := strings.LastIndexByte(path, '.')
+	if i <= 0 {
+		return nil
+	}
+
+	w, ok := b.scope.window(path[:i])
+	if !ok {
+		return nil
+	}
+
+	return w.AsWindowBase().Property(path[i+1:])
 }
 
-type subExpressions map[string]walk.Expression
+// valueType returns the type that expressions are compiled against for the
+// current value v of a property or expression. Only basic types are assumed
+// to stay the same, others are checked when the expression is evaluated.
+func valueType(v interface{}) reflect.Type {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil
+	}
 
-func (se subExpressions) Get(name string) (interface{}, error) {
-	if sub, ok := se[name]; ok {
-		return sub.Value(), nil
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return t
 	}
 
-	return nil, fmt.Errorf(`invalid sub expression: "%s"`, name)
+	return nil
+}
+
+type expression struct {
+	program                *expr.Program
+	subExprs               []walk.Expression
+	subExprsChangedHandles []int
+	changedPublisher       walk.EventPublisher
+	lastReportedValue      interface{}
 }
 
 func (e *expression) String() string {
-	return e.text
+	return e.program.String()
 }
 
 func (e *expression) Value() interface{} {
-	val, err := e.expr.Eval(e.subExprsByPath)
+	vars := make([]interface{}, len(e.subExprs))
+	for i, sub := range e.subExprs {
+		vars[i] = sub.Value()
+	}
+
+	val, err := e.program.Eval(nil, vars...)
 	if err != nil {
-		log.Printf(`walk - failed to evaluate expression "%s": %s`, e.text, err.Error())
+		log.Printf(`walk - failed to evaluate expression "%s": %s`, e.program, err.Error())
 	}
 
 	e.lastReportedValue = val
@@ -786,8 +909,8 @@ func (e *expression) Changed() *walk.Event {
 	return e.changedPublisher.Event()
 }
 
-func (e *expression) addSubExpression(path string, subExpr walk.Expression) {
-	e.subExprsByPath[path] = subExpr
+func (e *expression) addSubExpression(subExpr walk.Expression) {
+	e.subExprs = append(e.subExprs, subExpr)
 
 	handle := subExpr.Changed().Attach(func() {
 		last := e.lastReportedValue
@@ -0,0 +1,94 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package cpl
+
+import (
+	"github.com/xackery/wlk/walk"
+	"github.com/xackery/wlk/walk/chart"
+	"github.com/xackery/wlk/wcolor"
+)
+
+type Chart struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipText        Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	ArrowGroup         string
+	Column             int
+	ColumnSpan         int
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+	TabIndex           int
+	TabStop            Property
+
+	// Chart
+
+	AssignTo       **chart.Chart
+	HideGrid       bool
+	HideLegend     bool
+	Kind           chart.Kind
+	Palette        []wcolor.Color
+	Series         []*chart.Series
+	Title          string
+	ValueFormatter func(v float64) string
+}
+
+func (c Chart) Create(builder *Builder) error {
+	w, err := chart.NewChart(builder.Parent(), c.Kind)
+	if err != nil {
+		return err
+	}
+
+	if c.AssignTo != nil {
+		*c.AssignTo = w
+	}
+
+	return builder.InitWidget(c, w, func() error {
+		builder.translate(w, c.Title, w.Title, func(title string) error {
+			w.SetTitle(title)
+			return nil
+		})
+		w.SetGridVisible(!c.HideGrid)
+		if c.HideLegend {
+			w.SetLegendVisible(false)
+		}
+		if len(c.Palette) > 0 {
+			w.SetPalette(c.Palette)
+		}
+		w.SetValueFormatter(c.ValueFormatter)
+		w.AddSeries(c.Series...)
+
+		return nil
+	})
+}
@@ -40,12 +40,15 @@ type CheckBox struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	ArrowGroup         string
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
 	Row                int
 	RowSpan            int
 	StretchFactor      int
+	TabIndex           int
+	TabStop            Property
 
 	// Button
 
@@ -0,0 +1,248 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package cpl
+
+import (
+	"errors"
+
+	"github.com/xackery/wlk/walk"
+)
+
+// Expander is implemented by reusable declarations, like an address editor
+// that appears in several forms. The fields of the implementing type are its
+// parameters, and Expand returns the declaration it stands for. See Component.
+type Expander interface {
+	Expand() Widget
+}
+
+// Component declares an instance of an Expander, in a Composite of its own.
+//
+// The names of the widgets and DataBinders declared by Content are scoped to
+// the instance, so two instances don't clash. Inside, they are referred to
+// as they are declared, like "street.Text". Outside, they are qualified by
+// the Name of the Component, like "billing.street.Text". Names declared
+// outside are known inside, unless they are shadowed.
+//
+// If DataSource is set, the Composite gets a DataBinder with it as its
+// DataSource, so the paths bound in Content refer to it. It may be bound to a
+// path of an enclosing DataBinder, like Bind("BillingAddress"). A DataBinder
+// may be declared as well, for its other settings.
+//
+//	type AddressEditor struct {
+//		ReadOnly bool
+//	}
+//
+//	func (ae AddressEditor) Expand() Widget {
+//		return Composite{
+//			Layout: Grid{Columns: 2},
+//			Children: []Widget{
+//				Label{Text: "Street:"},
+//				LineEdit{Name: "street", Text: Bind("Street"), ReadOnly: ae.ReadOnly},
+//				Label{Text: "City:", Enabled: Bind("street.Text != ''")},
+//				LineEdit{Text: Bind("City"), Enabled: Bind("street.Text != ''")},
+//			},
+//		}
+//	}
+//
+//	Component{Name: "billing", DataSource: Bind("Billing"), Content: AddressEditor{}},
+//	Component{Name: "shipping", DataSource: Bind("Shipping"), Content: AddressEditor{ReadOnly: true}},
+//
+// Validate and package cplcheck do not look into Content.
+type Component struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipText        Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	ArrowGroup         string
+	Column             int
+	ColumnSpan         int
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+	TabIndex           int
+	TabStop            Property
+
+	// Container
+
+	DataBinder    DataBinder
+	Layout        Layout // defaults to a VBox without margins and spacing
+	TabNavigation walk.TabNavigation
+
+	// Component
+
+	AssignTo   **walk.Composite
+	Content    Expander
+	DataSource Property
+}
+
+func (c Component) Create(builder *Builder) error {
+	if c.Content == nil {
+		return errors.New("Component.Content must not be nil")
+	}
+
+	if c.Layout == nil {
+		c.Layout = VBox{MarginsZero: true, SpacingZero: true}
+	}
+
+	dataBinder := c.DataBinder.AssignTo
+	if c.DataSource != nil && dataBinder == nil {
+		dataBinder = new(*walk.DataBinder)
+		c.DataBinder.AssignTo = dataBinder
+	}
+
+	w, err := walk.NewComposite(builder.Parent())
+	if err != nil {
+		return err
+	}
+
+	if c.AssignTo != nil {
+		*c.AssignTo = w
+	}
+
+	if dataBinder != nil {
+		var dataSourceChangedPublisher walk.EventPublisher
+
+		w.MustRegisterProperty("DataSource", walk.NewProperty(
+			func() interface{} {
+				if *dataBinder == nil {
+					return nil
+				}
+				return (*dataBinder).DataSource()
+			},
+			func(v interface{}) error {
+				db := *dataBinder
+				if db == nil {
+					return nil
+				}
+				if err := db.SetDataSource(v); err != nil {
+					return err
+				}
+				dataSourceChangedPublisher.Publish()
+				return db.Reset()
+			},
+			dataSourceChangedPublisher.Event()))
+	}
+
+	w.SetSuspended(true)
+	builder.Defer(func() error {
+		w.SetSuspended(false)
+		return nil
+	})
+
+	return builder.InitWidget(c, w, func() error {
+		oldScope := builder.scope
+		builder.scope = newScope(oldScope, c.Name)
+		defer func() {
+			builder.scope = oldScope
+		}()
+
+		d := c.Content.Expand()
+		if d == nil {
+			return errors.New("Component.Content expanded to nil")
+		}
+
+		return d.Create(builder)
+	})
+}
+
+// scope holds the names of the widgets and DataBinders declared in a
+// Component, or outside of any.
+type scope struct {
+	parent          *scope
+	name            string // of the Component
+	name2Window     map[string]walk.Window
+	name2DataBinder map[string]*walk.DataBinder
+}
+
+func newScope(parent *scope, name string) *scope {
+	return &scope{
+		parent:          parent,
+		name:            name,
+		name2Window:     make(map[string]walk.Window),
+		name2DataBinder: make(map[string]*walk.DataBinder),
+	}
+}
+
+// window returns the widget of name, declared in s or an enclosing scope.
+func (s *scope) window(name string) (walk.Window, bool) {
+	for ; s != nil; s = s.parent {
+		if w, ok := s.name2Window[name]; ok {
+			return w, true
+		}
+	}
+
+	return nil, false
+}
+
+// dataBinder returns the DataBinder of name, declared in s or an enclosing
+// scope.
+func (s *scope) dataBinder(name string) (*walk.DataBinder, bool) {
+	for ; s != nil; s = s.parent {
+		if db, ok := s.name2DataBinder[name]; ok {
+			return db, true
+		}
+	}
+
+	return nil, false
+}
+
+// addWindow adds w to s by name, and to the enclosing scopes by the names
+// qualified with the names of the Components, like "billing.street".
+func (s *scope) addWindow(name string, w walk.Window) {
+	s.name2Window[name] = w
+
+	for ; s.parent != nil && s.name != ""; s = s.parent {
+		name = s.name + "." + name
+		s.parent.name2Window[name] = w
+	}
+}
+
+// addDataBinder is like addWindow, for DataBinders.
+func (s *scope) addDataBinder(name string, db *walk.DataBinder) {
+	s.name2DataBinder[name] = db
+
+	for ; s.parent != nil && s.name != ""; s = s.parent {
+		name = s.name + "." + name
+		s.parent.name2DataBinder[name] = db
+	}
+}
+
+// qualifiedName returns name qualified with the names of the Components it
+// is declared in, like "billing.street".
+func (s *scope) qualifiedName(name string) string {
+	for ; s.parent != nil && s.name != ""; s = s.parent {
+		name = s.name + "." + name
+	}
+
+	return name
+}
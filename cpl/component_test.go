@@ -0,0 +1,175 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package cpl
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/xackery/wlk/walk"
+)
+
+func TestScopeNames(t *testing.T) {
+	// The windows are only compared, never used.
+	title, billingStreet, shippingStreet, billingCity := new(walk.Composite), new(walk.Composite), new(walk.Composite), new(walk.Composite)
+
+	root := newScope(nil, "")
+	root.addWindow("title", title)
+
+	billing := newScope(root, "billing")
+	billing.addWindow("street", billingStreet)
+	city := newScope(billing, "city")
+	city.addWindow("name", billingCity)
+
+	shipping := newScope(root, "shipping")
+	shipping.addWindow("street", shippingStreet)
+	shipping.addWindow("title", shippingStreet)
+
+	tests := []struct {
+		scope *scope
+		name  string
+		want  walk.Window
+	}{
+		{root, "title", title},
+		{root, "billing.street", billingStreet},
+		{root, "shipping.street", shippingStreet},
+		{root, "billing.city.name", billingCity},
+		{root, "street", nil},
+		{billing, "street", billingStreet},
+		{billing, "city.name", billingCity},
+		{billing, "title", title},
+		{billing, "shipping.street", shippingStreet},
+		{city, "street", billingStreet},
+		{city, "name", billingCity},
+		{shipping, "street", shippingStreet},
+		{shipping, "title", shippingStreet}, // shadows the outer title
+		{shipping, "city.name", nil},
+	}
+
+	for _, tt := range tests {
+		w, ok := tt.scope.window(tt.name)
+		if ok != (tt.want != nil) || ok && w != tt.want {
+			t.Errorf("scope %q: window(%q): got %v, %t", tt.scope.name, tt.name, w, ok)
+		}
+	}
+
+	if got := city.qualifiedName("name"); got != "billing.city.name" {
+		t.Errorf("qualifiedName: got %q, want %q", got, "billing.city.name")
+	}
+}
+
+type componentTestAddress struct {
+	Street string
+}
+
+type componentTestEditor struct {
+	ReadOnly bool
+}
+
+func (e componentTestEditor) Expand() Widget {
+	return Composite{
+		Layout: VBox{},
+		Children: []Widget{
+			LineEdit{Name: "street", Text: Bind("Street"), ReadOnly: e.ReadOnly},
+			Label{Name: "copy", Text: Bind("street.Text")},
+		},
+	}
+}
+
+func TestComponentInstances(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	data := struct {
+		Billing, Shipping *componentTestAddress
+	}{
+		&componentTestAddress{"Main St"},
+		&componentTestAddress{"Side St"},
+	}
+
+	var billing, shipping *walk.Composite
+	var billingCopy, shippingCopy *walk.Label
+	var mw *walk.MainWindow
+
+	err := MainWindow{
+		AssignTo:   &mw,
+		Layout:     VBox{},
+		DataBinder: DataBinder{DataSource: &data},
+		Children: []Widget{
+			Component{
+				AssignTo:   &billing,
+				Name:       "billing",
+				DataSource: Bind("Billing"),
+				Content:    componentTestEditor{},
+			},
+			Component{
+				AssignTo:   &shipping,
+				Name:       "shipping",
+				DataSource: Bind("Shipping"),
+				Enabled:    false,
+				Content:    componentTestEditor{ReadOnly: true},
+			},
+			Label{AssignTo: &billingCopy, Text: Bind("billing.street.Text")},
+			Label{AssignTo: &shippingCopy, Text: Bind("shipping.street.Text")},
+		},
+	}.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mw.Dispose()
+
+	// Each instance has its own street, qualified by the name of the
+	// Component, bound to its own DataSource.
+	for _, c := range []struct {
+		composite *walk.Composite
+		label     *walk.Label
+		name      string
+		street    string
+		readOnly  bool
+	}{
+		{billing, billingCopy, "billing.street", "Main St", false},
+		{shipping, shippingCopy, "shipping.street", "Side St", true},
+	} {
+		content := c.composite.Children().At(0).(*walk.Composite)
+		le := content.Children().At(0).(*walk.LineEdit)
+
+		if got := le.Name(); got != c.name {
+			t.Errorf("Name: got %q, want %q", got, c.name)
+		}
+		if got := le.Text(); got != c.street {
+			t.Errorf("%s: got %q, want %q", c.name, got, c.street)
+		}
+		if got := le.ReadOnly(); got != c.readOnly {
+			t.Errorf("%s: ReadOnly: got %t, want %t", c.name, got, c.readOnly)
+		}
+
+		// Inside, the name refers to the street of the same instance.
+		if got := content.Children().At(1).(*walk.Label).Text(); got != c.street {
+			t.Errorf("%s: copy inside: got %q, want %q", c.name, got, c.street)
+		}
+		if got := c.label.Text(); got != c.street {
+			t.Errorf("%s: copy outside: got %q, want %q", c.name, got, c.street)
+		}
+	}
+
+	// The properties of the Component apply to its Composite.
+	if billing.Name() != "billing" || shipping.Name() != "shipping" {
+		t.Errorf("Name: got %q and %q", billing.Name(), shipping.Name())
+	}
+	if !billing.Enabled() || shipping.Enabled() {
+		t.Errorf("Enabled: got %t and %t, want true and false", billing.Enabled(), shipping.Enabled())
+	}
+
+	// Changing the DataSource rebinds the instance.
+	if err := shipping.Property("DataSource").Set(&componentTestAddress{"New St"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := shippingCopy.Text(); got != "New St" {
+		t.Errorf("after setting DataSource: got %q, want %q", got, "New St")
+	}
+}
@@ -41,18 +41,22 @@ type Composite struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	ArrowGroup         string
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
 	Row                int
 	RowSpan            int
 	StretchFactor      int
+	TabIndex           int
+	TabStop            Property
 
 	// Container
 
-	Children   []Widget
-	DataBinder DataBinder
-	Layout     Layout
+	Children      []Widget
+	DataBinder    DataBinder
+	Layout        Layout
+	TabNavigation walk.TabNavigation
 
 	// Composite
 
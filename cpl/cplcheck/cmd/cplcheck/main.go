@@ -0,0 +1,18 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command cplcheck reports mistakes in cpl declarations, see package
+// cplcheck. It is meant to be run by go vet:
+//
+//	GOOS=windows go vet -vettool=$(which cplcheck) ./...
+package main
+
+import (
+	"github.com/xackery/wlk/cpl/cplcheck"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(cplcheck.Analyzer)
+}
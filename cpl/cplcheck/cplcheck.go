@@ -0,0 +1,616 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cplcheck defines an Analyzer that reports mistakes in the
+// declaration literals of package cpl, which otherwise only surface when the
+// declarations are built:
+//
+//   - a Name used by more than one widget or DataBinder,
+//   - an AssignTo target shared by several declarations,
+//   - a syntax error in a Bind expression,
+//   - a Bind path that does not exist in the static type of the DataSource
+//     of the nearest DataBinder,
+//   - a variable of a Bind expression that is neither a widget property, a
+//     path in a named DataBinder, an expression nor a condition registered in
+//     the same package.
+//
+// Each declaration literal that is not nested in another one is checked on
+// its own. Names that are only known at runtime, like those of widgets in
+// children built by functions, suppress reports of unknown variables; see
+// cpl.Validate for checking complete declarations at runtime.
+//
+// Run it with go vet, for the windows target of package cpl:
+//
+//	go install github.com/xackery/wlk/cpl/cplcheck/cmd/cplcheck
+//	GOOS=windows go vet -vettool=$(which cplcheck) ./...
+package cplcheck
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"regexp"
+
+	"github.com/xackery/wlk/cpl/bindexpr"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"gopkg.in/Knetic/govaluate.v3"
+)
+
+const cplPath = "github.com/xackery/wlk/cpl"
+
+// Analyzer reports mistakes in cpl declaration literals.
+var Analyzer = &analysis.Analyzer{
+	Name:     "cplcheck",
+	Doc:      "check cpl declarations and their Bind expressions",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	cplPkg := findCplPackage(pass.Pkg)
+	if cplPkg == nil {
+		return nil, nil
+	}
+
+	widgetObj, _ := cplPkg.Scope().Lookup("Widget").(*types.TypeName)
+	dataBinderObj, _ := cplPkg.Scope().Lookup("DataBinder").(*types.TypeName)
+	if widgetObj == nil || dataBinderObj == nil {
+		return nil, nil
+	}
+	widgetIface, _ := widgetObj.Type().Underlying().(*types.Interface)
+
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	conditions := make(map[string]bool)
+	ins.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		if isCplFunc(pass, call, "MustRegisterCondition") && len(call.Args) > 0 {
+			if name, ok := stringConstant(pass, call.Args[0]); ok {
+				conditions[name] = true
+			}
+		}
+	})
+
+	ins.WithStack([]ast.Node{(*ast.CompositeLit)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		lit := n.(*ast.CompositeLit)
+		if !isCplType(pass.TypesInfo.TypeOf(lit)) || !isRoot(pass, stack) {
+			return true
+		}
+
+		c := &checker{
+			pass:        pass,
+			widget:      widgetIface,
+			dataBinder:  dataBinderObj.Type(),
+			conditions:  conditions,
+			names:       make(map[string]token.Pos),
+			widgets:     make(map[string]bool),
+			dataBinders: make(map[string]*bindScope),
+			targets:     make(map[string]token.Pos),
+			expressions: make(map[string]bool),
+			functions:   make(map[string]govaluate.ExpressionFunction),
+		}
+		c.visit(lit, &bindScope{})
+		c.checkBindings()
+
+		return true
+	})
+
+	return nil, nil
+}
+
+// findCplPackage returns package cpl if pkg is it or imports it.
+func findCplPackage(pkg *types.Package) *types.Package {
+	if pkg.Path() == cplPath {
+		return pkg
+	}
+
+	for _, imp := range pkg.Imports() {
+		if imp.Path() == cplPath {
+			return imp
+		}
+	}
+
+	return nil
+}
+
+// isRoot reports whether the composite literal on top of stack is not nested
+// in another cpl declaration literal, other than in a function literal.
+func isRoot(pass *analysis.Pass, stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch n := stack[i].(type) {
+		case *ast.FuncLit:
+			return true
+
+		case *ast.CompositeLit:
+			if isCplType(pass.TypesInfo.TypeOf(n)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// isCplType reports whether t is a type of package cpl, or a slice, array or
+// pointer of one.
+func isCplType(t types.Type) bool {
+	for t != nil {
+		if named, ok := t.(*types.Named); ok {
+			obj := named.Obj()
+			return obj.Pkg() != nil && obj.Pkg().Path() == cplPath
+		}
+
+		switch u := t.(type) {
+		case *types.Slice:
+			t = u.Elem()
+
+		case *types.Array:
+			t = u.Elem()
+
+		case *types.Pointer:
+			t = u.Elem()
+
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+func isCplFunc(pass *analysis.Pass, call *ast.CallExpr, name string) bool {
+	var id *ast.Ident
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		id = fun
+
+	case *ast.SelectorExpr:
+		id = fun.Sel
+
+	default:
+		return false
+	}
+
+	fn, ok := pass.TypesInfo.Uses[id].(*types.Func)
+	return ok && fn.Name() == name && fn.Pkg() != nil && fn.Pkg().Path() == cplPath
+}
+
+func stringConstant(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+
+	return constant.StringVal(tv.Value), true
+}
+
+// bindScope is the DataBinder that data source paths are resolved with.
+type bindScope struct {
+	dataSource types.Type // nil if unknown
+}
+
+type binding struct {
+	pos        token.Pos
+	expression string
+	scope      *bindScope // nil if not bound to a DataBinder, like for actions
+}
+
+// checker checks a declaration literal and the literals nested in it.
+type checker struct {
+	pass       *analysis.Pass
+	widget     *types.Interface
+	dataBinder types.Type
+	conditions map[string]bool
+
+	names       map[string]token.Pos
+	widgets     map[string]bool
+	dataBinders map[string]*bindScope
+	targets     map[string]token.Pos
+	expressions map[string]bool
+	functions   map[string]govaluate.ExpressionFunction
+	bindings    []binding
+
+	// incomplete is set if names may be declared outside of the literal.
+	incomplete bool
+
+	// anyFunction is set if the functions of expressions are not known.
+	anyFunction bool
+}
+
+func (c *checker) visit(expr ast.Expr, scope *bindScope) {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		c.visit(e.X, scope)
+
+	case *ast.UnaryExpr:
+		if e.Op == token.AND {
+			c.visit(e.X, scope)
+		}
+
+	case *ast.CompositeLit:
+		t := c.pass.TypesInfo.TypeOf(e)
+		if t == nil {
+			return
+		}
+
+		if named, ok := t.(*types.Named); ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == cplPath {
+			if _, ok := named.Underlying().(*types.Struct); ok {
+				c.visitStruct(e, named, scope)
+			}
+			return
+		}
+
+		for _, elt := range e.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				elt = kv.Value
+			}
+			c.visit(elt, scope)
+		}
+
+	case *ast.Ident:
+		if e.Name == "nil" {
+			return
+		}
+		c.visitOpaque(e)
+
+	default:
+		c.visitOpaque(e)
+	}
+}
+
+// visitOpaque notes widget declarations that are not literals, whose names
+// are not known.
+func (c *checker) visitOpaque(expr ast.Expr) {
+	t := c.pass.TypesInfo.TypeOf(expr)
+	for t != nil {
+		switch u := t.(type) {
+		case *types.Slice:
+			t = u.Elem()
+			continue
+
+		case *types.Array:
+			t = u.Elem()
+			continue
+
+		case *types.Pointer:
+			t = u.Elem()
+			continue
+		}
+		break
+	}
+
+	if t != nil && isCplType(t) && types.Implements(t, c.widget) {
+		c.incomplete = true
+	}
+}
+
+func (c *checker) visitStruct(lit *ast.CompositeLit, t *types.Named, scope *bindScope) {
+	fields := make(map[string]ast.Expr)
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			// Declarations are not meant to be written without keys.
+			return
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok {
+			fields[key.Name] = kv.Value
+		}
+	}
+
+	isWidget := types.Implements(t, c.widget) || hasField(t, "DataBinder", c.dataBinder)
+
+	if isWidget {
+		if value, ok := fields["Name"]; ok {
+			if name, ok := stringConstant(c.pass, value); ok && name != "" {
+				c.addName(name, value)
+				c.widgets[name] = true
+			} else if !ok {
+				c.incomplete = true
+			}
+		}
+
+		if value, ok := fields["DataBinder"]; ok {
+			if s := c.visitDataBinder(value); s != nil {
+				scope = s
+			}
+		}
+	}
+
+	propScope := scope
+	if !isWidget {
+		propScope = nil
+	}
+
+	for _, elt := range lit.Elts {
+		kv := elt.(*ast.KeyValueExpr)
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		switch key.Name {
+		case "AssignTo":
+			c.addTarget(kv.Value)
+
+		case "Expressions":
+			c.addExpressions(kv.Value)
+
+		case "Functions":
+			c.addFunctions(kv.Value)
+
+		case "DataBinder", "Name":
+			// Handled above.
+
+		default:
+			if call, ok := kv.Value.(*ast.CallExpr); ok && isCplFunc(c.pass, call, "Bind") {
+				if len(call.Args) > 0 {
+					if expression, ok := stringConstant(c.pass, call.Args[0]); ok && expression != "" {
+						c.bindings = append(c.bindings, binding{call.Args[0].Pos(), expression, propScope})
+					}
+				}
+				continue
+			}
+
+			c.visit(kv.Value, scope)
+		}
+	}
+}
+
+// visitDataBinder returns the scope of the DataBinder declared by expr, or
+// nil if it declares none.
+func (c *checker) visitDataBinder(expr ast.Expr) *bindScope {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		c.incomplete = true
+		return &bindScope{}
+	}
+
+	fields := make(map[string]ast.Expr)
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if key, ok := kv.Key.(*ast.Ident); ok {
+				fields[key.Name] = kv.Value
+			}
+		}
+	}
+
+	assignTo, hasAssignTo := fields["AssignTo"]
+	dataSource, hasDataSource := fields["DataSource"]
+	hasAssignTo = hasAssignTo && !isNil(c.pass, assignTo)
+	hasDataSource = hasDataSource && !isNil(c.pass, dataSource)
+	if !hasAssignTo && !hasDataSource {
+		return nil
+	}
+
+	if hasAssignTo {
+		c.addTarget(assignTo)
+	}
+
+	scope := &bindScope{}
+	if hasDataSource {
+		if t := c.pass.TypesInfo.TypeOf(dataSource); t != nil {
+			if _, ok := t.Underlying().(*types.Interface); !ok {
+				scope.dataSource = t
+			}
+		}
+	}
+
+	if value, ok := fields["Name"]; ok {
+		if name, ok := stringConstant(c.pass, value); ok && name != "" {
+			c.addName(name, value)
+			c.dataBinders[name] = scope
+		} else if !ok {
+			c.incomplete = true
+		}
+	}
+
+	return scope
+}
+
+func (c *checker) addName(name string, expr ast.Expr) {
+	if pos, ok := c.names[name]; ok {
+		c.pass.Reportf(expr.Pos(), "duplicate Name %q, also used at line %d", name, c.pass.Fset.Position(pos).Line)
+		return
+	}
+
+	c.names[name] = expr.Pos()
+}
+
+func (c *checker) addTarget(expr ast.Expr) {
+	unary, ok := expr.(*ast.UnaryExpr)
+	if !ok || unary.Op != token.AND {
+		return
+	}
+
+	var key string
+	if id, ok := unary.X.(*ast.Ident); ok {
+		obj := c.pass.TypesInfo.ObjectOf(id)
+		if obj == nil {
+			return
+		}
+		key = id.Name + "@" + c.pass.Fset.Position(obj.Pos()).String()
+	} else {
+		key = types.ExprString(unary.X)
+	}
+
+	if pos, ok := c.targets[key]; ok {
+		c.pass.Reportf(expr.Pos(), "AssignTo target %s is also used at line %d", types.ExprString(unary.X), c.pass.Fset.Position(pos).Line)
+		return
+	}
+
+	c.targets[key] = expr.Pos()
+}
+
+// addExpressions adds the names of the expressions returned by a function
+// literal like func() map[string]walk.Expression { return map[...]...{...} }.
+func (c *checker) addExpressions(expr ast.Expr) {
+	if isNil(c.pass, expr) {
+		return
+	}
+
+	fn, ok := expr.(*ast.FuncLit)
+	if !ok {
+		c.incomplete = true
+		return
+	}
+
+	for _, stmt := range fn.Body.List {
+		ret, ok := stmt.(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 {
+			continue
+		}
+
+		lit, ok := ret.Results[0].(*ast.CompositeLit)
+		if !ok || !c.addKeys(lit, func(name string) { c.expressions[name] = true }) {
+			c.incomplete = true
+		}
+		return
+	}
+
+	c.incomplete = true
+}
+
+func (c *checker) addFunctions(expr ast.Expr) {
+	if isNil(c.pass, expr) {
+		return
+	}
+
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok || !c.addKeys(lit, func(name string) { c.functions[name] = anyFunction }) {
+		c.anyFunction = true
+	}
+}
+
+// addKeys calls add with the keys of the map literal lit and reports
+// whether they all are constants.
+func (c *checker) addKeys(lit *ast.CompositeLit, add func(name string)) bool {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return false
+		}
+
+		name, ok := stringConstant(c.pass, kv.Key)
+		if !ok {
+			return false
+		}
+		add(name)
+	}
+
+	return true
+}
+
+func anyFunction(args ...interface{}) (interface{}, error) {
+	return nil, nil
+}
+
+var callRE = regexp.MustCompile(`([A-Za-z][0-9A-Za-z_]*)\s*\(`)
+
+func (c *checker) checkBindings() {
+	for _, b := range c.bindings {
+		functions := c.functions
+		if c.anyFunction {
+			functions = make(map[string]govaluate.ExpressionFunction)
+			for _, m := range callRE.FindAllStringSubmatch(b.expression, -1) {
+				functions[m[1]] = anyFunction
+			}
+		}
+
+		e, err := bindexpr.Parse(b.expression, functions)
+		if err != nil {
+			c.pass.Reportf(b.pos, "%s", err)
+			continue
+		}
+
+		if e.IsPath && len(e.Vars) == 1 {
+			path := e.Vars[0]
+
+			if ok, err := c.checkVar(path); ok {
+				if err != nil {
+					c.pass.Reportf(b.pos, "Bind(%q): %s", b.expression, err)
+				}
+				continue
+			}
+
+			if b.scope == nil {
+				if !c.incomplete {
+					c.pass.Reportf(b.pos, "Bind(%q): %q is neither a widget property, a DataBinder path, an expression nor a condition", b.expression, path)
+				}
+				continue
+			}
+
+			if b.scope.dataSource != nil {
+				if err := checkPath(b.scope.dataSource, path); err != nil {
+					c.pass.Reportf(b.pos, "Bind(%q): %s", b.expression, err)
+				}
+			}
+			continue
+		}
+
+		for _, name := range e.Vars {
+			if ok, err := c.checkVar(name); !ok {
+				if !c.incomplete {
+					c.pass.Reportf(b.pos, "Bind(%q): unknown variable %q, data source paths must start with the Name of their DataBinder", b.expression, name)
+				}
+			} else if err != nil {
+				c.pass.Reportf(b.pos, "Bind(%q): %s", b.expression, err)
+			}
+		}
+	}
+}
+
+// checkVar reports whether name refers to a widget property, a path in a
+// named DataBinder, an expression or a condition, and checks paths in named
+// DataBinders.
+func (c *checker) checkVar(name string) (bool, error) {
+	first, rest := bindexpr.SplitPath(name)
+
+	switch {
+	case c.expressions[first]:
+		return true, nil
+
+	case rest == "":
+		return c.conditions[first], nil
+
+	case c.widgets[first]:
+		return true, nil
+	}
+
+	if scope, ok := c.dataBinders[first]; ok {
+		if scope.dataSource != nil {
+			if err := checkPath(scope.dataSource, rest); err != nil {
+				return true, err
+			}
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func isNil(pass *analysis.Pass, expr ast.Expr) bool {
+	return pass.TypesInfo.Types[expr].IsNil()
+}
+
+func hasField(t types.Type, name string, fieldType types.Type) bool {
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+
+	for i := 0; i < st.NumFields(); i++ {
+		if f := st.Field(i); f.Name() == name && types.Identical(f.Type(), fieldType) {
+			return true
+		}
+	}
+
+	return false
+}
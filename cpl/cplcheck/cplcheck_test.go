@@ -0,0 +1,178 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cplcheck
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// The tests type-check the packages in testdata/src themselves, like
+// analysistest would, and compare the diagnostics with the "// want"
+// comments of the files.
+
+func TestAnalyzer(t *testing.T) {
+	fset := token.NewFileSet()
+
+	cplFiles := parseDir(t, fset, filepath.Join("testdata", "src", "github.com", "xackery", "wlk", "cpl"))
+	cplPkg := typeCheck(t, fset, cplPath, cplFiles, nil)
+
+	files := parseDir(t, fset, filepath.Join("testdata", "src", "a"))
+	info := &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Implicits:  make(map[ast.Node]types.Object),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+		Scopes:     make(map[ast.Node]*types.Scope),
+	}
+	pkg := typeCheck(t, fset, "a", files, info, cplPkg)
+
+	var diagnostics []analysis.Diagnostic
+	pass := &analysis.Pass{
+		Analyzer:  Analyzer,
+		Fset:      fset,
+		Files:     files,
+		Pkg:       pkg,
+		TypesInfo: info,
+		ResultOf: map[*analysis.Analyzer]interface{}{
+			inspect.Analyzer: inspector.New(files),
+		},
+		Report: func(d analysis.Diagnostic) {
+			diagnostics = append(diagnostics, d)
+		},
+	}
+
+	if _, err := Analyzer.Run(pass); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	wants := wantComments(t, fset, files)
+
+	for _, d := range diagnostics {
+		posn := fset.Position(d.Pos)
+		key := lineKey(posn)
+
+		matched := false
+		for i, re := range wants[key] {
+			if re.MatchString(d.Message) {
+				wants[key] = append(wants[key][:i], wants[key][i+1:]...)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			t.Errorf("%s: unexpected diagnostic: %s", posn, d.Message)
+		}
+	}
+
+	var missing []string
+	for key, res := range wants {
+		for _, re := range res {
+			missing = append(missing, fmt.Sprintf("%s: no diagnostic matching %q", key, re))
+		}
+	}
+	sort.Strings(missing)
+	for _, m := range missing {
+		t.Error(m)
+	}
+}
+
+func parseDir(t *testing.T, fset *token.FileSet, dir string) []*ast.File {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var files []*ast.File
+	for _, path := range paths {
+		f, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+
+	return files
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
+
+func typeCheck(t *testing.T, fset *token.FileSet, path string, files []*ast.File, info *types.Info, imports ...*types.Package) *types.Package {
+	std := importer.ForCompiler(fset, "source", nil)
+
+	conf := types.Config{
+		Importer: importerFunc(func(path string) (*types.Package, error) {
+			for _, pkg := range imports {
+				if pkg.Path() == path {
+					return pkg, nil
+				}
+			}
+			return std.Import(path)
+		}),
+		Sizes: types.SizesFor("gc", "amd64"),
+	}
+
+	pkg, err := conf.Check(path, fset, files, info)
+	if err != nil {
+		t.Fatalf("type-checking %s: %v", path, err)
+	}
+
+	return pkg
+}
+
+var wantRE = regexp.MustCompile("// want `([^`]*)`")
+
+// wantComments returns the expected diagnostics by file and line.
+func wantComments(t *testing.T, fset *token.FileSet, files []*ast.File) map[string][]*regexp.Regexp {
+	wants := make(map[string][]*regexp.Regexp)
+
+	for _, f := range files {
+		for _, group := range f.Comments {
+			for _, c := range group.List {
+				if !strings.HasPrefix(c.Text, "// want ") {
+					continue
+				}
+
+				m := wantRE.FindStringSubmatch(c.Text)
+				if m == nil {
+					t.Fatalf("%s: malformed want comment", fset.Position(c.Pos()))
+				}
+
+				re, err := regexp.Compile(m[1])
+				if err != nil {
+					t.Fatalf("%s: %v", fset.Position(c.Pos()), err)
+				}
+
+				key := lineKey(fset.Position(c.Pos()))
+				wants[key] = append(wants[key], re)
+			}
+		}
+	}
+
+	return wants
+}
+
+func lineKey(posn token.Position) string {
+	return posn.Filename + ":" + strconv.Itoa(posn.Line)
+}
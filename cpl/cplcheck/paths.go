@@ -0,0 +1,136 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cplcheck
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/xackery/wlk/cpl/bindexpr"
+)
+
+// checkPath checks that path may be resolved in values of type t, like
+// bindexpr.CheckPath does for reflect types.
+func checkPath(t types.Type, path string) error {
+	fullPath := path
+
+	for path != "" && t != nil {
+		var name string
+		name, path = bindexpr.SplitPath(path)
+
+		for {
+			p, ok := t.Underlying().(*types.Pointer)
+			if !ok {
+				break
+			}
+			t = p.Elem()
+		}
+
+		switch u := t.Underlying().(type) {
+		case *types.Interface:
+			return nil
+
+		case *types.Map:
+			if b, ok := u.Key().Underlying().(*types.Basic); !ok || b.Kind() != types.String {
+				return fmt.Errorf("cannot resolve %q in %q, %s has no string keys", name, fullPath, typeString(t))
+			}
+			t = u.Elem()
+
+		case *types.Struct:
+			var pkg *types.Package
+			if named, ok := t.(*types.Named); ok {
+				pkg = named.Obj().Pkg()
+			}
+
+			obj, _, _ := types.LookupFieldOrMethod(t, true, pkg, name)
+
+			var sig *types.Signature
+			switch obj := obj.(type) {
+			case *types.Var:
+				if s, ok := obj.Type().Underlying().(*types.Signature); ok {
+					sig = s
+				} else {
+					t = obj.Type()
+				}
+
+			case *types.Func:
+				sig = obj.Type().(*types.Signature)
+				if sig.Params().Len() > 0 {
+					return fmt.Errorf("cannot call method %s of %s in %q, it takes arguments", name, typeString(t), fullPath)
+				}
+
+			default:
+				return fmt.Errorf("unknown field or method %q in %s%s", name, typeString(t), bindexpr.Suggestion(name, members(t)))
+			}
+
+			if sig != nil {
+				var err error
+				if t, err = resultType(sig, name); err != nil {
+					return err
+				}
+			}
+
+		default:
+			return fmt.Errorf("cannot resolve %q in %q, %s is not a struct or map", name, fullPath, typeString(t))
+		}
+	}
+
+	return nil
+}
+
+// resultType returns the type of the value that walk.DataBinder takes from
+// calls of functions of type sig.
+func resultType(sig *types.Signature, name string) (types.Type, error) {
+	results := sig.Results()
+
+	switch results.Len() {
+	case 1:
+		return results.At(0).Type(), nil
+
+	case 2:
+		if !types.Identical(results.At(1).Type(), types.Universe.Lookup("error").Type()) {
+			return nil, fmt.Errorf("second result of %s must be an error", name)
+		}
+		return results.At(0).Type(), nil
+	}
+
+	return nil, fmt.Errorf("%s must return a value plus optionally an error", name)
+}
+
+// members returns the names of the fields and methods of t, for suggestions.
+func members(t types.Type) []string {
+	var names []string
+
+	var addFields func(t types.Type)
+	addFields = func(t types.Type) {
+		st, ok := t.Underlying().(*types.Struct)
+		if !ok {
+			return
+		}
+
+		for i := 0; i < st.NumFields(); i++ {
+			f := st.Field(i)
+			names = append(names, f.Name())
+			if f.Embedded() {
+				addFields(f.Type())
+			}
+		}
+	}
+	addFields(t)
+
+	ms := types.NewMethodSet(types.NewPointer(t))
+	for i := 0; i < ms.Len(); i++ {
+		names = append(names, ms.At(i).Obj().Name())
+	}
+
+	return names
+}
+
+// typeString formats t like reflect does, with package names.
+func typeString(t types.Type) string {
+	return types.TypeString(t, func(pkg *types.Package) string {
+		return pkg.Name()
+	})
+}
@@ -0,0 +1,100 @@
+package a
+
+import "github.com/xackery/wlk/cpl"
+
+type Address struct {
+	City string
+}
+
+type Person struct {
+	Name    string
+	Age     int
+	Address *Address
+}
+
+func (p *Person) Adult() bool { return p.Age >= 18 }
+
+func (p *Person) Lookup() (*Address, error) { return p.Address, nil }
+
+func init() {
+	cpl.MustRegisterCondition("isAdmin", nil)
+}
+
+func form(p *Person) cpl.MainWindow {
+	var nameLE, cityLE *cpl.LineEditW
+
+	return cpl.MainWindow{
+		Title: cpl.Bind("Name"),
+		DataBinder: cpl.DataBinder{
+			Name:       "person",
+			DataSource: p,
+		},
+		Expressions: func() map[string]cpl.Expression {
+			return map[string]cpl.Expression{"ready": nil}
+		},
+		Functions: map[string]func(args ...interface{}) (interface{}, error){
+			"len": nil,
+		},
+		MenuItems: []cpl.MenuItem{
+			cpl.Action{Enabled: cpl.Bind("isAdmin")},
+			cpl.Action{Enabled: cpl.Bind("ready && isAdmin")},
+			cpl.Action{Enabled: cpl.Bind("Adult")}, // want `Bind\("Adult"\): "Adult" is neither a widget property, a DataBinder path, an expression nor a condition`
+		},
+		Children: []cpl.Widget{
+			cpl.LineEdit{
+				AssignTo:  &nameLE,
+				Name:      "nameLE",
+				Alignment: cpl.AlignFar,
+				Text:      cpl.Bind("Nmae"), // want `Bind\("Nmae"\): unknown field or method "Nmae" in a.Person, did you mean "Name"\?`
+			},
+			cpl.LineEdit{
+				AssignTo: &nameLE,  // want `AssignTo target nameLE is also used at line 45`
+				Name:     "nameLE", // want `duplicate Name "nameLE", also used at line 46`
+				Text:     cpl.Bind("Address.City"),
+				Enabled:  cpl.Bind("Address.Town"), // want `Bind\("Address.Town"\): unknown field or method "Town" in a.Address`
+			},
+			cpl.LineEdit{
+				AssignTo: &cityLE,
+				Text:     cpl.Bind("Lookup.City"),
+				Enabled:  cpl.Bind("nameLE.Text != '' && person.Age >= 18 && len(person.Name) > 0"),
+			},
+			cpl.PushButton{
+				Text:    cpl.Bind("Age >"),     // want `invalid expression "Age >": .*`
+				Enabled: cpl.Bind("Age >= 18"), // want `Bind\("Age >= 18"\): unknown variable "Age", data source paths must start with the Name of their DataBinder`
+			},
+			cpl.PushButton{
+				Enabled: cpl.Bind("person.Agee > 0 || isAdmin"), // want `Bind\("person.Agee > 0 \|\| isAdmin"\): unknown field or method "Agee" in a.Person, did you mean "Age"\?`
+				OnClicked: func() {
+					_ = cpl.Composite{
+						Name: "nameLE",
+						Children: []cpl.Widget{
+							cpl.PushButton{Name: "inner", Text: cpl.Bind("nameLE.Text")},
+							cpl.PushButton{Name: "inner"}, // want `duplicate Name "inner", also used at line 71`
+						},
+					}
+				},
+			},
+			cpl.Composite{
+				DataBinder: cpl.DataBinder{DataSource: &Address{}},
+				Children: []cpl.Widget{
+					cpl.LineEdit{Text: cpl.Bind("City")},
+					cpl.LineEdit{Text: cpl.Bind("Name")}, // want `Bind\("Name"\): unknown field or method "Name" in a.Address`
+				},
+			},
+			cpl.Composite{
+				DataBinder: cpl.DataBinder{DataSource: interface{}(p)},
+				Children: []cpl.Widget{
+					cpl.LineEdit{Text: cpl.Bind("Anything")},
+				},
+			},
+		},
+	}
+}
+
+// children are declared elsewhere, so names are not known.
+func partial(children []cpl.Widget) cpl.Composite {
+	return cpl.Composite{
+		Enabled:  cpl.Bind("other.Enabled && isAdmin"),
+		Children: children,
+	}
+}
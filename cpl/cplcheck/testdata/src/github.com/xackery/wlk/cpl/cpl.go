@@ -0,0 +1,95 @@
+// Package cpl is a stand-in for the declarations of package cpl, which only
+// builds for windows.
+package cpl
+
+type Builder struct{}
+
+type Property interface{}
+
+type bindData struct {
+	expression string
+}
+
+func Bind(expression string, validators ...Validator) Property {
+	return bindData{expression}
+}
+
+type Condition interface{}
+
+func MustRegisterCondition(name string, condition Condition) {}
+
+type Expression interface{}
+
+type Validator interface{}
+
+type Widget interface {
+	Create(builder *Builder) error
+}
+
+type MenuItem interface{}
+
+type Alignment1D uint
+
+const AlignFar Alignment1D = 2
+
+type DataBinderW struct{}
+
+type DataBinder struct {
+	AssignTo   **DataBinderW
+	DataSource interface{}
+	Name       string
+}
+
+type MainWindowW struct{}
+
+type MainWindow struct {
+	AssignTo    **MainWindowW
+	Children    []Widget
+	DataBinder  DataBinder
+	Expressions func() map[string]Expression
+	Functions   map[string]func(args ...interface{}) (interface{}, error)
+	MenuItems   []MenuItem
+	Name        string
+	Title       Property
+}
+
+type CompositeW struct{}
+
+type Composite struct {
+	AssignTo   **CompositeW
+	Children   []Widget
+	DataBinder DataBinder
+	Enabled    Property
+	Name       string
+}
+
+func (Composite) Create(builder *Builder) error { return nil }
+
+type LineEditW struct{}
+
+type LineEdit struct {
+	AssignTo  **LineEditW
+	Alignment Alignment1D
+	Enabled   Property
+	Name      string
+	Text      Property
+}
+
+func (LineEdit) Create(builder *Builder) error { return nil }
+
+type PushButtonW struct{}
+
+type PushButton struct {
+	AssignTo  **PushButtonW
+	Enabled   Property
+	Name      string
+	OnClicked func()
+	Text      Property
+}
+
+func (PushButton) Create(builder *Builder) error { return nil }
+
+type Action struct {
+	Enabled Property
+	Text    string
+}
@@ -48,12 +48,15 @@ type CustomWidget struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	ArrowGroup         string
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
 	Row                int
 	RowSpan            int
 	StretchFactor      int
+	TabIndex           int
+	TabStop            Property
 
 	// CustomWidget
 
@@ -42,12 +42,15 @@ type DateEdit struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	ArrowGroup         string
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
 	Row                int
 	RowSpan            int
 	StretchFactor      int
+	TabIndex           int
+	TabStop            Property
 
 	// DateEdit
 
@@ -45,11 +45,13 @@ type Dialog struct {
 
 	// Form
 
-	Expressions func() map[string]walk.Expression
-	Functions   map[string]func(args ...interface{}) (interface{}, error)
-	Icon        Property
-	Title       Property
-	Size        Size
+	Expressions    func() map[string]walk.Expression
+	Functions      map[string]func(args ...interface{}) (interface{}, error)
+	Icon           Property
+	Mirrored       Property // bool or Condition, see walk.FormBase.SetMirrored
+	OnFocusChanged walk.FocusChangedEventHandler
+	Title          Property
+	Size           Size
 
 	// Dialog
 
@@ -105,8 +107,9 @@ func (d Dialog) Create(owner walk.Form) error {
 		Layout:     d.Layout,
 
 		// Form
-		Icon:  d.Icon,
-		Title: d.Title,
+		Icon:     d.Icon,
+		Mirrored: d.Mirrored,
+		Title:    d.Title,
 	}
 
 	var db *walk.DataBinder
@@ -154,6 +157,10 @@ func (d Dialog) Create(owner walk.Form) error {
 			}
 		}
 
+		if d.OnFocusChanged != nil {
+			w.FocusChanged().Attach(d.OnFocusChanged)
+		}
+
 		if d.Expressions != nil {
 			for name, expr := range d.Expressions() {
 				builder.expressions[name] = expr
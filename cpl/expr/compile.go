@@ -0,0 +1,653 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package expr
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+var (
+	anyType    = reflect.TypeOf((*interface{})(nil)).Elem()
+	boolType   = reflect.TypeOf(false)
+	errorType  = reflect.TypeOf((*error)(nil)).Elem()
+	floatType  = reflect.TypeOf(float64(0))
+	intType    = reflect.TypeOf(0)
+	stringType = reflect.TypeOf("")
+)
+
+type frame struct {
+	root interface{}
+	vars []interface{}
+}
+
+type evalFunc func(f *frame) (interface{}, error)
+
+type compiled struct {
+	typ  reflect.Type
+	eval evalFunc
+}
+
+type compiler struct {
+	src      string
+	env      *Env
+	vars     []string
+	varIndex map[string]int
+}
+
+func (c *compiler) errorf(pos int, format string, args ...interface{}) *Error {
+	return errorf(c.src, pos, format, args...)
+}
+
+func (c *compiler) compile(n node) (*compiled, error) {
+	switch n := n.(type) {
+	case *literalNode:
+		val := n.val
+		return &compiled{reflect.TypeOf(val), func(*frame) (interface{}, error) { return val, nil }}, nil
+
+	case *pathNode:
+		return c.compilePath(n)
+
+	case *unaryNode:
+		return c.compileUnary(n)
+
+	case *binaryNode:
+		return c.compileBinary(n)
+
+	case *ternaryNode:
+		return c.compileTernary(n)
+
+	case *callNode:
+		return c.compileCall(n)
+
+	case *listNode:
+		return nil, c.errorf(n.p, "lists are only allowed after in")
+	}
+
+	panic("unexpected node")
+}
+
+// isDynamic reports whether values of type t are only known at runtime.
+func isDynamic(t reflect.Type) bool {
+	return t.Kind() == reflect.Interface
+}
+
+func isNumber(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+
+	case int:
+		return float64(v), true
+
+	case nil:
+		return 0, false
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), true
+
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+
+	return 0, false
+}
+
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return rv.IsNil()
+	}
+
+	return false
+}
+
+func equal(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		if fb, ok := toFloat(b); ok {
+			return fa == fb
+		}
+	}
+
+	if isNil(a) || isNil(b) {
+		return isNil(a) && isNil(b)
+	}
+
+	if ta := reflect.TypeOf(a); ta == reflect.TypeOf(b) && ta.Comparable() {
+		return a == b
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+func typeName(v interface{}) string {
+	if v == nil {
+		return "nil"
+	}
+
+	return reflect.TypeOf(v).String()
+}
+
+// number returns a function that evaluates x as a number.
+func (c *compiler) number(x *compiled, pos int, op string) (func(f *frame) (float64, error), error) {
+	if !isNumber(x.typ) && !isDynamic(x.typ) {
+		return nil, c.errorf(pos, "cannot use %s as number with %q", typeString(x.typ), op)
+	}
+
+	return func(f *frame) (float64, error) {
+		v, err := x.eval(f)
+		if err != nil {
+			return 0, err
+		}
+
+		n, ok := toFloat(v)
+		if !ok {
+			return 0, c.errorf(pos, "cannot use %s as number with %q", typeName(v), op)
+		}
+		return n, nil
+	}, nil
+}
+
+// boolean returns a function that evaluates x as a bool.
+func (c *compiler) boolean(x *compiled, pos int, op string) (func(f *frame) (bool, error), error) {
+	if x.typ.Kind() != reflect.Bool && !isDynamic(x.typ) {
+		return nil, c.errorf(pos, "cannot use %s as bool with %q", typeString(x.typ), op)
+	}
+
+	return func(f *frame) (bool, error) {
+		v, err := x.eval(f)
+		if err != nil {
+			return false, err
+		}
+
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Bool {
+			return false, c.errorf(pos, "cannot use %s as bool with %q", typeName(v), op)
+		}
+		return rv.Bool(), nil
+	}, nil
+}
+
+// text returns a function that evaluates x as a string.
+func (c *compiler) text(x *compiled, pos int, op string) (func(f *frame) (string, error), error) {
+	if x.typ.Kind() != reflect.String && !isDynamic(x.typ) {
+		return nil, c.errorf(pos, "cannot use %s as string with %q", typeString(x.typ), op)
+	}
+
+	return func(f *frame) (string, error) {
+		v, err := x.eval(f)
+		if err != nil {
+			return "", err
+		}
+
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.String {
+			return "", c.errorf(pos, "cannot use %s as string with %q", typeName(v), op)
+		}
+		return rv.String(), nil
+	}, nil
+}
+
+func (c *compiler) compileUnary(n *unaryNode) (*compiled, error) {
+	x, err := c.compile(n.x)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "!":
+		b, err := c.boolean(x, n.x.pos(), n.op)
+		if err != nil {
+			return nil, err
+		}
+		return &compiled{boolType, func(f *frame) (interface{}, error) {
+			v, err := b(f)
+			return !v, err
+		}}, nil
+
+	case "-":
+		num, err := c.number(x, n.x.pos(), n.op)
+		if err != nil {
+			return nil, err
+		}
+		return &compiled{floatType, func(f *frame) (interface{}, error) {
+			v, err := num(f)
+			return -v, err
+		}}, nil
+
+	default: // "~"
+		num, err := c.number(x, n.x.pos(), n.op)
+		if err != nil {
+			return nil, err
+		}
+		return &compiled{floatType, func(f *frame) (interface{}, error) {
+			v, err := num(f)
+			return float64(^int64(v)), err
+		}}, nil
+	}
+}
+
+func (c *compiler) compileBinary(n *binaryNode) (*compiled, error) {
+	x, err := c.compile(n.x)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.op == "in" {
+		return c.compileIn(n, x)
+	}
+
+	y, err := c.compile(n.y)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "&&", "||":
+		bx, err := c.boolean(x, n.x.pos(), n.op)
+		if err != nil {
+			return nil, err
+		}
+		by, err := c.boolean(y, n.y.pos(), n.op)
+		if err != nil {
+			return nil, err
+		}
+
+		and := n.op == "&&"
+		return &compiled{boolType, func(f *frame) (interface{}, error) {
+			v, err := bx(f)
+			if err != nil || v != and {
+				return v, err
+			}
+			return by(f)
+		}}, nil
+
+	case "??":
+		return &compiled{commonType(x.typ, y.typ), func(f *frame) (interface{}, error) {
+			v, err := x.eval(f)
+			if err != nil || !isNil(v) {
+				return v, err
+			}
+			return y.eval(f)
+		}}, nil
+
+	case "+":
+		return c.compileAdd(n, x, y)
+
+	case "-", "*", "/", "%", "**", "&", "|", "^", "<<", ">>":
+		return c.compileArithmetic(n, x, y)
+
+	case "==", "!=":
+		if !isDynamic(x.typ) && !isDynamic(y.typ) && x.typ != y.typ && !(isNumber(x.typ) && isNumber(y.typ)) {
+			return nil, c.errorf(n.p, "cannot compare %s and %s with %q", typeString(x.typ), typeString(y.typ), n.op)
+		}
+
+		eq := n.op == "=="
+		return &compiled{boolType, func(f *frame) (interface{}, error) {
+			a, err := x.eval(f)
+			if err != nil {
+				return nil, err
+			}
+			b, err := y.eval(f)
+			if err != nil {
+				return nil, err
+			}
+			return equal(a, b) == eq, nil
+		}}, nil
+
+	case "<", "<=", ">", ">=":
+		return c.compileOrder(n, x, y)
+
+	default: // "=~", "!~"
+		return c.compileMatch(n, x, y)
+	}
+}
+
+// commonType returns the type of values that are either of type a or b.
+func commonType(a, b reflect.Type) reflect.Type {
+	if a == b {
+		return a
+	}
+
+	return anyType
+}
+
+func (c *compiler) compileAdd(n *binaryNode, x, y *compiled) (*compiled, error) {
+	isString := func(t reflect.Type) bool { return t.Kind() == reflect.String }
+	canConcat := func(t reflect.Type) bool {
+		return isString(t) || isNumber(t) || t.Kind() == reflect.Bool || isDynamic(t)
+	}
+
+	switch {
+	case isString(x.typ) || isString(y.typ):
+		if !canConcat(x.typ) || !canConcat(y.typ) {
+			return nil, c.errorf(n.p, "cannot add %s and %s", typeString(x.typ), typeString(y.typ))
+		}
+		return &compiled{stringType, func(f *frame) (interface{}, error) {
+			a, err := x.eval(f)
+			if err != nil {
+				return nil, err
+			}
+			b, err := y.eval(f)
+			if err != nil {
+				return nil, err
+			}
+			return fmt.Sprint(a) + fmt.Sprint(b), nil
+		}}, nil
+
+	case isDynamic(x.typ) || isDynamic(y.typ):
+		if !canConcat(x.typ) || !canConcat(y.typ) {
+			return nil, c.errorf(n.p, "cannot add %s and %s", typeString(x.typ), typeString(y.typ))
+		}
+		return &compiled{anyType, func(f *frame) (interface{}, error) {
+			a, err := x.eval(f)
+			if err != nil {
+				return nil, err
+			}
+			b, err := y.eval(f)
+			if err != nil {
+				return nil, err
+			}
+
+			if fa, ok := toFloat(a); ok {
+				if fb, ok := toFloat(b); ok {
+					return fa + fb, nil
+				}
+			}
+			_, aString := a.(string)
+			_, bString := b.(string)
+			if aString || bString {
+				return fmt.Sprint(a) + fmt.Sprint(b), nil
+			}
+			return nil, c.errorf(n.p, "cannot add %s and %s", typeName(a), typeName(b))
+		}}, nil
+	}
+
+	return c.compileArithmetic(n, x, y)
+}
+
+func (c *compiler) compileArithmetic(n *binaryNode, x, y *compiled) (*compiled, error) {
+	nx, err := c.number(x, n.x.pos(), n.op)
+	if err != nil {
+		return nil, err
+	}
+	ny, err := c.number(y, n.y.pos(), n.op)
+	if err != nil {
+		return nil, err
+	}
+
+	var op func(a, b float64) float64
+	switch n.op {
+	case "+":
+		op = func(a, b float64) float64 { return a + b }
+	case "-":
+		op = func(a, b float64) float64 { return a - b }
+	case "*":
+		op = func(a, b float64) float64 { return a * b }
+	case "/":
+		op = func(a, b float64) float64 { return a / b }
+	case "%":
+		op = math.Mod
+	case "**":
+		op = math.Pow
+	case "&":
+		op = func(a, b float64) float64 { return float64(int64(a) & int64(b)) }
+	case "|":
+		op = func(a, b float64) float64 { return float64(int64(a) | int64(b)) }
+	case "^":
+		op = func(a, b float64) float64 { return float64(int64(a) ^ int64(b)) }
+	case "<<":
+		op = func(a, b float64) float64 { return float64(int64(a) << uint64(b)) }
+	case ">>":
+		op = func(a, b float64) float64 { return float64(int64(a) >> uint64(b)) }
+	}
+
+	return &compiled{floatType, func(f *frame) (interface{}, error) {
+		a, err := nx(f)
+		if err != nil {
+			return nil, err
+		}
+		b, err := ny(f)
+		if err != nil {
+			return nil, err
+		}
+		return op(a, b), nil
+	}}, nil
+}
+
+func (c *compiler) compileOrder(n *binaryNode, x, y *compiled) (*compiled, error) {
+	var cmp func(a, b interface{}) (int, bool)
+
+	numbers := func(a, b interface{}) (int, bool) {
+		fa, ok := toFloat(a)
+		if !ok {
+			return 0, false
+		}
+		fb, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case fa < fb:
+			return -1, true
+		case fa > fb:
+			return 1, true
+		}
+		return 0, true
+	}
+	switch {
+	case isDynamic(x.typ) || isDynamic(y.typ):
+		cmp = dynamicOrder(numbers)
+
+	case isNumber(x.typ) && isNumber(y.typ):
+		cmp = numbers
+
+	case x.typ.Kind() == reflect.String && y.typ.Kind() == reflect.String:
+		cmp = func(a, b interface{}) (int, bool) {
+			return strings.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String()), true
+		}
+
+	default:
+		return nil, c.errorf(n.p, "cannot compare %s and %s with %q", typeString(x.typ), typeString(y.typ), n.op)
+	}
+
+	op := n.op
+	return &compiled{boolType, func(f *frame) (interface{}, error) {
+		a, err := x.eval(f)
+		if err != nil {
+			return nil, err
+		}
+		b, err := y.eval(f)
+		if err != nil {
+			return nil, err
+		}
+
+		r, ok := cmp(a, b)
+		if !ok {
+			return nil, c.errorf(n.p, "cannot compare %s and %s with %q", typeName(a), typeName(b), op)
+		}
+
+		switch op {
+		case "<":
+			return r < 0, nil
+		case "<=":
+			return r <= 0, nil
+		case ">":
+			return r > 0, nil
+		}
+		return r >= 0, nil
+	}}, nil
+}
+
+// dynamicOrder returns a comparison of values whose types are only known at
+// runtime: numbers compare by value and strings lexically.
+func dynamicOrder(numbers func(a, b interface{}) (int, bool)) func(a, b interface{}) (int, bool) {
+	return func(a, b interface{}) (int, bool) {
+		if r, ok := numbers(a, b); ok {
+			return r, true
+		}
+
+		ra, rb := reflect.ValueOf(a), reflect.ValueOf(b)
+		if ra.Kind() == reflect.String && rb.Kind() == reflect.String {
+			return strings.Compare(ra.String(), rb.String()), true
+		}
+
+		return 0, false
+	}
+}
+
+func (c *compiler) compileMatch(n *binaryNode, x, y *compiled) (*compiled, error) {
+	sx, err := c.text(x, n.x.pos(), n.op)
+	if err != nil {
+		return nil, err
+	}
+
+	var re func(f *frame) (*regexp.Regexp, error)
+
+	if lit, ok := n.y.(*literalNode); ok {
+		pattern, ok := lit.val.(string)
+		if !ok {
+			return nil, c.errorf(lit.p, "cannot use %s as pattern with %q", typeName(lit.val), n.op)
+		}
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, c.errorf(lit.p, "invalid pattern: %s", err)
+		}
+		re = func(*frame) (*regexp.Regexp, error) { return compiled, nil }
+	} else {
+		sy, err := c.text(y, n.y.pos(), n.op)
+		if err != nil {
+			return nil, err
+		}
+		re = func(f *frame) (*regexp.Regexp, error) {
+			pattern, err := sy(f)
+			if err != nil {
+				return nil, err
+			}
+			compiled, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, c.errorf(n.y.pos(), "invalid pattern: %s", err)
+			}
+			return compiled, nil
+		}
+	}
+
+	match := n.op == "=~"
+	return &compiled{boolType, func(f *frame) (interface{}, error) {
+		s, err := sx(f)
+		if err != nil {
+			return nil, err
+		}
+		r, err := re(f)
+		if err != nil {
+			return nil, err
+		}
+		return r.MatchString(s) == match, nil
+	}}, nil
+}
+
+func (c *compiler) compileIn(n *binaryNode, x *compiled) (*compiled, error) {
+	list := n.y.(*listNode)
+
+	elems := make([]*compiled, len(list.elems))
+	for i, elem := range list.elems {
+		e, err := c.compile(elem)
+		if err != nil {
+			return nil, err
+		}
+		elems[i] = e
+	}
+
+	return &compiled{boolType, func(f *frame) (interface{}, error) {
+		v, err := x.eval(f)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, elem := range elems {
+			e, err := elem.eval(f)
+			if err != nil {
+				return nil, err
+			}
+			if equal(v, e) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}}, nil
+}
+
+func (c *compiler) compileTernary(n *ternaryNode) (*compiled, error) {
+	cond, err := c.compile(n.cond)
+	if err != nil {
+		return nil, err
+	}
+	b, err := c.boolean(cond, n.cond.pos(), "?")
+	if err != nil {
+		return nil, err
+	}
+
+	x, err := c.compile(n.x)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.y == nil {
+		// cond ? x evaluates to nil if cond is false, as in govaluate.
+		typ := anyType
+		switch x.typ.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func:
+			typ = x.typ
+		}
+		return &compiled{typ, func(f *frame) (interface{}, error) {
+			ok, err := b(f)
+			if err != nil || !ok {
+				return nil, err
+			}
+			return x.eval(f)
+		}}, nil
+	}
+
+	y, err := c.compile(n.y)
+	if err != nil {
+		return nil, err
+	}
+
+	return &compiled{commonType(x.typ, y.typ), func(f *frame) (interface{}, error) {
+		ok, err := b(f)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return x.eval(f)
+		}
+		return y.eval(f)
+	}}, nil
+}
@@ -0,0 +1,227 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package expr compiles the expressions of cpl data bindings, like
+// "person.Age >= 18 && !nameEdit.ReadOnly", into typed programs.
+//
+// An expression is compiled once, against the types of the data source and
+// of the variables it refers to, so misspelled names and mismatched operands
+// are reported with their position, instead of evaluating to nil. Paths are
+// resolved to field and method indices at compile time. Package cpl logs
+// unresolved names and leaves their property unbound, see cpl.Bind.
+//
+// The syntax is that of govaluate, which cpl used before:
+//
+//	literals        1.5  0xFF  'text'  "text"  true  false
+//	paths           Name  person.Address.City  [Full Name]
+//	prefix          -x  !x  ~x
+//	arithmetic      x + y  x - y  x * y  x / y  x % y  x ** y
+//	bitwise         x & y  x | y  x ^ y  x << y  x >> y
+//	comparison      x == y  x != y  x < y  x <= y  x > y  x >= y
+//	regexp          x =~ 'a.*'  x !~ 'a.*'
+//	membership      x in (1, 2, 3)
+//	logical         x && y  x || y
+//	conditional     c ? x : y  c ? x
+//	null-coalescing x ?? y
+//	functions       format('%d items', count)  len(Name)  f(x, y)
+//
+// Arithmetic on numbers yields float64, as in govaluate; + concatenates if
+// either operand is a string. Values of interface types are checked when
+// the program is run.
+package expr
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Env describes what the paths and function calls of an expression refer to.
+type Env struct {
+	// Root is the type of the value that paths are resolved in, unless they
+	// start with a variable, like the type of a DataSource. If it is nil,
+	// all paths must start with a variable.
+	Root reflect.Type
+
+	// Vars are the types of the variables, by name. A name may contain dots,
+	// like "nameEdit.Text", in which case it matches the start of paths.
+	Vars map[string]reflect.Type
+
+	// Lookup, if not nil, returns the types of variables that are not in
+	// Vars. It is called with the paths of an expression and their
+	// prefixes, longest first.
+	Lookup func(name string) (reflect.Type, bool)
+
+	// Functions are the Go functions that expressions may call, by name.
+	// They may take any arguments and must return a value, optionally
+	// followed by an error.
+	Functions map[string]interface{}
+}
+
+// Program is a compiled expression.
+type Program struct {
+	src  string
+	typ  reflect.Type
+	vars []string
+	eval evalFunc
+}
+
+// Compile compiles the expression src in env, which may be nil.
+func Compile(src string, env *Env) (*Program, error) {
+	n, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if env == nil {
+		env = new(Env)
+	}
+
+	c := &compiler{src: src, env: env, varIndex: make(map[string]int)}
+
+	x, err := c.compile(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Program{src: src, typ: x.typ, vars: c.vars, eval: x.eval}, nil
+}
+
+// String returns the source of the expression.
+func (p *Program) String() string {
+	return p.src
+}
+
+// Type returns the type of the values of the program. It is the empty
+// interface type if that is only known when the program is run.
+func (p *Program) Type() reflect.Type {
+	return p.typ
+}
+
+// Vars returns the names of the variables the program refers to, in the
+// order that Eval takes their values.
+func (p *Program) Vars() []string {
+	return p.vars
+}
+
+// Eval runs the program with root, the value of the Env.Root type, and the
+// values of the variables in the order of Vars. Paths through nil pointers
+// evaluate to nil.
+func (p *Program) Eval(root interface{}, vars ...interface{}) (interface{}, error) {
+	if len(vars) != len(p.vars) {
+		return nil, fmt.Errorf("expr: got %d variables, want %d", len(vars), len(p.vars))
+	}
+
+	return p.eval(&frame{root: root, vars: vars})
+}
+
+// Error is an error in an expression.
+type Error struct {
+	// Expr is the expression.
+	Expr string
+
+	// Pos is the byte offset of the error in Expr.
+	Pos int
+
+	// Unknown is the unresolved name, if the error is about one.
+	Unknown string
+
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Pos+1, e.Msg)
+}
+
+func errorf(src string, pos int, format string, args ...interface{}) *Error {
+	return &Error{Expr: src, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Ref is a name in an expression.
+type Ref struct {
+	Name string
+
+	// Pos is the byte offset of the name in the expression.
+	Pos int
+}
+
+// Info describes the names an expression refers to, see Parse.
+type Info struct {
+	// Paths are the paths, like "Age" or "nameEdit.ReadOnly", in order of
+	// appearance and without duplicates.
+	Paths []Ref
+
+	// Funcs are the names of the functions called, in order of appearance.
+	Funcs []Ref
+
+	// IsPath reports whether the expression is a single path.
+	IsPath bool
+}
+
+// Parse checks the syntax of the expression src and returns the names it
+// refers to, for tools that check expressions without an Env.
+func Parse(src string) (*Info, error) {
+	n, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	info := new(Info)
+	_, info.IsPath = n.(*pathNode)
+
+	seen := make(map[string]bool)
+
+	var visit func(n node)
+	visit = func(n node) {
+		switch n := n.(type) {
+		case *pathNode:
+			if name := n.String(); !seen[name] {
+				seen[name] = true
+				info.Paths = append(info.Paths, Ref{name, n.p})
+			}
+
+		case *unaryNode:
+			visit(n.x)
+
+		case *binaryNode:
+			visit(n.x)
+			visit(n.y)
+
+		case *ternaryNode:
+			visit(n.cond)
+			visit(n.x)
+			if n.y != nil {
+				visit(n.y)
+			}
+
+		case *callNode:
+			info.Funcs = append(info.Funcs, Ref{n.name, n.p})
+			for _, arg := range n.args {
+				visit(arg)
+			}
+
+		case *listNode:
+			for _, elem := range n.elems {
+				visit(elem)
+			}
+		}
+	}
+	visit(n)
+
+	return info, nil
+}
+
+// IsBuiltin reports whether name is a function that is always available,
+// like format and len.
+func IsBuiltin(name string) bool {
+	_, ok := builtins[name]
+	return ok
+}
+
+func typeString(t reflect.Type) string {
+	if t == anyType {
+		return "interface{}"
+	}
+
+	return t.String()
+}
@@ -0,0 +1,439 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type Address struct {
+	City string
+}
+
+type Base struct {
+	ID int
+}
+
+type Person struct {
+	Base
+	Name     string
+	Age      int
+	Score    float64
+	Address  *Address
+	Tags     map[string]string
+	Extra    interface{}
+	Nickname *string
+	Computed func() bool
+	secret   string
+}
+
+func (p *Person) Adult() bool { return p.Age >= 18 }
+
+func (p Person) Initial() string { return p.Name[:1] }
+
+func (p *Person) Lookup() (*Address, error) {
+	if p.Address == nil {
+		return nil, errors.New("no address")
+	}
+	return p.Address, nil
+}
+
+func (p *Person) Greet(name string) string { return "hi " + name }
+
+func testPerson() *Person {
+	return &Person{
+		Base:     Base{ID: 7},
+		Name:     "Ann",
+		Age:      42,
+		Score:    2.5,
+		Address:  &Address{City: "Oslo"},
+		Tags:     map[string]string{"role": "admin"},
+		Extra:    &Address{City: "Rome"},
+		Computed: func() bool { return true },
+	}
+}
+
+func testEnv() *Env {
+	return &Env{
+		Root: reflect.TypeOf(&Person{}),
+		Vars: map[string]reflect.Type{
+			"nameEdit.Text": reflect.TypeOf(""),
+			"limit":         reflect.TypeOf(0),
+			"any":           nil,
+		},
+		Functions: map[string]interface{}{
+			"twice": func(x float64) float64 { return 2 * x },
+			"join": func(sep string, parts ...string) string {
+				return strings.Join(parts, sep)
+			},
+			"dyn": func(args ...interface{}) (interface{}, error) {
+				return len(args), nil
+			},
+			"fail": func() (int, error) { return 0, errors.New("boom") },
+		},
+	}
+}
+
+func TestEval(t *testing.T) {
+	vars := map[string]interface{}{
+		"nameEdit.Text": "Bob",
+		"limit":         40,
+		"any":           3,
+	}
+
+	testCases := []struct {
+		src  string
+		want interface{}
+		typ  reflect.Type
+	}{
+		// Literals.
+		{"1.5", 1.5, floatType},
+		{"0xFF", 255.0, floatType},
+		{`"a\"b"`, `a"b`, stringType},
+		{"true", true, boolType},
+
+		// Paths.
+		{"Name", "Ann", stringType},
+		{"Age", 42, intType},
+		{"ID", 7, intType},
+		{"Address.City", "Oslo", stringType},
+		{"Tags.role", "admin", stringType},
+		{"Tags.missing", nil, stringType},
+		{"Extra.City", "Rome", anyType},
+		{"Computed", true, boolType},
+		{"Adult", true, boolType},
+		{"Initial", "A", stringType},
+		{"Lookup.City", "Oslo", stringType},
+		{"nameEdit.Text", "Bob", stringType},
+		{"[nameEdit.Text]", "Bob", stringType},
+		{"limit", 40, intType},
+
+		// Operators.
+		{"Age + 1", 43.0, floatType},
+		{"Age - limit * 2 / 4", 22.0, floatType},
+		{"Age % 5", 2.0, floatType},
+		{"2 ** 10", 1024.0, floatType},
+		{"-Age", -42.0, floatType},
+		{"6 & 3 | 8 ^ 1", 11.0, floatType},
+		{"1 << 4 >> 1", 8.0, floatType},
+		{"~0", -1.0, floatType},
+		{"Name + ' ' + Age", "Ann 42", stringType},
+		{"any + 1", 4.0, anyType},
+		{"Age > limit && Name == 'Ann'", true, boolType},
+		{"Age < limit || !Adult", false, boolType},
+		{"Score >= 2.5 && Score <= 2.5", true, boolType},
+		{"Name < 'Bob'", true, boolType},
+		{"Age == 42.0", true, boolType},
+		{"Name != nameEdit.Text", true, boolType},
+		{"Name =~ '^A'", true, boolType},
+		{"Name !~ 'n$'", false, boolType},
+		{"Age in (1, 42, 3)", true, boolType},
+		{"Name IN ('x', 'y')", false, boolType},
+		{"Adult ? 'adult' : 'minor'", "adult", stringType},
+		{"Age > 50 ? 'old'", nil, anyType},
+		{"Age > 50 ? 1 : Age > 40 ? 2 : 3", 2.0, floatType},
+		{"Nickname ?? 'none'", "none", anyType},
+		{"Address.City ?? 'none'", "Oslo", stringType},
+		{"(Age + 8) * 2", 100.0, floatType},
+
+		// Functions.
+		{"format('%s is %d', Name, Age)", "Ann is 42", stringType},
+		{"len(Name)", 3, intType},
+		{"twice(Age)", 84.0, floatType},
+		{"join('-', 'a', Name)", "a-Ann", stringType},
+		{"dyn(1, 'x', Age)", 3, anyType},
+		{"twice(any)", 6.0, floatType},
+	}
+
+	for _, c := range testCases {
+		p, err := Compile(c.src, testEnv())
+		if err != nil {
+			t.Errorf("%q: %v", c.src, err)
+			continue
+		}
+
+		if p.Type() != c.typ {
+			t.Errorf("%q: got type %v, want %v", c.src, p.Type(), c.typ)
+		}
+
+		args := make([]interface{}, len(p.Vars()))
+		for i, name := range p.Vars() {
+			args[i] = vars[name]
+		}
+
+		got, err := p.Eval(testPerson(), args...)
+		if err != nil {
+			t.Errorf("%q: Eval: %v", c.src, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%q: got %#v, want %#v", c.src, got, c.want)
+		}
+	}
+}
+
+func TestEvalNil(t *testing.T) {
+	env := testEnv()
+
+	for _, src := range []string{"Address.City", "Lookup.City", "Extra.City", "Name"} {
+		p, err := Compile(src, env)
+		if err != nil {
+			t.Errorf("%q: %v", src, err)
+			continue
+		}
+
+		got, err := p.Eval(nil)
+		if err != nil || got != nil {
+			t.Errorf("%q with nil root: got %v, %v, want nil", src, got, err)
+		}
+	}
+
+	p, _ := Compile("Address.City ?? 'nowhere'", env)
+	if got, err := p.Eval(&Person{}); err != nil || got != "nowhere" {
+		t.Errorf("null-coalescing: got %v, %v, want nowhere", got, err)
+	}
+}
+
+func TestEvalReplacedRoot(t *testing.T) {
+	type Other struct {
+		Age int
+	}
+
+	p, err := Compile("Age + 1", testEnv())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := p.Eval(&Other{Age: 1})
+	if err != nil || got != 2.0 {
+		t.Errorf("got %v, %v, want 2", got, err)
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	testCases := []struct {
+		src  string
+		root interface{}
+		vars []interface{}
+		want string
+	}{
+		{"Lookup.City", &Person{}, nil, "column 1: Lookup: no address"},
+		{"fail()", nil, nil, "column 1: fail: boom"},
+		{"any + 1", nil, []interface{}{true}, `column 5: cannot add bool and float64`},
+		{"any && true", nil, []interface{}{1}, `column 1: cannot use int as bool with "&&"`},
+		{"any > 'x'", nil, []interface{}{1}, `column 5: cannot compare int and string with ">"`},
+		{"Extra.Town", &Person{Extra: &Address{}}, nil, `column 7: unknown field or method "Town" in expr.Address`},
+	}
+
+	for _, c := range testCases {
+		p, err := Compile(c.src, testEnv())
+		if err != nil {
+			t.Errorf("%q: %v", c.src, err)
+			continue
+		}
+
+		vars := c.vars
+		if vars == nil {
+			vars = make([]interface{}, len(p.Vars()))
+		}
+
+		_, err = p.Eval(c.root, vars...)
+		if err == nil {
+			t.Errorf("%q: got no error, want %q", c.src, c.want)
+			continue
+		}
+		if err.Error() != c.want {
+			t.Errorf("%q: got error %q, want %q", c.src, err, c.want)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	testCases := []struct {
+		src     string
+		want    string
+		unknown string
+	}{
+		{"", "column 1: empty expression", ""},
+		{"Age >", "column 6: unexpected end of expression", ""},
+		{"(Age > 1", `column 9: expected ")", found end of expression`, ""},
+		{"Age > 1)", `column 8: unexpected ")"`, ""},
+		{"Name == 'x", "column 9: unterminated string", ""},
+		{"Age # 1", `column 5: unexpected character '#'`, ""},
+		{"Address.", `column 9: expected name after ".", found end of expression`, ""},
+		{"Nmae", `column 1: unknown field or method "Nmae" in expr.Person`, ""},
+		{"name", `column 1: unknown field or method "name" in expr.Person, did you mean "Name"?`, ""},
+		{"Address.Town", `column 9: unknown field or method "Town" in expr.Address`, ""},
+		{"secret", `column 1: field secret of expr.Person is not exported`, ""},
+		{"Greet", `column 1: cannot call Greet of expr.Person without arguments`, ""},
+		{"Name.Length", `column 6: cannot resolve "Length" in string`, ""},
+		{"Name > 3", `column 6: cannot compare string and float64 with ">"`, ""},
+		{"Name == 3", `column 6: cannot compare string and float64 with "=="`, ""},
+		{"Name - 1", `column 1: cannot use string as number with "-"`, ""},
+		{"Age && true", `column 1: cannot use int as bool with "&&"`, ""},
+		{"!Name", `column 2: cannot use string as bool with "!"`, ""},
+		{"Age =~ 'x'", `column 1: cannot use int as string with "=~"`, ""},
+		{"Name =~ '('", "column 9: invalid pattern: error parsing regexp: missing closing ): `(`", ""},
+		{"Age ? 1 : 2", `column 1: cannot use int as bool with "?"`, ""},
+		{"(1, 2)", `column 3: expected ")", found ","`, ""},
+		{"len(Age)", `column 5: cannot take len of int`, ""},
+		{"twice(Name)", `column 7: cannot use string as float64 in argument 1 of twice`, ""},
+		{"twice()", `column 1: twice takes 1 arguments, got 0`, ""},
+		{"join()", `column 1: join takes at least 1 arguments, got 0`, ""},
+		{"size(Name)", `column 1: unknown function "size"`, "size"},
+		{"'it''s'", `column 5: unexpected 's'`, ""},
+	}
+
+	for _, c := range testCases {
+		_, err := Compile(c.src, testEnv())
+
+		var e *Error
+		if !errors.As(err, &e) {
+			t.Errorf("%q: got error %v, want *Error", c.src, err)
+			continue
+		}
+		if e.Error() != c.want {
+			t.Errorf("%q: got error %q, want %q", c.src, e.Error(), c.want)
+		}
+		if e.Unknown != c.unknown {
+			t.Errorf("%q: got Unknown %q, want %q", c.src, e.Unknown, c.unknown)
+		}
+	}
+}
+
+func TestCompileUnknownName(t *testing.T) {
+	_, err := Compile("person.Age > 1", &Env{})
+
+	var e *Error
+	if !errors.As(err, &e) || e.Unknown != "person" || e.Pos != 0 {
+		t.Errorf("got %#v, want unknown name person at 0", err)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	var asked []string
+
+	env := &Env{
+		Lookup: func(name string) (reflect.Type, bool) {
+			asked = append(asked, name)
+			if name == "person" {
+				return reflect.TypeOf(&Person{}), true
+			}
+			return nil, false
+		},
+	}
+
+	p, err := Compile("person.Address.City", env)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"person.Address.City", "person.Address", "person"}; !reflect.DeepEqual(asked, want) {
+		t.Errorf("asked %q, want %q", asked, want)
+	}
+	if want := []string{"person"}; !reflect.DeepEqual(p.Vars(), want) {
+		t.Errorf("Vars: got %q, want %q", p.Vars(), want)
+	}
+	if p.Type() != stringType {
+		t.Errorf("Type: got %v, want string", p.Type())
+	}
+
+	got, err := p.Eval(nil, testPerson())
+	if err != nil || got != "Oslo" {
+		t.Errorf("Eval: got %v, %v, want Oslo", got, err)
+	}
+
+	if _, err := p.Eval(nil); err == nil {
+		t.Errorf("Eval without variables: got no error")
+	}
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		src    string
+		paths  []string
+		funcs  []string
+		isPath bool
+	}{
+		{"Name", []string{"Name"}, nil, true},
+		{"a.b.c", []string{"a.b.c"}, nil, true},
+		{"x > 1 && x < y.z", []string{"x", "y.z"}, nil, false},
+		{"format('%d', len(items)) ?? other", []string{"items", "other"}, []string{"format", "len"}, false},
+		{"x in (a, b)", []string{"x", "a", "b"}, nil, false},
+		{"c ? [Full Name]", []string{"c", "Full Name"}, nil, false},
+	}
+
+	for _, c := range testCases {
+		info, err := Parse(c.src)
+		if err != nil {
+			t.Errorf("%q: %v", c.src, err)
+			continue
+		}
+
+		var paths, funcs []string
+		for _, r := range info.Paths {
+			paths = append(paths, r.Name)
+		}
+		for _, r := range info.Funcs {
+			funcs = append(funcs, r.Name)
+		}
+
+		if !reflect.DeepEqual(paths, c.paths) {
+			t.Errorf("%q: got paths %q, want %q", c.src, paths, c.paths)
+		}
+		if !reflect.DeepEqual(funcs, c.funcs) {
+			t.Errorf("%q: got funcs %q, want %q", c.src, funcs, c.funcs)
+		}
+		if info.IsPath != c.isPath {
+			t.Errorf("%q: got IsPath %v, want %v", c.src, info.IsPath, c.isPath)
+		}
+	}
+
+	if _, err := Parse("a >"); err == nil {
+		t.Errorf("Parse: got no error for a syntax error")
+	}
+}
+
+func BenchmarkEval(b *testing.B) {
+	p, err := Compile("Address.City == 'Oslo' && Age > 18", testEnv())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	person := testPerson()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := p.Eval(person); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func ExampleCompile() {
+	type Order struct {
+		Items    int
+		Customer string
+	}
+
+	p, err := Compile("format('%s ordered %d items', Customer, Items)", &Env{Root: reflect.TypeOf(Order{})})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	v, _ := p.Eval(Order{Items: 3, Customer: "Ann"})
+	fmt.Println(v, p.Type())
+
+	_, err = Compile("Itmes > 0", &Env{Root: reflect.TypeOf(Order{})})
+	fmt.Println(err)
+	// Output:
+	// Ann ordered 3 items string
+	// column 1: unknown field or method "Itmes" in expr.Order
+}
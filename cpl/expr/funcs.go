@@ -0,0 +1,191 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package expr
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// builtins are the functions that are always available, unless Env.Functions
+// has a function of the same name.
+var builtins = map[string]func(c *compiler, n *callNode, args []*compiled) (*compiled, error){
+	"format": compileFormat,
+	"len":    compileLen,
+}
+
+// compileFormat compiles format(layout, args...), which formats like
+// fmt.Sprintf.
+func compileFormat(c *compiler, n *callNode, args []*compiled) (*compiled, error) {
+	if len(args) == 0 {
+		return nil, c.errorf(n.p, "format needs a format string")
+	}
+
+	layout, err := c.text(args[0], n.args[0].pos(), "format")
+	if err != nil {
+		return nil, err
+	}
+
+	return &compiled{stringType, func(f *frame) (interface{}, error) {
+		s, err := layout(f)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make([]interface{}, len(args)-1)
+		for i, arg := range args[1:] {
+			if values[i], err = arg.eval(f); err != nil {
+				return nil, err
+			}
+		}
+
+		return fmt.Sprintf(s, values...), nil
+	}}, nil
+}
+
+// compileLen compiles len(x), the length of a string, slice, array or map.
+func compileLen(c *compiler, n *callNode, args []*compiled) (*compiled, error) {
+	if len(args) != 1 {
+		return nil, c.errorf(n.p, "len takes 1 argument, got %d", len(args))
+	}
+
+	x := args[0]
+	switch x.typ.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Interface:
+
+	default:
+		return nil, c.errorf(n.args[0].pos(), "cannot take len of %s", typeString(x.typ))
+	}
+
+	return &compiled{intType, func(f *frame) (interface{}, error) {
+		v, err := x.eval(f)
+		if err != nil || v == nil {
+			return 0, err
+		}
+
+		rv := reflect.ValueOf(v)
+		switch rv.Kind() {
+		case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+			return rv.Len(), nil
+		}
+
+		return nil, c.errorf(n.args[0].pos(), "cannot take len of %s", typeName(v))
+	}}, nil
+}
+
+func (c *compiler) compileCall(n *callNode) (*compiled, error) {
+	args := make([]*compiled, len(n.args))
+	for i, arg := range n.args {
+		x, err := c.compile(arg)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = x
+	}
+
+	fn, ok := c.env.Functions[n.name]
+	if !ok {
+		if builtin, ok := builtins[n.name]; ok {
+			return builtin(c, n, args)
+		}
+
+		err := c.errorf(n.p, "unknown function %q", n.name)
+		err.Unknown = n.name
+		return nil, err
+	}
+
+	fv := reflect.ValueOf(fn)
+	ft := fv.Type()
+	if ft.Kind() != reflect.Func {
+		return nil, c.errorf(n.p, "%s is %s, not a function", n.name, ft)
+	}
+
+	switch {
+	case ft.NumOut() == 1:
+	case ft.NumOut() == 2 && ft.Out(1) == errorType:
+	default:
+		return nil, c.errorf(n.p, "%s must return a value plus optionally an error", n.name)
+	}
+
+	numIn := ft.NumIn()
+	if ft.IsVariadic() {
+		if len(args) < numIn-1 {
+			return nil, c.errorf(n.p, "%s takes at least %d arguments, got %d", n.name, numIn-1, len(args))
+		}
+	} else if len(args) != numIn {
+		return nil, c.errorf(n.p, "%s takes %d arguments, got %d", n.name, numIn, len(args))
+	}
+
+	paramTypes := make([]reflect.Type, len(args))
+	for i, arg := range args {
+		pt := ft.In(minInt(i, numIn-1))
+		if ft.IsVariadic() && i >= numIn-1 {
+			pt = pt.Elem()
+		}
+		paramTypes[i] = pt
+
+		if !assignable(arg.typ, pt) {
+			return nil, c.errorf(n.args[i].pos(), "cannot use %s as %s in argument %d of %s", typeString(arg.typ), typeString(pt), i+1, n.name)
+		}
+	}
+
+	typ := ft.Out(0)
+	if isDynamic(typ) {
+		typ = anyType
+	}
+
+	return &compiled{typ, func(f *frame) (interface{}, error) {
+		in := make([]reflect.Value, len(args))
+		for i, arg := range args {
+			v, err := arg.eval(f)
+			if err != nil {
+				return nil, err
+			}
+
+			if in[i], err = c.convert(v, paramTypes[i], n.args[i].pos()); err != nil {
+				return nil, err
+			}
+		}
+
+		out := fv.Call(in)
+		if len(out) == 2 && !out[1].IsNil() {
+			return nil, c.errorf(n.p, "%s: %s", n.name, out[1].Interface())
+		}
+
+		return out[0].Interface(), nil
+	}}, nil
+}
+
+// assignable reports whether values of static type t may be passed as
+// arguments of type param.
+func assignable(t, param reflect.Type) bool {
+	return isDynamic(t) || t.AssignableTo(param) || isNumber(t) && isNumber(param)
+}
+
+// convert converts v to a value of type t, for arguments of functions.
+func (c *compiler) convert(v interface{}, t reflect.Type, pos int) (reflect.Value, error) {
+	if v == nil {
+		return reflect.Zero(t), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Type().AssignableTo(t):
+		return rv, nil
+
+	case isNumber(rv.Type()) && isNumber(t):
+		return rv.Convert(t), nil
+	}
+
+	return reflect.Value{}, c.errorf(pos, "cannot use %s as %s", typeName(v), typeString(t))
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
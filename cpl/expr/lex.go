@@ -0,0 +1,143 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package expr
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokString
+	tokIdent
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	pos  int
+	text string      // the identifier or operator
+	val  interface{} // the value of numbers and strings
+}
+
+// operators are ordered so that longer operators match first.
+var operators = []string{
+	"??", "**", "&&", "||", "==", "!=", ">=", "<=", "=~", "!~", "<<", ">>",
+	"+", "-", "*", "/", "%", ">", "<", "!", "~", "&", "|", "^", "?", ":",
+	"(", ")", ",", ".",
+}
+
+func lex(src string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(src); {
+		r, size := utf8.DecodeRuneInString(src[i:])
+
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+
+		case r >= '0' && r <= '9':
+			start := i
+			if strings.HasPrefix(src[i:], "0x") || strings.HasPrefix(src[i:], "0X") {
+				i += 2
+				for i < len(src) && isHexDigit(src[i]) {
+					i++
+				}
+				n, err := strconv.ParseUint(src[start+2:i], 16, 64)
+				if err != nil {
+					return nil, errorf(src, start, "invalid number %q", src[start:i])
+				}
+				tokens = append(tokens, token{kind: tokNumber, pos: start, text: src[start:i], val: float64(n)})
+				continue
+			}
+
+			for i < len(src) && (src[i] >= '0' && src[i] <= '9' || src[i] == '.') {
+				i++
+			}
+			f, err := strconv.ParseFloat(src[start:i], 64)
+			if err != nil {
+				return nil, errorf(src, start, "invalid number %q", src[start:i])
+			}
+			tokens = append(tokens, token{kind: tokNumber, pos: start, text: src[start:i], val: f})
+
+		case r == '\'' || r == '"':
+			start := i
+			i += size
+
+			var b strings.Builder
+			closed := false
+			for i < len(src) {
+				c := src[i]
+				if c == byte(r) {
+					i++
+					closed = true
+					break
+				}
+				if c == '\\' && i+1 < len(src) {
+					i++
+					c = src[i]
+				}
+				b.WriteByte(c)
+				i++
+			}
+			if !closed {
+				return nil, errorf(src, start, "unterminated string")
+			}
+			tokens = append(tokens, token{kind: tokString, pos: start, text: src[start:i], val: b.String()})
+
+		case r == '[':
+			// An escaped variable, like [Full Name], as in govaluate.
+			start := i
+			end := strings.IndexByte(src[i:], ']')
+			if end < 0 {
+				return nil, errorf(src, start, "unterminated [variable]")
+			}
+			name := src[i+1 : i+end]
+			if name == "" {
+				return nil, errorf(src, start, "empty [variable]")
+			}
+			tokens = append(tokens, token{kind: tokIdent, pos: start, text: name})
+			i += end + 1
+
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(src) {
+				r, size := utf8.DecodeRuneInString(src[i:])
+				if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+					break
+				}
+				i += size
+			}
+			tokens = append(tokens, token{kind: tokIdent, pos: start, text: src[start:i]})
+
+		default:
+			op := ""
+			for _, o := range operators {
+				if strings.HasPrefix(src[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, errorf(src, i, "unexpected character %q", r)
+			}
+			tokens = append(tokens, token{kind: tokOp, pos: i, text: op})
+			i += len(op)
+		}
+	}
+
+	return append(tokens, token{kind: tokEOF, pos: len(src)}), nil
+}
+
+func isHexDigit(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
@@ -0,0 +1,360 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package expr
+
+import "strings"
+
+type node interface {
+	pos() int
+}
+
+type literalNode struct {
+	p   int
+	val interface{} // bool, float64 or string
+}
+
+type pathNode struct {
+	p     int
+	parts []string
+	poss  []int
+}
+
+type unaryNode struct {
+	p  int
+	op string
+	x  node
+}
+
+type binaryNode struct {
+	p    int // position of the operator
+	op   string
+	x, y node
+}
+
+type ternaryNode struct {
+	p          int  // position of the ?
+	cond, x, y node // y is nil for cond ? x
+}
+
+type callNode struct {
+	p    int
+	name string
+	args []node
+}
+
+type listNode struct {
+	p     int
+	elems []node
+}
+
+func (n *literalNode) pos() int { return n.p }
+func (n *pathNode) pos() int    { return n.p }
+func (n *unaryNode) pos() int   { return n.p }
+func (n *binaryNode) pos() int  { return n.p }
+func (n *ternaryNode) pos() int { return n.p }
+func (n *callNode) pos() int    { return n.p }
+func (n *listNode) pos() int    { return n.p }
+
+func (n *pathNode) String() string {
+	return strings.Join(n.parts, ".")
+}
+
+type parser struct {
+	src    string
+	tokens []token
+	i      int
+}
+
+func parse(src string) (node, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{src: src, tokens: tokens}
+
+	if p.peek().kind == tokEOF {
+		return nil, errorf(src, 0, "empty expression")
+	}
+
+	n, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, errorf(src, t.pos, "unexpected %s", describe(t))
+	}
+
+	return n, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.i]
+	if t.kind != tokEOF {
+		p.i++
+	}
+	return t
+}
+
+// accept consumes the next token if it is one of the operators ops.
+func (p *parser) accept(ops ...string) (token, bool) {
+	t := p.peek()
+	if t.kind != tokOp {
+		return t, false
+	}
+
+	for _, op := range ops {
+		if t.text == op {
+			p.i++
+			return t, true
+		}
+	}
+
+	return t, false
+}
+
+func (p *parser) expect(op string) (token, error) {
+	if t, ok := p.accept(op); ok {
+		return t, nil
+	}
+
+	t := p.peek()
+	return t, errorf(p.src, t.pos, "expected %q, found %s", op, describe(t))
+}
+
+func describe(t token) string {
+	switch t.kind {
+	case tokEOF:
+		return "end of expression"
+
+	case tokOp:
+		return `"` + t.text + `"`
+	}
+
+	return t.text
+}
+
+// The precedence of the operators follows govaluate, from lowest to highest:
+// ternary and null-coalescing, ||, &&, comparisons, bitwise, shifts,
+// additive, multiplicative, exponent and prefix operators.
+
+func (p *parser) parseTernary() (node, error) {
+	x, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		if t, ok := p.accept("??"); ok {
+			y, err := p.parseBinary(0)
+			if err != nil {
+				return nil, err
+			}
+			x = &binaryNode{p: t.pos, op: "??", x: x, y: y}
+			continue
+		}
+
+		if t, ok := p.accept("?"); ok {
+			a, err := p.parseTernary()
+			if err != nil {
+				return nil, err
+			}
+
+			var b node
+			if _, ok := p.accept(":"); ok {
+				if b, err = p.parseTernary(); err != nil {
+					return nil, err
+				}
+			}
+
+			return &ternaryNode{p: t.pos, cond: x, x: a, y: b}, nil
+		}
+
+		return x, nil
+	}
+}
+
+var binaryLevels = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", ">", ">=", "<", "<=", "=~", "!~", "in"},
+	{"|"},
+	{"^"},
+	{"&"},
+	{"<<", ">>"},
+	{"+", "-"},
+	{"*", "/", "%"},
+	{"**"},
+}
+
+func (p *parser) parseBinary(level int) (node, error) {
+	if level == len(binaryLevels) {
+		return p.parseUnary()
+	}
+
+	x, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		t, ok := p.acceptBinary(binaryLevels[level])
+		if !ok {
+			return x, nil
+		}
+
+		var y node
+		if t.text == "in" {
+			y, err = p.parseList()
+		} else {
+			y, err = p.parseBinary(level + 1)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		x = &binaryNode{p: t.pos, op: t.text, x: x, y: y}
+	}
+}
+
+// acceptBinary consumes the next token if it is one of ops, which includes
+// the keyword operator in.
+func (p *parser) acceptBinary(ops []string) (token, bool) {
+	t := p.peek()
+
+	if t.kind == tokIdent && (t.text == "in" || t.text == "IN") {
+		for _, op := range ops {
+			if op == "in" {
+				p.i++
+				t.text = "in"
+				return t, true
+			}
+		}
+		return t, false
+	}
+
+	return p.accept(ops...)
+}
+
+// parseList parses the right operand of in, like (1, 2, 3).
+func (p *parser) parseList() (node, error) {
+	open, err := p.expect("(")
+	if err != nil {
+		return nil, err
+	}
+
+	list := &listNode{p: open.pos}
+
+	if _, ok := p.accept(")"); ok {
+		return list, nil
+	}
+
+	for {
+		elem, err := p.parseTernary()
+		if err != nil {
+			return nil, err
+		}
+		list.elems = append(list.elems, elem)
+
+		if _, ok := p.accept(","); ok {
+			continue
+		}
+		if _, err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return list, nil
+	}
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if t, ok := p.accept("-", "!", "~"); ok {
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{p: t.pos, op: t.text, x: x}, nil
+	}
+
+	return p.parseOperand()
+}
+
+func (p *parser) parseOperand() (node, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokNumber, tokString:
+		return &literalNode{p: t.pos, val: t.val}, nil
+
+	case tokIdent:
+		switch t.text {
+		case "true":
+			return &literalNode{p: t.pos, val: true}, nil
+
+		case "false":
+			return &literalNode{p: t.pos, val: false}, nil
+		}
+
+		if _, ok := p.accept("("); ok {
+			return p.parseCall(t)
+		}
+
+		path := &pathNode{p: t.pos, parts: []string{t.text}, poss: []int{t.pos}}
+		for {
+			if _, ok := p.accept("."); !ok {
+				return path, nil
+			}
+
+			part := p.next()
+			if part.kind != tokIdent {
+				return nil, errorf(p.src, part.pos, "expected name after \".\", found %s", describe(part))
+			}
+			path.parts = append(path.parts, part.text)
+			path.poss = append(path.poss, part.pos)
+		}
+
+	case tokOp:
+		if t.text == "(" {
+			x, err := p.parseTernary()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		}
+	}
+
+	return nil, errorf(p.src, t.pos, "unexpected %s", describe(t))
+}
+
+func (p *parser) parseCall(name token) (node, error) {
+	call := &callNode{p: name.pos, name: name.text}
+
+	if _, ok := p.accept(")"); ok {
+		return call, nil
+	}
+
+	for {
+		arg, err := p.parseTernary()
+		if err != nil {
+			return nil, err
+		}
+		call.args = append(call.args, arg)
+
+		if _, ok := p.accept(","); ok {
+			continue
+		}
+		if _, err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return call, nil
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package expr
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type stepKind int
+
+const (
+	stepField stepKind = iota
+	stepMethod
+	stepMapKey
+	stepDynamic
+)
+
+// step is a compiled part of a path. Paths are resolved like walk.DataBinder
+// resolves them: by field first, then by method, calling functions and
+// methods without arguments.
+type step struct {
+	kind   stepKind
+	name   string
+	pos    int
+	index  []int        // of the field
+	method int          // index of the method in the method set of the value or pointer type
+	ptr    bool         // the method has a pointer receiver
+	call   bool         // call the field or method
+	key    reflect.Type // of the map
+	typ    reflect.Type // the type of the struct or map
+}
+
+func (c *compiler) lookupVar(name string) (reflect.Type, bool) {
+	if t, ok := c.env.Vars[name]; ok {
+		if t == nil {
+			t = anyType
+		}
+		return t, true
+	}
+
+	if c.env.Lookup != nil {
+		if t, ok := c.env.Lookup(name); ok {
+			if t == nil {
+				t = anyType
+			}
+			return t, true
+		}
+	}
+
+	return nil, false
+}
+
+func (c *compiler) addVar(name string) int {
+	if i, ok := c.varIndex[name]; ok {
+		return i
+	}
+
+	i := len(c.vars)
+	c.vars = append(c.vars, name)
+	c.varIndex[name] = i
+
+	return i
+}
+
+func (c *compiler) compilePath(n *pathNode) (*compiled, error) {
+	for i := len(n.parts); i > 0; i-- {
+		name := strings.Join(n.parts[:i], ".")
+
+		t, ok := c.lookupVar(name)
+		if !ok {
+			continue
+		}
+
+		index := c.addVar(name)
+		return c.compileSteps(n, i, t, func(f *frame) interface{} { return f.vars[index] })
+	}
+
+	if c.env.Root != nil {
+		return c.compileSteps(n, 0, c.env.Root, func(f *frame) interface{} { return f.root })
+	}
+
+	err := c.errorf(n.p, "unknown name %q", n.parts[0])
+	err.Unknown = n.parts[0]
+	return nil, err
+}
+
+func (c *compiler) compileSteps(n *pathNode, start int, t reflect.Type, get func(f *frame) interface{}) (*compiled, error) {
+	var steps []*step
+
+	for i := start; i < len(n.parts); i++ {
+		s := &step{name: n.parts[i], pos: n.poss[i]}
+
+		if t == anyType || isDynamic(t) {
+			s.kind = stepDynamic
+			steps = append(steps, s)
+			t = anyType
+			continue
+		}
+
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		switch t.Kind() {
+		case reflect.Interface:
+			s.kind = stepDynamic
+			t = anyType
+
+		case reflect.Map:
+			if t.Key().Kind() != reflect.String {
+				return nil, c.errorf(s.pos, "cannot resolve %q in %s, it has no string keys", s.name, typeString(t))
+			}
+			s.kind = stepMapKey
+			s.key = t.Key()
+			s.typ = t
+			t = t.Elem()
+
+		case reflect.Struct:
+			var fun reflect.Type
+			s.typ = t
+
+			if f, ok := t.FieldByName(s.name); ok {
+				if f.PkgPath != "" {
+					return nil, c.errorf(s.pos, "field %s of %s is not exported", s.name, typeString(t))
+				}
+
+				s.kind = stepField
+				s.index = f.Index
+				if f.Type.Kind() == reflect.Func {
+					s.call = true
+					fun = f.Type
+				} else {
+					t = f.Type
+				}
+			} else if m, ok := t.MethodByName(s.name); ok {
+				s.kind = stepMethod
+				s.method = m.Index
+				s.call = true
+				fun = m.Type
+			} else if m, ok := reflect.PtrTo(t).MethodByName(s.name); ok {
+				s.kind = stepMethod
+				s.method = m.Index
+				s.ptr = true
+				s.call = true
+				fun = m.Type
+			} else {
+				return nil, c.errorf(s.pos, "unknown field or method %q in %s%s", s.name, typeString(t), suggestion(s.name, t))
+			}
+
+			if fun != nil {
+				// Method types include the receiver.
+				in := fun.NumIn()
+				if s.kind == stepMethod {
+					in--
+				}
+				if in > 0 {
+					return nil, c.errorf(s.pos, "cannot call %s of %s without arguments", s.name, typeString(t))
+				}
+
+				switch {
+				case fun.NumOut() == 1:
+				case fun.NumOut() == 2 && fun.Out(1) == errorType:
+				default:
+					return nil, c.errorf(s.pos, "%s of %s must return a value plus optionally an error", s.name, typeString(t))
+				}
+				t = fun.Out(0)
+			}
+
+		default:
+			return nil, c.errorf(s.pos, "cannot resolve %q in %s", s.name, typeString(t))
+		}
+
+		steps = append(steps, s)
+	}
+
+	if isDynamic(t) {
+		t = anyType
+	}
+
+	return &compiled{t, func(f *frame) (interface{}, error) {
+		root := get(f)
+		if len(steps) == 0 || root == nil {
+			return root, nil
+		}
+
+		v := reflect.ValueOf(root)
+		for _, s := range steps {
+			var err error
+			if v, err = c.apply(s, v); err != nil {
+				return nil, err
+			}
+			if !v.IsValid() {
+				return nil, nil
+			}
+		}
+
+		return v.Interface(), nil
+	}}, nil
+}
+
+// apply applies s to v. It returns the invalid value for paths through nil
+// pointers and missing map keys.
+func (c *compiler) apply(s *step, v reflect.Value) (reflect.Value, error) {
+	var ptr reflect.Value
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, nil
+		}
+		if v.Kind() == reflect.Ptr {
+			ptr = v
+		}
+		v = v.Elem()
+	}
+
+	if s.kind == stepDynamic || s.typ != nil && v.Type() != s.typ {
+		// The value is not of the type that s was compiled for, like a
+		// DataSource that was replaced by one of another type.
+		return c.applyDynamic(s, v, ptr)
+	}
+
+	var fun reflect.Value
+
+	switch s.kind {
+	case stepField:
+		f, err := v.FieldByIndexErr(s.index)
+		if err != nil {
+			// Through a nil embedded pointer.
+			return reflect.Value{}, nil
+		}
+		if !s.call {
+			return f, nil
+		}
+		if f.IsNil() {
+			return reflect.Value{}, nil
+		}
+		fun = f
+
+	case stepMethod:
+		if s.ptr {
+			if !ptr.IsValid() {
+				if v.CanAddr() {
+					ptr = v.Addr()
+				} else {
+					ptr = reflect.New(v.Type())
+					ptr.Elem().Set(v)
+				}
+			}
+			fun = ptr.Method(s.method)
+		} else {
+			fun = v.Method(s.method)
+		}
+
+	case stepMapKey:
+		return v.MapIndex(reflect.ValueOf(s.name).Convert(s.key)), nil
+	}
+
+	return c.call(s, fun)
+}
+
+func (c *compiler) call(s *step, fun reflect.Value) (reflect.Value, error) {
+	out := fun.Call(nil)
+	if len(out) == 2 && !out[1].IsNil() {
+		return reflect.Value{}, c.errorf(s.pos, "%s: %s", s.name, out[1].Interface())
+	}
+
+	return out[0], nil
+}
+
+// applyDynamic resolves s in v, whose type is only known at runtime.
+func (c *compiler) applyDynamic(s *step, v reflect.Value, ptr reflect.Value) (reflect.Value, error) {
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return reflect.Value{}, c.errorf(s.pos, "cannot resolve %q in %s, it has no string keys", s.name, v.Type())
+		}
+		return v.MapIndex(reflect.ValueOf(s.name).Convert(v.Type().Key())), nil
+
+	case reflect.Struct:
+		if f := v.FieldByName(s.name); f.IsValid() {
+			if f.Kind() != reflect.Func {
+				return f, nil
+			}
+			if f.IsNil() {
+				return reflect.Value{}, nil
+			}
+			return c.callDynamic(s, f)
+		}
+
+		var fun reflect.Value
+		if ptr.IsValid() {
+			fun = ptr.MethodByName(s.name)
+		}
+		if !fun.IsValid() {
+			fun = v.MethodByName(s.name)
+		}
+		if fun.IsValid() {
+			return c.callDynamic(s, fun)
+		}
+
+		return reflect.Value{}, c.errorf(s.pos, "unknown field or method %q in %s", s.name, v.Type())
+	}
+
+	return reflect.Value{}, c.errorf(s.pos, "cannot resolve %q in %s", s.name, v.Type())
+}
+
+func (c *compiler) callDynamic(s *step, fun reflect.Value) (reflect.Value, error) {
+	t := fun.Type()
+	if t.NumIn() > 0 || !(t.NumOut() == 1 || t.NumOut() == 2 && t.Out(1) == errorType) {
+		return reflect.Value{}, c.errorf(s.pos, "cannot call %s, it must take no arguments and return a value plus optionally an error", s.name)
+	}
+
+	return c.call(s, fun)
+}
+
+// suggestion returns a hint like `, did you mean "Name"?` for a field or
+// method of t that matches name but for case.
+func suggestion(name string, t reflect.Type) string {
+	var candidates []string
+	for i := 0; i < t.NumField(); i++ {
+		candidates = append(candidates, t.Field(i).Name)
+	}
+	pt := reflect.PtrTo(t)
+	for i := 0; i < pt.NumMethod(); i++ {
+		candidates = append(candidates, pt.Method(i).Name)
+	}
+
+	for _, c := range candidates {
+		if strings.EqualFold(c, name) {
+			return fmt.Sprintf(", did you mean %q?", c)
+		}
+	}
+
+	return ""
+}
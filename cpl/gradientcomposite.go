@@ -41,18 +41,22 @@ type GradientComposite struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	ArrowGroup         string
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
 	Row                int
 	RowSpan            int
 	StretchFactor      int
+	TabIndex           int
+	TabStop            Property
 
 	// Container
 
-	Children   []Widget
-	Layout     Layout
-	DataBinder DataBinder
+	Children      []Widget
+	Layout        Layout
+	DataBinder    DataBinder
+	TabNavigation walk.TabNavigation
 
 	// GradientComposite
 
@@ -40,18 +40,22 @@ type GroupBox struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	ArrowGroup         string
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
 	Row                int
 	RowSpan            int
 	StretchFactor      int
+	TabIndex           int
+	TabStop            Property
 
 	// Container
 
-	Children   []Widget
-	DataBinder DataBinder
-	Layout     Layout
+	Children      []Widget
+	DataBinder    DataBinder
+	Layout        Layout
+	TabNavigation walk.TabNavigation
 
 	// GroupBox
 
@@ -78,7 +82,7 @@ func (gb GroupBox) Create(builder *Builder) error {
 	})
 
 	return builder.InitWidget(gb, w, func() error {
-		if err := w.SetTitle(gb.Title); err != nil {
+		if err := builder.translate(w, gb.Title, w.Title, w.SetTitle); err != nil {
 			return err
 		}
 
@@ -0,0 +1,332 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package cpl
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/xackery/wlk/cpl/markup"
+	"github.com/xackery/wlk/walk"
+)
+
+// DefaultMarkupPollInterval is the interval at which a MarkupReloader checks
+// its file for changes.
+const DefaultMarkupPollInterval = 500 * time.Millisecond
+
+// MarkupReloader rebuilds the children of a form from a markup file each time
+// the file changes. It is meant for development, so layouts can be edited
+// without restarting the application.
+//
+// A reload rebuilds the children, the layout and the DataBinder of the form
+// with a fresh Builder, with the same DataSource as before. Data entered
+// into the old children is submitted to the DataSource first, if it is
+// valid, so it survives. The state of persistent children, see
+// walk.FormBase.SaveState, and the focus, by the name of the focused widget,
+// are restored as well.
+//
+// For a MainWindow the menu is rebuilt, too. The tool bar, the status bar and
+// event handlers of the form itself are left as they are.
+type MarkupReloader struct {
+	form              walk.Form
+	path              string
+	registry          *markup.Registry
+	interval          time.Duration
+	done              chan struct{}
+	closeOnce         sync.Once
+	reloadedPublisher walk.EventPublisher
+	failedPublisher   walk.ErrorEventPublisher
+	translations      []func() // detach the LanguageChanged handlers of the last reload
+}
+
+// WatchMarkup returns a MarkupReloader that reloads form from the markup file
+// at path whenever it changes, until the form is disposed or the reloader is
+// closed. The markup must declare the same kind of form, a MainWindow for a
+// *walk.MainWindow or a Dialog for a *walk.Dialog, and is decoded with
+// registry, see UnmarshalMarkup.
+func WatchMarkup(form walk.Form, path string, registry *markup.Registry) (*MarkupReloader, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &MarkupReloader{
+		form:     form,
+		path:     path,
+		registry: registry,
+		interval: DefaultMarkupPollInterval,
+		done:     make(chan struct{}),
+	}
+
+	form.Disposing().Attach(r.Close)
+
+	go r.watch(markupChange{modTime: fi.ModTime(), size: fi.Size()})
+
+	return r, nil
+}
+
+// Reloaded returns the event that is published after each successful reload.
+func (r *MarkupReloader) Reloaded() *walk.Event {
+	return r.reloadedPublisher.Event()
+}
+
+// Failed returns the event that is published when a reload failed, for
+// example because of an error in the markup. The form keeps the children
+// that were built so far, and the next change of the file is loaded as
+// usual.
+func (r *MarkupReloader) Failed() *walk.ErrorEvent {
+	return r.failedPublisher.Event()
+}
+
+// Close stops watching the file.
+func (r *MarkupReloader) Close() {
+	r.closeOnce.Do(func() {
+		close(r.done)
+	})
+}
+
+// markupChange detects changes of a markup file by its modification time and
+// size.
+type markupChange struct {
+	modTime time.Time
+	size    int64
+	pending bool
+}
+
+// settled is called with the state of the file at each poll. It returns true
+// once the file changed and then stayed the same for an interval, so files
+// that editors write in several steps are loaded once.
+func (c *markupChange) settled(modTime time.Time, size int64) bool {
+	if !modTime.Equal(c.modTime) || size != c.size {
+		c.modTime, c.size = modTime, size
+		c.pending = true
+		return false
+	}
+
+	if !c.pending {
+		return false
+	}
+	c.pending = false
+
+	return true
+}
+
+// watch polls the file and reloads the form once a change has settled.
+func (r *MarkupReloader) watch(change markupChange) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+
+		case <-ticker.C:
+		}
+
+		fi, err := os.Stat(r.path)
+		if err != nil {
+			continue
+		}
+
+		if !change.settled(fi.ModTime(), fi.Size()) {
+			continue
+		}
+
+		r.form.Synchronize(func() {
+			select {
+			case <-r.done:
+				return
+
+			default:
+			}
+
+			if err := r.Reload(); err != nil {
+				r.failedPublisher.Publish(err)
+			} else {
+				r.reloadedPublisher.Publish()
+			}
+		})
+	}
+}
+
+// Reload reloads the form from the markup file. It must be called on the
+// thread of the form.
+func (r *MarkupReloader) Reload() error {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return err
+	}
+
+	builder := NewBuilder(nil)
+	builder.target = r.form
+
+	var fi formInfo
+	var menuItems []MenuItem
+	var defaultButton, cancelButton **walk.PushButton
+
+	switch r.form.(type) {
+	case *walk.MainWindow:
+		var mw MainWindow
+		if err := UnmarshalMarkup(data, &mw, r.registry); err != nil {
+			return err
+		}
+
+		fi = reloadFormInfo(mw.Name, mw.Font, mw.Background, mw.MinSize, mw.MaxSize, mw.Title, mw.Children, mw.Layout, mw.DataBinder)
+		menuItems = mw.MenuItems
+		builder.addExpressions(mw.Expressions, mw.Functions)
+
+	case *walk.Dialog:
+		var d Dialog
+		if err := UnmarshalMarkup(data, &d, r.registry); err != nil {
+			return err
+		}
+
+		fi = reloadFormInfo(d.Name, d.Font, d.Background, d.MinSize, d.MaxSize, d.Title, d.Children, d.Layout, d.DataBinder)
+		defaultButton, cancelButton = d.DefaultButton, d.CancelButton
+		builder.addExpressions(d.Expressions, d.Functions)
+
+	default:
+		return fmt.Errorf("cannot reload markup of %T", r.form)
+	}
+
+	if fi.Name == "" {
+		fi.Name = r.form.Name()
+	}
+
+	// Keep the data entered so far.
+	var dataSource interface{}
+	if db := r.form.DataBinder(); db != nil {
+		db.Submit()
+		dataSource = db.DataSource()
+	}
+	keepDataSource(&fi.DataBinder, dataSource)
+
+	var focusName string
+	if fw := walk.FocusedWindow(); fw != nil && fw.Form() == r.form {
+		focusName = fw.Name()
+	}
+
+	persistent := r.form.AsFormBase().Persistent() && walk.App().Settings() != nil
+	if persistent {
+		if err := r.form.AsFormBase().SaveState(); err != nil {
+			return err
+		}
+	}
+
+	r.form.SetSuspended(true)
+	defer r.form.SetSuspended(false)
+
+	// The form outlives its children, so the handlers that translate its
+	// title and menu would pile up with each reload.
+	for _, detach := range r.translations {
+		detach()
+	}
+	defer func() {
+		r.translations = builder.translations
+	}()
+
+	children := r.form.Children()
+	old := make([]walk.Widget, children.Len())
+	for i := range old {
+		old[i] = children.At(i)
+	}
+	if err := children.Clear(); err != nil {
+		return err
+	}
+	for _, w := range old {
+		w.Dispose()
+	}
+
+	if mw, ok := r.form.(*walk.MainWindow); ok {
+		if err := mw.Menu().Actions().Clear(); err != nil {
+			return err
+		}
+		builder.deferBuildMenuActions(mw.Menu(), menuItems)
+	}
+
+	if err := builder.InitWidget(fi, r.form, func() error {
+		dlg, ok := r.form.(*walk.Dialog)
+		if !ok {
+			return nil
+		}
+
+		if defaultButton != nil {
+			if err := dlg.SetDefaultButton(*defaultButton); err != nil {
+				return err
+			}
+		}
+		if cancelButton != nil {
+			if err := dlg.SetCancelButton(*cancelButton); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if persistent {
+		if err := r.form.AsFormBase().RestoreState(); err != nil {
+			return err
+		}
+	}
+
+	if focusName != "" {
+		if w := walk.DescendantByName(r.form, focusName); w != nil {
+			w.SetFocus()
+		}
+	}
+
+	return nil
+}
+
+// keepDataSource makes db, the DataBinder declared by the reloaded markup,
+// bind to dataSource, the DataSource of the form so far, unless the markup
+// declares a DataSource of its own. A DataBinder with a DataSource is always
+// created, so the next reload finds it.
+func keepDataSource(db *DataBinder, dataSource interface{}) {
+	if db.DataSource == nil {
+		db.DataSource = dataSource
+	}
+	if db.DataSource != nil && db.AssignTo == nil {
+		var b *walk.DataBinder
+		db.AssignTo = &b
+	}
+}
+
+// reloadFormInfo returns the formInfo for rebuilding a form, which leaves out
+// event handlers, as those of the form are still attached.
+func reloadFormInfo(name string, font Font, background Brush, minSize, maxSize Size, title Property, children []Widget, layout Layout, dataBinder DataBinder) formInfo {
+	return formInfo{
+		Background: background,
+		Font:       font,
+		MaxSize:    maxSize,
+		MinSize:    minSize,
+		Name:       name,
+		Children:   children,
+		DataBinder: dataBinder,
+		Layout:     layout,
+		Title:      title,
+	}
+}
+
+func (b *Builder) addExpressions(expressions func() map[string]walk.Expression, functions map[string]func(args ...interface{}) (interface{}, error)) {
+	if expressions != nil {
+		for name, expr := range expressions() {
+			b.expressions[name] = expr
+		}
+	}
+
+	for name, fn := range functions {
+		b.functions[name] = fn
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package cpl
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMarkupChangeSettled(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Second)
+
+	c := markupChange{modTime: t0, size: 10}
+
+	polls := []struct {
+		modTime time.Time
+		size    int64
+		want    bool
+	}{
+		{t0, 10, false}, // unchanged
+		{t1, 10, false}, // changed, not settled yet
+		{t1, 12, false}, // still being written
+		{t1, 12, true},  // settled
+		{t1, 12, false}, // loaded once
+		{t0, 12, false}, // a restored backup is a change, too
+		{t0, 12, true},
+	}
+
+	for i, p := range polls {
+		if got := c.settled(p.modTime, p.size); got != p.want {
+			t.Errorf("poll %d: got %t, want %t", i, got, p.want)
+		}
+	}
+}
+
+func TestKeepDataSource(t *testing.T) {
+	type data struct{ Name string }
+	old, declared := new(data), new(data)
+
+	var db DataBinder
+	keepDataSource(&db, old)
+	if db.DataSource != old {
+		t.Errorf("DataSource: got %v, want the old one", db.DataSource)
+	}
+	if db.AssignTo == nil {
+		t.Error("AssignTo is nil, so the DataBinder would not be created")
+	}
+
+	db = DataBinder{DataSource: declared}
+	keepDataSource(&db, old)
+	if db.DataSource != declared {
+		t.Errorf("DataSource: got %v, want the declared one", db.DataSource)
+	}
+
+	db = DataBinder{}
+	keepDataSource(&db, nil)
+	if db.AssignTo != nil {
+		t.Error("AssignTo set without a DataSource")
+	}
+}
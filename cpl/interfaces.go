@@ -28,6 +28,11 @@ type bindData struct {
 	validator  Validator
 }
 
+// Bind returns a Property bound to expression, see package expr for its
+// syntax. Expressions are compiled when the widget is created, so syntax
+// errors, mismatched operands and properties a widget does not have make
+// Create panic. A name that cannot be resolved leaves the property unbound
+// and is logged, as such expressions evaluated to nil before.
 func Bind(expression string, validators ...Validator) Property {
 	bd := bindData{expression: expression}
 	switch len(validators) {
@@ -148,8 +153,9 @@ type formInfo struct {
 
 	// Form
 
-	Icon  Property
-	Title Property
+	Icon     Property
+	Mirrored Property
+	Title    Property
 }
 
 func (formInfo) Create(builder *Builder) error {
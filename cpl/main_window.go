@@ -49,9 +49,11 @@ type MainWindow struct {
 
 	// Form
 
-	Icon  Property
-	Size  Size
-	Title Property
+	Icon           Property
+	Mirrored       Property // bool or Condition, see walk.FormBase.SetMirrored
+	OnFocusChanged walk.FocusChangedEventHandler
+	Size           Size
+	Title          Property
 
 	// MainWindow
 
@@ -108,8 +110,9 @@ func (mw MainWindow) Create() error {
 		Layout:     mw.Layout,
 
 		// Form
-		Icon:  mw.Icon,
-		Title: mw.Title,
+		Icon:     mw.Icon,
+		Mirrored: mw.Mirrored,
+		Title:    mw.Title,
 	}
 	builder := NewBuilder(nil)
 
@@ -151,8 +154,8 @@ func (mw MainWindow) Create() error {
 				*sbi.AssignTo = s
 			}
 			s.SetIcon(sbi.Icon)
-			s.SetText(sbi.Text)
-			s.SetToolTipText(sbi.ToolTipText)
+			builder.translate(w, sbi.Text, s.Text, s.SetText)
+			builder.translate(w, sbi.ToolTipText, s.ToolTipText, s.SetToolTipText)
 			if sbi.Width > 0 {
 				s.SetWidth(sbi.Width)
 			}
@@ -178,6 +181,10 @@ func (mw MainWindow) Create() error {
 			w.DropFiles().Attach(mw.OnDropFiles)
 		}
 
+		if mw.OnFocusChanged != nil {
+			w.FocusChanged().Attach(mw.OnFocusChanged)
+		}
+
 		// if mw.AssignTo != nil {
 		// 	*mw.AssignTo = w
 		// }
@@ -0,0 +1,227 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package cpl
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/xackery/wlk/cpl/markup"
+	"github.com/xackery/wlk/walk"
+	"github.com/xackery/wlk/wcolor"
+)
+
+var (
+	markupSchema     *markup.Schema
+	markupSchemaOnce sync.Once
+)
+
+// MarkupSchema returns the markup.Schema of the declarations of this
+// package. Applications may register types of their own, like custom
+// widgets, before loading markup.
+func MarkupSchema() *markup.Schema {
+	markupSchemaOnce.Do(func() {
+		markupSchema = newMarkupSchema()
+	})
+
+	return markupSchema
+}
+
+// UnmarshalMarkup decodes the JSON or YAML markup data into the declaration
+// v points to, like a *MainWindow, a *Dialog or a *Widget. Event handlers
+// and AssignTo targets are resolved by name with registry.
+//
+//	var mw *walk.MainWindow
+//	reg := markup.NewRegistry().
+//		Register("mainWindow", &mw).
+//		Register("quit", func() { mw.Close() })
+//
+//	var decl MainWindow
+//	if err := UnmarshalMarkup(data, &decl, reg); err != nil {
+//		...
+//	}
+//	decl.Run()
+//
+// See package markup for the format. Errors in the markup are returned as a
+// markup.ErrorList.
+func UnmarshalMarkup(data []byte, v interface{}, registry *markup.Registry) error {
+	return MarkupSchema().Unmarshal(data, v, registry)
+}
+
+func newMarkupSchema() *markup.Schema {
+	s := markup.NewSchema()
+
+	s.RegisterTypes((*Widget)(nil),
+		Chart{},
+		CheckBox{},
+		ComboBox{},
+		Composite{},
+		CustomWidget{},
+		DateEdit{},
+		DateLabel{},
+		GradientComposite{},
+		GroupBox{},
+		HSeparator{},
+		HSpacer{},
+		HSplitter{},
+		ImageView{},
+		Label{},
+		LineEdit{},
+		LinkLabel{},
+		ListBox{},
+		NumberEdit{},
+		NumberLabel{},
+		ProgressBar{},
+		PushButton{},
+		RadioButton{},
+		RadioButtonGroup{},
+		RadioButtonGroupBox{},
+		Repeater{},
+		ScrollView{},
+		Slider{},
+		SplitButton{},
+		TabPage{},
+		TabWidget{},
+		TableView{},
+		TextEdit{},
+		TextLabel{},
+		ToolBar{},
+		ToolButton{},
+		TreeView{},
+		VSeparator{},
+		VSpacer{},
+		VSplitter{},
+		WebView{})
+
+	s.RegisterTypes((*Layout)(nil),
+		Flow{},
+		Grid{},
+		HBox{},
+		VBox{})
+
+	s.RegisterTypes((*MenuItem)(nil),
+		Action{},
+		ActionRef{},
+		Menu{},
+		Separator{})
+
+	s.RegisterTypes((*Brush)(nil),
+		BitmapBrush{},
+		GradientBrush{},
+		HorizontalGradientBrush{},
+		SolidColorBrush{},
+		SystemColorBrush{},
+		VerticalGradientBrush{})
+
+	s.RegisterTypes((*Validator)(nil),
+		Range{},
+		Regexp{},
+		SelRequired{},
+		ValidatorRef{})
+
+	s.RegisterTypes((*ErrorPresenter)(nil),
+		ErrorPresenterRef{},
+		ToolTipErrorPresenter{})
+
+	s.RegisterTypes((*Property)(nil),
+		SysDLLIcon{})
+
+	registerMarkupConstants(s, map[string]interface{}{
+		"AlignDefault": AlignDefault,
+		"AlignNear":    AlignNear,
+		"AlignCenter":  AlignCenter,
+		"AlignFar":     AlignFar,
+
+		"AlignHVDefault":      AlignHVDefault,
+		"AlignHNearVNear":     AlignHNearVNear,
+		"AlignHCenterVNear":   AlignHCenterVNear,
+		"AlignHFarVNear":      AlignHFarVNear,
+		"AlignHNearVCenter":   AlignHNearVCenter,
+		"AlignHCenterVCenter": AlignHCenterVCenter,
+		"AlignHFarVCenter":    AlignHFarVCenter,
+		"AlignHNearVFar":      AlignHNearVFar,
+		"AlignHCenterVFar":    AlignHCenterVFar,
+		"AlignHFarVFar":       AlignHFarVFar,
+
+		"CaseModeMixed": CaseModeMixed,
+		"CaseModeUpper": CaseModeUpper,
+		"CaseModeLower": CaseModeLower,
+
+		"EllipsisNone": EllipsisNone,
+		"EllipsisEnd":  EllipsisEnd,
+		"EllipsisPath": EllipsisPath,
+
+		"ImageViewModeIdeal":   ImageViewModeIdeal,
+		"ImageViewModeCorner":  ImageViewModeCorner,
+		"ImageViewModeCenter":  ImageViewModeCenter,
+		"ImageViewModeShrink":  ImageViewModeShrink,
+		"ImageViewModeZoom":    ImageViewModeZoom,
+		"ImageViewModeStretch": ImageViewModeStretch,
+
+		"Horizontal": Horizontal,
+		"Vertical":   Vertical,
+
+		"PaintNormal":   PaintNormal,
+		"PaintNoErase":  PaintNoErase,
+		"PaintBuffered": PaintBuffered,
+
+		"ToolBarButtonImageOnly":       ToolBarButtonImageOnly,
+		"ToolBarButtonTextOnly":        ToolBarButtonTextOnly,
+		"ToolBarButtonImageBeforeText": ToolBarButtonImageBeforeText,
+		"ToolBarButtonImageAboveText":  ToolBarButtonImageAboveText,
+	})
+
+	s.RegisterParser(Shortcut{}, func(text string) (interface{}, error) {
+		shortcut, err := walk.ParseShortcut(text)
+		if err != nil {
+			return nil, err
+		}
+
+		return Shortcut{Modifiers: shortcut.Modifiers, Key: shortcut.Key}, nil
+	})
+	s.RegisterParser(walk.Key(0), func(text string) (interface{}, error) {
+		return walk.ParseKey(text)
+	})
+	s.RegisterParser(wcolor.Color(0), parseMarkupColor)
+
+	s.Property = reflect.TypeOf((*Property)(nil)).Elem()
+	s.Validator = reflect.TypeOf((*Validator)(nil)).Elem()
+	s.Bind = func(expression string, validators []interface{}) interface{} {
+		vs := make([]Validator, len(validators))
+		for i, v := range validators {
+			vs[i] = v.(Validator)
+		}
+
+		return Bind(expression, vs...)
+	}
+
+	return s
+}
+
+func registerMarkupConstants(s *markup.Schema, constants map[string]interface{}) {
+	for name, value := range constants {
+		s.RegisterConstant(name, value)
+	}
+}
+
+// parseMarkupColor parses colors in the format "#RRGGBB".
+func parseMarkupColor(text string) (interface{}, error) {
+	if len(text) != 7 || !strings.HasPrefix(text, "#") {
+		return nil, fmt.Errorf("invalid color %q, want #RRGGBB", text)
+	}
+
+	rgb, err := strconv.ParseUint(text[1:], 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid color %q, want #RRGGBB", text)
+	}
+
+	return wcolor.RGB(byte(rgb>>16), byte(rgb>>8), byte(rgb)), nil
+}
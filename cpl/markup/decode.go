@@ -0,0 +1,585 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package markup
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	typeKey       = "type"
+	refKey        = "Ref"
+	bindKey       = "Bind"
+	validatorsKey = "Validators"
+)
+
+// nodesPerByte limits the number of nodes decoded per byte of input, which
+// aliases could otherwise multiply exponentially.
+const nodesPerByte = 10
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// Unmarshal decodes the JSON or YAML document data into v, which must be a
+// non-nil pointer. Names of handlers and other values are resolved with
+// registry, which may be nil if the document doesn't refer to any.
+//
+// Errors in the document are returned as an ErrorList.
+func (s *Schema) Unmarshal(data []byte, v interface{}, registry *Registry) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("markup: Unmarshal needs a non-nil pointer")
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return ErrorList{syntaxError(err)}
+	}
+
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return nil
+	}
+
+	d := &decoder{schema: s, registry: registry, budget: 1000 + nodesPerByte*len(data)}
+	d.decode(doc.Content[0], rv.Elem(), "")
+
+	if len(d.errs) > 0 {
+		return d.errs
+	}
+
+	return nil
+}
+
+// syntaxError converts an error of package yaml, like "yaml: line 3: did not
+// find expected key", to an Error.
+func syntaxError(err error) *Error {
+	msg := strings.TrimPrefix(err.Error(), "yaml: ")
+
+	e := &Error{Msg: msg}
+	if strings.HasPrefix(msg, "line ") {
+		if i := strings.Index(msg, ": "); i > 0 {
+			if line, err := strconv.Atoi(msg[len("line "):i]); err == nil {
+				e.Line, e.Msg = line, msg[i+2:]
+			}
+		}
+	}
+
+	return e
+}
+
+type decoder struct {
+	schema   *Schema
+	registry *Registry
+	errs     ErrorList
+	budget   int
+}
+
+// enter resolves the alias n, if it is one, and counts the node against the
+// budget of the decoder. It returns false once the budget is used up.
+func (d *decoder) enter(n *yaml.Node, path string) (*yaml.Node, bool) {
+	if d.budget <= 0 {
+		return nil, false
+	}
+
+	d.budget--
+	if d.budget == 0 {
+		d.errorf(n, path, "document expands too many aliases")
+		return nil, false
+	}
+
+	if n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+
+	return n, true
+}
+
+func (d *decoder) errorf(n *yaml.Node, path, format string, args ...interface{}) {
+	d.errs = append(d.errs, &Error{
+		Path:   path,
+		Line:   n.Line,
+		Column: n.Column,
+		Msg:    fmt.Sprintf(format, args...),
+	})
+}
+
+func fieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func indexPath(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+func kindName(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.MappingNode:
+		return "a mapping"
+
+	case yaml.SequenceNode:
+		return "a sequence"
+	}
+
+	return fmt.Sprintf("%q", n.Value)
+}
+
+func (d *decoder) decode(n *yaml.Node, v reflect.Value, path string) {
+	n, ok := d.enter(n, path)
+	if !ok {
+		return
+	}
+	if n.Kind == yaml.ScalarNode && n.Tag == "!!null" {
+		return
+	}
+
+	t := v.Type()
+
+	if parse := d.schema.parsers[t]; parse != nil && n.Kind == yaml.ScalarNode {
+		x, err := parse(n.Value)
+		if err != nil {
+			d.errorf(n, path, "%v", err)
+			return
+		}
+		d.set(n, v, reflect.ValueOf(x), path)
+		return
+	}
+
+	if n.Kind == yaml.ScalarNode {
+		if c, ok := d.schema.constants[t][n.Value]; ok && n.Tag == "!!str" {
+			v.Set(c)
+			return
+		}
+
+		if t.Kind() != reflect.String && reflect.PtrTo(t).Implements(textUnmarshalerType) {
+			if err := v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(n.Value)); err != nil {
+				d.errorf(n, path, "%v", err)
+			}
+			return
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		d.decodeInterface(n, v, path)
+
+	case reflect.Struct:
+		d.decodeStruct(n, v, path)
+
+	case reflect.Ptr:
+		if t.Elem().Kind() == reflect.Struct && n.Kind == yaml.MappingNode {
+			p := reflect.New(t.Elem())
+			d.decodeStruct(n, p.Elem(), path)
+			v.Set(p)
+		} else {
+			d.decodeRef(n, v, path)
+		}
+
+	case reflect.Slice:
+		if n.Kind == yaml.SequenceNode {
+			s := reflect.MakeSlice(t, len(n.Content), len(n.Content))
+			for i, c := range n.Content {
+				d.decode(c, s.Index(i), indexPath(path, i))
+			}
+			v.Set(s)
+		} else {
+			d.decodeRef(n, v, path)
+		}
+
+	case reflect.Map:
+		if n.Kind == yaml.MappingNode && t.Key().Kind() == reflect.String {
+			m := reflect.MakeMapWithSize(t, len(n.Content)/2)
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				k, c := n.Content[i], n.Content[i+1]
+				e := reflect.New(t.Elem()).Elem()
+				d.decode(c, e, fieldPath(path, k.Value))
+				m.SetMapIndex(reflect.ValueOf(k.Value).Convert(t.Key()), e)
+			}
+			v.Set(m)
+		} else {
+			d.decodeRef(n, v, path)
+		}
+
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		d.decodeScalar(n, v, path)
+
+	default:
+		d.decodeRef(n, v, path)
+	}
+}
+
+func (d *decoder) decodeScalar(n *yaml.Node, v reflect.Value, path string) {
+	t := v.Type()
+
+	if n.Kind != yaml.ScalarNode {
+		d.errorf(n, path, "cannot use %s as %s", kindName(n), t)
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(n.Value)
+		return
+
+	case reflect.Bool:
+		if n.Tag == "!!bool" {
+			if b, err := strconv.ParseBool(n.Value); err == nil {
+				v.SetBool(b)
+				return
+			}
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n.Tag == "!!int" {
+			i, err := strconv.ParseInt(strings.ReplaceAll(n.Value, "_", ""), 0, 64)
+			if err == nil && !v.OverflowInt(i) {
+				v.SetInt(i)
+				return
+			}
+			d.errorf(n, path, "%s overflows %s", n.Value, t)
+			return
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if n.Tag == "!!int" {
+			u, err := strconv.ParseUint(strings.ReplaceAll(n.Value, "_", ""), 0, 64)
+			if err == nil && !v.OverflowUint(u) {
+				v.SetUint(u)
+				return
+			}
+			d.errorf(n, path, "%s overflows %s", n.Value, t)
+			return
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if n.Tag == "!!float" || n.Tag == "!!int" {
+			if f, err := strconv.ParseFloat(strings.ReplaceAll(n.Value, "_", ""), 64); err == nil {
+				v.SetFloat(f)
+				return
+			}
+		}
+	}
+
+	d.errorf(n, path, "cannot use %s as %s", kindName(n), t)
+}
+
+// decodeStruct decodes the mapping n into the struct v. The "type" key is
+// accepted if it names the type of v.
+func (d *decoder) decodeStruct(n *yaml.Node, v reflect.Value, path string) {
+	t := v.Type()
+
+	if n.Kind == yaml.ScalarNode && n.Tag == "!!str" && n.Value == t.Name() {
+		return
+	}
+	if n.Kind != yaml.MappingNode {
+		d.errorf(n, path, "cannot use %s as %s", kindName(n), t)
+		return
+	}
+
+	seen := make(map[string]bool)
+
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, c := n.Content[i], n.Content[i+1]
+		name := k.Value
+
+		if seen[name] {
+			d.errorf(k, path, "duplicate field %q", name)
+			continue
+		}
+		seen[name] = true
+
+		if name == typeKey {
+			if c.Value != t.Name() {
+				d.errorf(c, fieldPath(path, typeKey), "type %q, want %q", c.Value, t.Name())
+			}
+			continue
+		}
+
+		f, ok := t.FieldByName(name)
+		if !ok || f.PkgPath != "" || !embeddedValues(t, f.Index) {
+			d.unknownField(k, t, path, name)
+			continue
+		}
+
+		d.decode(c, v.FieldByIndex(f.Index), fieldPath(path, name))
+	}
+}
+
+// embeddedValues returns whether the field of t at index is not promoted
+// from an embedded pointer, which may be nil.
+func embeddedValues(t reflect.Type, index []int) bool {
+	for _, i := range index[:len(index)-1] {
+		f := t.Field(i)
+		if f.Type.Kind() != reflect.Struct {
+			return false
+		}
+		t = f.Type
+	}
+
+	return true
+}
+
+func (d *decoder) unknownField(k *yaml.Node, t reflect.Type, path, name string) {
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.PkgPath == "" && strings.EqualFold(f.Name, name) {
+			d.errorf(k, path, "unknown field %q in %s, did you mean %q?", name, t, f.Name)
+			return
+		}
+	}
+
+	d.errorf(k, path, "unknown field %q in %s", name, t)
+}
+
+func mappingKeys(n *yaml.Node) map[string]*yaml.Node {
+	keys := make(map[string]*yaml.Node, len(n.Content)/2)
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		keys[n.Content[i].Value] = n.Content[i+1]
+	}
+
+	return keys
+}
+
+func (d *decoder) decodeInterface(n *yaml.Node, v reflect.Value, path string) {
+	t := v.Type()
+	kinds := d.schema.kinds[t]
+
+	switch n.Kind {
+	case yaml.MappingNode:
+		keys := mappingKeys(n)
+
+		if ref, ok := keys[refKey]; ok && len(keys) == 1 {
+			d.decodeRef(ref, v, fieldPath(path, refKey))
+			return
+		}
+
+		if _, ok := keys[bindKey]; ok && t == d.schema.Property {
+			d.decodeBind(n, v, path)
+			return
+		}
+
+		if typeNode, ok := keys[typeKey]; ok && kinds != nil {
+			concrete, ok := kinds[typeNode.Value]
+			if !ok {
+				d.errorf(typeNode, fieldPath(path, typeKey), "unknown %s type %q", t, typeNode.Value)
+				return
+			}
+
+			d.set(n, v, d.newKind(n, concrete, path), path)
+			return
+		}
+
+	case yaml.ScalarNode:
+		if concrete, ok := kinds[n.Value]; ok && n.Tag == "!!str" && t.NumMethod() > 0 {
+			d.set(n, v, d.newKind(n, concrete, path), path)
+			return
+		}
+	}
+
+	if t.NumMethod() == 0 {
+		if x := d.natural(n, path); x != nil {
+			d.set(n, v, reflect.ValueOf(x), path)
+		}
+		return
+	}
+
+	if n.Kind == yaml.ScalarNode && kinds == nil {
+		d.decodeRef(n, v, path)
+		return
+	}
+
+	if n.Kind == yaml.MappingNode && kinds != nil {
+		d.errorf(n, path, "missing %q key for %s", typeKey, t)
+		return
+	}
+
+	d.errorf(n, path, "cannot use %s as %s", kindName(n), t)
+}
+
+// newKind returns a new value of the registered type concrete, decoded from
+// n.
+func (d *decoder) newKind(n *yaml.Node, concrete reflect.Type, path string) reflect.Value {
+	if concrete.Kind() == reflect.Ptr {
+		p := reflect.New(concrete.Elem())
+		d.decodeStruct(n, p.Elem(), path)
+		return p
+	}
+
+	p := reflect.New(concrete)
+	if concrete.Kind() == reflect.Struct {
+		d.decodeStruct(n, p.Elem(), path)
+	} else if n.Kind != yaml.ScalarNode {
+		d.errorf(n, path, "cannot use %s as %s", kindName(n), concrete)
+	}
+
+	return p.Elem()
+}
+
+func (d *decoder) decodeBind(n *yaml.Node, v reflect.Value, path string) {
+	if d.schema.Bind == nil {
+		d.errorf(n, path, "data binding is not supported")
+		return
+	}
+
+	var expression string
+	var validators []interface{}
+
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, c := n.Content[i], n.Content[i+1]
+
+		switch k.Value {
+		case bindKey:
+			if c.Kind != yaml.ScalarNode || c.Value == "" {
+				d.errorf(c, fieldPath(path, bindKey), "want an expression, got %s", kindName(c))
+				return
+			}
+			expression = c.Value
+
+		case validatorsKey:
+			if d.schema.Validator == nil {
+				d.errorf(k, path, "validators are not supported")
+				continue
+			}
+			if c.Kind != yaml.SequenceNode {
+				d.errorf(c, fieldPath(path, validatorsKey), "cannot use %s as a list of validators", kindName(c))
+				continue
+			}
+
+			for j, vn := range c.Content {
+				vv := reflect.New(d.schema.Validator).Elem()
+				d.decode(vn, vv, indexPath(fieldPath(path, validatorsKey), j))
+				if !vv.IsNil() {
+					validators = append(validators, vv.Interface())
+				}
+			}
+
+		default:
+			d.errorf(k, path, "unknown field %q of data binding", k.Value)
+		}
+	}
+
+	if x := d.schema.Bind(expression, validators); x != nil {
+		d.set(n, v, reflect.ValueOf(x), path)
+	}
+}
+
+// natural returns the value of n for a field of an empty interface type.
+func (d *decoder) natural(n *yaml.Node, path string) interface{} {
+	n, ok := d.enter(n, path)
+	if !ok {
+		return nil
+	}
+
+	switch n.Kind {
+	case yaml.ScalarNode:
+		switch n.Tag {
+		case "!!null":
+			return nil
+
+		case "!!bool":
+			if b, err := strconv.ParseBool(n.Value); err == nil {
+				return b
+			}
+
+		case "!!int":
+			if i, err := strconv.ParseInt(strings.ReplaceAll(n.Value, "_", ""), 0, 0); err == nil {
+				return int(i)
+			}
+
+		case "!!float":
+			if f, err := strconv.ParseFloat(n.Value, 64); err == nil {
+				return f
+			}
+		}
+
+		return n.Value
+
+	case yaml.SequenceNode:
+		s := make([]interface{}, len(n.Content))
+		for i, c := range n.Content {
+			s[i] = d.natural(c, indexPath(path, i))
+		}
+		return s
+
+	case yaml.MappingNode:
+		keys := mappingKeys(n)
+		if ref, ok := keys[refKey]; ok && len(keys) == 1 {
+			x, _ := d.lookup(ref, fieldPath(path, refKey))
+			return x
+		}
+
+		m := make(map[string]interface{}, len(keys))
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k := n.Content[i].Value
+			m[k] = d.natural(n.Content[i+1], fieldPath(path, k))
+		}
+		return m
+	}
+
+	return nil
+}
+
+func (d *decoder) lookup(n *yaml.Node, path string) (interface{}, bool) {
+	if n.Kind != yaml.ScalarNode || n.Tag != "!!str" {
+		d.errorf(n, path, "want the name of a registered value, got %s", kindName(n))
+		return nil, false
+	}
+
+	x, ok := d.registry.Lookup(n.Value)
+	if !ok {
+		d.errorf(n, path, "unknown name %q", n.Value)
+		return nil, false
+	}
+
+	return x, true
+}
+
+// decodeRef sets v to the registered value named by n.
+func (d *decoder) decodeRef(n *yaml.Node, v reflect.Value, path string) {
+	x, ok := d.lookup(n, path)
+	if !ok || x == nil {
+		return
+	}
+
+	rv := reflect.ValueOf(x)
+	if !assignable(rv, v.Type()) {
+		d.errorf(n, path, "%q is %s, want %s", n.Value, rv.Type(), v.Type())
+		return
+	}
+
+	d.set(n, v, rv, path)
+}
+
+// assignable returns whether x can be assigned to a field of type t, which
+// includes functions that convert to a named function type.
+func assignable(x reflect.Value, t reflect.Type) bool {
+	xt := x.Type()
+
+	return xt.AssignableTo(t) || xt.Kind() == t.Kind() && xt.ConvertibleTo(t)
+}
+
+func (d *decoder) set(n *yaml.Node, v, x reflect.Value, path string) {
+	if !assignable(x, v.Type()) {
+		d.errorf(n, path, "cannot use %s as %s", x.Type(), v.Type())
+		return
+	}
+
+	if x.Type().AssignableTo(v.Type()) {
+		v.Set(x)
+	} else {
+		v.Set(x.Convert(v.Type()))
+	}
+}
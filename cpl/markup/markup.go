@@ -0,0 +1,193 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package markup decodes JSON and YAML documents into declaration structs
+// like those of package cpl, so user interfaces can be loaded at runtime.
+//
+// Mappings are decoded into structs by the exact names of their fields. A
+// field of an interface type, like cpl.Widget or cpl.Layout, takes a mapping
+// whose "type" key names the concrete type, as registered with a Schema, or
+// just the name of the type if no fields are set:
+//
+//	type: MainWindow
+//	Title: Example
+//	Layout: VBox
+//	Children:
+//	  - type: LineEdit
+//	    AssignTo: nameEdit
+//	    Text: {Bind: Name}
+//	  - type: PushButton
+//	    Text: Save
+//	    OnClicked: save
+//
+// Fields of function and pointer types, like event handlers and AssignTo
+// targets, take the name of a value registered with a Registry. Fields of
+// empty interface types take scalars, sequences and mappings, a mapping with
+// only a "Ref" key for a registered value and, for the property type of the
+// Schema, a mapping with a "Bind" key for a data binding expression.
+//
+// JSON documents are decoded as YAML, of which JSON is a subset, so errors
+// report lines and columns for both. See cpl.UnmarshalMarkup for the Schema
+// of package cpl.
+package markup
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Schema describes the types that documents are decoded into.
+type Schema struct {
+	kinds     map[reflect.Type]map[string]reflect.Type
+	constants map[reflect.Type]map[string]reflect.Value
+	parsers   map[reflect.Type]func(string) (interface{}, error)
+
+	// Property is the type of properties, which take a mapping with a "Bind"
+	// key for a data binding expression.
+	Property reflect.Type
+
+	// Validator is the type of the validators of data bindings, which are
+	// listed in the "Validators" key next to the "Bind" key.
+	Validator reflect.Type
+
+	// Bind returns the value of a property bound to expression.
+	Bind func(expression string, validators []interface{}) interface{}
+}
+
+// NewSchema returns an empty Schema.
+func NewSchema() *Schema {
+	return &Schema{
+		kinds:     make(map[reflect.Type]map[string]reflect.Type),
+		constants: make(map[reflect.Type]map[string]reflect.Value),
+		parsers:   make(map[reflect.Type]func(string) (interface{}, error)),
+	}
+}
+
+// RegisterTypes registers the types of values as implementations of the
+// interface type pointed to by iface, by the names of the types. Pass
+// (*Widget)(nil) for the interface type Widget.
+//
+// It panics if a value doesn't implement the interface.
+func (s *Schema) RegisterTypes(iface interface{}, values ...interface{}) {
+	it := reflect.TypeOf(iface).Elem()
+	if it.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("markup: %s is not an interface type", it))
+	}
+
+	types := s.kinds[it]
+	if types == nil {
+		types = make(map[string]reflect.Type)
+		s.kinds[it] = types
+	}
+
+	for _, v := range values {
+		t := reflect.TypeOf(v)
+		if !t.Implements(it) {
+			panic(fmt.Sprintf("markup: %s does not implement %s", t, it))
+		}
+
+		name := t.Name()
+		if t.Kind() == reflect.Ptr {
+			name = t.Elem().Name()
+		}
+
+		types[name] = t
+	}
+}
+
+// RegisterConstant registers value by name, so name may be used for fields
+// of the type of value.
+func (s *Schema) RegisterConstant(name string, value interface{}) {
+	v := reflect.ValueOf(value)
+
+	constants := s.constants[v.Type()]
+	if constants == nil {
+		constants = make(map[string]reflect.Value)
+		s.constants[v.Type()] = constants
+	}
+
+	constants[name] = v
+}
+
+// RegisterParser registers parse for the type of sample, so fields of that
+// type may be given as strings, like "Ctrl+S" for a shortcut.
+func (s *Schema) RegisterParser(sample interface{}, parse func(string) (interface{}, error)) {
+	s.parsers[reflect.TypeOf(sample)] = parse
+}
+
+// Registry holds the values that documents refer to by name, like event
+// handlers and AssignTo targets.
+type Registry struct {
+	values map[string]interface{}
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{values: make(map[string]interface{})}
+}
+
+// Register registers value by name. Handlers are registered as functions,
+// like func() for walk.EventHandler, AssignTo targets as pointers, like
+// &nameEdit for a field of type **walk.LineEdit.
+func (r *Registry) Register(name string, value interface{}) *Registry {
+	r.values[name] = value
+
+	return r
+}
+
+// Lookup returns the value registered by name.
+func (r *Registry) Lookup(name string) (interface{}, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	v, ok := r.values[name]
+	return v, ok
+}
+
+// Error is an error at a node of a document.
+type Error struct {
+	// Path is the path of the node, like "Children[2].Layout.Margins".
+	Path string
+
+	// Line and Column are the position of the node in the document, starting
+	// at 1, or 0 if unknown. Syntax errors have no column.
+	Line   int
+	Column int
+
+	Msg string
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+
+	switch {
+	case e.Column > 0:
+		fmt.Fprintf(&b, "%d:%d: ", e.Line, e.Column)
+
+	case e.Line > 0:
+		fmt.Fprintf(&b, "%d: ", e.Line)
+	}
+	if e.Path != "" {
+		b.WriteString(e.Path)
+		b.WriteString(": ")
+	}
+	b.WriteString(e.Msg)
+
+	return b.String()
+}
+
+// ErrorList is the list of errors of a document, in the order of the
+// document.
+type ErrorList []*Error
+
+func (l ErrorList) Error() string {
+	msgs := make([]string, len(l))
+	for i, e := range l {
+		msgs[i] = e.Error()
+	}
+
+	return strings.Join(msgs, "\n")
+}
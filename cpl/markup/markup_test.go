@@ -0,0 +1,412 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package markup
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The types below mirror the shapes of the declarations of package cpl.
+
+type Property interface{}
+
+type Widget interface{ create() }
+
+type Layout interface{ layout() }
+
+type MenuItem interface{ menuItem() }
+
+type Validator interface{ validate() }
+
+type EventHandler func()
+
+type KeyEventHandler func(key int)
+
+type Alignment uint
+
+const (
+	AlignDefault Alignment = iota
+	AlignNear
+	AlignFar
+)
+
+type Shortcut struct {
+	Modifiers int
+	Key       int
+}
+
+type Font struct {
+	Family    string
+	PointSize int
+	Bold      bool
+}
+
+type Margins struct {
+	Left, Top, Right, Bottom int
+}
+
+type VBox struct {
+	Margins Margins
+	Spacing int
+}
+
+func (VBox) layout() {}
+
+type Grid struct {
+	Columns int
+}
+
+func (*Grid) layout() {}
+
+type Button struct{}
+
+type PushButton struct {
+	AssignTo  **Button
+	Enabled   Property
+	Font      Font
+	OnClicked EventHandler
+	OnKeyDown KeyEventHandler
+	Text      Property
+	Alignment Alignment
+}
+
+func (PushButton) create() {}
+
+type Composite struct {
+	Children []Widget
+	Layout   Layout
+	Name     string
+}
+
+func (Composite) create() {}
+
+type Action struct {
+	Text        Property
+	Shortcut    Shortcut
+	OnTriggered EventHandler
+}
+
+func (Action) menuItem() {}
+
+type Menu struct {
+	Text  Property
+	Items []MenuItem
+}
+
+func (Menu) menuItem() {}
+
+type Range struct {
+	Min, Max float64
+}
+
+func (Range) validate() {}
+
+type MainWindow struct {
+	Children  []Widget
+	Layout    Layout
+	MenuItems []MenuItem
+	Model     interface{}
+	Title     Property
+	Timeout   time.Time
+	Opacity   float64
+}
+
+type binding struct {
+	expression string
+	validators []interface{}
+}
+
+func testSchema() *Schema {
+	s := NewSchema()
+
+	s.RegisterTypes((*Widget)(nil), PushButton{}, Composite{})
+	s.RegisterTypes((*Layout)(nil), VBox{}, &Grid{})
+	s.RegisterTypes((*MenuItem)(nil), Action{}, Menu{})
+	s.RegisterTypes((*Validator)(nil), Range{})
+
+	s.RegisterConstant("AlignNear", AlignNear)
+	s.RegisterConstant("AlignFar", AlignFar)
+
+	s.RegisterParser(Shortcut{}, func(text string) (interface{}, error) {
+		if text == "Ctrl+S" {
+			return Shortcut{Modifiers: 2, Key: 'S'}, nil
+		}
+		return nil, fmt.Errorf("invalid shortcut %q", text)
+	})
+
+	s.Property = reflect.TypeOf((*Property)(nil)).Elem()
+	s.Validator = reflect.TypeOf((*Validator)(nil)).Elem()
+	s.Bind = func(expression string, validators []interface{}) interface{} {
+		return binding{expression, validators}
+	}
+
+	return s
+}
+
+const testYAML = `
+Title: Example
+Layout: VBox
+MenuItems:
+  - type: Menu
+    Text: "&File"
+    Items:
+      - type: Action
+        Text: "&Save"
+        Shortcut: Ctrl+S
+        OnTriggered: save
+Children:
+  - type: Composite
+    Name: buttons
+    Layout: {type: Grid, Columns: 2}
+    Children:
+      - type: PushButton
+        AssignTo: saveButton
+        Text: Save
+        Enabled: {Bind: CanSave, Validators: [{type: Range, Min: 1, Max: 5}]}
+        Font: {Family: Segoe UI, PointSize: 9, Bold: true}
+        Alignment: AlignFar
+        OnClicked: save
+        OnKeyDown: keyDown
+Model: [a, 1, true, {Ref: model}]
+Opacity: 0.5
+Timeout: 2026-01-02T03:04:05Z
+`
+
+func TestUnmarshal(t *testing.T) {
+	var saveButton *Button
+	saved, keyed := 0, 0
+
+	reg := NewRegistry().
+		Register("save", func() { saved++ }).
+		Register("keyDown", func(key int) { keyed = key }).
+		Register("saveButton", &saveButton).
+		Register("model", "the model")
+
+	var mw MainWindow
+	if err := testSchema().Unmarshal([]byte(testYAML), &mw, reg); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if mw.Title != "Example" {
+		t.Errorf("Title: got %v, want %q", mw.Title, "Example")
+	}
+	if _, ok := mw.Layout.(VBox); !ok {
+		t.Errorf("Layout: got %T, want VBox", mw.Layout)
+	}
+	if mw.Opacity != 0.5 {
+		t.Errorf("Opacity: got %v, want 0.5", mw.Opacity)
+	}
+	if want := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC); !mw.Timeout.Equal(want) {
+		t.Errorf("Timeout: got %v, want %v", mw.Timeout, want)
+	}
+	if want := []interface{}{"a", 1, true, "the model"}; !reflect.DeepEqual(mw.Model, want) {
+		t.Errorf("Model: got %#v, want %#v", mw.Model, want)
+	}
+
+	menu := mw.MenuItems[0].(Menu)
+	action := menu.Items[0].(Action)
+	if action.Text != "&Save" {
+		t.Errorf("action Text: got %v, want %q", action.Text, "&Save")
+	}
+	if want := (Shortcut{Modifiers: 2, Key: 'S'}); action.Shortcut != want {
+		t.Errorf("action Shortcut: got %v, want %v", action.Shortcut, want)
+	}
+
+	composite := mw.Children[0].(Composite)
+	if grid, ok := composite.Layout.(*Grid); !ok || grid.Columns != 2 {
+		t.Errorf("composite Layout: got %#v, want &Grid{Columns: 2}", composite.Layout)
+	}
+
+	pb := composite.Children[0].(PushButton)
+	if pb.AssignTo != &saveButton {
+		t.Errorf("AssignTo: got %p, want %p", pb.AssignTo, &saveButton)
+	}
+	if want := (Font{Family: "Segoe UI", PointSize: 9, Bold: true}); pb.Font != want {
+		t.Errorf("Font: got %v, want %v", pb.Font, want)
+	}
+	if pb.Alignment != AlignFar {
+		t.Errorf("Alignment: got %v, want %v", pb.Alignment, AlignFar)
+	}
+	if want := (binding{"CanSave", []interface{}{Range{Min: 1, Max: 5}}}); !reflect.DeepEqual(pb.Enabled, want) {
+		t.Errorf("Enabled: got %#v, want %#v", pb.Enabled, want)
+	}
+
+	pb.OnClicked()
+	action.OnTriggered()
+	if saved != 2 {
+		t.Errorf("handlers: got %d calls of save, want 2", saved)
+	}
+	pb.OnKeyDown(42)
+	if keyed != 42 {
+		t.Errorf("OnKeyDown: got key %d, want 42", keyed)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	const doc = `{
+	"Title": "Example",
+	"Layout": {"type": "VBox", "Margins": {"Left": 9, "Top": 9}},
+	"Children": [
+		{"type": "PushButton", "Text": "OK", "Enabled": false}
+	]
+}`
+
+	var mw MainWindow
+	if err := testSchema().Unmarshal([]byte(doc), &mw, nil); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if want := (VBox{Margins: Margins{Left: 9, Top: 9}}); mw.Layout != want {
+		t.Errorf("Layout: got %#v, want %#v", mw.Layout, want)
+	}
+	if want := (PushButton{Text: "OK", Enabled: false}); !reflect.DeepEqual(mw.Children[0], want) {
+		t.Errorf("Children[0]: got %#v, want %#v", mw.Children[0], want)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	reg := NewRegistry().
+		Register("save", func() {}).
+		Register("count", 1)
+
+	testCases := []struct {
+		doc  string
+		want string
+	}{
+		{
+			"Title: x\nTitel: y\n",
+			`2:1: unknown field "Titel" in markup.MainWindow`,
+		},
+		{
+			"Children:\n  - type: PushButton\n    text: Save\n",
+			`3:5: Children[0]: unknown field "text" in markup.PushButton, did you mean "Text"?`,
+		},
+		{
+			"Children:\n  - type: Label\n",
+			`2:11: Children[0].type: unknown markup.Widget type "Label"`,
+		},
+		{
+			"Children:\n  - Text: Save\n",
+			`2:5: Children[0]: missing "type" key for markup.Widget`,
+		},
+		{
+			"Children:\n  - type: Composite\n    Layout: {type: Grid, Columns: many}\n",
+			`3:35: Children[0].Layout.Columns: cannot use "many" as int`,
+		},
+		{
+			"Children:\n  - type: PushButton\n    OnClicked: load\n",
+			`3:16: Children[0].OnClicked: unknown name "load"`,
+		},
+		{
+			"Children:\n  - type: PushButton\n    OnClicked: count\n",
+			`3:16: Children[0].OnClicked: "count" is int, want markup.EventHandler`,
+		},
+		{
+			"Children:\n  - type: PushButton\n    Alignment: AlignMiddle\n",
+			`3:16: Children[0].Alignment: cannot use "AlignMiddle" as markup.Alignment`,
+		},
+		{
+			"MenuItems:\n  - type: Action\n    Shortcut: Ctrl+Q\n",
+			`3:15: MenuItems[0].Shortcut: invalid shortcut "Ctrl+Q"`,
+		},
+		{
+			"Title: {Bind: Name, Format: x}\n",
+			`1:21: Title: unknown field "Format" of data binding`,
+		},
+		{
+			"Title: x\nTitle: y\n",
+			`2:1: duplicate field "Title"`,
+		},
+		{
+			"Children: [\n",
+			`1: did not find expected node content`,
+		},
+	}
+
+	for _, c := range testCases {
+		var mw MainWindow
+		err := testSchema().Unmarshal([]byte(c.doc), &mw, reg)
+
+		var errs ErrorList
+		if !errors.As(err, &errs) {
+			t.Errorf("%q: got error %v, want ErrorList", c.doc, err)
+			continue
+		}
+		if got := errs[0].Error(); got != c.want {
+			t.Errorf("%q: got error %q, want %q", c.doc, got, c.want)
+		}
+	}
+}
+
+func TestUnmarshalAliasBudget(t *testing.T) {
+	// Each level refers ten times to the previous one, which expands to a
+	// billion scalars.
+	var b strings.Builder
+	b.WriteString("Title:\n  - &a0 [x, x, x, x, x, x, x, x, x, x]\n")
+	for i := 1; i <= 9; i++ {
+		fmt.Fprintf(&b, "  - &a%d [", i)
+		for j := 0; j < 10; j++ {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "*a%d", i-1)
+		}
+		b.WriteString("]\n")
+	}
+
+	var mw MainWindow
+	err := testSchema().Unmarshal([]byte(b.String()), &mw, nil)
+
+	var errs ErrorList
+	if !errors.As(err, &errs) {
+		t.Fatalf("got error %v, want ErrorList", err)
+	}
+	if got := errs[0].Msg; got != "document expands too many aliases" {
+		t.Errorf("got error %q", got)
+	}
+
+	// Reusing an anchor a few times stays well within the budget.
+	const doc = "Children:\n  - &b {type: PushButton, Text: Save}\n  - *b\n  - *b\n"
+	if err := testSchema().Unmarshal([]byte(doc), &mw, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(mw.Children) != 3 {
+		t.Errorf("got %d children, want 3", len(mw.Children))
+	}
+}
+
+func TestUnmarshalAllErrors(t *testing.T) {
+	const doc = `
+Titel: x
+Children:
+  - type: PushButton
+    Txt: y
+  - type: Label
+`
+
+	var mw MainWindow
+	err := testSchema().Unmarshal([]byte(doc), &mw, nil)
+
+	var errs ErrorList
+	if !errors.As(err, &errs) {
+		t.Fatalf("got error %v, want ErrorList", err)
+	}
+
+	var lines []int
+	for _, e := range errs {
+		lines = append(lines, e.Line)
+	}
+	if want := []int{2, 5, 6}; !reflect.DeepEqual(lines, want) {
+		t.Errorf("got errors at lines %v, want %v:\n%v", lines, want, err)
+	}
+	if !strings.Contains(err.Error(), "\n") {
+		t.Errorf("got %q, want one line per error", err.Error())
+	}
+}
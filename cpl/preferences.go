@@ -0,0 +1,184 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package cpl
+
+import (
+	"reflect"
+
+	"github.com/xackery/wlk/walk"
+	"github.com/xackery/wlk/walk/prefs"
+)
+
+// NewPreferencesDialog creates a dialog for editing the preferences p, which must have been
+// loaded. Its fields are grouped into a tab per category and edited with a CheckBox for
+// bool fields, a ComboBox for fields with choices, a NumberEdit for numbers and a LineEdit
+// otherwise, all bound to the preferences struct through a DataBinder.
+//
+// Texts are translated like those of other declarations, see
+// walk.Application.SetTranslations.
+//
+// Accepting the dialog submits the edited values to the preferences struct and saves p,
+// which publishes the Changed events of the values that changed. Run the returned dialog
+// to show it.
+func NewPreferencesDialog(owner walk.Form, p *prefs.Preferences) (*walk.Dialog, error) {
+	var dlg *walk.Dialog
+	var db *walk.DataBinder
+	var acceptPB, cancelPB *walk.PushButton
+
+	// Restoring the defaults changes the preferences struct, which must be undone if the
+	// dialog is canceled.
+	var defaultsRestored bool
+
+	var categories []string
+	category2Widgets := make(map[string][]Widget)
+	for _, f := range p.Fields() {
+		if _, ok := category2Widgets[f.Category]; !ok {
+			categories = append(categories, f.Category)
+		}
+		category2Widgets[f.Category] = append(category2Widgets[f.Category], preferenceWidgets(f)...)
+	}
+
+	var content Widget
+	if len(categories) == 1 {
+		content = Composite{
+			Layout:   Grid{Columns: 2, MarginsZero: true},
+			Children: append(category2Widgets[categories[0]], VSpacer{ColumnSpan: 2}),
+		}
+	} else {
+		var pages []TabPage
+		for _, category := range categories {
+			title := category
+			if title == "" {
+				title = "General"
+			}
+
+			pages = append(pages, TabPage{
+				Title:    title,
+				Layout:   Grid{Columns: 2},
+				Children: append(category2Widgets[category], VSpacer{ColumnSpan: 2}),
+			})
+		}
+
+		content = TabWidget{Pages: pages}
+	}
+
+	err := Dialog{
+		AssignTo:      &dlg,
+		Title:         "Preferences",
+		DefaultButton: &acceptPB,
+		CancelButton:  &cancelPB,
+		MinSize:       Size{Width: 360, Height: 200},
+		DataBinder: DataBinder{
+			AssignTo:       &db,
+			DataSource:     p.Target(),
+			ErrorPresenter: ToolTipErrorPresenter{},
+		},
+		Layout: VBox{},
+		Children: []Widget{
+			content,
+			Composite{
+				Layout: HBox{MarginsZero: true},
+				Children: []Widget{
+					PushButton{
+						Text: "Restore Defaults",
+						OnClicked: func() {
+							p.Reset()
+							defaultsRestored = true
+							db.Reset()
+						},
+					},
+					HSpacer{},
+					PushButton{
+						AssignTo: &acceptPB,
+						Text:     "OK",
+						OnClicked: func() {
+							if err := db.Submit(); err != nil {
+								return
+							}
+
+							if err := p.Save(); err != nil {
+								walk.MsgBox(dlg, tr("Preferences"), err.Error(), walk.MsgBoxIconError)
+								return
+							}
+
+							dlg.Accept()
+						},
+					},
+					PushButton{
+						AssignTo: &cancelPB,
+						Text:     "Cancel",
+						OnClicked: func() {
+							dlg.Cancel()
+						},
+					},
+				},
+			},
+		},
+	}.Create(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	dlg.Closing().Attach(func(canceled *bool, reason byte) {
+		if defaultsRestored && dlg.Result() != walk.DlgCmdOK {
+			p.Load()
+		}
+	})
+
+	return dlg, nil
+}
+
+// preferenceWidgets returns the widgets editing f, which take up a row of a two column grid.
+func preferenceWidgets(f prefs.Field) []Widget {
+	if f.Type.Kind() == reflect.Bool {
+		return []Widget{
+			CheckBox{
+				ColumnSpan:  2,
+				Text:        f.Label,
+				ToolTipText: f.Description,
+				Checked:     Bind(f.Name),
+			},
+		}
+	}
+
+	var editor Widget
+	switch {
+	case f.Choices != nil:
+		editor = ComboBox{
+			ToolTipText: f.Description,
+			Model:       f.Choices,
+			Value:       Bind(f.Name),
+		}
+
+	case f.IsNumber():
+		ne := NumberEdit{
+			ToolTipText: f.Description,
+			Value:       Bind(f.Name),
+		}
+		if f.IsFloat() {
+			ne.Decimals = 2
+		}
+		if f.HasRange {
+			ne.MinValue, ne.MaxValue = f.MinValue, f.MaxValue
+			ne.Value = Bind(f.Name, Range{Min: f.MinValue, Max: f.MaxValue})
+		}
+
+		editor = ne
+
+	default:
+		editor = LineEdit{
+			ToolTipText: f.Description,
+			Text:        Bind(f.Name),
+		}
+	}
+
+	return []Widget{
+		Label{Text: f.Label + ":"},
+		editor,
+	}
+}
@@ -40,18 +40,22 @@ type RadioButtonGroupBox struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	ArrowGroup         string
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
 	Row                int
 	RowSpan            int
 	StretchFactor      int
+	TabIndex           int
+	TabStop            Property
 
 	// Container
 
-	Children   []Widget
-	DataBinder DataBinder
-	Layout     Layout
+	Children      []Widget
+	DataBinder    DataBinder
+	Layout        Layout
+	TabNavigation walk.TabNavigation
 
 	// GroupBox
 
@@ -84,7 +88,7 @@ func (rbgb RadioButtonGroupBox) Create(builder *Builder) error {
 	})
 
 	return builder.InitWidget(rbgb, w, func() error {
-		if err := w.SetTitle(rbgb.Title); err != nil {
+		if err := builder.translate(w, rbgb.Title, w.Title, w.SetTitle); err != nil {
 			return err
 		}
 
@@ -0,0 +1,131 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package cpl
+
+import (
+	"fmt"
+
+	"github.com/xackery/wlk/walk"
+	"github.com/xackery/wlk/win"
+)
+
+// Repeater is a container with a child widget per item of ItemsSource, which
+// may be bound, like Bind("Items"). Template returns the declaration of the
+// widget of the item at an index; properties of the widget bound to paths
+// refer to the item, see walk.Repeater.
+//
+//	Repeater{
+//		ItemsSource: Bind("Orders"),
+//		Layout:      VBox{},
+//		Template: func(i int) Widget {
+//			return Composite{
+//				Layout: HBox{},
+//				Children: []Widget{
+//					Label{Text: Bind("Customer")},
+//					NumberLabel{Value: Bind("Total")},
+//				},
+//			}
+//		},
+//	}
+type Repeater struct {
+	// Window
+
+	Accessibility      Accessibility
+	Background         Brush
+	ContextMenuItems   []MenuItem
+	DoubleBuffering    bool
+	Enabled            Property
+	Font               Font
+	MaxSize            Size
+	MinSize            Size
+	Name               string
+	OnBoundsChanged    walk.EventHandler
+	OnKeyDown          walk.KeyEventHandler
+	OnKeyPress         walk.KeyEventHandler
+	OnKeyUp            walk.KeyEventHandler
+	OnMouseDown        walk.MouseEventHandler
+	OnMouseMove        walk.MouseEventHandler
+	OnMouseUp          walk.MouseEventHandler
+	OnSizeChanged      walk.EventHandler
+	Persistent         bool
+	RightToLeftReading bool
+	ToolTipText        Property
+	Visible            Property
+
+	// Widget
+
+	Alignment          Alignment2D
+	AlwaysConsumeSpace bool
+	ArrowGroup         string
+	Column             int
+	ColumnSpan         int
+	GraphicsEffects    []walk.WidgetGraphicsEffect
+	Row                int
+	RowSpan            int
+	StretchFactor      int
+	TabIndex           int
+	TabStop            Property
+
+	// Container
+
+	DataBinder    DataBinder
+	Layout        Layout
+	TabNavigation walk.TabNavigation
+
+	// Repeater
+
+	AssignTo    **walk.Repeater
+	Border      bool
+	ItemsSource Property
+	Template    func(index int) Widget
+}
+
+func (r Repeater) Create(builder *Builder) error {
+	var style uint32
+	if r.Border {
+		style |= win.WS_BORDER
+	}
+	w, err := walk.NewRepeaterWithStyle(builder.Parent(), style)
+	if err != nil {
+		return err
+	}
+
+	if r.AssignTo != nil {
+		*r.AssignTo = w
+	}
+
+	w.SetSuspended(true)
+	builder.Defer(func() error {
+		w.SetSuspended(false)
+		return nil
+	})
+
+	// Items are created after builder has finished, but know the names of
+	// the Component the Repeater is declared in.
+	scope := builder.scope
+
+	return builder.InitWidget(r, w, func() error {
+		if r.Template == nil {
+			return nil
+		}
+
+		return w.SetItemWidgetCreator(func(index int) (walk.Widget, error) {
+			d := r.Template(index)
+			if d == nil {
+				return nil, fmt.Errorf("Template returned nil for item %d", index)
+			}
+
+			ib := builder.itemBuilder(w, scope)
+			if err := d.Create(ib); err != nil {
+				return nil, err
+			}
+
+			return ib.declWidgets[0].w.(walk.Widget), nil
+		})
+	})
+}
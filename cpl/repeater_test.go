@@ -0,0 +1,141 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package cpl
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/xackery/wlk/walk"
+)
+
+type repeaterTestItem struct {
+	Name string
+}
+
+type repeaterTestModel struct {
+	walk.ListModelBase
+	items []*repeaterTestItem
+}
+
+func (m *repeaterTestModel) ItemCount() int {
+	return len(m.items)
+}
+
+func (m *repeaterTestModel) Value(index int) interface{} {
+	return m.items[index]
+}
+
+// createTestWindow creates a hidden MainWindow with children, which is
+// disposed when the test ends. The test must be locked to its thread.
+func createTestWindow(t *testing.T, children ...Widget) *walk.MainWindow {
+	t.Helper()
+
+	var mw *walk.MainWindow
+	if err := (MainWindow{AssignTo: &mw, Layout: VBox{}, Children: children}).Create(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(mw.Dispose)
+
+	return mw
+}
+
+func TestRepeaterItems(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	a, b, c := &repeaterTestItem{"a"}, &repeaterTestItem{"b"}, &repeaterTestItem{"c"}
+	model := &repeaterTestModel{items: []*repeaterTestItem{a, b}}
+
+	var r *walk.Repeater
+	createTestWindow(t,
+		LineEdit{Name: "filter", Text: "f"},
+		Repeater{
+			AssignTo:    &r,
+			ItemsSource: model,
+			Layout:      VBox{},
+			Template: func(int) Widget {
+				return Composite{
+					Layout: HBox{},
+					Children: []Widget{
+						LineEdit{Name: "name", Text: Bind("Name")},
+						Label{Name: "copy", Text: Bind("name.Text")},
+						Label{Name: "outer", Text: Bind("filter.Text")},
+					},
+				}
+			},
+		},
+	)
+
+	// check verifies that the items show the names want, each in its own
+	// widgets, bound to its own DataBinder.
+	check := func(want ...string) []walk.Widget {
+		t.Helper()
+
+		if n := r.ItemCount(); n != len(want) {
+			t.Fatalf("ItemCount: got %d, want %d", n, len(want))
+		}
+
+		widgets := make([]walk.Widget, len(want))
+		for i, name := range want {
+			widgets[i] = r.ItemWidget(i)
+
+			if db := r.ItemDataBinder(i); db == nil || db.DataSource() != model.items[i] {
+				t.Errorf("item %d: the DataBinder is not bound to the item", i)
+			}
+
+			children := widgets[i].(walk.Container).Children()
+			if got := children.At(0).(*walk.LineEdit).Text(); got != name {
+				t.Errorf("item %d: got name %q, want %q", i, got, name)
+			}
+			// The names declared by the template refer to the widgets of
+			// the same item.
+			if got := children.At(1).(*walk.Label).Text(); got != name {
+				t.Errorf("item %d: got copy %q, want %q", i, got, name)
+			}
+			if got := children.At(2).(*walk.Label).Text(); got != "f" {
+				t.Errorf("item %d: got outer %q, want %q", i, got, "f")
+			}
+		}
+
+		return widgets
+	}
+
+	before := check("a", "b")
+
+	model.items = []*repeaterTestItem{a, c, b}
+	model.PublishItemsInserted(1, 1)
+	after := check("a", "c", "b")
+	if after[0] != before[0] || after[2] != before[1] {
+		t.Error("insert recreated the widgets of other items")
+	}
+
+	model.items = []*repeaterTestItem{c, b}
+	model.PublishItemsRemoved(0, 0)
+	before, after = after, check("c", "b")
+	if after[0] != before[1] || after[1] != before[2] {
+		t.Error("remove recreated the widgets of other items")
+	}
+
+	// A reset keeps the widgets of the items that are still there.
+	model.items = []*repeaterTestItem{b, a, c}
+	model.PublishItemsReset()
+	before, after = after, check("b", "a", "c")
+	if after[0] != before[1] || after[2] != before[0] {
+		t.Error("reorder recreated the widgets of kept items")
+	}
+
+	model.items = []*repeaterTestItem{{"x"}, {"y"}}
+	model.PublishItemsReset()
+	before, after = after, check("x", "y")
+	for _, w := range before {
+		if !w.IsDisposed() {
+			t.Error("reset did not dispose the widget of a removed item")
+		}
+	}
+}
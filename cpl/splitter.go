@@ -40,17 +40,21 @@ type HSplitter struct {
 
 	Alignment          Alignment2D
 	AlwaysConsumeSpace bool
+	ArrowGroup         string
 	Column             int
 	ColumnSpan         int
 	GraphicsEffects    []walk.WidgetGraphicsEffect
 	Row                int
 	RowSpan            int
 	StretchFactor      int
+	TabIndex           int
+	TabStop            Property
 
 	// Container
 
-	Children   []Widget
-	DataBinder DataBinder
+	Children      []Widget
+	DataBinder    DataBinder
+	TabNavigation walk.TabNavigation
 
 	// Splitter
 
@@ -112,16 +116,20 @@ type VSplitter struct {
 	// Widget
 
 	AlwaysConsumeSpace bool
+	ArrowGroup         string
 	Column             int
 	ColumnSpan         int
 	Row                int
 	RowSpan            int
 	StretchFactor      int
+	TabIndex           int
+	TabStop            Property
 
 	// Container
 
-	Children   []Widget
-	DataBinder DataBinder
+	Children      []Widget
+	DataBinder    DataBinder
+	TabNavigation walk.TabNavigation
 
 	// Splitter
 
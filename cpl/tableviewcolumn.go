@@ -51,7 +51,7 @@ func (tvc TableViewColumn) Create(tv *walk.TableView) error {
 		return err
 	}
 	w.SetName(tvc.Name)
-	if err := w.SetTitle(tvc.Title); err != nil {
+	if _, err := translate(tv, tvc.Title, w.Title, w.SetTitle); err != nil {
 		return err
 	}
 	if err := w.SetVisible(!tvc.Hidden); err != nil {
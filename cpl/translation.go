@@ -0,0 +1,106 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package cpl
+
+import (
+	"github.com/xackery/wlk/walk"
+)
+
+// translatableProperties are the properties whose string values are user
+// interface texts, which are translated.
+var translatableProperties = map[string]bool{
+	"Text":        true,
+	"Title":       true,
+	"ToolTipText": true,
+}
+
+// isTranslatable returns whether the string value of the property name of w
+// is a user interface text, rather than content like the text of a LineEdit.
+func isTranslatable(w walk.Window, name string) bool {
+	if !translatableProperties[name] {
+		return false
+	}
+
+	if name == "Text" {
+		switch w.(type) {
+		case *walk.LineEdit, *walk.TextEdit, *walk.ComboBox, *walk.NumberEdit, *walk.DateEdit, *walk.WebView:
+			return false
+		}
+	}
+
+	return true
+}
+
+// translate calls set with the translation of source, and calls it again with
+// the new translation whenever the language of the application changes,
+// until owner is disposed or the returned detach function is called. Texts
+// the application changed in the meantime, as reported by get, are left
+// alone. Without an owner, source is translated only once, as nothing would
+// detach the handler.
+func translate(owner walk.Window, source string, get func() string, set func(text string) error) (detach func(), err error) {
+	last := tr(source)
+	if err := set(last); err != nil {
+		return func() {}, err
+	}
+
+	if source == "" || owner == nil || owner.IsDisposed() {
+		return func() {}, nil
+	}
+
+	languageChanged := walk.App().LanguageChanged()
+	disposing := owner.Disposing()
+
+	// Handles are reused once their handlers are detached, so they are only
+	// detached once.
+	var handle, disposingHandle int
+	detached := false
+	detach = func() {
+		if !detached {
+			detached = true
+			languageChanged.Detach(handle)
+			disposing.Detach(disposingHandle)
+		}
+	}
+
+	handle = languageChanged.Attach(func() {
+		if owner.IsDisposed() {
+			detach()
+			return
+		}
+		if get() != last {
+			return
+		}
+
+		text := tr(source)
+		if err := set(text); err == nil {
+			last = text
+		}
+	})
+
+	disposingHandle = disposing.Attach(detach)
+
+	return detach, nil
+}
+
+// translate is like the function translate, and remembers the handler, so
+// it can be detached when the widgets of b are rebuilt, see MarkupReloader.
+func (b *Builder) translate(owner walk.Window, source string, get func() string, set func(text string) error) error {
+	detach, err := translate(owner, source, get, set)
+	b.translations = append(b.translations, detach)
+
+	return err
+}
+
+// owner returns the window the widgets created by b belong to, or nil.
+func (b *Builder) owner() walk.Window {
+	if len(b.declWidgets) == 0 {
+		return nil
+	}
+
+	return b.declWidgets[0].w
+}
@@ -0,0 +1,329 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package cpl
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/xackery/wlk/cpl/bindexpr"
+	"github.com/xackery/wlk/walk"
+	"gopkg.in/Knetic/govaluate.v3"
+)
+
+// ValidationError is a mistake in a declaration, found by Validate.
+type ValidationError struct {
+	// Path is the path of the declaration or field, like
+	// "Children[2].Children[0].Text".
+	Path string
+
+	Msg string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Msg
+	}
+
+	return e.Path + ": " + e.Msg
+}
+
+// ValidationErrors is the list of mistakes found by Validate, in the order
+// of the declaration.
+type ValidationErrors []*ValidationError
+
+func (l ValidationErrors) Error() string {
+	msgs := make([]string, len(l))
+	for i, e := range l {
+		msgs[i] = e.Error()
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+// Validate checks decl, like a MainWindow, a Dialog or a Widget, for mistakes
+// that otherwise only surface when it is built:
+//
+//   - a Name used by more than one widget or DataBinder,
+//   - an AssignTo target shared by several declarations,
+//   - a syntax error in a Bind expression,
+//   - a Bind path that does not exist in the type of the DataSource of the
+//     nearest DataBinder,
+//   - a variable of a Bind expression that is neither a widget property, a
+//     path in a named DataBinder, an expression nor a registered condition.
+//
+// AssignTo fields are typed, so the compiler already rejects mismatched
+// targets. Validate does not create any windows. It returns nil or
+// ValidationErrors.
+//
+// See package cplcheck for an analyzer that reports the same mistakes in
+// declaration literals at compile time.
+func Validate(decl interface{}) error {
+	v := &validator{
+		names:       make(map[string]string),
+		widgets:     make(map[string]bool),
+		dataBinders: make(map[string]*bindScope),
+		targets:     make(map[uintptr]string),
+		expressions: make(map[string]bool),
+		functions:   make(map[string]govaluate.ExpressionFunction),
+	}
+
+	v.visit(reflect.ValueOf(decl), "", &bindScope{})
+
+	for _, b := range v.bindings {
+		v.checkBinding(b)
+	}
+
+	if len(v.errs) > 0 {
+		return v.errs
+	}
+
+	return nil
+}
+
+var (
+	widgetType     = reflect.TypeOf((*Widget)(nil)).Elem()
+	bindDataType   = reflect.TypeOf(bindData{})
+	dataBinderType = reflect.TypeOf(DataBinder{})
+	cplPkgPath     = dataBinderType.PkgPath()
+)
+
+type validator struct {
+	errs        ValidationErrors
+	names       map[string]string // name -> path of the declaration
+	widgets     map[string]bool
+	dataBinders map[string]*bindScope
+	targets     map[uintptr]string // AssignTo target -> path of the declaration
+	expressions map[string]bool
+	functions   map[string]govaluate.ExpressionFunction
+	bindings    []declBinding
+}
+
+// bindScope is the DataBinder that data source paths are resolved with.
+type bindScope struct {
+	dataSource reflect.Type // nil if unknown
+}
+
+type declBinding struct {
+	path       string
+	expression string
+	scope      *bindScope // nil if not bound to a DataBinder, like for actions
+}
+
+func (v *validator) errorf(path, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{Path: path, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) visit(val reflect.Value, path string, scope *bindScope) {
+	for val.Kind() == reflect.Interface || val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return
+		}
+		val = val.Elem()
+	}
+
+	switch val.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			v.visit(val.Index(i), fmt.Sprintf("%s[%d]", path, i), scope)
+		}
+
+	case reflect.Struct:
+		if val.Type().PkgPath() == cplPkgPath {
+			v.visitStruct(val, path, scope)
+		}
+	}
+}
+
+func (v *validator) visitStruct(val reflect.Value, path string, scope *bindScope) {
+	t := val.Type()
+
+	if t == bindDataType {
+		return
+	}
+
+	dbVal := val.FieldByName("DataBinder")
+	isWidget := t.Implements(widgetType) || dbVal.IsValid() && dbVal.Type() == dataBinderType
+
+	if isWidget {
+		if nameVal := val.FieldByName("Name"); nameVal.Kind() == reflect.String && nameVal.String() != "" {
+			name := nameVal.String()
+			v.addName(name, path)
+			v.widgets[name] = true
+		}
+
+		if dbVal.IsValid() && dbVal.Type() == dataBinderType {
+			db := dbVal.Interface().(DataBinder)
+			if db.AssignTo != nil || db.DataSource != nil {
+				scope = &bindScope{}
+				if db.DataSource != nil {
+					scope.dataSource = reflect.TypeOf(db.DataSource)
+				}
+
+				if db.Name != "" {
+					v.addName(db.Name, joinPath(path, "DataBinder"))
+					v.dataBinders[db.Name] = scope
+				}
+			}
+		}
+	}
+
+	propScope := scope
+	if !isWidget {
+		propScope = nil
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		fv := val.Field(i)
+		fieldPath := joinPath(path, sf.Name)
+
+		switch sf.Name {
+		case "AssignTo":
+			if fv.Kind() == reflect.Ptr && !fv.IsNil() {
+				if other, ok := v.targets[fv.Pointer()]; ok {
+					v.errorf(fieldPath, "AssignTo target is also used by %s", describePath(other))
+				} else {
+					v.targets[fv.Pointer()] = path
+				}
+			}
+			continue
+
+		case "Expressions":
+			if expressions, ok := fv.Interface().(func() map[string]walk.Expression); ok && expressions != nil {
+				for name := range expressions() {
+					v.expressions[name] = true
+				}
+			}
+			continue
+
+		case "Functions":
+			if functions, ok := fv.Interface().(map[string]func(args ...interface{}) (interface{}, error)); ok {
+				for name, fn := range functions {
+					v.functions[name] = fn
+				}
+			}
+			continue
+		}
+
+		if fv.Kind() == reflect.Interface && !fv.IsNil() {
+			if bd, ok := fv.Interface().(bindData); ok {
+				if bd.expression != "" {
+					v.bindings = append(v.bindings, declBinding{fieldPath, bd.expression, propScope})
+				}
+				continue
+			}
+		}
+
+		if sf.Name == "DataBinder" {
+			// Its handlers and DataSource are no declarations.
+			continue
+		}
+
+		v.visit(fv, fieldPath, scope)
+	}
+}
+
+func (v *validator) addName(name, path string) {
+	if other, ok := v.names[name]; ok {
+		v.errorf(path, "duplicate Name %q, also used by %s", name, describePath(other))
+		return
+	}
+
+	v.names[name] = path
+}
+
+func (v *validator) checkBinding(b declBinding) {
+	e, err := bindexpr.Parse(b.expression, v.functions)
+	if err != nil {
+		v.errorf(b.path, "%s", err)
+		return
+	}
+
+	if e.IsPath && len(e.Vars) == 1 {
+		path := e.Vars[0]
+
+		if ok, err := v.checkVar(path); ok {
+			if err != nil {
+				v.errorf(b.path, "Bind(%q): %s", b.expression, err)
+			}
+			return
+		}
+
+		if b.scope == nil {
+			v.errorf(b.path, "Bind(%q): %q is neither a widget property, a DataBinder path, an expression nor a condition", b.expression, path)
+			return
+		}
+
+		if b.scope.dataSource != nil {
+			if _, err := bindexpr.CheckPath(b.scope.dataSource, path); err != nil {
+				v.errorf(b.path, "Bind(%q): %s", b.expression, err)
+			}
+		}
+		return
+	}
+
+	for _, name := range e.Vars {
+		if ok, err := v.checkVar(name); !ok {
+			v.errorf(b.path, "Bind(%q): unknown variable %q, data source paths must start with the Name of their DataBinder", b.expression, name)
+		} else if err != nil {
+			v.errorf(b.path, "Bind(%q): %s", b.expression, err)
+		}
+	}
+}
+
+// checkVar reports whether name refers to a widget property, a path in a
+// named DataBinder, an expression or a condition, and checks paths in named
+// DataBinders.
+func (v *validator) checkVar(name string) (bool, error) {
+	first, rest := bindexpr.SplitPath(name)
+
+	switch {
+	case v.expressions[first]:
+		return true, nil
+
+	case rest == "":
+		_, ok := conditionsByName[first]
+		return ok, nil
+
+	case v.widgets[first]:
+		return true, nil
+	}
+
+	if scope, ok := v.dataBinders[first]; ok {
+		if scope.dataSource != nil {
+			if _, err := bindexpr.CheckPath(scope.dataSource, rest); err != nil {
+				return true, err
+			}
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func describePath(path string) string {
+	if path == "" {
+		return "the root declaration"
+	}
+
+	return path
+}
@@ -8,6 +8,7 @@ require (
 	golang.org/x/sys v0.8.0
 	golang.org/x/tools v0.2.0
 	gopkg.in/Knetic/govaluate.v3 v3.0.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require golang.org/x/mod v0.6.0 // indirect
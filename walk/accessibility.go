@@ -124,9 +124,23 @@ const (
 	AccRoleOutlineButton      AccRole = win.ROLE_SYSTEM_OUTLINEBUTTON
 )
 
-// Accessibility provides basic Dynamic Annotation of windows and controls.
+// AccLiveSetting defines how screen readers announce changes of a live region.
+type AccLiveSetting int32
+
+const (
+	AccLiveOff       AccLiveSetting = win.LiveSetting_Off
+	AccLivePolite    AccLiveSetting = win.LiveSetting_Polite    // announced when the user is idle
+	AccLiveAssertive AccLiveSetting = win.LiveSetting_Assertive // announced right away
+)
+
+// Accessibility provides basic Dynamic Annotation of windows and controls, and
+// exposes AccessibleElements and live regions through UI Automation.
 type Accessibility struct {
-	wb *WindowBase
+	wb          *WindowBase
+	name        string
+	role        AccRole
+	state       AccState
+	liveSetting AccLiveSetting
 }
 
 // SetAccelerator sets window accelerator name using Dynamic Annotation.
@@ -149,15 +163,37 @@ func (a *Accessibility) SetHelp(help string) error {
 	return a.accSetPropertyStr(a.wb.hWnd, &win.PROPID_ACC_HELP, win.EVENT_OBJECT_HELPCHANGE, help)
 }
 
+// Name returns the window name set with SetName.
+func (a *Accessibility) Name() string {
+	return a.name
+}
+
 // SetName sets window name using Dynamic Annotation.
 func (a *Accessibility) SetName(name string) error {
-	return a.accSetPropertyStr(a.wb.hWnd, &win.PROPID_ACC_NAME, win.EVENT_OBJECT_NAMECHANGE, name)
+	if err := a.accSetPropertyStr(a.wb.hWnd, &win.PROPID_ACC_NAME, win.EVENT_OBJECT_NAMECHANGE, name); err != nil {
+		return err
+	}
+
+	a.name = name
+
+	return nil
+}
+
+// Role returns the window role set with SetRole, or zero.
+func (a *Accessibility) Role() AccRole {
+	return a.role
 }
 
 // SetRole sets window role using Dynamic Annotation. The role must be set when the window is
 // created and is not to be modified later.
 func (a *Accessibility) SetRole(role AccRole) error {
-	return a.accSetPropertyInt(a.wb.hWnd, &win.PROPID_ACC_ROLE, 0, int32(role))
+	if err := a.accSetPropertyInt(a.wb.hWnd, &win.PROPID_ACC_ROLE, 0, int32(role)); err != nil {
+		return err
+	}
+
+	a.role = role
+
+	return nil
 }
 
 // SetRoleMap sets window role map using Dynamic Annotation. The role map must be set when the
@@ -166,9 +202,20 @@ func (a *Accessibility) SetRoleMap(roleMap string) error {
 	return a.accSetPropertyStr(a.wb.hWnd, &win.PROPID_ACC_ROLEMAP, 0, roleMap)
 }
 
+// State returns the window state set with SetState.
+func (a *Accessibility) State() AccState {
+	return a.state
+}
+
 // SetState sets window state using Dynamic Annotation.
 func (a *Accessibility) SetState(state AccState) error {
-	return a.accSetPropertyInt(a.wb.hWnd, &win.PROPID_ACC_STATE, win.EVENT_OBJECT_STATECHANGE, int32(state))
+	if err := a.accSetPropertyInt(a.wb.hWnd, &win.PROPID_ACC_STATE, win.EVENT_OBJECT_STATECHANGE, int32(state)); err != nil {
+		return err
+	}
+
+	a.state = state
+
+	return nil
 }
 
 // SetStateMap sets window state map using Dynamic Annotation. The state map must be set when
@@ -183,6 +230,71 @@ func (a *Accessibility) SetValueMap(valueMap string) error {
 	return a.accSetPropertyStr(a.wb.hWnd, &win.PROPID_ACC_VALUEMAP, 0, valueMap)
 }
 
+// LiveSetting returns the live setting set with SetLiveSetting.
+func (a *Accessibility) LiveSetting() AccLiveSetting {
+	return a.liveSetting
+}
+
+// SetLiveSetting makes the window a live region using UI Automation. Screen readers announce
+// changes of the text of live regions, and of their AccessibleElements, without them having
+// the focus.
+func (a *Accessibility) SetLiveSetting(liveSetting AccLiveSetting) error {
+	a.liveSetting = liveSetting
+
+	if root := a.wb.uiaRoot(false); root != nil && win.UiaClientsAreListening() {
+		var oldValue, newValue win.VARIANT
+		newValue.SetLong(int32(liveSetting))
+		win.UiaRaiseAutomationPropertyChangedEvent(root.node(0).provider(), win.UIA_LiveSettingPropertyId, &oldValue, &newValue)
+	}
+
+	return nil
+}
+
+// Announce makes screen readers speak text using a UI Automation notification. Assertive
+// windows interrupt what is being spoken, others wait for it.
+//
+// Notifications are available since Windows 10 version 1709.
+func (a *Accessibility) Announce(text string) error {
+	if !win.UiaClientsAreListening() {
+		return nil
+	}
+
+	processing := int32(win.NotificationProcessing_MostRecent)
+	if a.liveSetting == AccLiveAssertive {
+		processing = win.NotificationProcessing_ImportantMostRecent
+	}
+
+	displayString := win.SysAllocString(text)
+	defer win.SysFreeString(displayString)
+	activityId := win.SysAllocString("walk.Announce")
+	defer win.SysFreeString(activityId)
+
+	provider := a.wb.uiaRoot(true).node(0).provider()
+	if hr := win.UiaRaiseNotificationEvent(provider, win.NotificationKind_Other, processing, displayString, activityId); win.FAILED(hr) {
+		return errorFromHRESULT("UiaRaiseNotificationEvent", hr)
+	}
+
+	return nil
+}
+
+// NotifyElementsChanged makes UI Automation pick up changes of the AccessibleElements of the
+// window, and raises events for elements that changed their name, value, toggle state or
+// selection.
+func (a *Accessibility) NotifyElementsChanged() {
+	if root := a.wb.uiaRoot(false); root != nil {
+		root.update()
+	}
+}
+
+// notifyTextChanged raises a live region change event, if the window is a live region.
+func (a *Accessibility) notifyTextChanged() {
+	if a.liveSetting == AccLiveOff || !win.UiaClientsAreListening() {
+		return
+	}
+
+	win.UiaRaiseAutomationEvent(a.wb.uiaRoot(true).node(0).provider(), win.UIA_LiveRegionChangedEventId)
+}
+
 // accSetPropertyInt sets integer window property for Dynamic Annotation.
 func (a *Accessibility) accSetPropertyInt(hwnd windows.HWND, idProp *win.MSAAPROPID, event uint32, value int32) error {
 	accPropServices := a.wb.group.accessibilityServices()
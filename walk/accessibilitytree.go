@@ -0,0 +1,412 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AccessibilityNode is a window or an AccessibleElement as AccessibilityTree
+// sees it.
+type AccessibilityNode struct {
+	Window   Window // nil for AccessibleElements
+	Role     AccRole
+	Name     string
+	Value    string
+	State    AccState
+	Live     AccLiveSetting
+	Children []*AccessibilityNode
+}
+
+// AccessibilityTree returns what form exposes to screen readers: its widgets
+// with the role, name and state set through their Accessibility or implied by
+// their type, and the AccessibleElements of custom-painted widgets.
+//
+// It is meant for audits and tests, e.g. by comparing its String to a golden
+// file. It must be called from the goroutine of form.
+func AccessibilityTree(form Form) *AccessibilityNode {
+	return accessibilityNodeFromWindow(form)
+}
+
+func accessibilityNodeFromWindow(window Window) *AccessibilityNode {
+	wb := window.AsWindowBase()
+
+	n := &AccessibilityNode{
+		Window: window,
+		Role:   defaultAccRole(window),
+	}
+
+	if acc := wb.acc; acc != nil {
+		if acc.role != 0 {
+			n.Role = acc.role
+		}
+		n.Name = acc.name
+		n.State = acc.state
+		n.Live = acc.liveSetting
+	}
+
+	var text string
+	if f, ok := window.(interface{ Title() string }); ok {
+		text = f.Title()
+	} else if t, ok := window.(interface{ Text() string }); ok {
+		text = t.Text()
+	}
+
+	switch n.Role {
+	case AccRoleText, AccRoleCombobox:
+		n.Value = text
+	default:
+		if n.Name == "" {
+			n.Name = stripMnemonic(text)
+		}
+	}
+
+	if !wb.Enabled() {
+		n.State |= AccStateUnavailable
+	}
+	if !wb.Visible() {
+		n.State |= AccStateInvisible
+	}
+	if wb.Focused() {
+		n.State |= AccStateFocused
+	}
+	if ro, ok := window.(interface{ ReadOnly() bool }); ok && ro.ReadOnly() {
+		n.State |= AccStateReadonly
+	}
+	switch w := window.(type) {
+	case *CheckBox:
+		switch w.CheckState() {
+		case CheckChecked:
+			n.State |= AccStateChecked
+		case CheckIndeterminate:
+			n.State |= AccStateMixed
+		}
+
+	case *RadioButton:
+		if w.Checked() {
+			n.State |= AccStateChecked
+		}
+	}
+
+	switch w := window.(type) {
+	case *TabWidget:
+		pages := w.Pages()
+		for i := 0; i < pages.Len(); i++ {
+			n.Children = append(n.Children, accessibilityNodeFromWindow(pages.At(i)))
+		}
+
+	case Container:
+		if children := w.Children(); children != nil {
+			for i := 0; i < children.Len(); i++ {
+				n.Children = append(n.Children, accessibilityNodeFromWindow(children.At(i)))
+			}
+		}
+	}
+
+	if aep, ok := window.(AccessibleElementsProvider); ok {
+		for _, el := range aep.AccessibleElements() {
+			n.Children = append(n.Children, accessibilityNodeFromElement(el))
+		}
+	}
+
+	return n
+}
+
+func accessibilityNodeFromElement(el *AccessibleElement) *AccessibilityNode {
+	n := &AccessibilityNode{
+		Role:  el.Role,
+		Name:  el.Name,
+		Value: el.Value,
+	}
+
+	if n.Role == 0 {
+		n.Role = AccRoleClient
+	}
+
+	if el.Disabled {
+		n.State |= AccStateUnavailable
+	}
+	if el.Checked {
+		n.State |= AccStateChecked
+	}
+	if el.Selectable {
+		n.State |= AccStateSelectable
+	}
+	if el.Selected {
+		n.State |= AccStateSelected
+	}
+	if el.MultiSelectable {
+		n.State |= AccStateMultiselectable
+	}
+
+	for _, child := range el.Children {
+		n.Children = append(n.Children, accessibilityNodeFromElement(child))
+	}
+
+	return n
+}
+
+// defaultAccRole returns the role screen readers see for window, unless
+// another one is set with Accessibility.SetRole.
+func defaultAccRole(window Window) AccRole {
+	switch window.(type) {
+	case *MainWindow:
+		return AccRoleWindow
+	case *Dialog:
+		return AccRoleDialog
+	case *PushButton, *ToolButton:
+		return AccRolePushbutton
+	case *SplitButton:
+		return AccRoleSplitButton
+	case *CheckBox:
+		return AccRoleCheckbutton
+	case *RadioButton:
+		return AccRoleRadiobutton
+	case *LineEdit, *TextEdit, *NumberEdit:
+		return AccRoleText
+	case *Label, *TextLabel, *NumberLabel, *DateLabel:
+		return AccRoleStatictext
+	case *LinkLabel:
+		return AccRoleLink
+	case *ComboBox, *DateEdit:
+		return AccRoleCombobox
+	case *ListBox:
+		return AccRoleList
+	case *TableView:
+		return AccRoleTable
+	case *TreeView:
+		return AccRoleOutline
+	case *TabWidget:
+		return AccRolePageTabList
+	case *TabPage:
+		return AccRolePropertyPage
+	case *GroupBox:
+		return AccRoleGrouping
+	case *ProgressBar:
+		return AccRoleProgressbar
+	case *Slider:
+		return AccRoleSlider
+	case *ImageView:
+		return AccRoleGraphic
+	case *ToolBar:
+		return AccRoleToolbar
+	case *StatusBar:
+		return AccRoleStatusbar
+	case *Separator:
+		return AccRoleSeparator
+	case *Spacer:
+		return AccRoleWhitespace
+	case *WebView:
+		return AccRoleDocument
+	case Container:
+		return AccRolePane
+	}
+
+	return AccRoleClient
+}
+
+// stripMnemonic returns text without the ampersands that mark mnemonics, as
+// screen readers speak it.
+func stripMnemonic(text string) string {
+	if !strings.Contains(text, "&") {
+		return text
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] == '&' {
+			i++
+			if i == len(text) {
+				break
+			}
+		}
+		sb.WriteByte(text[i])
+	}
+
+	return sb.String()
+}
+
+// String returns the tree below n, one node per line, indented by depth:
+//
+//	window "Settings" (focused)
+//	  checkbutton "Enable sync" (checked)
+//	  list "Accounts"
+//	    listitem "Work" (selected, selectable)
+func (n *AccessibilityNode) String() string {
+	var sb strings.Builder
+
+	var write func(n *AccessibilityNode, depth int)
+	write = func(n *AccessibilityNode, depth int) {
+		sb.WriteString(strings.Repeat("  ", depth))
+		sb.WriteString(n.Role.String())
+		fmt.Fprintf(&sb, " %q", n.Name)
+		if n.Value != "" {
+			fmt.Fprintf(&sb, " value=%q", n.Value)
+		}
+		if n.State != AccStateNormal {
+			fmt.Fprintf(&sb, " (%s)", n.State)
+		}
+		if n.Live != AccLiveOff {
+			fmt.Fprintf(&sb, " live=%s", n.Live)
+		}
+		sb.WriteByte('\n')
+
+		for _, child := range n.Children {
+			write(child, depth+1)
+		}
+	}
+	write(n, 0)
+
+	return sb.String()
+}
+
+var accRoleNames = map[AccRole]string{
+	AccRoleTitlebar:           "titlebar",
+	AccRoleMenubar:            "menubar",
+	AccRoleScrollbar:          "scrollbar",
+	AccRoleGrip:               "grip",
+	AccRoleSound:              "sound",
+	AccRoleCursor:             "cursor",
+	AccRoleCaret:              "caret",
+	AccRoleAlert:              "alert",
+	AccRoleWindow:             "window",
+	AccRoleClient:             "client",
+	AccRoleMenuPopup:          "menupopup",
+	AccRoleMenuItem:           "menuitem",
+	AccRoleTooltip:            "tooltip",
+	AccRoleApplication:        "application",
+	AccRoleDocument:           "document",
+	AccRolePane:               "pane",
+	AccRoleChart:              "chart",
+	AccRoleDialog:             "dialog",
+	AccRoleBorder:             "border",
+	AccRoleGrouping:           "grouping",
+	AccRoleSeparator:          "separator",
+	AccRoleToolbar:            "toolbar",
+	AccRoleStatusbar:          "statusbar",
+	AccRoleTable:              "table",
+	AccRoleColumnHeader:       "columnheader",
+	AccRoleRowHeader:          "rowheader",
+	AccRoleColumn:             "column",
+	AccRoleRow:                "row",
+	AccRoleCell:               "cell",
+	AccRoleLink:               "link",
+	AccRoleHelpBalloon:        "helpballoon",
+	AccRoleCharacter:          "character",
+	AccRoleList:               "list",
+	AccRoleListItem:           "listitem",
+	AccRoleOutline:            "outline",
+	AccRoleOutlineItem:        "outlineitem",
+	AccRolePagetab:            "pagetab",
+	AccRolePropertyPage:       "propertypage",
+	AccRoleIndicator:          "indicator",
+	AccRoleGraphic:            "graphic",
+	AccRoleStatictext:         "statictext",
+	AccRoleText:               "text",
+	AccRolePushbutton:         "pushbutton",
+	AccRoleCheckbutton:        "checkbutton",
+	AccRoleRadiobutton:        "radiobutton",
+	AccRoleCombobox:           "combobox",
+	AccRoleDroplist:           "droplist",
+	AccRoleProgressbar:        "progressbar",
+	AccRoleDial:               "dial",
+	AccRoleHotkeyfield:        "hotkeyfield",
+	AccRoleSlider:             "slider",
+	AccRoleSpinbutton:         "spinbutton",
+	AccRoleDiagram:            "diagram",
+	AccRoleAnimation:          "animation",
+	AccRoleEquation:           "equation",
+	AccRoleButtonDropdown:     "buttondropdown",
+	AccRoleButtonMenu:         "buttonmenu",
+	AccRoleButtonDropdownGrid: "buttondropdowngrid",
+	AccRoleWhitespace:         "whitespace",
+	AccRolePageTabList:        "pagetablist",
+	AccRoleClock:              "clock",
+	AccRoleSplitButton:        "splitbutton",
+	AccRoleIPAddress:          "ipaddress",
+	AccRoleOutlineButton:      "outlinebutton",
+}
+
+func (r AccRole) String() string {
+	if name, ok := accRoleNames[r]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("AccRole(%d)", int32(r))
+}
+
+var accStateNames = []struct {
+	state AccState
+	name  string
+}{
+	{AccStateUnavailable, "unavailable"},
+	{AccStateSelected, "selected"},
+	{AccStateFocused, "focused"},
+	{AccStatePressed, "pressed"},
+	{AccStateChecked, "checked"},
+	{AccStateMixed, "mixed"},
+	{AccStateReadonly, "readonly"},
+	{AccStateHotTracked, "hottracked"},
+	{AccStateDefault, "default"},
+	{AccStateExpanded, "expanded"},
+	{AccStateCollapsed, "collapsed"},
+	{AccStateBusy, "busy"},
+	{AccStateFloating, "floating"},
+	{AccStateMarqueed, "marqueed"},
+	{AccStateAnimated, "animated"},
+	{AccStateInvisible, "invisible"},
+	{AccStateOffscreen, "offscreen"},
+	{AccStateSizeable, "sizeable"},
+	{AccStateMoveable, "moveable"},
+	{AccStateSelfVoicing, "selfvoicing"},
+	{AccStateFocusable, "focusable"},
+	{AccStateSelectable, "selectable"},
+	{AccStateLinked, "linked"},
+	{AccStateTraversed, "traversed"},
+	{AccStateMultiselectable, "multiselectable"},
+	{AccStateExtselectable, "extselectable"},
+	{AccStateAlertLow, "alertlow"},
+	{AccStateAlertMedium, "alertmedium"},
+	{AccStateAlertHigh, "alerthigh"},
+	{AccStateProtected, "protected"},
+	{AccStateHasPopup, "haspopup"},
+}
+
+// String returns the names of the states of s, separated by commas.
+func (s AccState) String() string {
+	if s == AccStateNormal {
+		return "normal"
+	}
+
+	var names []string
+	for _, sn := range accStateNames {
+		if s&sn.state != 0 {
+			names = append(names, sn.name)
+			s &^= sn.state
+		}
+	}
+	if s != 0 {
+		names = append(names, fmt.Sprintf("%#x", uint32(s)))
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func (ls AccLiveSetting) String() string {
+	switch ls {
+	case AccLiveOff:
+		return "off"
+	case AccLivePolite:
+		return "polite"
+	case AccLiveAssertive:
+		return "assertive"
+	}
+
+	return fmt.Sprintf("AccLiveSetting(%d)", int32(ls))
+}
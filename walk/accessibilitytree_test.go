@@ -0,0 +1,130 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"testing"
+)
+
+func TestAccStateString(t *testing.T) {
+	testCases := []struct {
+		state AccState
+		want  string
+	}{
+		{AccStateNormal, "normal"},
+		{AccStateChecked, "checked"},
+		{AccStateFocused | AccStateUnavailable, "unavailable, focused"},
+		{AccStateSelected | AccStateSelectable, "selected, selectable"},
+		{AccStateHasPopup | -0x80000000, "haspopup, 0x80000000"},
+	}
+
+	for _, c := range testCases {
+		if got := c.state.String(); got != c.want {
+			t.Errorf("AccState(%#x).String(): got %q, want %q", int32(c.state), got, c.want)
+		}
+	}
+}
+
+func TestAccRoleString(t *testing.T) {
+	testCases := []struct {
+		role AccRole
+		want string
+	}{
+		{AccRolePushbutton, "pushbutton"},
+		{AccRoleListItem, "listitem"},
+		{AccRoleOutlineButton, "outlinebutton"},
+		{0, "AccRole(0)"},
+	}
+
+	for _, c := range testCases {
+		if got := c.role.String(); got != c.want {
+			t.Errorf("AccRole(%d).String(): got %q, want %q", int32(c.role), got, c.want)
+		}
+	}
+}
+
+func TestDefaultAccRole(t *testing.T) {
+	testCases := []struct {
+		window Window
+		want   AccRole
+	}{
+		{new(MainWindow), AccRoleWindow},
+		{new(Dialog), AccRoleDialog},
+		{new(PushButton), AccRolePushbutton},
+		{new(CheckBox), AccRoleCheckbutton},
+		{new(LineEdit), AccRoleText},
+		{new(Label), AccRoleStatictext},
+		{new(ListBox), AccRoleList},
+		{new(TabPage), AccRolePropertyPage},
+		{new(Composite), AccRolePane},
+		{new(CustomWidget), AccRoleClient},
+	}
+
+	for _, c := range testCases {
+		if got := defaultAccRole(c.window); got != c.want {
+			t.Errorf("defaultAccRole(%T): got %v, want %v", c.window, got, c.want)
+		}
+	}
+}
+
+func TestStripMnemonic(t *testing.T) {
+	testCases := []struct {
+		text string
+		want string
+	}{
+		{"", ""},
+		{"Save", "Save"},
+		{"Save &As", "Save As"},
+		{"Fish && &Chips", "Fish & Chips"},
+		{"Trailing&", "Trailing"},
+	}
+
+	for _, c := range testCases {
+		if got := stripMnemonic(c.text); got != c.want {
+			t.Errorf("stripMnemonic(%q): got %q, want %q", c.text, got, c.want)
+		}
+	}
+}
+
+func TestAccessibilityNodeString(t *testing.T) {
+	elements := []*AccessibleElement{
+		{ID: 1, Role: AccRoleListItem, Name: "Work", Selectable: true, Selected: true},
+		{ID: 2, Role: AccRoleListItem, Name: "Home", Selectable: true, Disabled: true},
+		{ID: 3, Name: "Volume", Value: "40 %"},
+		{ID: 4, Role: AccRoleCheckbutton, Name: "Mute", Checkable: true, Checked: true, Children: []*AccessibleElement{
+			{ID: 5, Role: AccRoleGraphic, Name: "Speaker"},
+		}},
+	}
+
+	list := &AccessibilityNode{Role: AccRoleList, Name: "Accounts", State: AccStateFocused, Live: AccLivePolite}
+	for _, el := range elements {
+		list.Children = append(list.Children, accessibilityNodeFromElement(el))
+	}
+
+	root := &AccessibilityNode{
+		Role: AccRoleWindow,
+		Name: "Settings",
+		Children: []*AccessibilityNode{
+			{Role: AccRoleText, Value: "alice", State: AccStateReadonly},
+			list,
+		},
+	}
+
+	want := `window "Settings"
+  text "" value="alice" (readonly)
+  list "Accounts" (focused) live=polite
+    listitem "Work" (selected, selectable)
+    listitem "Home" (unavailable, selectable)
+    client "Volume" value="40 %"
+    checkbutton "Mute" (checked)
+      graphic "Speaker"
+`
+	if got := root.String(); got != want {
+		t.Errorf("String: got\n%s\nwant\n%s", got, want)
+	}
+}
@@ -376,9 +376,10 @@ func (a *Action) SetShortcut(shortcut Shortcut) (err error) {
 			a.shortcut = old
 			a.raiseChanged()
 		} else {
-			if shortcut.Key == 0 {
+			if shortcut2Action[old] == a {
 				delete(shortcut2Action, old)
-			} else {
+			}
+			if shortcut.Key != 0 {
 				shortcut2Action[shortcut] = a
 			}
 		}
@@ -510,6 +511,16 @@ func (a *Action) Triggered() *Event {
 	return a.triggeredPublisher.Event()
 }
 
+// Trigger triggers the action as if the user had clicked it, unless it is
+// disabled or invisible.
+func (a *Action) Trigger() {
+	if !a.Enabled() || !a.Visible() {
+		return
+	}
+
+	a.raiseTriggered()
+}
+
 func (a *Action) raiseTriggered() {
 	if a.Checkable() {
 		a.SetChecked(!a.Checked())
@@ -12,6 +12,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/xackery/wlk/walk/i18n"
 	"github.com/xackery/wlk/win"
 )
 
@@ -42,6 +43,12 @@ type Application struct {
 	exiting            bool
 	exitCode           int
 	panickingPublisher ErrorEventPublisher
+
+	translations             *i18n.Bundle
+	languageChangedPublisher EventPublisher
+	rightToLeftCondition     Condition
+
+	secondInstanceStartedPublisher SecondInstanceEventPublisher
 }
 
 var appSingleton *Application = new(Application)
@@ -106,6 +113,90 @@ func (app *Application) Panicking() *ErrorEvent {
 	return app.panickingPublisher.Event()
 }
 
+// Translations returns the bundle set with SetTranslations, or nil.
+func (app *Application) Translations() *i18n.Bundle {
+	app.mutex.RLock()
+	defer app.mutex.RUnlock()
+	return app.translations
+}
+
+// SetTranslations makes b the translation function of walk, see
+// SetTranslationFunc. Texts, titles, menu items and TableView column titles
+// declared with cpl are translated by it and are translated again when the
+// language is changed with SetLanguage.
+func (app *Application) SetTranslations(b *i18n.Bundle) {
+	app.mutex.Lock()
+	app.translations = b
+	app.mutex.Unlock()
+
+	if b == nil {
+		SetTranslationFunc(nil)
+	} else {
+		SetTranslationFunc(b.Tr)
+	}
+}
+
+// Language returns the language translations are made into, or an empty
+// string if there are no translations for the selected language.
+func (app *Application) Language() string {
+	if b := app.Translations(); b != nil {
+		return b.Language()
+	}
+
+	return ""
+}
+
+// SetLanguage changes the language of the translations set with
+// SetTranslations, an empty language selecting the languages of the operating
+// system, and translates the texts of all live forms again. It must be called
+// on the UI thread.
+func (app *Application) SetLanguage(language string) error {
+	b := app.Translations()
+	if b == nil {
+		return newError("SetLanguage: no translations set")
+	}
+
+	b.SetLanguage(language)
+
+	app.languageChangedPublisher.Publish()
+
+	// Translated texts usually differ in length.
+	for _, wb := range hwnd2WindowBase {
+		if form, ok := wb.window.(Form); ok {
+			form.RequestLayout()
+			form.Invalidate()
+		}
+	}
+
+	return nil
+}
+
+// LanguageChanged returns the event published by SetLanguage, after which
+// the translation function returns translations into the new language.
+func (app *Application) LanguageChanged() *Event {
+	return app.languageChangedPublisher.Event()
+}
+
+// RightToLeft returns whether the language returned by Language is written
+// from right to left, like Arabic or Hebrew.
+func (app *Application) RightToLeft() bool {
+	return i18n.IsRightToLeft(app.Language())
+}
+
+// RightToLeftCondition returns a Condition that is satisfied while
+// RightToLeft returns true. Binding the Mirrored property of a form to it
+// makes the form switch direction together with the language.
+func (app *Application) RightToLeftCondition() Condition {
+	app.mutex.Lock()
+	defer app.mutex.Unlock()
+
+	if app.rightToLeftCondition == nil {
+		app.rightToLeftCondition = NewDelegateCondition(app.RightToLeft, app.languageChangedPublisher.Event())
+	}
+
+	return app.rightToLeftCondition
+}
+
 // ActiveForm returns the currently active form for the caller's thread.
 // It returns nil if no form is active or the caller's thread does not
 // have any windows associated with it. It should be called from within
@@ -10,6 +10,7 @@ package walk
 import (
 	"image"
 	"image/color"
+	"io/fs"
 	"math"
 	"syscall"
 	"unsafe"
@@ -141,6 +142,19 @@ func NewBitmapFromFileForDPI(filePath string, dpi int) (*Bitmap, error) {
 	return gbmp.Bitmap()
 }
 
+// NewBitmapFromFSForDPI creates a Bitmap from the file name in fsys, which may for example be
+// an embed.FS. If fsys holds DPI variants of the file, like name@2x.png, the variant best
+// suited for the given DPI is loaded, see package resfs. Supported are the formats
+// registered with package image, which include PNG, JPEG and GIF.
+func NewBitmapFromFSForDPI(fsys fs.FS, name string, dpi int) (*Bitmap, error) {
+	set, err := findVariants(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return set.bitmapForDPI(fsys, dpi)
+}
+
 // NewBitmapFromImage creates a Bitmap from image.Image at 96dpi.
 //
 // Deprecated: Newer applications should use NewBitmapFromImageForDPI.
@@ -27,6 +27,7 @@ type Button struct {
 	imageChangedPublisher   EventPublisher
 	image                   Image
 	persistent              bool
+	themedImageWatched      bool
 }
 
 func (b *Button) init() {
@@ -110,6 +111,13 @@ func (b *Button) SetImage(image Image) error {
 
 	b.image = image
 
+	if _, ok := image.(themedImage); ok && !b.themedImageWatched {
+		b.themedImageWatched = true
+		reapplyOnDarkModeChange(&b.WindowBase, func() {
+			b.SetImage(b.image)
+		})
+	}
+
 	b.RequestLayout()
 
 	b.imageChangedPublisher.Publish()
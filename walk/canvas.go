@@ -47,14 +47,6 @@ const (
 	TextPrefixOnly           DrawTextFormat = win.DT_PREFIXONLY
 )
 
-var gM *uint16
-
-func init() {
-	AppendToWalkInit(func() {
-		gM = syscall.StringToUTF16Ptr("gM")
-	})
-}
-
 type Canvas struct {
 	hdc                 win.HDC
 	hBmpStock           win.HBITMAP
@@ -425,6 +417,71 @@ func (c *Canvas) DrawPolylinePixels(pen Pen, points []Point) error {
 	})
 }
 
+// polygonPixels draws a closed polygon in native pixels.
+func (c *Canvas) polygonPixels(brush Brush, pen Pen, points []Point) error {
+	if len(points) < 2 {
+		return nil
+	}
+
+	pts := make([]win.POINT, len(points))
+	for i, p := range points {
+		pts[i] = p.toPOINT()
+	}
+
+	return c.withBrushAndPen(brush, pen, func() error {
+		if !win.Polygon(c.hdc, unsafe.Pointer(&pts[0].X), int32(len(pts))) {
+			return newError("Polygon failed")
+		}
+
+		return nil
+	})
+}
+
+// DrawPolygonPixels draws the outline of a closed polygon in native pixels.
+func (c *Canvas) DrawPolygonPixels(pen Pen, points []Point) error {
+	return c.polygonPixels(nullBrushSingleton, pen, points)
+}
+
+// FillPolygonPixels draws a filled closed polygon in native pixels.
+func (c *Canvas) FillPolygonPixels(brush Brush, points []Point) error {
+	return c.polygonPixels(brush, nullPenSingleton, points)
+}
+
+// piePixels draws a pie-shaped wedge bounded by the ellipse inscribed in bounds. The wedge
+// starts at the ray through radial1 and runs counter-clockwise to the ray through radial2.
+// All coordinates are in native pixels.
+func (c *Canvas) piePixels(brush Brush, pen Pen, bounds Rectangle, radial1, radial2 Point, sizeCorrection int) error {
+	return c.withBrushAndPen(brush, pen, func() error {
+		if !win.Pie(
+			c.hdc,
+			int32(bounds.X),
+			int32(bounds.Y),
+			int32(bounds.X+bounds.Width+sizeCorrection),
+			int32(bounds.Y+bounds.Height+sizeCorrection),
+			int32(radial1.X),
+			int32(radial1.Y),
+			int32(radial2.X),
+			int32(radial2.Y)) {
+
+			return newError("Pie failed")
+		}
+
+		return nil
+	})
+}
+
+// DrawPiePixels draws the outline of a pie-shaped wedge in native pixels. See FillPiePixels.
+func (c *Canvas) DrawPiePixels(pen Pen, bounds Rectangle, radial1, radial2 Point) error {
+	return c.piePixels(nullBrushSingleton, pen, bounds, radial1, radial2, 0)
+}
+
+// FillPiePixels draws a filled pie-shaped wedge bounded by the ellipse inscribed in bounds.
+// The wedge runs counter-clockwise from the ray through radial1 to the ray through radial2.
+// All coordinates are in native pixels.
+func (c *Canvas) FillPiePixels(brush Brush, bounds Rectangle, radial1, radial2 Point) error {
+	return c.piePixels(brush, nullPenSingleton, bounds, radial1, radial2, 1)
+}
+
 // rectangle draws a rectangle in 1/96" units. sizeCorrection parameter is in native pixels.
 //
 // Deprecated: Newer applications should use rectanglePixels.
@@ -598,25 +655,6 @@ func (c *Canvas) DrawTextPixels(text string, font *Font, color wcolor.Color, bou
 	})
 }
 
-// fontHeight returns font height in native pixels.
-func (c *Canvas) fontHeight(font *Font) (height int, err error) {
-	err = c.withFontAndTextColor(font, 0, func() error {
-		var size win.SIZE
-		if !win.GetTextExtentPoint32(c.hdc, gM, 2, &size) {
-			return newError("GetTextExtentPoint32 failed")
-		}
-
-		height = int(size.CY)
-		if height == 0 {
-			return newError("invalid font height")
-		}
-
-		return nil
-	})
-
-	return
-}
-
 // measureTextForDPI measures text for given DPI. Input and output bounds are in native pixels.
 func (c *Canvas) measureTextForDPI(text string, font *Font, bounds Rectangle, format DrawTextFormat, dpi int) (boundsMeasured Rectangle, err error) {
 	hFont := win.HGDIOBJ(font.handleForDPI(dpi))
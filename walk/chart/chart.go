@@ -0,0 +1,1201 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package chart
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"unsafe"
+
+	"github.com/xackery/wlk/common"
+	"github.com/xackery/wlk/walk"
+	"github.com/xackery/wlk/wcolor"
+	"github.com/xackery/wlk/win"
+	"golang.org/x/sys/windows"
+)
+
+// Kind selects how a Chart presents its series.
+type Kind int
+
+const (
+	Line      Kind = iota // one polyline per series over a numeric X axis
+	Area                  // like Line, with the area down to zero filled
+	Bar                   // grouped vertical bars, one category per point index
+	Pie                   // one wedge per point of the first series
+	Sparkline             // a minimal line without axes, legend or title
+)
+
+// DefaultPalette holds the colors assigned to series that have no explicit
+// color, in order.
+var DefaultPalette = []wcolor.Color{
+	wcolor.RGB(0x1f, 0x77, 0xb4),
+	wcolor.RGB(0xff, 0x7f, 0x0e),
+	wcolor.RGB(0x2c, 0xa0, 0x2c),
+	wcolor.RGB(0xd6, 0x27, 0x28),
+	wcolor.RGB(0x94, 0x67, 0xbd),
+	wcolor.RGB(0x8c, 0x56, 0x4b),
+	wcolor.RGB(0xe3, 0x77, 0xc2),
+	wcolor.RGB(0x7f, 0x7f, 0x7f),
+	wcolor.RGB(0xbc, 0xbd, 0x22),
+	wcolor.RGB(0x17, 0xbe, 0xcf),
+}
+
+// hit identifies the data point under the mouse cursor.
+type hit struct {
+	series int
+	index  int
+}
+
+var noHit = hit{-1, -1}
+
+// chartLayout holds the geometry computed by the last paint, in native pixels.
+// Hit testing for tooltips is done against it.
+type chartLayout struct {
+	plot       walk.Rectangle
+	xScale     Scale // maps X values across the full plot width
+	xTicks     Scale // the nice scale X tick labels are taken from
+	yScale     Scale
+	categories int
+	slices     []Slice
+	pieCenter  walk.Point
+	pieRadius  int
+}
+
+// Chart is a widget that draws one or more Series as a line, area, bar, pie
+// or sparkline chart. All drawing is done in native pixels, so charts stay
+// crisp at any DPI.
+type Chart struct {
+	*walk.CustomWidget
+	kind           Kind
+	title          string
+	series         []*Series
+	seriesHandles  []int
+	legendVisible  bool
+	gridVisible    bool
+	palette        []wcolor.Color
+	yMin, yMax     float64
+	fixedYRange    bool
+	layout         chartLayout
+	hover          hit
+	trackingMouse  bool
+	valueFormatter func(v float64) string
+}
+
+// NewChart creates a new, empty Chart of kind as a child of parent.
+func NewChart(parent walk.Container, kind Kind) (*Chart, error) {
+	c := &Chart{
+		kind:          kind,
+		legendVisible: kind != Sparkline,
+		gridVisible:   true,
+		palette:       DefaultPalette,
+		hover:         noHit,
+	}
+
+	cw, err := walk.NewCustomWidgetPixels(parent, 0, c.paint)
+	if err != nil {
+		return nil, err
+	}
+	c.CustomWidget = cw
+
+	cw.SetPaintMode(walk.PaintBuffered)
+	cw.SetInvalidatesOnResize(true)
+
+	if err := walk.InitWrapperWindow(c); err != nil {
+		cw.Dispose()
+		return nil, err
+	}
+
+	c.MouseMove().Attach(c.onMouseMove)
+
+	return c, nil
+}
+
+// Dispose releases the event handlers attached to the series of c and
+// destroys the underlying window.
+func (c *Chart) Dispose() {
+	for i, s := range c.series {
+		s.Changed().Detach(c.seriesHandles[i])
+	}
+	c.series = nil
+	c.seriesHandles = nil
+
+	c.CustomWidget.Dispose()
+}
+
+// Kind returns how c presents its series.
+func (c *Chart) Kind() Kind {
+	return c.kind
+}
+
+// SetKind changes how c presents its series.
+func (c *Chart) SetKind(kind Kind) {
+	if kind == c.kind {
+		return
+	}
+
+	c.kind = kind
+	c.hover = noHit
+	c.Invalidate()
+}
+
+// Title returns the title drawn above the chart.
+func (c *Chart) Title() string {
+	return c.title
+}
+
+// SetTitle sets the title drawn above the chart. Sparklines ignore it.
+func (c *Chart) SetTitle(title string) {
+	if title == c.title {
+		return
+	}
+
+	c.title = title
+	c.Invalidate()
+}
+
+// LegendVisible returns whether c draws a legend.
+func (c *Chart) LegendVisible() bool {
+	return c.legendVisible
+}
+
+// SetLegendVisible sets whether c draws a legend.
+func (c *Chart) SetLegendVisible(visible bool) {
+	if visible == c.legendVisible {
+		return
+	}
+
+	c.legendVisible = visible
+	c.Invalidate()
+}
+
+// GridVisible returns whether c draws horizontal grid lines at the Y ticks.
+func (c *Chart) GridVisible() bool {
+	return c.gridVisible
+}
+
+// SetGridVisible sets whether c draws horizontal grid lines at the Y ticks.
+func (c *Chart) SetGridVisible(visible bool) {
+	if visible == c.gridVisible {
+		return
+	}
+
+	c.gridVisible = visible
+	c.Invalidate()
+}
+
+// Palette returns the colors assigned to series without an explicit color.
+func (c *Chart) Palette() []wcolor.Color {
+	return c.palette
+}
+
+// SetPalette sets the colors assigned to series without an explicit color.
+// Passing an empty palette restores DefaultPalette.
+func (c *Chart) SetPalette(palette []wcolor.Color) {
+	if len(palette) == 0 {
+		palette = DefaultPalette
+	}
+
+	c.palette = palette
+	c.Invalidate()
+}
+
+// SetYRange fixes the Y axis to the range [min, max] instead of deriving it
+// from the data.
+func (c *Chart) SetYRange(min, max float64) {
+	c.yMin, c.yMax, c.fixedYRange = min, max, true
+	c.Invalidate()
+}
+
+// ResetYRange makes the Y axis follow the data again.
+func (c *Chart) ResetYRange() {
+	c.fixedYRange = false
+	c.Invalidate()
+}
+
+// SetValueFormatter sets the function used to format Y values in tooltips.
+// A nil formatter restores the default formatting.
+func (c *Chart) SetValueFormatter(formatter func(v float64) string) {
+	c.valueFormatter = formatter
+}
+
+// Series returns the series displayed by c.
+func (c *Chart) Series() []*Series {
+	return append([]*Series(nil), c.series...)
+}
+
+// AddSeries adds series to c. The chart repaints whenever a series changes.
+func (c *Chart) AddSeries(series ...*Series) {
+	for _, s := range series {
+		c.series = append(c.series, s)
+		c.seriesHandles = append(c.seriesHandles, s.Changed().Attach(c.onSeriesChanged))
+	}
+
+	c.Invalidate()
+}
+
+// RemoveSeries removes s from c. It returns false if c does not display s.
+func (c *Chart) RemoveSeries(s *Series) bool {
+	for i, cs := range c.series {
+		if cs != s {
+			continue
+		}
+
+		s.Changed().Detach(c.seriesHandles[i])
+		c.series = append(c.series[:i], c.series[i+1:]...)
+		c.seriesHandles = append(c.seriesHandles[:i], c.seriesHandles[i+1:]...)
+		c.hover = noHit
+		c.Invalidate()
+
+		return true
+	}
+
+	return false
+}
+
+// ClearSeries removes all series from c.
+func (c *Chart) ClearSeries() {
+	for i, s := range c.series {
+		s.Changed().Detach(c.seriesHandles[i])
+	}
+	c.series = nil
+	c.seriesHandles = nil
+	c.hover = noHit
+
+	c.Invalidate()
+}
+
+func (c *Chart) onSeriesChanged() {
+	c.hover = noHit
+	c.Invalidate()
+}
+
+func (c *Chart) seriesColor(index int) wcolor.Color {
+	if color, ok := c.series[index].Color(); ok {
+		return color
+	}
+
+	return c.palette[index%len(c.palette)]
+}
+
+func (c *Chart) sliceColor(index int) wcolor.Color {
+	return c.palette[index%len(c.palette)]
+}
+
+func (c *Chart) formatValue(v float64) string {
+	if c.valueFormatter != nil {
+		return c.valueFormatter(v)
+	}
+
+	return strconv.FormatFloat(v, 'g', 6, 64)
+}
+
+func (c *Chart) WndProc(hwnd windows.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_MOUSEMOVE:
+		if !c.trackingMouse {
+			var tme win.TRACKMOUSEEVENT
+			tme.CbSize = uint32(unsafe.Sizeof(tme))
+			tme.DwFlags = win.TME_LEAVE
+			tme.HwndTrack = hwnd
+
+			c.trackingMouse = win.TrackMouseEvent(&tme)
+		}
+
+	case win.WM_MOUSELEAVE:
+		c.trackingMouse = false
+		c.setHover(noHit)
+	}
+
+	return c.CustomWidget.WndProc(hwnd, msg, wParam, lParam)
+}
+
+func (c *Chart) onMouseMove(x, y int, button walk.MouseButton) {
+	c.setHover(c.hitTest(x, y))
+}
+
+func (c *Chart) setHover(h hit) {
+	if h == c.hover {
+		return
+	}
+
+	c.hover = h
+	c.Invalidate()
+}
+
+// hitTest returns the data point at (x, y), in native pixels.
+func (c *Chart) hitTest(x, y int) hit {
+	l := &c.layout
+	fx, fy := float64(x), float64(y)
+
+	switch c.kind {
+	case Pie:
+		if len(c.series) == 0 || l.pieRadius <= 0 {
+			return noHit
+		}
+		dx, dy := fx-float64(l.pieCenter.X), fy-float64(l.pieCenter.Y)
+		if math.Hypot(dx, dy) > float64(l.pieRadius) {
+			return noHit
+		}
+		if i := SliceAt(l.slices, AngleOf(float64(l.pieCenter.X), float64(l.pieCenter.Y), fx, fy)); i >= 0 {
+			return hit{0, i}
+		}
+
+	case Bar:
+		if !contains(l.plot, x, y) {
+			return noHit
+		}
+		left, width := float64(l.plot.X), float64(l.plot.Width)
+		cat := CategoryAt(fx, l.categories, left, width)
+		if cat < 0 {
+			return noHit
+		}
+		for si, s := range c.series {
+			if cat >= s.Len() {
+				continue
+			}
+			bx, bw := BarSpan(cat, si, l.categories, len(c.series), left, width, barGap)
+			if fx >= bx && fx < bx+bw {
+				return hit{si, cat}
+			}
+		}
+
+	default:
+		if !contains(l.plot, x, y) {
+			return noHit
+		}
+		best, bestDist := noHit, math.Inf(1)
+		for si, s := range c.series {
+			xs := s.xValues()
+			if !sortedAscending(xs) {
+				continue
+			}
+			i := NearestIndex(xs, l.xScale.Invert(fx, float64(l.plot.X), float64(l.plot.X+l.plot.Width)))
+			if i < 0 {
+				continue
+			}
+			p := s.Point(i)
+			px, py := c.mapPoint(p)
+			if d := math.Hypot(px-fx, py-fy); d < bestDist {
+				best, bestDist = hit{si, i}, d
+			}
+		}
+		if bestDist <= float64(c.IntFrom96DPI(hoverRadius)) {
+			return best
+		}
+	}
+
+	return noHit
+}
+
+func sortedAscending(xs []float64) bool {
+	for i := 1; i < len(xs); i++ {
+		if xs[i] < xs[i-1] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Layout constants, in 1/96".
+const (
+	padding     = 8
+	tickGap     = 4
+	swatchSize  = 10
+	lineWidth   = 2
+	markerSize  = 7
+	hoverRadius = 24
+	minTickGap  = 36
+	barGap      = 0.25
+)
+
+type colorScheme struct {
+	background wcolor.Color
+	text       wcolor.Color
+	grid       wcolor.Color
+	axis       wcolor.Color
+	tooltipBG  wcolor.Color
+	tooltipFG  wcolor.Color
+}
+
+func currentColorScheme() colorScheme {
+	if walk.IsDarkMode() {
+		return colorScheme{
+			background: common.DarkFormBG,
+			text:       common.DarkTextFG,
+			grid:       wcolor.RGB(0x3a, 0x3a, 0x3a),
+			axis:       wcolor.RGB(0x80, 0x80, 0x80),
+			tooltipBG:  common.DarkButtonBG,
+			tooltipFG:  common.DarkTextTitleFG,
+		}
+	}
+
+	return colorScheme{
+		background: wcolor.Color(win.GetSysColor(win.COLOR_WINDOW)),
+		text:       wcolor.Color(win.GetSysColor(win.COLOR_WINDOWTEXT)),
+		grid:       wcolor.RGB(0xe4, 0xe4, 0xe4),
+		axis:       wcolor.RGB(0x90, 0x90, 0x90),
+		tooltipBG:  wcolor.Color(win.GetSysColor(win.COLOR_INFOBK)),
+		tooltipFG:  wcolor.Color(win.GetSysColor(win.COLOR_INFOTEXT)),
+	}
+}
+
+// blend mixes a and b, with weight being the share of b.
+func blend(a, b wcolor.Color, weight float64) wcolor.Color {
+	mix := func(x, y byte) byte {
+		return byte(math.Round(float64(x)*(1-weight) + float64(y)*weight))
+	}
+
+	return wcolor.RGB(mix(a.R(), b.R()), mix(a.G(), b.G()), mix(a.B(), b.B()))
+}
+
+// painter bundles the state needed while painting a chart.
+type painter struct {
+	c       *Chart
+	canvas  *walk.Canvas
+	font    *walk.Font
+	colors  colorScheme
+	brushes []walk.Brush
+	pens    []walk.Pen
+}
+
+func (p *painter) dispose() {
+	for _, b := range p.brushes {
+		b.Dispose()
+	}
+	for _, pen := range p.pens {
+		pen.Dispose()
+	}
+}
+
+func (p *painter) brush(color wcolor.Color) (walk.Brush, error) {
+	b, err := walk.NewSolidColorBrush(color)
+	if err != nil {
+		return nil, err
+	}
+	p.brushes = append(p.brushes, b)
+
+	return b, nil
+}
+
+// pen returns a pen of width, in 1/96", that is scaled to the DPI of the canvas.
+func (p *painter) pen(color wcolor.Color, width int) (walk.Pen, error) {
+	b, err := p.brush(color)
+	if err != nil {
+		return nil, err
+	}
+
+	pen, err := walk.NewGeometricPen(walk.PenSolid|walk.PenCapRound|walk.PenJoinRound, width, b)
+	if err != nil {
+		return nil, err
+	}
+	p.pens = append(p.pens, pen)
+
+	return pen, nil
+}
+
+func (p *painter) textSize(text string) walk.Size {
+	bounds, _, err := p.canvas.MeasureTextPixels(text, p.font, walk.Rectangle{Width: 10000, Height: 10000}, walk.TextSingleLine)
+	if err != nil {
+		return walk.Size{}
+	}
+
+	return bounds.Size()
+}
+
+func (p *painter) text(text string, color wcolor.Color, bounds walk.Rectangle, format walk.DrawTextFormat) error {
+	return p.canvas.DrawTextPixels(text, p.font, color, bounds, format|walk.TextSingleLine|walk.TextNoPrefix)
+}
+
+func (c *Chart) paint(canvas *walk.Canvas, updateBounds walk.Rectangle) error {
+	p := &painter{
+		c:      c,
+		canvas: canvas,
+		font:   c.Font(),
+		colors: currentColorScheme(),
+	}
+	defer p.dispose()
+
+	bounds := c.ClientBoundsPixels()
+
+	bg, err := p.brush(p.colors.background)
+	if err != nil {
+		return err
+	}
+	if err := canvas.FillRectanglePixels(bg, bounds); err != nil {
+		return err
+	}
+
+	if c.kind == Sparkline {
+		return c.paintSparkline(p, bounds)
+	}
+
+	pad := c.IntFrom96DPI(padding)
+	area := walk.Rectangle{X: bounds.X + pad, Y: bounds.Y + pad, Width: bounds.Width - 2*pad, Height: bounds.Height - 2*pad}
+
+	if c.title != "" {
+		size := p.textSize(c.title)
+		if err := p.text(c.title, p.colors.text, walk.Rectangle{X: area.X, Y: area.Y, Width: area.Width, Height: size.Height}, walk.TextCenter); err != nil {
+			return err
+		}
+		area.Y += size.Height + pad
+		area.Height -= size.Height + pad
+	}
+
+	if c.legendVisible {
+		var err error
+		if area, err = c.paintLegend(p, area); err != nil {
+			return err
+		}
+	}
+
+	if area.Width <= 0 || area.Height <= 0 {
+		return nil
+	}
+
+	switch c.kind {
+	case Pie:
+		err = c.paintPie(p, area)
+
+	default:
+		err = c.paintAxisChart(p, area)
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.paintTooltip(p, bounds)
+}
+
+type legendEntry struct {
+	text  string
+	color wcolor.Color
+}
+
+func (c *Chart) legendEntries() []legendEntry {
+	var entries []legendEntry
+
+	if c.kind == Pie {
+		if len(c.series) == 0 {
+			return nil
+		}
+		for i, pt := range c.series[0].points {
+			text := pt.Label
+			if text == "" {
+				text = c.formatValue(pt.X)
+			}
+			entries = append(entries, legendEntry{text, c.sliceColor(i)})
+		}
+
+		return entries
+	}
+
+	for i, s := range c.series {
+		entries = append(entries, legendEntry{s.Name(), c.seriesColor(i)})
+	}
+
+	return entries
+}
+
+// paintLegend draws the legend at the right side of area and returns what is
+// left of area for the chart itself.
+func (c *Chart) paintLegend(p *painter, area walk.Rectangle) (walk.Rectangle, error) {
+	entries := c.legendEntries()
+	if len(entries) == 0 {
+		return area, nil
+	}
+
+	swatch := c.IntFrom96DPI(swatchSize)
+	gap := c.IntFrom96DPI(tickGap)
+	pad := c.IntFrom96DPI(padding)
+
+	var textWidth, lineHeight int
+	for _, e := range entries {
+		size := p.textSize(e.text)
+		if size.Width > textWidth {
+			textWidth = size.Width
+		}
+		if size.Height > lineHeight {
+			lineHeight = size.Height
+		}
+	}
+	if lineHeight < swatch {
+		lineHeight = swatch
+	}
+
+	width := swatch + gap + textWidth
+	if width > area.Width/3 {
+		width = area.Width / 3
+	}
+	if width <= swatch+gap {
+		return area, nil
+	}
+
+	x := area.X + area.Width - width
+	y := area.Y
+	for _, e := range entries {
+		if y+lineHeight > area.Y+area.Height {
+			break
+		}
+
+		b, err := p.brush(e.color)
+		if err != nil {
+			return area, err
+		}
+		if err := p.canvas.FillRectanglePixels(b, walk.Rectangle{X: x, Y: y + (lineHeight-swatch)/2, Width: swatch, Height: swatch}); err != nil {
+			return area, err
+		}
+
+		textBounds := walk.Rectangle{X: x + swatch + gap, Y: y, Width: width - swatch - gap, Height: lineHeight}
+		if err := p.text(e.text, p.colors.text, textBounds, walk.TextLeft|walk.TextVCenter|walk.TextEndEllipsis); err != nil {
+			return area, err
+		}
+
+		y += lineHeight + gap
+	}
+
+	area.Width -= width + pad
+
+	return area, nil
+}
+
+func (c *Chart) yExtent() (min, max float64, ok bool) {
+	if c.fixedYRange {
+		return c.yMin, c.yMax, true
+	}
+
+	var values []float64
+	for _, s := range c.series {
+		values = append(values, s.yValues()...)
+	}
+
+	return Extent(values)
+}
+
+func (c *Chart) categoryCount() int {
+	var n int
+	for _, s := range c.series {
+		if s.Len() > n {
+			n = s.Len()
+		}
+	}
+
+	return n
+}
+
+func (c *Chart) categoryLabel(index int) string {
+	for _, s := range c.series {
+		if index < s.Len() {
+			if pt := s.Point(index); pt.Label != "" {
+				return pt.Label
+			}
+		}
+	}
+
+	return strconv.Itoa(index + 1)
+}
+
+// mapPoint maps a data point to native pixel coordinates using the last layout.
+func (c *Chart) mapPoint(pt Point) (x, y float64) {
+	l := &c.layout
+	x = l.xScale.Map(pt.X, float64(l.plot.X), float64(l.plot.X+l.plot.Width))
+	y = l.yScale.Map(pt.Y, float64(l.plot.Y+l.plot.Height), float64(l.plot.Y))
+
+	return x, y
+}
+
+func (c *Chart) paintAxisChart(p *painter, area walk.Rectangle) error {
+	l := &c.layout
+
+	lineHeight := p.textSize("0").Height
+	gap := c.IntFrom96DPI(tickGap)
+
+	ymin, ymax, ok := c.yExtent()
+	if !ok {
+		ymin, ymax = 0, 1
+	}
+	yTicks := (area.Height - lineHeight - gap) / c.IntFrom96DPI(minTickGap)
+	if c.kind == Line && !c.fixedYRange {
+		l.yScale = NiceScale(ymin, ymax, yTicks)
+	} else if c.fixedYRange {
+		l.yScale = NiceScale(ymin, ymax, yTicks)
+		l.yScale.Min, l.yScale.Max = math.Min(ymin, ymax), math.Max(ymin, ymax)
+	} else {
+		l.yScale = NiceScaleFromZero(ymin, ymax, yTicks)
+	}
+
+	var labelWidth int
+	for _, v := range l.yScale.Ticks() {
+		if w := p.textSize(l.yScale.Format(v)).Width; w > labelWidth {
+			labelWidth = w
+		}
+	}
+
+	l.plot = walk.Rectangle{
+		X:      area.X + labelWidth + gap,
+		Y:      area.Y + lineHeight/2,
+		Width:  area.Width - labelWidth - gap,
+		Height: area.Height - lineHeight/2 - lineHeight - gap,
+	}
+	if l.plot.Width <= 0 || l.plot.Height <= 0 {
+		return nil
+	}
+
+	if c.kind == Bar {
+		l.categories = c.categoryCount()
+	} else {
+		var xs []float64
+		for _, s := range c.series {
+			xs = append(xs, s.xValues()...)
+		}
+		xmin, xmax, ok := Extent(xs)
+		if !ok {
+			xmin, xmax = 0, 1
+		}
+		l.xTicks = NiceScale(xmin, xmax, l.plot.Width/c.IntFrom96DPI(2*minTickGap))
+		// Lines span the full width, the nice range is only used for ticks.
+		l.xScale = l.xTicks
+		if xmin < xmax {
+			l.xScale.Min, l.xScale.Max = xmin, xmax
+		}
+	}
+
+	plotBottom := l.plot.Y + l.plot.Height
+	plotRight := l.plot.X + l.plot.Width
+
+	gridPen, err := walk.NewCosmeticPen(walk.PenSolid, p.colors.grid)
+	if err != nil {
+		return err
+	}
+	p.pens = append(p.pens, gridPen)
+
+	axisPen, err := walk.NewCosmeticPen(walk.PenSolid, p.colors.axis)
+	if err != nil {
+		return err
+	}
+	p.pens = append(p.pens, axisPen)
+
+	// Y ticks and grid
+	for _, v := range l.yScale.Ticks() {
+		y := int(math.Round(l.yScale.Map(v, float64(plotBottom), float64(l.plot.Y))))
+		if c.gridVisible {
+			if err := p.canvas.DrawLinePixels(gridPen, walk.Point{X: l.plot.X, Y: y}, walk.Point{X: plotRight, Y: y}); err != nil {
+				return err
+			}
+		}
+		bounds := walk.Rectangle{X: area.X, Y: y - lineHeight/2, Width: labelWidth, Height: lineHeight}
+		if err := p.text(l.yScale.Format(v), p.colors.text, bounds, walk.TextRight|walk.TextVCenter); err != nil {
+			return err
+		}
+	}
+
+	// X ticks
+	labelTop := plotBottom + gap
+	if c.kind == Bar {
+		if l.categories > 0 {
+			catWidth := l.plot.Width / l.categories
+			for i := 0; i < l.categories; i++ {
+				bounds := walk.Rectangle{X: l.plot.X + i*catWidth, Y: labelTop, Width: catWidth, Height: lineHeight}
+				if err := p.text(c.categoryLabel(i), p.colors.text, bounds, walk.TextCenter|walk.TextEndEllipsis); err != nil {
+					return err
+				}
+			}
+		}
+	} else {
+		for _, v := range l.xTicks.Ticks() {
+			if !l.xScale.Contains(v) {
+				continue
+			}
+			text := l.xTicks.Format(v)
+			x := int(math.Round(l.xScale.Map(v, float64(l.plot.X), float64(plotRight))))
+			w := p.textSize(text).Width
+			bounds := walk.Rectangle{X: x - w/2, Y: labelTop, Width: w, Height: lineHeight}
+			if err := p.text(text, p.colors.text, bounds, walk.TextCenter); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Axes
+	if err := p.canvas.DrawLinePixels(axisPen, walk.Point{X: l.plot.X, Y: l.plot.Y}, walk.Point{X: l.plot.X, Y: plotBottom}); err != nil {
+		return err
+	}
+	if err := p.canvas.DrawLinePixels(axisPen, walk.Point{X: l.plot.X, Y: plotBottom}, walk.Point{X: plotRight, Y: plotBottom}); err != nil {
+		return err
+	}
+
+	if c.kind == Bar {
+		return c.paintBars(p)
+	}
+
+	return c.paintLines(p)
+}
+
+func (c *Chart) zeroY() int {
+	l := &c.layout
+	zero := math.Max(l.yScale.Min, math.Min(0, l.yScale.Max))
+
+	return int(math.Round(l.yScale.Map(zero, float64(l.plot.Y+l.plot.Height), float64(l.plot.Y))))
+}
+
+func (c *Chart) paintBars(p *painter) error {
+	zeroY := c.zeroY()
+
+	for si, s := range c.series {
+		for i, pt := range s.points {
+			if !isFinite(pt.Y) {
+				continue
+			}
+
+			color := c.seriesColor(si)
+			if si == c.hover.series && i == c.hover.index {
+				color = blend(color, p.colors.background, 0.3)
+			}
+
+			if err := c.paintBar(p, color, si, i, pt, zeroY); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Chart) paintBar(p *painter, color wcolor.Color, si, i int, pt Point, zeroY int) error {
+	l := &c.layout
+
+	x, w := BarSpan(i, si, l.categories, len(c.series), float64(l.plot.X), float64(l.plot.Width), barGap)
+	y := int(math.Round(l.yScale.Map(pt.Y, float64(l.plot.Y+l.plot.Height), float64(l.plot.Y))))
+
+	top, bottom := y, zeroY
+	if top > bottom {
+		top, bottom = bottom, top
+	}
+
+	b, err := p.brush(color)
+	if err != nil {
+		return err
+	}
+
+	left := int(math.Round(x))
+	right := int(math.Round(x + w))
+
+	return p.canvas.FillRectanglePixels(b, walk.Rectangle{X: left, Y: top, Width: maxInt(right-left-1, 1), Height: maxInt(bottom-top, 1)})
+}
+
+func (c *Chart) paintLines(p *painter) error {
+	zeroY := c.zeroY()
+
+	for si, s := range c.series {
+		if s.Len() == 0 {
+			continue
+		}
+
+		color := c.seriesColor(si)
+
+		pts := make([]walk.Point, 0, s.Len())
+		for _, pt := range s.points {
+			if !isFinite(pt.X) || !isFinite(pt.Y) {
+				continue
+			}
+			x, y := c.mapPoint(pt)
+			pts = append(pts, walk.Point{X: int(math.Round(x)), Y: int(math.Round(y))})
+		}
+		if len(pts) == 0 {
+			continue
+		}
+
+		if c.kind == Area && len(pts) > 1 {
+			fill, err := p.brush(blend(color, p.colors.background, 0.65))
+			if err != nil {
+				return err
+			}
+			poly := make([]walk.Point, 0, len(pts)+2)
+			poly = append(poly, walk.Point{X: pts[0].X, Y: zeroY})
+			poly = append(poly, pts...)
+			poly = append(poly, walk.Point{X: pts[len(pts)-1].X, Y: zeroY})
+			if err := p.canvas.FillPolygonPixels(fill, poly); err != nil {
+				return err
+			}
+		}
+
+		pen, err := p.pen(color, lineWidth)
+		if err != nil {
+			return err
+		}
+		if err := p.canvas.DrawPolylinePixels(pen, pts); err != nil {
+			return err
+		}
+
+		if c.hover.series == si && c.hover.index >= 0 && c.hover.index < s.Len() {
+			x, y := c.mapPoint(s.Point(c.hover.index))
+			if err := c.paintMarker(p, color, int(math.Round(x)), int(math.Round(y))); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Chart) paintMarker(p *painter, color wcolor.Color, x, y int) error {
+	size := c.IntFrom96DPI(markerSize)
+	b, err := p.brush(color)
+	if err != nil {
+		return err
+	}
+
+	return p.canvas.FillEllipsePixels(b, walk.Rectangle{X: x - size/2, Y: y - size/2, Width: size, Height: size})
+}
+
+func (c *Chart) paintPie(p *painter, area walk.Rectangle) error {
+	l := &c.layout
+	l.slices = nil
+	l.pieRadius = 0
+
+	if len(c.series) == 0 {
+		return nil
+	}
+
+	size := minInt(area.Width, area.Height)
+	l.pieRadius = size / 2
+	l.pieCenter = walk.Point{X: area.X + area.Width/2, Y: area.Y + area.Height/2}
+	l.slices = PieSlices(c.series[0].yValues())
+
+	bounds := walk.Rectangle{X: l.pieCenter.X - l.pieRadius, Y: l.pieCenter.Y - l.pieRadius, Width: size, Height: size}
+	cx, cy := float64(l.pieCenter.X), float64(l.pieCenter.Y)
+	// Radials are only used for their direction. Placing them well outside the
+	// ellipse keeps rounding from skewing the angles of thin slices.
+	far := float64(l.pieRadius) * 4
+
+	for i, sl := range l.slices {
+		if sl.Sweep <= 0 {
+			continue
+		}
+
+		color := c.sliceColor(i)
+		if c.hover.series == 0 && c.hover.index == i {
+			color = blend(color, p.colors.background, 0.3)
+		}
+
+		b, err := p.brush(color)
+		if err != nil {
+			return err
+		}
+
+		if sl.Sweep >= 359.99 {
+			if err := p.canvas.FillEllipsePixels(b, bounds); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// GDI draws wedges counter-clockwise, slices run clockwise.
+		x1, y1 := PolarPoint(cx, cy, far, sl.End())
+		x2, y2 := PolarPoint(cx, cy, far, sl.Start)
+		r1 := walk.Point{X: int(math.Round(x1)), Y: int(math.Round(y1))}
+		r2 := walk.Point{X: int(math.Round(x2)), Y: int(math.Round(y2))}
+		if r1 == r2 {
+			// Identical radials would make GDI draw the full ellipse.
+			continue
+		}
+
+		if err := p.canvas.FillPiePixels(b, bounds, r1, r2); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Chart) paintSparkline(p *painter, bounds walk.Rectangle) error {
+	l := &c.layout
+	inset := c.IntFrom96DPI(markerSize) / 2
+	l.plot = walk.Rectangle{X: bounds.X + inset, Y: bounds.Y + inset, Width: bounds.Width - 2*inset, Height: bounds.Height - 2*inset}
+
+	if len(c.series) == 0 || c.series[0].Len() == 0 || l.plot.Width <= 0 || l.plot.Height <= 0 {
+		return nil
+	}
+
+	s := c.series[0]
+	xmin, xmax, _ := Extent(s.xValues())
+	ymin, ymax, ok := c.yExtent()
+	if !ok {
+		return nil
+	}
+	if ymin == ymax {
+		ymin, ymax = ymin-1, ymax+1
+	}
+	if xmin == xmax {
+		xmin, xmax = xmin-1, xmax+1
+	}
+	l.xScale = Scale{Min: xmin, Max: xmax}
+	l.yScale = Scale{Min: ymin, Max: ymax}
+
+	pts := make([]walk.Point, 0, s.Len())
+	for _, pt := range s.points {
+		if !isFinite(pt.X) || !isFinite(pt.Y) {
+			continue
+		}
+		x, y := c.mapPoint(pt)
+		pts = append(pts, walk.Point{X: int(math.Round(x)), Y: int(math.Round(y))})
+	}
+	if len(pts) == 0 {
+		return nil
+	}
+
+	color := c.seriesColor(0)
+	pen, err := p.pen(color, 1)
+	if err != nil {
+		return err
+	}
+	if err := p.canvas.DrawPolylinePixels(pen, pts); err != nil {
+		return err
+	}
+
+	last := pts[len(pts)-1]
+	if c.hover.series == 0 && c.hover.index >= 0 && c.hover.index < s.Len() {
+		x, y := c.mapPoint(s.Point(c.hover.index))
+		last = walk.Point{X: int(math.Round(x)), Y: int(math.Round(y))}
+	}
+	if err := c.paintMarker(p, color, last.X, last.Y); err != nil {
+		return err
+	}
+
+	return c.paintTooltip(p, bounds)
+}
+
+func (c *Chart) tooltipText() string {
+	h := c.hover
+	if h.series < 0 || h.series >= len(c.series) || h.index < 0 || h.index >= c.series[h.series].Len() {
+		return ""
+	}
+
+	s := c.series[h.series]
+	pt := s.Point(h.index)
+
+	value := c.formatValue(pt.Y)
+	switch c.kind {
+	case Pie:
+		label := pt.Label
+		if label == "" {
+			label = c.formatValue(pt.X)
+		}
+		var pct float64
+		if sl := c.layout.slices; h.index < len(sl) {
+			pct = sl[h.index].Sweep / 360 * 100
+		}
+		return fmt.Sprintf("%s: %s (%.1f%%)", label, value, pct)
+
+	case Bar:
+		return fmt.Sprintf("%s, %s: %s", s.Name(), c.categoryLabel(h.index), value)
+
+	case Sparkline:
+		return value
+	}
+
+	label := pt.Label
+	if label == "" {
+		label = c.formatValue(pt.X)
+	}
+	if s.Name() == "" {
+		return fmt.Sprintf("%s: %s", label, value)
+	}
+
+	return fmt.Sprintf("%s, %s: %s", s.Name(), label, value)
+}
+
+// tooltipAnchor returns the point, in native pixels, the tooltip is placed next to.
+func (c *Chart) tooltipAnchor() walk.Point {
+	l := &c.layout
+	h := c.hover
+	pt := c.series[h.series].Point(h.index)
+
+	switch c.kind {
+	case Pie:
+		if h.index < len(l.slices) {
+			sl := l.slices[h.index]
+			x, y := PolarPoint(float64(l.pieCenter.X), float64(l.pieCenter.Y), float64(l.pieRadius)*0.6, sl.Start+sl.Sweep/2)
+			return walk.Point{X: int(x), Y: int(y)}
+		}
+		return l.pieCenter
+
+	case Bar:
+		x, w := BarSpan(h.index, h.series, l.categories, len(c.series), float64(l.plot.X), float64(l.plot.Width), barGap)
+		y := l.yScale.Map(pt.Y, float64(l.plot.Y+l.plot.Height), float64(l.plot.Y))
+		return walk.Point{X: int(x + w/2), Y: int(y)}
+	}
+
+	x, y := c.mapPoint(pt)
+	return walk.Point{X: int(x), Y: int(y)}
+}
+
+func (c *Chart) paintTooltip(p *painter, bounds walk.Rectangle) error {
+	text := c.tooltipText()
+	if text == "" {
+		return nil
+	}
+
+	gap := c.IntFrom96DPI(tickGap)
+	size := p.textSize(text)
+	box := walk.Rectangle{Width: size.Width + 2*gap, Height: size.Height + 2*gap}
+
+	anchor := c.tooltipAnchor()
+	offset := c.IntFrom96DPI(markerSize)
+	box.X = anchor.X + offset
+	box.Y = anchor.Y - box.Height - offset
+	if box.X+box.Width > bounds.X+bounds.Width {
+		box.X = anchor.X - offset - box.Width
+	}
+	if box.X < bounds.X {
+		box.X = bounds.X
+	}
+	if box.Y < bounds.Y {
+		box.Y = anchor.Y + offset
+	}
+
+	bg, err := p.brush(p.colors.tooltipBG)
+	if err != nil {
+		return err
+	}
+	if err := p.canvas.FillRectanglePixels(bg, box); err != nil {
+		return err
+	}
+
+	border, err := walk.NewCosmeticPen(walk.PenSolid, p.colors.axis)
+	if err != nil {
+		return err
+	}
+	p.pens = append(p.pens, border)
+	if err := p.canvas.DrawRectanglePixels(border, box); err != nil {
+		return err
+	}
+
+	return p.text(text, p.colors.tooltipFG, walk.Rectangle{X: box.X + gap, Y: box.Y + gap, Width: size.Width, Height: size.Height}, walk.TextLeft)
+}
+
+func contains(r walk.Rectangle, x, y int) bool {
+	return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package chart
+
+import (
+	"math"
+	"sort"
+)
+
+// Slice describes one wedge of a pie chart. Angles are measured in degrees,
+// clockwise, starting at 12 o'clock.
+type Slice struct {
+	Start float64
+	Sweep float64
+}
+
+// End returns the angle at which sl ends.
+func (sl Slice) End() float64 {
+	return sl.Start + sl.Sweep
+}
+
+// PieSlices distributes 360 degrees over values proportionally. Negative and
+// non-finite values are treated as zero. If all values are zero, all slices
+// are empty.
+func PieSlices(values []float64) []Slice {
+	var total float64
+	for _, v := range values {
+		if isFinite(v) && v > 0 {
+			total += v
+		}
+	}
+
+	slices := make([]Slice, len(values))
+	if total == 0 {
+		return slices
+	}
+
+	var angle float64
+	for i, v := range values {
+		if !isFinite(v) || v < 0 {
+			v = 0
+		}
+		sweep := v / total * 360
+		slices[i] = Slice{Start: angle, Sweep: sweep}
+		angle += sweep
+	}
+
+	return slices
+}
+
+// SliceAt returns the index of the slice containing angle (in degrees, as
+// described for Slice), or -1 if no non-empty slice contains it.
+func SliceAt(slices []Slice, angle float64) int {
+	angle = math.Mod(angle, 360)
+	if angle < 0 {
+		angle += 360
+	}
+
+	for i, sl := range slices {
+		if sl.Sweep > 0 && angle >= sl.Start && angle < sl.End() {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// PolarPoint returns the point at distance radius from (cx, cy) in direction
+// angle, using the pie angle convention described for Slice and screen
+// coordinates growing downward.
+func PolarPoint(cx, cy, radius, angle float64) (x, y float64) {
+	rad := angle * math.Pi / 180
+	return cx + radius*math.Sin(rad), cy - radius*math.Cos(rad)
+}
+
+// AngleOf is the inverse of PolarPoint. It returns the angle of (x, y) as seen
+// from (cx, cy), in the range [0, 360).
+func AngleOf(cx, cy, x, y float64) float64 {
+	angle := math.Atan2(x-cx, cy-y) * 180 / math.Pi
+	if angle < 0 {
+		angle += 360
+	}
+
+	return angle
+}
+
+// BarSpan returns the horizontal position and width of the bar for series
+// within category, when categories equally divide the span [left, left+width]
+// and the bars of all series sit side by side inside their category. gap is
+// the fraction of each category left empty, split evenly at both sides.
+func BarSpan(category, series, categoryCount, seriesCount int, left, width, gap float64) (x, w float64) {
+	if categoryCount < 1 || seriesCount < 1 {
+		return left, 0
+	}
+	if gap < 0 {
+		gap = 0
+	} else if gap > 0.9 {
+		gap = 0.9
+	}
+
+	catWidth := width / float64(categoryCount)
+	inner := catWidth * (1 - gap)
+	w = inner / float64(seriesCount)
+	x = left + float64(category)*catWidth + (catWidth-inner)/2 + float64(series)*w
+
+	return x, w
+}
+
+// CategoryAt returns the index of the category containing the horizontal
+// position x, using the same division as BarSpan, or -1 if x lies outside.
+func CategoryAt(x float64, categoryCount int, left, width float64) int {
+	if categoryCount < 1 || width <= 0 || x < left || x >= left+width {
+		return -1
+	}
+
+	return int((x - left) / width * float64(categoryCount))
+}
+
+// NearestIndex returns the index of the value in sorted that is closest to x,
+// or -1 if sorted is empty. sorted must be in ascending order.
+func NearestIndex(sorted []float64, x float64) int {
+	switch len(sorted) {
+	case 0:
+		return -1
+
+	case 1:
+		return 0
+	}
+
+	i := sort.SearchFloat64s(sorted, x)
+	if i == 0 {
+		return 0
+	}
+	if i == len(sorted) {
+		return len(sorted) - 1
+	}
+	if x-sorted[i-1] <= sorted[i]-x {
+		return i - 1
+	}
+
+	return i
+}
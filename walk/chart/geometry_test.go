@@ -0,0 +1,131 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package chart
+
+import (
+	"testing"
+)
+
+func TestPieSlices(t *testing.T) {
+	slices := PieSlices([]float64{1, 1, 2, -5})
+
+	want := []Slice{{0, 90}, {90, 90}, {180, 180}, {360, 0}}
+	for i, sl := range slices {
+		if !almostEqual(sl.Start, want[i].Start) || !almostEqual(sl.Sweep, want[i].Sweep) {
+			t.Errorf("slice %d: got %+v, want %+v", i, sl, want[i])
+		}
+	}
+
+	for _, sl := range PieSlices([]float64{0, 0}) {
+		if sl.Sweep != 0 {
+			t.Errorf("all-zero values: got sweep %v, want 0", sl.Sweep)
+		}
+	}
+}
+
+func TestSliceAt(t *testing.T) {
+	slices := PieSlices([]float64{1, 0, 3})
+
+	testCases := []struct {
+		angle float64
+		want  int
+	}{
+		{0, 0},
+		{89.9, 0},
+		{90, 2},
+		{359, 2},
+		{360, 0},
+		{-10, 2},
+	}
+
+	for _, c := range testCases {
+		if got := SliceAt(slices, c.angle); got != c.want {
+			t.Errorf("SliceAt(%v): got %d, want %d", c.angle, got, c.want)
+		}
+	}
+}
+
+func TestPolarRoundTrip(t *testing.T) {
+	x, y := PolarPoint(100, 100, 50, 0)
+	if !almostEqual(x, 100) || !almostEqual(y, 50) {
+		t.Errorf("PolarPoint at 0 degrees: got (%v, %v), want (100, 50)", x, y)
+	}
+
+	x, y = PolarPoint(100, 100, 50, 90)
+	if !almostEqual(x, 150) || !almostEqual(y, 100) {
+		t.Errorf("PolarPoint at 90 degrees: got (%v, %v), want (150, 100)", x, y)
+	}
+
+	for _, a := range []float64{0, 45, 135, 200, 359} {
+		x, y := PolarPoint(10, 20, 30, a)
+		if got := AngleOf(10, 20, x, y); !almostEqual(got, a) {
+			t.Errorf("AngleOf(PolarPoint(%v)): got %v", a, got)
+		}
+	}
+}
+
+func TestBarSpan(t *testing.T) {
+	// Two categories of 100 pixels each, two series, no gap.
+	x, w := BarSpan(1, 1, 2, 2, 0, 200, 0)
+	if x != 150 || w != 50 {
+		t.Errorf("BarSpan: got (%v, %v), want (150, 50)", x, w)
+	}
+
+	// A 20% gap leaves 10 pixels at each side of the category.
+	x, w = BarSpan(0, 0, 1, 1, 10, 100, 0.2)
+	if x != 20 || w != 80 {
+		t.Errorf("BarSpan with gap: got (%v, %v), want (20, 80)", x, w)
+	}
+
+	if _, w := BarSpan(0, 0, 0, 1, 0, 100, 0); w != 0 {
+		t.Errorf("BarSpan without categories: got width %v, want 0", w)
+	}
+}
+
+func TestCategoryAt(t *testing.T) {
+	testCases := []struct {
+		x    float64
+		want int
+	}{
+		{-1, -1},
+		{0, 0},
+		{49.9, 0},
+		{50, 1},
+		{199, 3},
+		{200, -1},
+	}
+
+	for _, c := range testCases {
+		if got := CategoryAt(c.x, 4, 0, 200); got != c.want {
+			t.Errorf("CategoryAt(%v): got %d, want %d", c.x, got, c.want)
+		}
+	}
+}
+
+func TestNearestIndex(t *testing.T) {
+	xs := []float64{0, 10, 20, 40}
+
+	testCases := []struct {
+		x    float64
+		want int
+	}{
+		{-5, 0},
+		{4, 0},
+		{5, 0},
+		{6, 1},
+		{31, 3},
+		{100, 3},
+	}
+
+	for _, c := range testCases {
+		if got := NearestIndex(xs, c.x); got != c.want {
+			t.Errorf("NearestIndex(%v): got %d, want %d", c.x, got, c.want)
+		}
+	}
+
+	if got := NearestIndex(nil, 1); got != -1 {
+		t.Errorf("NearestIndex on empty slice: got %d, want -1", got)
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package chart provides line, area, bar, pie and sparkline chart widgets.
+//
+// Axes are scaled to round boundaries with NiceScale and labeled at the
+// Ticks of the resulting Scale.
+package chart
+
+import (
+	"math"
+	"strconv"
+)
+
+// Scale describes a linear axis range that has been extended to "nice"
+// boundaries, along with the distance between two adjacent ticks.
+type Scale struct {
+	Min  float64
+	Max  float64
+	Step float64
+}
+
+// NiceScale returns a Scale covering min and max whose boundaries and tick step
+// are round numbers (1, 2 or 5 times a power of ten). maxTicks is the maximum
+// number of tick intervals that should be produced and is clamped to at least 1.
+//
+// If min equals max, the range is widened so that the single value ends up in
+// the middle of the axis. Non-finite input yields the unit scale [0, 1].
+func NiceScale(min, max float64, maxTicks int) Scale {
+	if maxTicks < 1 {
+		maxTicks = 1
+	}
+
+	if !isFinite(min) || !isFinite(max) {
+		return Scale{Min: 0, Max: 1, Step: niceNum(1/float64(maxTicks), true)}
+	}
+
+	if min > max {
+		min, max = max, min
+	}
+
+	if min == max {
+		pad := math.Abs(min) * 0.1
+		if pad == 0 {
+			pad = 1
+		}
+		min -= pad
+		max += pad
+	}
+
+	span := niceNum(max-min, false)
+	step := niceNum(span/float64(maxTicks), true)
+
+	s := Scale{Step: step}
+	s.Min = roundTo(math.Floor(min/step)*step, s.decimals())
+	s.Max = roundTo(math.Ceil(max/step)*step, s.decimals())
+
+	return s
+}
+
+// NiceScaleFromZero is like NiceScale but always includes zero in the range.
+// This is what bar and area charts use, since their fill starts at zero.
+func NiceScaleFromZero(min, max float64, maxTicks int) Scale {
+	if min > 0 {
+		min = 0
+	}
+	if max < 0 {
+		max = 0
+	}
+
+	return NiceScale(min, max, maxTicks)
+}
+
+// niceNum returns a round number approximately equal to x. If round is true,
+// the number is rounded to the closest nice value, otherwise it is the ceiling.
+func niceNum(x float64, round bool) float64 {
+	if x <= 0 {
+		return 1
+	}
+
+	exp := math.Floor(math.Log10(x))
+	f := x / math.Pow(10, exp)
+
+	var nf float64
+	if round {
+		switch {
+		case f < 1.5:
+			nf = 1
+		case f < 3:
+			nf = 2
+		case f < 7:
+			nf = 5
+		default:
+			nf = 10
+		}
+	} else {
+		switch {
+		case f <= 1:
+			nf = 1
+		case f <= 2:
+			nf = 2
+		case f <= 5:
+			nf = 5
+		default:
+			nf = 10
+		}
+	}
+
+	return nf * math.Pow(10, exp)
+}
+
+// Ticks returns the tick values of s, from Min to Max inclusive.
+func (s Scale) Ticks() []float64 {
+	if s.Step <= 0 || s.Max < s.Min {
+		return nil
+	}
+
+	n := int(math.Round((s.Max-s.Min)/s.Step)) + 1
+	ticks := make([]float64, n)
+	for i := range ticks {
+		v := s.Min + float64(i)*s.Step
+		// Avoid printing -0 and accumulating float noise like 0.30000000000000004.
+		ticks[i] = roundTo(v, s.decimals())
+	}
+
+	return ticks
+}
+
+// Map maps v from the domain of s onto the range [from, to], which typically is
+// a span of pixel coordinates. from may be greater than to, which is how a
+// vertical axis growing upward is expressed.
+func (s Scale) Map(v, from, to float64) float64 {
+	span := s.Max - s.Min
+	if span == 0 {
+		return (from + to) / 2
+	}
+
+	return from + (v-s.Min)/span*(to-from)
+}
+
+// Invert is the inverse of Map and converts the coordinate p in [from, to] back
+// into the domain of s.
+func (s Scale) Invert(p, from, to float64) float64 {
+	if to == from {
+		return s.Min
+	}
+
+	return s.Min + (p-from)/(to-from)*(s.Max-s.Min)
+}
+
+// Contains returns whether v lies within the range of s.
+func (s Scale) Contains(v float64) bool {
+	return v >= s.Min && v <= s.Max
+}
+
+// Format formats the tick value v using just enough fractional digits to tell
+// adjacent ticks of s apart.
+func (s Scale) Format(v float64) string {
+	return strconv.FormatFloat(v, 'f', s.decimals(), 64)
+}
+
+func (s Scale) decimals() int {
+	if s.Step <= 0 || !isFinite(s.Step) {
+		return 0
+	}
+
+	// Find the fewest fractional digits that represent the step exactly, so
+	// that steps like 0.025 keep all three digits.
+	for d := 0; d < 15; d++ {
+		scaled := s.Step * math.Pow(10, float64(d))
+		if math.Abs(scaled-math.Round(scaled)) < 1e-6*scaled {
+			return d
+		}
+	}
+
+	return 15
+}
+
+func roundTo(v float64, decimals int) float64 {
+	p := math.Pow(10, float64(decimals))
+	r := math.Round(v*p) / p
+	if r == 0 {
+		return 0
+	}
+
+	return r
+}
+
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// Extent returns the smallest and largest finite values in values. ok is false
+// if values contains no finite value.
+func Extent(values []float64) (min, max float64, ok bool) {
+	for _, v := range values {
+		if !isFinite(v) {
+			continue
+		}
+		if !ok {
+			min, max, ok = v, v, true
+			continue
+		}
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+
+	return
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package chart
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestNiceScale(t *testing.T) {
+	testCases := []struct {
+		min, max float64
+		maxTicks int
+		want     Scale
+	}{
+		{0, 10, 5, Scale{0, 10, 2}},
+		{0, 100, 10, Scale{0, 100, 10}},
+		{-3.2, 7.9, 5, Scale{-5, 10, 5}},
+		{0.12, 0.97, 5, Scale{0, 1, 0.2}},
+		{1000, 1003, 4, Scale{1000, 1003, 1}},
+		{5, 5, 4, Scale{4.4, 5.6, 0.2}},
+		{0, 0, 4, Scale{-1, 1, 0.5}},
+		{10, 0, 5, Scale{0, 10, 2}},
+		{math.NaN(), 1, 5, Scale{0, 1, 0.2}},
+	}
+
+	for _, c := range testCases {
+		got := NiceScale(c.min, c.max, c.maxTicks)
+		if !almostEqual(got.Min, c.want.Min) || !almostEqual(got.Max, c.want.Max) || !almostEqual(got.Step, c.want.Step) {
+			t.Errorf("NiceScale(%v, %v, %d): got %+v, want %+v", c.min, c.max, c.maxTicks, got, c.want)
+		}
+	}
+}
+
+func TestNiceScaleCoversInput(t *testing.T) {
+	for _, r := range [][2]float64{{-17, 3}, {0.001, 0.0043}, {12345, 98765}, {-1e6, -999}} {
+		s := NiceScale(r[0], r[1], 6)
+		if s.Min > r[0] || s.Max < r[1] {
+			t.Errorf("NiceScale(%v, %v) = %+v does not cover input", r[0], r[1], s)
+		}
+		if n := len(s.Ticks()); n > 13 {
+			t.Errorf("NiceScale(%v, %v) produced %d ticks", r[0], r[1], n)
+		}
+	}
+}
+
+func TestNiceScaleFromZero(t *testing.T) {
+	s := NiceScaleFromZero(3, 9, 5)
+	if s.Min != 0 {
+		t.Errorf("Min: got %v, want 0", s.Min)
+	}
+
+	s = NiceScaleFromZero(-9, -3, 5)
+	if s.Max != 0 {
+		t.Errorf("Max: got %v, want 0", s.Max)
+	}
+}
+
+func TestScaleTicks(t *testing.T) {
+	got := Scale{0, 1, 0.1}.Ticks()
+	want := []float64{0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Ticks: got %v, want %v", got, want)
+	}
+
+	if ticks := (Scale{0, 1, 0}).Ticks(); ticks != nil {
+		t.Errorf("Ticks with zero step: got %v, want nil", ticks)
+	}
+}
+
+func TestScaleMapInvert(t *testing.T) {
+	s := Scale{Min: -10, Max: 10, Step: 5}
+
+	// A vertical axis: value -10 at the bottom (pixel 200), 10 at the top (pixel 0).
+	if got := s.Map(0, 200, 0); got != 100 {
+		t.Errorf("Map(0): got %v, want 100", got)
+	}
+	if got := s.Map(10, 200, 0); got != 0 {
+		t.Errorf("Map(10): got %v, want 0", got)
+	}
+
+	for _, v := range []float64{-10, -2.5, 0, 7.25, 10} {
+		p := s.Map(v, 40, 440)
+		if got := s.Invert(p, 40, 440); !almostEqual(got, v) {
+			t.Errorf("Invert(Map(%v)): got %v", v, got)
+		}
+	}
+}
+
+func TestScaleFormat(t *testing.T) {
+	testCases := []struct {
+		scale Scale
+		value float64
+		want  string
+	}{
+		{Scale{0, 100, 20}, 40, "40"},
+		{Scale{0, 1, 0.2}, 0.4, "0.4"},
+		{Scale{0, 0.1, 0.02}, 0.06, "0.06"},
+		{Scale{0, 0.1, 0.025}, 0.075, "0.075"},
+	}
+
+	for _, c := range testCases {
+		if got := c.scale.Format(c.value); got != c.want {
+			t.Errorf("Format(%v) with step %v: got %q, want %q", c.value, c.scale.Step, got, c.want)
+		}
+	}
+}
+
+func TestExtent(t *testing.T) {
+	min, max, ok := Extent([]float64{3, math.NaN(), -2, 8, math.Inf(1)})
+	if !ok || min != -2 || max != 8 {
+		t.Errorf("Extent: got (%v, %v, %v), want (-2, 8, true)", min, max, ok)
+	}
+
+	if _, _, ok := Extent([]float64{math.NaN()}); ok {
+		t.Error("Extent of only NaN: got ok, want !ok")
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package chart
+
+import (
+	"github.com/xackery/wlk/walk"
+	"github.com/xackery/wlk/wcolor"
+)
+
+// Point is a single data point of a Series. Label is optional and, if set, is
+// used for category axes, pie legends and tooltips instead of the X value.
+type Point struct {
+	X     float64
+	Y     float64
+	Label string
+}
+
+// Series is a named sequence of data points. Charts observe the Changed event
+// of their series and repaint whenever the data or appearance changes.
+type Series struct {
+	name             string
+	color            wcolor.Color
+	hasColor         bool
+	points           []Point
+	changedPublisher walk.EventPublisher
+}
+
+// NewSeries creates a new, empty Series. The color of a Series is picked from
+// the palette of the chart displaying it, unless SetColor is called.
+func NewSeries(name string) *Series {
+	return &Series{name: name}
+}
+
+// NewSeriesFromValues creates a new Series whose points have the X values 0,
+// 1, 2, ... and the Y values from values.
+func NewSeriesFromValues(name string, values ...float64) *Series {
+	s := NewSeries(name)
+	s.points = make([]Point, len(values))
+	for i, v := range values {
+		s.points[i] = Point{X: float64(i), Y: v}
+	}
+
+	return s
+}
+
+// Name returns the name of the Series, as displayed in the legend.
+func (s *Series) Name() string {
+	return s.name
+}
+
+// SetName sets the name of the Series.
+func (s *Series) SetName(name string) {
+	if name == s.name {
+		return
+	}
+
+	s.name = name
+	s.changedPublisher.Publish()
+}
+
+// Color returns the color of the Series and whether it has been set explicitly.
+func (s *Series) Color() (wcolor.Color, bool) {
+	return s.color, s.hasColor
+}
+
+// SetColor sets the color the Series is drawn with.
+func (s *Series) SetColor(color wcolor.Color) {
+	if s.hasColor && color == s.color {
+		return
+	}
+
+	s.color = color
+	s.hasColor = true
+	s.changedPublisher.Publish()
+}
+
+// Len returns the number of points in the Series.
+func (s *Series) Len() int {
+	return len(s.points)
+}
+
+// Point returns the point at index.
+func (s *Series) Point(index int) Point {
+	return s.points[index]
+}
+
+// Points returns a copy of the points of the Series.
+func (s *Series) Points() []Point {
+	return append([]Point(nil), s.points...)
+}
+
+// SetPoints replaces all points of the Series.
+func (s *Series) SetPoints(points []Point) {
+	s.points = append(s.points[:0:0], points...)
+	s.changedPublisher.Publish()
+}
+
+// Append adds points to the end of the Series.
+func (s *Series) Append(points ...Point) {
+	if len(points) == 0 {
+		return
+	}
+
+	s.points = append(s.points, points...)
+	s.changedPublisher.Publish()
+}
+
+// AppendValue adds a point with the next X value after the last point and
+// value as Y. This is convenient for live data such as sparklines. If max is
+// greater than zero, points are dropped from the start so that at most max
+// points remain.
+func (s *Series) AppendValue(value float64, max int) {
+	var x float64
+	if n := len(s.points); n > 0 {
+		x = s.points[n-1].X + 1
+	}
+
+	s.points = append(s.points, Point{X: x, Y: value})
+	if max > 0 && len(s.points) > max {
+		s.points = append(s.points[:0], s.points[len(s.points)-max:]...)
+	}
+
+	s.changedPublisher.Publish()
+}
+
+// Clear removes all points from the Series.
+func (s *Series) Clear() {
+	if len(s.points) == 0 {
+		return
+	}
+
+	s.points = nil
+	s.changedPublisher.Publish()
+}
+
+// Changed returns the event that is published whenever the points, name or
+// color of the Series change.
+func (s *Series) Changed() *walk.Event {
+	return s.changedPublisher.Event()
+}
+
+func (s *Series) xValues() []float64 {
+	xs := make([]float64, len(s.points))
+	for i, p := range s.points {
+		xs[i] = p.X
+	}
+
+	return xs
+}
+
+func (s *Series) yValues() []float64 {
+	ys := make([]float64, len(s.points))
+	for i, p := range s.points {
+		ys[i] = p.Y
+	}
+
+	return ys
+}
@@ -0,0 +1,441 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"sort"
+
+	"github.com/xackery/wlk/walk/fuzzy"
+	"github.com/xackery/wlk/win"
+	"golang.org/x/sys/windows"
+)
+
+const defaultCommandPaletteMaxRecent = 8
+
+// CommandPalette is a popup that lets users search the actions of a form by
+// typing parts of their text and trigger them with the keyboard.
+//
+// It indexes the visible and enabled actions of the menu bar, the tool bars
+// and the ShortcutActions of the form each time it is shown, and ranks them
+// with package fuzzy. Actions the user triggered recently are ranked first.
+type CommandPalette struct {
+	form      Form
+	action    *Action
+	recent    []string
+	maxRecent int
+}
+
+type commandPaletteItem struct {
+	action *Action
+	label  string
+}
+
+// NewCommandPalette returns a CommandPalette for form. It adds an action to
+// the ShortcutActions of form that shows the palette when Ctrl+Shift+P is
+// pressed, see Action.
+func NewCommandPalette(form Form) (*CommandPalette, error) {
+	if form == nil {
+		return nil, newError("form must not be nil")
+	}
+
+	cp := &CommandPalette{
+		form:      form,
+		maxRecent: defaultCommandPaletteMaxRecent,
+	}
+
+	cp.action = NewAction()
+	if err := cp.action.SetText("Command Palette..."); err != nil {
+		return nil, err
+	}
+	if err := cp.action.SetShortcut(Shortcut{ModControl | ModShift, KeyP}); err != nil {
+		return nil, err
+	}
+	cp.action.Triggered().Attach(func() {
+		cp.Show()
+	})
+
+	if err := form.AsFormBase().ShortcutActions().Add(cp.action); err != nil {
+		return nil, err
+	}
+
+	return cp, nil
+}
+
+// Form returns the form whose actions are shown by the palette.
+func (cp *CommandPalette) Form() Form {
+	return cp.form
+}
+
+// Action returns the action that shows the palette. Its shortcut may be
+// changed and it may be added to menus as well. It is not listed in the
+// palette itself.
+func (cp *CommandPalette) Action() *Action {
+	return cp.action
+}
+
+// Recent returns the labels of the commands that were triggered recently,
+// most recent first. An application can persist them and restore them with
+// SetRecent.
+func (cp *CommandPalette) Recent() []string {
+	return append([]string(nil), cp.recent...)
+}
+
+// SetRecent sets the labels of the commands that were triggered recently,
+// most recent first.
+func (cp *CommandPalette) SetRecent(labels []string) {
+	cp.recent = append([]string(nil), labels...)
+	if len(cp.recent) > cp.maxRecent {
+		cp.recent = cp.recent[:cp.maxRecent]
+	}
+}
+
+// MaxRecent returns the number of recently triggered commands that are
+// remembered.
+func (cp *CommandPalette) MaxRecent() int {
+	return cp.maxRecent
+}
+
+// SetMaxRecent sets the number of recently triggered commands that are
+// remembered.
+func (cp *CommandPalette) SetMaxRecent(value int) {
+	if value < 0 {
+		value = 0
+	}
+
+	cp.maxRecent = value
+	if len(cp.recent) > value {
+		cp.recent = cp.recent[:value]
+	}
+}
+
+func (cp *CommandPalette) remember(label string) {
+	recent := []string{label}
+	for _, l := range cp.recent {
+		if l != label {
+			recent = append(recent, l)
+		}
+	}
+
+	cp.SetRecent(recent)
+}
+
+// items returns the actions of the form that can currently be triggered.
+// Actions of submenus are labeled with the texts of their menus, like
+// "File: Open".
+func (cp *CommandPalette) items() []*commandPaletteItem {
+	var items []*commandPaletteItem
+	seen := map[*Action]bool{cp.action: true}
+
+	var collect func(actions *ActionList, prefix string)
+	collect = func(actions *ActionList, prefix string) {
+		if actions == nil {
+			return
+		}
+
+		for i := 0; i < actions.Len(); i++ {
+			a := actions.At(i)
+			if seen[a] || a.IsSeparator() || !actionVisible(a) {
+				continue
+			}
+			seen[a] = true
+
+			text := actionDisplayText(a.Text())
+			if text == "" {
+				continue
+			}
+
+			label := text
+			if prefix != "" {
+				label = prefix + ": " + text
+			}
+
+			if a.menu != nil {
+				collect(a.menu.Actions(), label)
+				continue
+			}
+
+			if actionEnabled(a) {
+				items = append(items, &commandPaletteItem{action: a, label: label})
+			}
+		}
+	}
+
+	if mb, ok := cp.form.(interface{ Menu() *Menu }); ok && mb.Menu() != nil {
+		collect(mb.Menu().Actions(), "")
+	}
+
+	if tbo, ok := cp.form.(interface{ ToolBar() *ToolBar }); ok && tbo.ToolBar() != nil {
+		collect(tbo.ToolBar().Actions(), "")
+	}
+	walkDescendants(cp.form.AsFormBase().clientComposite, func(w Window) bool {
+		if tb, ok := w.(*ToolBar); ok && tb.Visible() {
+			collect(tb.Actions(), "")
+		}
+
+		return true
+	})
+
+	collect(cp.form.AsFormBase().ShortcutActions(), "")
+
+	return items
+}
+
+// rank returns the items matching pattern, best first.
+func (cp *CommandPalette) rank(items []*commandPaletteItem, pattern string) []*commandPaletteItem {
+	recentIndex := make(map[string]int, len(cp.recent))
+	for i, label := range cp.recent {
+		recentIndex[label] = i
+	}
+
+	labels := make([]string, len(items))
+	for i, item := range items {
+		labels[i] = item.label
+	}
+
+	results := fuzzy.Rank(pattern, labels)
+
+	score := func(r fuzzy.Result) int {
+		if i, ok := recentIndex[items[r.Index].label]; ok {
+			return r.Score + 2*(len(cp.recent)-i)
+		}
+		return r.Score
+	}
+	recent := func(r fuzzy.Result) int {
+		if i, ok := recentIndex[items[r.Index].label]; ok {
+			return i
+		}
+		return len(cp.recent)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if pattern == "" {
+			return recent(results[i]) < recent(results[j])
+		}
+		return score(results[i]) > score(results[j])
+	})
+
+	ranked := make([]*commandPaletteItem, len(results))
+	for i, r := range results {
+		ranked[i] = items[r.Index]
+	}
+
+	return ranked
+}
+
+// Show shows the palette and runs it until the user triggered a command or
+// dismissed the palette.
+func (cp *CommandPalette) Show() error {
+	items := cp.items()
+
+	dlg := &commandPaletteDialog{
+		Dialog: Dialog{
+			FormBase: FormBase{
+				owner: cp.form,
+			},
+		},
+	}
+
+	if err := InitWindow(
+		dlg,
+		cp.form,
+		dialogWindowClass,
+		win.WS_POPUP|win.WS_BORDER,
+		0); err != nil {
+		return err
+	}
+	defer dlg.Dispose()
+
+	dlg.result = DlgCmdNone
+
+	layout := NewVBoxLayout()
+	if err := layout.SetMargins(Margins{4, 4, 4, 4}); err != nil {
+		return err
+	}
+	if err := dlg.SetLayout(layout); err != nil {
+		return err
+	}
+
+	le, err := NewLineEdit(dlg)
+	if err != nil {
+		return err
+	}
+	if err := le.SetCueBanner("Type the name of a command"); err != nil {
+		return err
+	}
+
+	tv, err := NewTableView(dlg)
+	if err != nil {
+		return err
+	}
+	if err := tv.SetHeaderHidden(true); err != nil {
+		return err
+	}
+	if err := tv.SetLastColumnStretched(true); err != nil {
+		return err
+	}
+
+	labelColumn := NewTableViewColumn()
+	if err := labelColumn.SetWidth(320); err != nil {
+		return err
+	}
+	shortcutColumn := NewTableViewColumn()
+	if err := shortcutColumn.SetAlignment(AlignFar); err != nil {
+		return err
+	}
+	for _, col := range []*TableViewColumn{labelColumn, shortcutColumn} {
+		if err := tv.Columns().Add(col); err != nil {
+			return err
+		}
+	}
+
+	model := &commandPaletteModel{items: cp.rank(items, "")}
+	if err := tv.SetModel(model); err != nil {
+		return err
+	}
+	if len(model.items) > 0 {
+		tv.SetCurrentIndex(0)
+	}
+
+	var chosen *commandPaletteItem
+	choose := func() {
+		if index := tv.CurrentIndex(); index >= 0 && index < len(model.items) {
+			chosen = model.items[index]
+			dlg.Accept()
+		}
+	}
+
+	le.TextChanged().Attach(func() {
+		model.items = cp.rank(items, le.Text())
+		model.PublishRowsReset()
+
+		if len(model.items) > 0 {
+			tv.SetCurrentIndex(0)
+		}
+	})
+
+	le.KeyDown().Attach(func(key Key) {
+		index := tv.CurrentIndex()
+
+		switch key {
+		case KeyUp:
+			if index > 0 {
+				tv.SetCurrentIndex(index - 1)
+			}
+
+		case KeyDown:
+			if index < len(model.items)-1 {
+				tv.SetCurrentIndex(index + 1)
+			}
+
+		case KeyReturn:
+			choose()
+		}
+	})
+
+	tv.ItemActivated().Attach(choose)
+
+	// Show the palette at the top of the form, like editors do.
+	ob := cp.form.BoundsPixels()
+	width := ob.Width / 2
+	if min := dlg.IntFrom96DPI(400); width < min {
+		width = min
+	}
+	height := dlg.IntFrom96DPI(320)
+
+	dlg.SetBoundsPixels(fitRectToScreen(dlg.hWnd, Rectangle{
+		X:      ob.X + (ob.Width-width)/2,
+		Y:      ob.Y + dlg.IntFrom96DPI(48),
+		Width:  width,
+		Height: height,
+	}))
+
+	le.SetFocus()
+
+	if dlg.Run() != DlgCmdOK || chosen == nil {
+		return nil
+	}
+
+	// The form may have changed while the palette was shown.
+	if !actionEnabled(chosen.action) {
+		return nil
+	}
+
+	cp.remember(chosen.label)
+	chosen.action.raiseTriggered()
+
+	return nil
+}
+
+// commandPaletteDialog is the popup of a CommandPalette, which closes on
+// Escape and when it loses the activation.
+type commandPaletteDialog struct {
+	Dialog
+}
+
+func (dlg *commandPaletteDialog) WndProc(hwnd windows.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	switch msg {
+	case win.WM_COMMAND:
+		if win.HIWORD(uint32(wParam)) == 0 && win.LOWORD(uint32(wParam)) == DlgCmdCancel {
+			dlg.Cancel()
+			return 0
+		}
+
+	case win.WM_ACTIVATE:
+		if win.LOWORD(uint32(wParam)) == win.WA_INACTIVE && dlg.result == DlgCmdNone {
+			dlg.Cancel()
+		}
+	}
+
+	return dlg.Dialog.WndProc(hwnd, msg, wParam, lParam)
+}
+
+type commandPaletteModel struct {
+	TableModelBase
+	items []*commandPaletteItem
+}
+
+func (m *commandPaletteModel) RowCount() int {
+	return len(m.items)
+}
+
+func (m *commandPaletteModel) Value(row, col int) interface{} {
+	item := m.items[row]
+
+	if col == 0 {
+		return item.label
+	}
+
+	if item.action.shortcut.Key == 0 {
+		return ""
+	}
+	return item.action.shortcut.String()
+}
+
+func (m *commandPaletteModel) Image(row int) interface{} {
+	return m.items[row].action.image
+}
+
+// actionVisible returns whether a is visible, evaluating its
+// VisibleCondition.
+func actionVisible(a *Action) bool {
+	if c := a.visibleCondition; c != nil {
+		return c.Satisfied()
+	}
+
+	return a.visible
+}
+
+// actionEnabled returns whether a is enabled, evaluating its
+// EnabledCondition.
+func actionEnabled(a *Action) bool {
+	if c := a.enabledCondition; c != nil {
+		return c.Satisfied()
+	}
+
+	return a.enabled
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 	"unsafe"
 
+	"github.com/xackery/wlk/walk/taborder"
 	"github.com/xackery/wlk/win"
 	"golang.org/x/sys/windows"
 )
@@ -26,13 +27,36 @@ type Container interface {
 	SetDataBinder(dbm *DataBinder)
 }
 
+// TabNavigation is how the Tab key moves the keyboard focus through the
+// descendants of a Container.
+type TabNavigation int
+
+const (
+	// TabNavigationContinue makes the descendants part of the tab order
+	// around the Container.
+	TabNavigationContinue TabNavigation = TabNavigation(taborder.Continue)
+
+	// TabNavigationCycle keeps the focus within the descendants once it got
+	// there, wrapping around at the last one.
+	TabNavigationCycle TabNavigation = TabNavigation(taborder.Cycle)
+
+	// TabNavigationOnce makes the descendants a single tab stop, which moves
+	// the focus to the one focused last. Arrow keys move it between them.
+	TabNavigationOnce TabNavigation = TabNavigation(taborder.Once)
+
+	// TabNavigationNone leaves the descendants out of the tab order.
+	TabNavigationNone TabNavigation = TabNavigation(taborder.None)
+)
+
 type ContainerBase struct {
 	WidgetBase
-	layout      Layout
-	children    *WidgetList
-	dataBinder  *DataBinder
-	nextChildID int32
-	persistent  bool
+	layout        Layout
+	children      *WidgetList
+	dataBinder    *DataBinder
+	nextChildID   int32
+	persistent    bool
+	tabNavigation TabNavigation
+	lastFocused   Widget
 }
 
 func (cb *ContainerBase) AsWidgetBase() *WidgetBase {
@@ -43,6 +67,24 @@ func (cb *ContainerBase) AsContainerBase() *ContainerBase {
 	return cb
 }
 
+// TabNavigation returns how the Tab key moves the keyboard focus through the
+// descendants of the ContainerBase.
+func (cb *ContainerBase) TabNavigation() TabNavigation {
+	return cb.tabNavigation
+}
+
+// SetTabNavigation sets how the Tab key moves the keyboard focus through the
+// descendants of the ContainerBase.
+func (cb *ContainerBase) SetTabNavigation(navigation TabNavigation) error {
+	if navigation < TabNavigationContinue || navigation > TabNavigationNone {
+		return newError("invalid TabNavigation value")
+	}
+
+	cb.tabNavigation = navigation
+
+	return nil
+}
+
 func (cb *ContainerBase) NextChildID() int32 {
 	cb.nextChildID++
 	return cb.nextChildID
@@ -143,10 +185,6 @@ func (cb *ContainerBase) SetDataBinder(db *DataBinder) {
 				return true
 			}
 
-			if c, ok := w.(Container); ok && c.DataBinder() != nil {
-				return false
-			}
-
 			for _, prop := range w.AsWindowBase().name2Property {
 				if _, ok := prop.Source().(string); ok {
 					boundWidgets = append(boundWidgets, w.(Widget))
@@ -154,6 +192,12 @@ func (cb *ContainerBase) SetDataBinder(db *DataBinder) {
 				}
 			}
 
+			// The descendants of a container with a DataBinder of its own
+			// are bound by that, but its own properties are bound by db.
+			if c, ok := w.(Container); ok && c.DataBinder() != nil {
+				return false
+			}
+
 			return true
 		})
 
@@ -404,6 +448,10 @@ func (cb *ContainerBase) onInsertedWidget(index int, widget Widget) (err error)
 
 	widget.(applyFonter).applyFont(cb.Font())
 
+	if windowMirrored(cb.window) {
+		err = applyMirroredToDescendants(widget, true)
+	}
+
 	return
 }
 
@@ -446,7 +494,12 @@ func (cb *ContainerBase) onClearedWidgets() (err error) {
 }
 
 func (cb *ContainerBase) focusFirstCandidateDescendant() {
-	window := firstFocusableDescendant(cb)
+	var window Window
+	if order := taborder.Order(&taborder.Node{Children: tabOrderNodes(cb.window.(Container), nil)}); len(order) > 0 {
+		window = order[0].Value.(Widget)
+	} else {
+		window = firstFocusableDescendant(cb)
+	}
 	if window == nil {
 		return
 	}
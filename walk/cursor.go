@@ -8,7 +8,12 @@
 package walk
 
 import (
+	"bytes"
 	"image"
+	"io/fs"
+	"path"
+	"strings"
+	"syscall"
 
 	"github.com/xackery/wlk/win"
 )
@@ -106,6 +111,49 @@ func NewCursorFromImage(im image.Image, hotspot image.Point) (Cursor, error) {
 	return customCursor{win.HCURSOR(i)}, nil
 }
 
+// NewCursorFromFile loads a cursor from the .cur or .ani file at filePath.
+func NewCursorFromFile(filePath string) (Cursor, error) {
+	name, err := syscall.UTF16PtrFromString(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	hCursor := win.LoadImage(0, name, win.IMAGE_CURSOR, 0, 0, win.LR_LOADFROMFILE|win.LR_DEFAULTSIZE)
+	if hCursor == 0 {
+		return nil, lastError("LoadImage")
+	}
+
+	return customCursor{win.HCURSOR(hCursor)}, nil
+}
+
+// NewCursorFromFS loads a cursor from the file name in fsys, which may for example be an
+// embed.FS. The file is either a .cur file or an image, whose hotspot is its top left corner.
+func NewCursorFromFS(fsys fs.FS, name string) (Cursor, error) {
+	data, err := readFS(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(path.Ext(name), ".cur") {
+		dpi := screenDPI()
+		width := int(win.GetSystemMetricsForDpi(win.SM_CXCURSOR, uint32(dpi)))
+
+		hCursor, err := hIconFromIconFile(data, Size{width, width}, false)
+		if err != nil {
+			return nil, err
+		}
+
+		return customCursor{win.HCURSOR(hCursor)}, nil
+	}
+
+	im, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return NewCursorFromImage(im, image.Pt(0, 0))
+}
+
 func (cc customCursor) Dispose() {
 	win.DestroyIcon(win.HICON(cc.hCursor))
 }
@@ -39,6 +39,7 @@ type CustomWidget struct {
 	paintPixels         PaintFunc // in native pixels
 	invalidatesOnResize bool
 	paintMode           PaintMode
+	accessibleElements  func() []*AccessibleElement
 }
 
 // NewCustomWidget creates and initializes a new custom draw widget.
@@ -112,6 +113,25 @@ func (cw *CustomWidget) SetPaintMode(value PaintMode) {
 	cw.paintMode = value
 }
 
+// AccessibleElements returns the elements returned by the func set with
+// SetAccessibleElementsFunc.
+func (cw *CustomWidget) AccessibleElements() []*AccessibleElement {
+	if cw.accessibleElements == nil {
+		return nil
+	}
+
+	return cw.accessibleElements()
+}
+
+// SetAccessibleElementsFunc sets the func that describes the parts the widget
+// paints to screen readers. Call Accessibility().NotifyElementsChanged when
+// they change.
+func (cw *CustomWidget) SetAccessibleElementsFunc(f func() []*AccessibleElement) {
+	cw.accessibleElements = f
+
+	cw.Accessibility().NotifyElementsChanged()
+}
+
 func (cw *CustomWidget) WndProc(hwnd windows.HWND, msg uint32, wParam, lParam uintptr) uintptr {
 	switch msg {
 	case win.WM_PAINT:
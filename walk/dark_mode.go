@@ -4,11 +4,14 @@
 package walk
 
 import (
-	"syscall"
-	"unsafe"
-
 	"github.com/xackery/wlk/common"
-	"github.com/xackery/wlk/win"
+)
+
+const personalizeKeyPath = `Software\Microsoft\Windows\CurrentVersion\Themes\Personalize`
+
+var (
+	darkModeWatcher          *RegistryWatcher
+	darkModeChangedPublisher EventPublisher
 )
 
 // SetDarkModeAllowed is used to allow dark mode. This should be called prior to initializing walk
@@ -23,42 +26,86 @@ func IsDarkMode() bool {
 	}
 
 	common.SetDarkModeChecked(true)
-	var hKey win.HKEY
 
-	ptr, err := syscall.UTF16PtrFromString(`Software\Microsoft\Windows\CurrentVersion\Themes\Personalize`)
-	if err != nil {
+	dark, ok := readDarkMode()
+	if !ok {
 		return false
 	}
 
-	if win.RegOpenKeyEx(
-		win.HKEY_CURRENT_USER,
-		ptr,
-		0,
-		win.KEY_READ,
-		&hKey) != win.ERROR_SUCCESS {
-		return false
+	common.SetDarkMode(dark)
+	return common.IsDarkMode()
+}
+
+// readDarkMode reads whether apps should use the dark theme from the registry.
+func readDarkMode() (dark, ok bool) {
+	key, err := CurrentUserKey().OpenKey(personalizeKeyPath)
+	if err != nil {
+		return false, false
 	}
+	defer key.Close()
 
-	defer win.RegCloseKey(hKey)
+	val, err := key.Uint32("AppsUseLightTheme")
+	if err != nil {
+		return false, false
+	}
 
-	bufSize := uint32(4)
-	var val uint32
+	return val == 0, true
+}
 
-	valueName, err := syscall.UTF16PtrFromString("AppsUseLightTheme")
-	if err != nil {
-		return false
+// DarkModeChanged returns the event published when the user switches between light and
+// dark mode, after which IsDarkMode returns the new mode. The first call starts watching
+// the setting and must be made on the UI thread, where the event is published. Watching
+// stops when the windows of that thread are gone.
+func DarkModeChanged() *Event {
+	if darkModeWatcher == nil {
+		if key, err := CurrentUserKey().OpenKey(personalizeKeyPath); err == nil {
+			darkModeWatcher, _ = key.Watch(false)
+			key.Close()
+		}
+
+		if darkModeWatcher != nil {
+			// The key holds other personalization values as well, whose changes are ignored.
+			wasDark, _ := readDarkMode()
+
+			darkModeWatcher.Changed().Attach(func() {
+				dark, ok := readDarkMode()
+				if !ok || dark == wasDark {
+					return
+				}
+				wasDark = dark
+
+				common.SetDarkModeChecked(true)
+				common.SetDarkMode(dark)
+				iconCache.clearThemed()
+				darkModeChangedPublisher.Publish()
+			})
+
+			// Like its ToolTip, the watcher belongs to the group and must not keep it
+			// alive. The group closes it when it is disposed, see closeDarkModeWatcher.
+			darkModeWatcher.group.ignore(1)
+		}
 	}
 
-	if win.ERROR_SUCCESS != win.RegQueryValueEx(
-		hKey,
-		valueName,
-		nil,
-		nil,
-		(*byte)(unsafe.Pointer(&val)),
-		&bufSize) {
-		return false
+	return darkModeChangedPublisher.Event()
+}
+
+// reapplyOnDarkModeChange calls apply each time the user switches between light and
+// dark mode, until wb is disposed. Windows that hand themed images to Windows use it to
+// replace them, as the icon cache drops the variant of the previous mode.
+func reapplyOnDarkModeChange(wb *WindowBase, apply func()) {
+	handle := DarkModeChanged().Attach(apply)
+	wb.Disposing().Attach(func() {
+		DarkModeChanged().Detach(handle)
+	})
+}
+
+// closeDarkModeWatcher stops watching the dark mode setting if the watcher belongs to
+// group g, which is being disposed.
+func closeDarkModeWatcher(g *WindowGroup) {
+	if darkModeWatcher == nil || darkModeWatcher.group != g {
+		return
 	}
 
-	common.SetDarkMode(val == 0)
-	return common.IsDarkMode()
+	darkModeWatcher.Close()
+	darkModeWatcher = nil
 }
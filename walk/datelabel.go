@@ -91,10 +91,14 @@ func (dl *DateLabel) SetDate(date time.Time) error {
 	return nil
 }
 
+// Format returns the format of the date, see SetFormat.
 func (dl *DateLabel) Format() string {
 	return dl.format
 }
 
+// SetFormat sets the format of the date, which is one of the named date and time formats,
+// like ShortDateFormat, or a layout of package time. The named formats, including the
+// default empty format, which is ShortDateFormat, use the patterns of the current locale.
 func (dl *DateLabel) SetFormat(format string) error {
 	if format == dl.format {
 		return nil
@@ -115,5 +119,13 @@ func (dl *DateLabel) SetFormat(format string) error {
 }
 
 func (dl *DateLabel) updateText() (changed bool, err error) {
-	return dl.setText(dl.date.Format(dl.format))
+	if dl.date.IsZero() {
+		return dl.setText("")
+	}
+
+	return dl.setText(formatTime(dl.date, dl.format))
+}
+
+func (dl *DateLabel) applyLocale() {
+	dl.updateText()
 }
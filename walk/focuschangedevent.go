@@ -0,0 +1,65 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+type focusChangedEventHandlerInfo struct {
+	handler FocusChangedEventHandler
+	once    bool
+}
+
+// FocusChangedEventHandler is called with the widget that had the keyboard
+// focus and the one that has it now, either of which may be nil.
+type FocusChangedEventHandler func(old, new Widget)
+
+type FocusChangedEvent struct {
+	handlers []focusChangedEventHandlerInfo
+}
+
+func (e *FocusChangedEvent) Attach(handler FocusChangedEventHandler) int {
+	handlerInfo := focusChangedEventHandlerInfo{handler, false}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+func (e *FocusChangedEvent) Detach(handle int) {
+	e.handlers[handle].handler = nil
+}
+
+func (e *FocusChangedEvent) Once(handler FocusChangedEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+type FocusChangedEventPublisher struct {
+	event FocusChangedEvent
+}
+
+func (p *FocusChangedEventPublisher) Event() *FocusChangedEvent {
+	return &p.event
+}
+
+func (p *FocusChangedEventPublisher) Publish(old, new Widget) {
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(old, new)
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+}
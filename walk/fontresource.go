@@ -8,13 +8,16 @@
 package walk
 
 import (
+	"io/fs"
+	"runtime"
 	"syscall"
+	"unsafe"
 
 	"github.com/xackery/wlk/win"
 )
 
 // FontMemResource represents a font resource loaded into memory from
-// the application's resources.
+// the application's resources or a file system.
 type FontMemResource struct {
 	hFontResource win.HANDLE
 }
@@ -45,6 +48,10 @@ func newFontMemResource(resourceName *uint16) (*FontMemResource, error) {
 		return nil, lastError("LockResource")
 	}
 
+	return newFontMemResourceFromMemory(ptr, size)
+}
+
+func newFontMemResourceFromMemory(ptr uintptr, size uint32) (*FontMemResource, error) {
 	numFonts := uint32(0)
 	hFontResource := win.AddFontMemResourceEx(ptr, size, nil, &numFonts)
 
@@ -76,6 +83,32 @@ func NewFontMemResourceById(id int) (*FontMemResource, error) {
 	return newFontMemResource(win.MAKEINTRESOURCE(uintptr(id)))
 }
 
+// NewFontMemResourceFromBytes loads the fonts contained in data, which holds a
+// font file such as a .ttf or .otf file. The fonts are private to the process.
+func NewFontMemResourceFromBytes(data []byte) (*FontMemResource, error) {
+	if len(data) == 0 {
+		return nil, newError("empty font data")
+	}
+
+	// AddFontMemResourceEx copies the font data, so data need not stay alive
+	// beyond the call.
+	fmr, err := newFontMemResourceFromMemory(uintptr(unsafe.Pointer(&data[0])), uint32(len(data)))
+	runtime.KeepAlive(data)
+
+	return fmr, err
+}
+
+// NewFontMemResourceFromFS loads the fonts contained in the font file name in
+// fsys, which may for example be an embed.FS.
+func NewFontMemResourceFromFS(fsys fs.FS, name string) (*FontMemResource, error) {
+	data, err := readFS(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFontMemResourceFromBytes(data)
+}
+
 // Dispose removes the font resource from memory
 func (fmr *FontMemResource) Dispose() {
 	if fmr.hFontResource != 0 {
@@ -50,6 +50,10 @@ type Form interface {
 	SetOwner(owner Form) error
 	ProgressIndicator() *ProgressIndicator
 
+	// FocusChanged returns the event that is published when the keyboard
+	// focus moves from one widget of the Form to another.
+	FocusChanged() *FocusChangedEvent
+
 	// RightToLeftLayout returns whether coordinates on the x axis of the
 	// Form increase from right to left.
 	RightToLeftLayout() bool
@@ -76,6 +80,9 @@ type FormBase struct {
 	startingPublisher           EventPublisher
 	titleChangedPublisher       EventPublisher
 	iconChangedPublisher        EventPublisher
+	mirroredChangedPublisher    EventPublisher
+	focusChangedPublisher       FocusChangedEventPublisher
+	focusedWidget               Widget
 	progressIndicator           *ProgressIndicator
 	icon                        Image
 	prevFocusHWnd               windows.HWND
@@ -86,6 +93,8 @@ type FormBase struct {
 	isInRestoreState            bool
 	started                     bool
 	layoutScheduled             bool
+	mirrored                    bool
+	themedIconWatched           bool
 }
 
 func (fb *FormBase) init(form Form) error {
@@ -128,6 +137,15 @@ func (fb *FormBase) init(form Form) error {
 		},
 		fb.titleChangedPublisher.Event()))
 
+	fb.MustRegisterProperty("Mirrored", NewBoolProperty(
+		func() bool {
+			return fb.Mirrored()
+		},
+		func(b bool) error {
+			return fb.SetMirrored(b)
+		},
+		fb.mirroredChangedPublisher.Event()))
+
 	version := win.GetVersion()
 	if (version&0xFF) > 6 || ((version&0xFF) == 6 && (version&0xFF00>>8) > 0) {
 		win.ChangeWindowMessageFilterEx(fb.hWnd, taskbarButtonCreatedMsgId, win.MSGFLT_ALLOW, nil)
@@ -314,6 +332,53 @@ func (fb *FormBase) TitleChanged() *Event {
 	return fb.titleChangedPublisher.Event()
 }
 
+// FocusChanged returns the event that is published when the keyboard focus
+// moves from one widget of the FormBase to another.
+func (fb *FormBase) FocusChanged() *FocusChangedEvent {
+	return fb.focusChangedPublisher.Event()
+}
+
+// setFocusedWidget records that widget got the keyboard focus, as the one
+// Containers with TabNavigationOnce give it back to, and publishes
+// FocusChanged.
+func (fb *FormBase) setFocusedWidget(widget Widget) {
+	if widget == fb.focusedWidget {
+		return
+	}
+
+	old := fb.focusedWidget
+	fb.focusedWidget = widget
+
+	widget.AsWidgetBase().ForEachAncestor(func(window Window) bool {
+		if container, ok := window.(Container); ok {
+			if cb := container.AsContainerBase(); cb != nil && cb.tabNavigation == TabNavigationOnce {
+				cb.lastFocused = widget
+			}
+		}
+
+		return window != fb.window
+	})
+
+	fb.focusChangedPublisher.Publish(old, widget)
+}
+
+// forgetWidget drops the references to widget, which is being disposed.
+func (fb *FormBase) forgetWidget(widget Widget) {
+	if fb.focusedWidget == widget {
+		fb.focusedWidget = nil
+	}
+
+	widget.AsWidgetBase().ForEachAncestor(func(window Window) bool {
+		if container, ok := window.(Container); ok {
+			if cb := container.AsContainerBase(); cb != nil && cb.lastFocused == widget {
+				cb.lastFocused = nil
+			}
+		}
+
+		return window != fb.window
+	})
+}
+
 // RightToLeftLayout returns whether coordinates on the x axis of the
 // FormBase increase from right to left.
 func (fb *FormBase) RightToLeftLayout() bool {
@@ -326,6 +391,83 @@ func (fb *FormBase) SetRightToLeftLayout(rtl bool) error {
 	return fb.ensureExtendedStyleBits(win.WS_EX_LAYOUTRTL, rtl)
 }
 
+// Mirrored returns whether the content of the FormBase is laid out and
+// painted from right to left.
+func (fb *FormBase) Mirrored() bool {
+	return fb.mirrored
+}
+
+// SetMirrored sets whether the content of the FormBase is laid out and painted
+// from right to left, as needed for languages like Arabic and Hebrew.
+//
+// Unlike SetRightToLeftLayout, which only affects windows created afterwards,
+// SetMirrored can be called at any time. Layouts flip their items, stock
+// custom drawn widgets flip their drawing and all descendants get a right to
+// left reading order. It should not be combined with RightToLeftLayout, which
+// would mirror the content a second time.
+func (fb *FormBase) SetMirrored(mirrored bool) error {
+	if mirrored == fb.mirrored {
+		return nil
+	}
+
+	fb.mirrored = mirrored
+
+	if fb.clientComposite != nil {
+		if err := applyMirroredToDescendants(fb.clientComposite, mirrored); err != nil {
+			return err
+		}
+	}
+
+	fb.RequestLayout()
+	fb.Invalidate()
+
+	fb.mirroredChangedPublisher.Publish()
+
+	return nil
+}
+
+// MirroredChanged returns the event that is published when the mirroring of
+// the FormBase changes.
+func (fb *FormBase) MirroredChanged() *Event {
+	return fb.mirroredChangedPublisher.Event()
+}
+
+// windowMirrored returns whether the Form of window is mirrored.
+func windowMirrored(window Window) bool {
+	if window == nil {
+		return false
+	}
+
+	if form := window.Form(); form != nil {
+		return form.AsFormBase().mirrored
+	}
+
+	return false
+}
+
+// applyMirroredToDescendants sets the reading order of window and its
+// descendants and lets those that draw direction dependent content know
+// about the new mirroring.
+func applyMirroredToDescendants(window Window, mirrored bool) (err error) {
+	type mirrorer interface {
+		applyMirrored(mirrored bool)
+	}
+
+	walkDescendants(window, func(w Window) bool {
+		if err = w.AsWindowBase().SetRightToLeftReading(mirrored); err != nil {
+			return false
+		}
+
+		if m, ok := w.(mirrorer); ok {
+			m.applyMirrored(mirrored)
+		}
+
+		return true
+	})
+
+	return
+}
+
 func (fb *FormBase) Run() int {
 	if fb.owner != nil {
 		win.EnableWindow(fb.owner.Handle(), false)
@@ -442,6 +584,11 @@ func (fb *FormBase) handleKeyDown(msg *win.MSG) bool {
 		hwnd = win.GetParent(hwnd)
 	}
 
+	// Keyboard navigation
+	if handleTabKey(msg, key, mods) {
+		return true
+	}
+
 	// WebView
 	walkDescendants(fb.window, func(w Window) bool {
 		if webView, ok := w.(*WebView); ok {
@@ -535,6 +682,13 @@ func (fb *FormBase) SetIcon(icon Image) error {
 
 	fb.icon = icon
 
+	if _, ok := icon.(themedImage); ok && !fb.themedIconWatched {
+		fb.themedIconWatched = true
+		reapplyOnDarkModeChange(&fb.WindowBase, func() {
+			fb.SetIcon(fb.icon)
+		})
+	}
+
 	fb.iconChangedPublisher.Publish()
 
 	return nil
@@ -0,0 +1,160 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"bytes"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io/fs"
+
+	"github.com/xackery/wlk/walk/resfs"
+	"github.com/xackery/wlk/win"
+)
+
+// readFS reads the file name from fsys. name may use backslashes or start with
+// a slash, as resource names commonly do.
+func readFS(fsys fs.FS, name string) ([]byte, error) {
+	cleaned, ok := resfs.Clean(name)
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	return fs.ReadFile(fsys, cleaned)
+}
+
+func decodeFS(fsys fs.FS, name string) (image.Image, error) {
+	data, err := readFS(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	im, _, err := image.Decode(bytes.NewReader(data))
+	return im, err
+}
+
+// variantSet holds the DPI variants of an image in a file system along with
+// the width of the image in 1/96" units, if it is needed to tell the density
+// of variants named by pixel width.
+type variantSet struct {
+	variants []resfs.Variant
+	width96  int
+}
+
+func findVariants(fsys fs.FS, name string) (variantSet, error) {
+	variants, err := resfs.Find(fsys, name)
+	if err != nil {
+		return variantSet{}, err
+	}
+
+	set := variantSet{variants: variants, width96: resfs.BaseWidth(variants)}
+	if set.width96 == 0 {
+		return set, nil
+	}
+
+	// If the plain file exists, it defines the width at 96 DPI.
+	for _, v := range variants {
+		if v.Scale != 1 {
+			continue
+		}
+
+		if data, err := readFS(fsys, v.Name); err == nil {
+			if cfg, _, err := image.DecodeConfig(bytes.NewReader(data)); err == nil {
+				set.width96 = cfg.Width
+			}
+		}
+		break
+	}
+
+	return set, nil
+}
+
+func (set variantSet) bitmapForDPI(fsys fs.FS, dpi int) (*Bitmap, error) {
+	v := resfs.Select(set.variants, dpi, set.width96)
+
+	im, err := decodeFS(fsys, v.Name)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return NewBitmapFromImageForDPI(im, v.DPI(set.width96))
+}
+
+// variantImage is an Image loaded from a file system that may provide variants
+// of the image for several pixel densities. It draws the variant best suited
+// for the target DPI.
+type variantImage struct {
+	fsys    fs.FS
+	set     variantSet
+	size    Size // in 1/96" units
+	bitmaps map[string]*Bitmap
+}
+
+func newVariantImageFromFS(fsys fs.FS, name string) (*variantImage, error) {
+	set, err := findVariants(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	vi := &variantImage{fsys: fsys, set: set, bitmaps: make(map[string]*Bitmap)}
+
+	bmp, err := vi.bitmapForDPI(96)
+	if err != nil {
+		return nil, err
+	}
+	vi.size = bmp.Size()
+
+	return vi, nil
+}
+
+func (vi *variantImage) bitmapForDPI(dpi int) (*Bitmap, error) {
+	v := resfs.Select(vi.set.variants, dpi, vi.set.width96)
+	if bmp := vi.bitmaps[v.Name]; bmp != nil {
+		return bmp, nil
+	}
+
+	bmp, err := vi.set.bitmapForDPI(vi.fsys, dpi)
+	if err != nil {
+		return nil, err
+	}
+	vi.bitmaps[v.Name] = bmp
+
+	return bmp, nil
+}
+
+func (vi *variantImage) draw(hdc win.HDC, location Point) error {
+	dpi := dpiForHDC(hdc)
+	size := SizeFrom96DPI(vi.size, dpi)
+
+	return vi.drawStretched(hdc, Rectangle{location.X, location.Y, size.Width, size.Height})
+}
+
+func (vi *variantImage) drawStretched(hdc win.HDC, bounds Rectangle) error {
+	dpi := int(float64(bounds.Width) / float64(vi.size.Width) * 96.0)
+
+	bmp, err := vi.bitmapForDPI(dpi)
+	if err != nil {
+		return err
+	}
+
+	return bmp.drawStretched(hdc, bounds)
+}
+
+func (vi *variantImage) Dispose() {
+	for name, bmp := range vi.bitmaps {
+		bmp.Dispose()
+		delete(vi.bitmaps, name)
+	}
+}
+
+// Size returns image size in 1/96" units.
+func (vi *variantImage) Size() Size {
+	return vi.size
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fuzzy implements fuzzy matching of short texts like the names of
+// commands, as used by walk.CommandPalette.
+//
+// A pattern matches a text if all of its runes appear in the text in the same
+// order, ignoring case and white space in the pattern. Matches are scored so
+// that runes at the start of words and consecutive runes rank higher than
+// runes scattered across the text.
+package fuzzy
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	scoreMatch       = 16
+	bonusFirst       = 8
+	bonusWordStart   = 8
+	bonusCamelCase   = 6
+	bonusConsecutive = 6
+	bonusCase        = 1
+	penaltyGap       = 1
+	penaltyLeading   = 1
+	maxLeading       = 3
+)
+
+// Match is a successful match of a pattern in a text.
+type Match struct {
+	// Score rates the match, higher is better. Scores are only comparable for
+	// the same pattern.
+	Score int
+
+	// Positions are the indexes of the matched runes in the text, in
+	// increasing order.
+	Positions []int
+}
+
+// Result is a Match of a candidate passed to Rank.
+type Result struct {
+	Index int
+	Match
+}
+
+// MatchString returns the best match of pattern in text and whether there is
+// one. The empty pattern matches any text with a score of 0.
+func MatchString(pattern, text string) (Match, bool) {
+	var p []rune
+	for _, r := range pattern {
+		if !unicode.IsSpace(r) {
+			p = append(p, r)
+		}
+	}
+	if len(p) == 0 {
+		return Match{}, true
+	}
+
+	t := []rune(text)
+	m, n := len(p), len(t)
+	if m > n {
+		return Match{}, false
+	}
+
+	bonus := make([]int, n)
+	for j := range t {
+		bonus[j] = positionBonus(t, j)
+	}
+
+	// score[i*n+j] is the best score of matching p[:i+1] with p[i] at t[j],
+	// or noMatch. prev holds the position of p[i-1] for that score.
+	const noMatch = -1 << 30
+	score := make([]int, m*n)
+	prev := make([]int, m*n)
+
+	for i := 0; i < m; i++ {
+		// gapBest is the best score of p[i-1] at a position k < j-1,
+		// including the gap penalty for the runes between k and j.
+		gapBest, gapPrev := noMatch, -1
+
+		for j := 0; j < n; j++ {
+			if i > 0 && j >= 2 {
+				if gapBest != noMatch {
+					gapBest -= penaltyGap
+				}
+				if s := score[(i-1)*n+j-2]; s != noMatch && s-penaltyGap > gapBest {
+					gapBest, gapPrev = s-penaltyGap, j-2
+				}
+			}
+
+			idx := i*n + j
+			score[idx], prev[idx] = noMatch, -1
+
+			if !equalFold(p[i], t[j]) {
+				continue
+			}
+
+			s := scoreMatch + bonus[j]
+			if p[i] == t[j] {
+				s += bonusCase
+			}
+
+			if i == 0 {
+				leading := j
+				if leading > maxLeading {
+					leading = maxLeading
+				}
+				score[idx] = s - leading*penaltyLeading
+				continue
+			}
+
+			best, from := gapBest, gapPrev
+			if j > 0 {
+				if c := score[(i-1)*n+j-1]; c != noMatch && c+bonusConsecutive >= best {
+					best, from = c+bonusConsecutive, j-1
+				}
+			}
+			if best == noMatch {
+				continue
+			}
+
+			score[idx], prev[idx] = s+best, from
+		}
+	}
+
+	end, best := -1, noMatch
+	for j := 0; j < n; j++ {
+		if s := score[(m-1)*n+j]; s > best {
+			end, best = j, s
+		}
+	}
+	if end < 0 {
+		return Match{}, false
+	}
+
+	positions := make([]int, m)
+	for i, j := m-1, end; i >= 0; i-- {
+		positions[i] = j
+		j = prev[i*n+j]
+	}
+
+	return Match{Score: best, Positions: positions}, true
+}
+
+// Rank matches pattern against candidates and returns the results for those
+// that match, best first. Results with equal scores are ordered by the length
+// of the candidate and then by index. For the empty pattern, all candidates
+// are returned in their order.
+func Rank(pattern string, candidates []string) []Result {
+	var results []Result
+	lengths := make(map[int]int)
+
+	for i, c := range candidates {
+		if m, ok := MatchString(pattern, c); ok {
+			results = append(results, Result{Index: i, Match: m})
+			lengths[i] = len([]rune(c))
+		}
+	}
+
+	if strings.TrimSpace(pattern) == "" {
+		return results
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if lengths[a.Index] != lengths[b.Index] {
+			return lengths[a.Index] < lengths[b.Index]
+		}
+		return a.Index < b.Index
+	})
+
+	return results
+}
+
+func positionBonus(t []rune, j int) int {
+	if j == 0 {
+		return bonusFirst + bonusWordStart
+	}
+
+	prev, cur := t[j-1], t[j]
+	switch {
+	case !unicode.IsLetter(prev) && !unicode.IsDigit(prev) && (unicode.IsLetter(cur) || unicode.IsDigit(cur)):
+		return bonusWordStart
+
+	case unicode.IsLower(prev) && unicode.IsUpper(cur),
+		unicode.IsLetter(prev) && unicode.IsDigit(cur):
+		return bonusCamelCase
+	}
+
+	return 0
+}
+
+func equalFold(a, b rune) bool {
+	if a == b {
+		return true
+	}
+
+	return unicode.ToLower(a) == unicode.ToLower(b) || unicode.ToUpper(a) == unicode.ToUpper(b)
+}
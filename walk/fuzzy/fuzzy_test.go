@@ -0,0 +1,108 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fuzzy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatchString(t *testing.T) {
+	testCases := []struct {
+		pattern   string
+		text      string
+		wantOK    bool
+		positions []int
+	}{
+		{"", "Open File", true, nil},
+		{"of", "Open File", true, []int{0, 5}},
+		{"OF", "open file", true, []int{0, 5}},
+		{"open", "Open File", true, []int{0, 1, 2, 3}},
+		{"o f", "Open File", true, []int{0, 5}},
+		{"fo", "Open File", false, nil},
+		{"file", "Open", false, nil},
+		{"sa", "Save As", true, []int{0, 1}},
+		{"sas", "Save As", true, []int{0, 5, 6}},
+		{"fn", "fileName", true, []int{0, 4}},
+		{"ü", "Über", true, []int{0}},
+		{"x", "Close Tab", false, nil},
+	}
+
+	for _, c := range testCases {
+		m, ok := MatchString(c.pattern, c.text)
+		if ok != c.wantOK {
+			t.Errorf("MatchString(%q, %q): got ok %v, want %v", c.pattern, c.text, ok, c.wantOK)
+			continue
+		}
+		if ok && !reflect.DeepEqual(m.Positions, c.positions) {
+			t.Errorf("MatchString(%q, %q): got positions %v, want %v", c.pattern, c.text, m.Positions, c.positions)
+		}
+	}
+}
+
+func TestMatchStringScores(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		better  string
+		worse   string
+	}{
+		// Word starts beat runes within words.
+		{"of", "Open File", "Proof"},
+		// Consecutive runes beat scattered ones.
+		{"cop", "Copy", "Close Other Panes"},
+		// Earlier matches beat later ones.
+		{"s", "Save", "Close Tabs"},
+		// Exact case beats other case.
+		{"Save", "Save", "save"},
+	}
+
+	for _, c := range testCases {
+		better, ok := MatchString(c.pattern, c.better)
+		if !ok {
+			t.Errorf("MatchString(%q, %q): no match", c.pattern, c.better)
+			continue
+		}
+		worse, ok := MatchString(c.pattern, c.worse)
+		if !ok {
+			t.Errorf("MatchString(%q, %q): no match", c.pattern, c.worse)
+			continue
+		}
+		if better.Score <= worse.Score {
+			t.Errorf("pattern %q: got score %d for %q and %d for %q, want the first higher",
+				c.pattern, better.Score, c.better, worse.Score, c.worse)
+		}
+	}
+}
+
+func TestRank(t *testing.T) {
+	candidates := []string{
+		"Edit: Copy",
+		"File: Close",
+		"File: Close All",
+		"View: Toggle Word Wrap",
+		"Help: About",
+	}
+
+	testCases := []struct {
+		pattern string
+		want    []int
+	}{
+		{"", []int{0, 1, 2, 3, 4}},
+		{"close", []int{1, 2}},
+		{"fca", []int{2}},
+		{"ww", []int{3}},
+		{"zzz", nil},
+	}
+
+	for _, c := range testCases {
+		var got []int
+		for _, r := range Rank(c.pattern, candidates) {
+			got = append(got, r.Index)
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Rank(%q): got %v, want %v", c.pattern, got, c.want)
+		}
+	}
+}
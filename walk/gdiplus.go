@@ -13,6 +13,7 @@ import (
 	"sync"
 
 	"github.com/dblohm7/wingoes/com"
+	"github.com/xackery/wlk/wcolor"
 	"github.com/xackery/wlk/win"
 	"golang.org/x/sys/windows"
 )
@@ -35,6 +36,46 @@ func ensureGDIPlus() error {
 	return gdiplusInitError
 }
 
+// PointF is a point with floating point coordinates, as used by the GDI+
+// vector drawing API. Fractional coordinates are honored when anti-aliasing is
+// enabled via SetSmoothingMode.
+type PointF struct {
+	X, Y float32
+}
+
+// PointFFromPoint converts p to a PointF.
+func PointFFromPoint(p Point) PointF {
+	return PointF{X: float32(p.X), Y: float32(p.Y)}
+}
+
+func (p PointF) toGpPointF() win.GpPointF {
+	return win.GpPointF{X: p.X, Y: p.Y}
+}
+
+// RectangleF is a rectangle with floating point coordinates, as used by the
+// GDI+ vector drawing API.
+type RectangleF struct {
+	X, Y, Width, Height float32
+}
+
+// RectangleFFromRectangle converts r to a RectangleF.
+func RectangleFFromRectangle(r Rectangle) RectangleF {
+	return RectangleF{X: float32(r.X), Y: float32(r.Y), Width: float32(r.Width), Height: float32(r.Height)}
+}
+
+func (r RectangleF) toGpRectF() win.GpRectF {
+	return win.GpRectF{X: r.X, Y: r.Y, Width: r.Width, Height: r.Height}
+}
+
+func gpPointFs(points []PointF) []win.GpPointF {
+	result := make([]win.GpPointF, len(points))
+	for i, p := range points {
+		result[i] = p.toGpPointF()
+	}
+
+	return result
+}
+
 // GDIPlusCanvas facilitates performing graphics operations against a rendering
 // target.
 type GDIPlusCanvas struct {
@@ -188,6 +229,226 @@ func (g *GDIPlusCanvas) FillEllipse(brush *GDIPlusBrush, rect Rectangle) error {
 	return nil
 }
 
+// DrawEllipse draws the outline of the ellipse bounded by rect into g using pen.
+func (g *GDIPlusCanvas) DrawEllipse(pen *GDIPlusPen, rect RectangleF) error {
+	if status := win.GdipDrawEllipse(g.gpGraphics, pen.gpPen, rect.X, rect.Y, rect.Width, rect.Height); status != win.Ok {
+		return newError(fmt.Sprintf("GdipDrawEllipse failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// FillEllipseF draws a filled ellipse bounded by rect into g using brush.
+// Unlike FillEllipse, rect may have fractional coordinates.
+func (g *GDIPlusCanvas) FillEllipseF(brush *GDIPlusBrush, rect RectangleF) error {
+	if status := win.GdipFillEllipse(g.gpGraphics, brush.gpBrush, rect.X, rect.Y, rect.Width, rect.Height); status != win.Ok {
+		return newError(fmt.Sprintf("GdipFillEllipse failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// DrawLine draws a line from from to to into g using pen.
+func (g *GDIPlusCanvas) DrawLine(pen *GDIPlusPen, from, to PointF) error {
+	if status := win.GdipDrawLine(g.gpGraphics, pen.gpPen, from.X, from.Y, to.X, to.Y); status != win.Ok {
+		return newError(fmt.Sprintf("GdipDrawLine failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// DrawRectangle draws the outline of rect into g using pen.
+func (g *GDIPlusCanvas) DrawRectangle(pen *GDIPlusPen, rect RectangleF) error {
+	if status := win.GdipDrawRectangle(g.gpGraphics, pen.gpPen, rect.X, rect.Y, rect.Width, rect.Height); status != win.Ok {
+		return newError(fmt.Sprintf("GdipDrawRectangle failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// FillRectangle fills rect in g using brush.
+func (g *GDIPlusCanvas) FillRectangle(brush *GDIPlusBrush, rect RectangleF) error {
+	if status := win.GdipFillRectangle(g.gpGraphics, brush.gpBrush, rect.X, rect.Y, rect.Width, rect.Height); status != win.Ok {
+		return newError(fmt.Sprintf("GdipFillRectangle failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// DrawRoundedRectangle draws the outline of rect with corners of radius into
+// g using pen.
+func (g *GDIPlusCanvas) DrawRoundedRectangle(pen *GDIPlusPen, rect RectangleF, radius float32) error {
+	return g.withTemporaryPath(func(path *GDIPlusPath) error {
+		if err := path.AddRoundedRectangle(rect, radius); err != nil {
+			return err
+		}
+
+		return g.DrawPath(pen, path)
+	})
+}
+
+// FillRoundedRectangle fills rect with corners of radius in g using brush.
+func (g *GDIPlusCanvas) FillRoundedRectangle(brush *GDIPlusBrush, rect RectangleF, radius float32) error {
+	return g.withTemporaryPath(func(path *GDIPlusPath) error {
+		if err := path.AddRoundedRectangle(rect, radius); err != nil {
+			return err
+		}
+
+		return g.FillPath(brush, path)
+	})
+}
+
+// DrawPolygon draws the outline of the closed polygon through points into g
+// using pen.
+func (g *GDIPlusCanvas) DrawPolygon(pen *GDIPlusPen, points []PointF) error {
+	return g.withTemporaryPath(func(path *GDIPlusPath) error {
+		if err := path.AddPolygon(points); err != nil {
+			return err
+		}
+
+		return g.DrawPath(pen, path)
+	})
+}
+
+// FillPolygon fills the closed polygon through points in g using brush.
+func (g *GDIPlusCanvas) FillPolygon(brush *GDIPlusBrush, points []PointF) error {
+	return g.withTemporaryPath(func(path *GDIPlusPath) error {
+		if err := path.AddPolygon(points); err != nil {
+			return err
+		}
+
+		return g.FillPath(brush, path)
+	})
+}
+
+// DrawPolyline draws connected line segments through points into g using pen.
+func (g *GDIPlusCanvas) DrawPolyline(pen *GDIPlusPen, points []PointF) error {
+	return g.withTemporaryPath(func(path *GDIPlusPath) error {
+		if err := path.AddLines(points); err != nil {
+			return err
+		}
+
+		return g.DrawPath(pen, path)
+	})
+}
+
+// DrawPath draws the outline of path into g using pen.
+func (g *GDIPlusCanvas) DrawPath(pen *GDIPlusPen, path *GDIPlusPath) error {
+	if status := win.GdipDrawPath(g.gpGraphics, pen.gpPen, path.gpPath); status != win.Ok {
+		return newError(fmt.Sprintf("GdipDrawPath failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// FillPath fills the interior of path in g using brush.
+func (g *GDIPlusCanvas) FillPath(brush *GDIPlusBrush, path *GDIPlusPath) error {
+	if status := win.GdipFillPath(g.gpGraphics, brush.gpBrush, path.gpPath); status != win.Ok {
+		return newError(fmt.Sprintf("GdipFillPath failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+func (g *GDIPlusCanvas) withTemporaryPath(f func(path *GDIPlusPath) error) error {
+	path, err := NewGDIPlusPath(win.FillModeAlternate)
+	if err != nil {
+		return err
+	}
+	defer path.Dispose()
+
+	return f(path)
+}
+
+// MeasureString computes the bounds text occupies when drawn with DrawText
+// using font and strFmt within layoutRect. strFmt may be nil. It also reports
+// how many UTF-16 code units and lines fit into layoutRect.
+func (g *GDIPlusCanvas) MeasureString(text string, font *GDIPlusFont, layoutRect RectangleF, strFmt *GDIPlusStringFormat) (bounds RectangleF, codepointsFitted, linesFilled int, _ error) {
+	utf16Text, err := windows.UTF16FromString(text)
+	if err != nil {
+		return bounds, 0, 0, err
+	}
+
+	var useFmt *win.GpStringFormat
+	if strFmt != nil {
+		useFmt = strFmt.gpStringFormat
+	}
+
+	layout := layoutRect.toGpRectF()
+	var box win.GpRectF
+	var fitted, lines int32
+	if status := win.GdipMeasureString(g.gpGraphics, &utf16Text[0], int32(len(utf16Text)-1), font.gpFont, &layout, useFmt, &box, &fitted, &lines); status != win.Ok {
+		return bounds, 0, 0, newError(fmt.Sprintf("GdipMeasureString failed with status '%s'", status))
+	}
+
+	return RectangleF{X: box.X, Y: box.Y, Width: box.Width, Height: box.Height}, int(fitted), int(lines), nil
+}
+
+// TranslateTransform prepends a translation by dx and dy to the world
+// transformation of g.
+func (g *GDIPlusCanvas) TranslateTransform(dx, dy float32) error {
+	if status := win.GdipTranslateWorldTransform(g.gpGraphics, dx, dy, win.MatrixOrderPrepend); status != win.Ok {
+		return newError(fmt.Sprintf("GdipTranslateWorldTransform failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// RotateTransform prepends a clockwise rotation by angle degrees around the
+// origin to the world transformation of g.
+func (g *GDIPlusCanvas) RotateTransform(angle float32) error {
+	if status := win.GdipRotateWorldTransform(g.gpGraphics, angle, win.MatrixOrderPrepend); status != win.Ok {
+		return newError(fmt.Sprintf("GdipRotateWorldTransform failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// ScaleTransform prepends a scaling by sx and sy to the world transformation
+// of g.
+func (g *GDIPlusCanvas) ScaleTransform(sx, sy float32) error {
+	if status := win.GdipScaleWorldTransform(g.gpGraphics, sx, sy, win.MatrixOrderPrepend); status != win.Ok {
+		return newError(fmt.Sprintf("GdipScaleWorldTransform failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// ResetTransform resets the world transformation of g to the identity.
+func (g *GDIPlusCanvas) ResetTransform() error {
+	if status := win.GdipResetWorldTransform(g.gpGraphics); status != win.Ok {
+		return newError(fmt.Sprintf("GdipResetWorldTransform failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// GDIPlusCanvasState identifies a state of a GDIPlusCanvas saved by Save.
+type GDIPlusCanvasState struct {
+	state win.GraphicsState
+}
+
+// Save saves the transformation, clipping region and quality settings of g,
+// so that they can be restored by passing the result to Restore.
+func (g *GDIPlusCanvas) Save() (GDIPlusCanvasState, error) {
+	var state win.GraphicsState
+	if status := win.GdipSaveGraphics(g.gpGraphics, &state); status != win.Ok {
+		return GDIPlusCanvasState{}, newError(fmt.Sprintf("GdipSaveGraphics failed with status '%s'", status))
+	}
+
+	return GDIPlusCanvasState{state}, nil
+}
+
+// Restore restores the state of g to what it was when state was obtained from
+// Save.
+func (g *GDIPlusCanvas) Restore(state GDIPlusCanvasState) error {
+	if status := win.GdipRestoreGraphics(g.gpGraphics, state.state); status != win.Ok {
+		return newError(fmt.Sprintf("GdipRestoreGraphics failed with status '%s'", status))
+	}
+
+	return nil
+}
+
 // NewCompatibleBitmap creates a new GDIPlusBitmap with size whose format is
 // compatible with g.
 func (g *GDIPlusCanvas) NewCompatibleBitmap(size Size) (*GDIPlusBitmap, error) {
@@ -333,6 +594,147 @@ func (p *GDIPlusPath) AddEllipse(rect Rectangle) error {
 	return nil
 }
 
+// AddEllipseF adds an ellipse bounded by rect to p. Unlike AddEllipse, rect
+// may have fractional coordinates.
+func (p *GDIPlusPath) AddEllipseF(rect RectangleF) error {
+	if status := win.GdipAddPathEllipse(p.gpPath, rect.X, rect.Y, rect.Width, rect.Height); status != win.Ok {
+		return newError(fmt.Sprintf("GdipAddPathEllipse failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// AddLine adds a line segment from from to to to the current figure of p.
+func (p *GDIPlusPath) AddLine(from, to PointF) error {
+	if status := win.GdipAddPathLine(p.gpPath, from.X, from.Y, to.X, to.Y); status != win.Ok {
+		return newError(fmt.Sprintf("GdipAddPathLine failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// AddLines adds connected line segments through points to the current figure
+// of p.
+func (p *GDIPlusPath) AddLines(points []PointF) error {
+	if len(points) < 2 {
+		return nil
+	}
+
+	pts := gpPointFs(points)
+	if status := win.GdipAddPathLine2(p.gpPath, &pts[0], int32(len(pts))); status != win.Ok {
+		return newError(fmt.Sprintf("GdipAddPathLine2 failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// AddBezier adds a cubic Bézier curve from start to end, shaped by the control
+// points c1 and c2, to the current figure of p.
+func (p *GDIPlusPath) AddBezier(start, c1, c2, end PointF) error {
+	if status := win.GdipAddPathBezier(p.gpPath, start.X, start.Y, c1.X, c1.Y, c2.X, c2.Y, end.X, end.Y); status != win.Ok {
+		return newError(fmt.Sprintf("GdipAddPathBezier failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// AddArc adds an elliptical arc to the current figure of p. The arc is a
+// section of the ellipse bounded by rect, starting at startAngle and spanning
+// sweepAngle, both in degrees measured clockwise from the positive x axis.
+func (p *GDIPlusPath) AddArc(rect RectangleF, startAngle, sweepAngle float32) error {
+	if status := win.GdipAddPathArc(p.gpPath, rect.X, rect.Y, rect.Width, rect.Height, startAngle, sweepAngle); status != win.Ok {
+		return newError(fmt.Sprintf("GdipAddPathArc failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// AddRectangle adds rect to p as a closed figure.
+func (p *GDIPlusPath) AddRectangle(rect RectangleF) error {
+	if status := win.GdipAddPathRectangle(p.gpPath, rect.X, rect.Y, rect.Width, rect.Height); status != win.Ok {
+		return newError(fmt.Sprintf("GdipAddPathRectangle failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// AddRoundedRectangle adds rect with corners of radius to p as a closed
+// figure. radius is clamped to half the width or height of rect.
+func (p *GDIPlusPath) AddRoundedRectangle(rect RectangleF, radius float32) error {
+	if radius > rect.Width/2 {
+		radius = rect.Width / 2
+	}
+	if radius > rect.Height/2 {
+		radius = rect.Height / 2
+	}
+	if radius <= 0 {
+		return p.AddRectangle(rect)
+	}
+
+	d := 2 * radius
+	right := rect.X + rect.Width - d
+	bottom := rect.Y + rect.Height - d
+
+	if err := p.StartFigure(); err != nil {
+		return err
+	}
+	for _, arc := range [...]struct{ x, y, start float32 }{
+		{rect.X, rect.Y, 180},
+		{right, rect.Y, 270},
+		{right, bottom, 0},
+		{rect.X, bottom, 90},
+	} {
+		if err := p.AddArc(RectangleF{arc.x, arc.y, d, d}, arc.start, 90); err != nil {
+			return err
+		}
+	}
+
+	return p.CloseFigure()
+}
+
+// AddPolygon adds the closed polygon through points to p.
+func (p *GDIPlusPath) AddPolygon(points []PointF) error {
+	if len(points) < 3 {
+		return os.ErrInvalid
+	}
+
+	pts := gpPointFs(points)
+	if status := win.GdipAddPathPolygon(p.gpPath, &pts[0], int32(len(pts))); status != win.Ok {
+		return newError(fmt.Sprintf("GdipAddPathPolygon failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// StartFigure starts a new figure in p without closing the current one.
+// Subsequently added segments are not connected to the previous ones.
+func (p *GDIPlusPath) StartFigure() error {
+	if status := win.GdipStartPathFigure(p.gpPath); status != win.Ok {
+		return newError(fmt.Sprintf("GdipStartPathFigure failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// CloseFigure closes the current figure of p by connecting its last point to
+// its first point, and starts a new figure.
+func (p *GDIPlusPath) CloseFigure() error {
+	if status := win.GdipClosePathFigure(p.gpPath); status != win.Ok {
+		return newError(fmt.Sprintf("GdipClosePathFigure failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// Reset removes all figures from p.
+func (p *GDIPlusPath) Reset() error {
+	if status := win.GdipResetPath(p.gpPath); status != win.Ok {
+		return newError(fmt.Sprintf("GdipResetPath failed with status '%s'", status))
+	}
+
+	return nil
+}
+
 // Dispose frees system resources associated with p.
 func (p *GDIPlusPath) Dispose() {
 	if win.GdipDeletePath(p.gpPath) == win.Ok {
@@ -359,6 +761,138 @@ func NewGDIPlusSolidBrush(color win.ARGB) (*GDIPlusBrush, error) {
 	return &GDIPlusBrush{gpBrush: (*win.GpBrush)(brush)}, nil
 }
 
+// GDIPlusGradientStop specifies the color of a gradient at Offset, which
+// ranges from 0 (start of the gradient) to 1 (end of the gradient).
+type GDIPlusGradientStop struct {
+	Offset float32
+	Color  win.ARGB
+}
+
+// NewGDIPlusLinearGradientBrush creates a brush that blends linearly from
+// color1 at point from to color2 at point to. The gradient repeats beyond
+// both points.
+func NewGDIPlusLinearGradientBrush(from, to PointF, color1, color2 win.ARGB) (*GDIPlusBrush, error) {
+	if err := ensureGDIPlus(); err != nil {
+		return nil, err
+	}
+
+	p1, p2 := from.toGpPointF(), to.toGpPointF()
+
+	var brush *win.GpLineGradient
+	if status := win.GdipCreateLineBrush(&p1, &p2, color1, color2, win.WrapModeTileFlipXY, &brush); status != win.Ok {
+		return nil, newError(fmt.Sprintf("GdipCreateLineBrush failed with status '%s'", status))
+	}
+
+	return &GDIPlusBrush{gpBrush: (*win.GpBrush)(brush)}, nil
+}
+
+// NewGDIPlusLinearGradientBrushWithStops creates a brush that blends linearly
+// through stops along the line from from to to. stops must be sorted by
+// Offset, start at Offset 0 and end at Offset 1.
+func NewGDIPlusLinearGradientBrushWithStops(from, to PointF, stops []GDIPlusGradientStop) (*GDIPlusBrush, error) {
+	if err := validateGradientStops(stops); err != nil {
+		return nil, err
+	}
+
+	result, err := NewGDIPlusLinearGradientBrush(from, to, stops[0].Color, stops[len(stops)-1].Color)
+	if err != nil {
+		return nil, err
+	}
+
+	colors, positions := splitGradientStops(stops, false)
+	if status := win.GdipSetLinePresetBlend((*win.GpLineGradient)(result.gpBrush), &colors[0], &positions[0], int32(len(stops))); status != win.Ok {
+		result.Dispose()
+		return nil, newError(fmt.Sprintf("GdipSetLinePresetBlend failed with status '%s'", status))
+	}
+
+	return result, nil
+}
+
+// NewGDIPlusRadialGradientBrush creates a brush that fills the ellipse bounded
+// by bounds, blending from centerColor at its center to edgeColor at its
+// outline. Areas outside the ellipse are not painted by the brush.
+func NewGDIPlusRadialGradientBrush(bounds RectangleF, centerColor, edgeColor win.ARGB) (*GDIPlusBrush, error) {
+	return NewGDIPlusRadialGradientBrushWithStops(bounds, []GDIPlusGradientStop{
+		{Offset: 0, Color: centerColor},
+		{Offset: 1, Color: edgeColor},
+	})
+}
+
+// NewGDIPlusRadialGradientBrushWithStops creates a brush that fills the
+// ellipse bounded by bounds, blending through stops from its center (Offset
+// 0) to its outline (Offset 1). stops must be sorted by Offset, start at
+// Offset 0 and end at Offset 1.
+func NewGDIPlusRadialGradientBrushWithStops(bounds RectangleF, stops []GDIPlusGradientStop) (*GDIPlusBrush, error) {
+	if err := validateGradientStops(stops); err != nil {
+		return nil, err
+	}
+
+	path, err := NewGDIPlusPath(win.FillModeAlternate)
+	if err != nil {
+		return nil, err
+	}
+	defer path.Dispose()
+
+	if err := path.AddEllipseF(bounds); err != nil {
+		return nil, err
+	}
+
+	var brush *win.GpPathGradient
+	if status := win.GdipCreatePathGradientFromPath(path.gpPath, &brush); status != win.Ok {
+		return nil, newError(fmt.Sprintf("GdipCreatePathGradientFromPath failed with status '%s'", status))
+	}
+	result := &GDIPlusBrush{gpBrush: (*win.GpBrush)(brush)}
+
+	center := win.GpPointF{X: bounds.X + bounds.Width/2, Y: bounds.Y + bounds.Height/2}
+	if status := win.GdipSetPathGradientCenterPoint(brush, &center); status != win.Ok {
+		result.Dispose()
+		return nil, newError(fmt.Sprintf("GdipSetPathGradientCenterPoint failed with status '%s'", status))
+	}
+
+	// Path gradients measure positions from the outline (0) to the center (1),
+	// which is the reverse of how stops are specified.
+	colors, positions := splitGradientStops(stops, true)
+	if status := win.GdipSetPathGradientPresetBlend(brush, &colors[0], &positions[0], int32(len(stops))); status != win.Ok {
+		result.Dispose()
+		return nil, newError(fmt.Sprintf("GdipSetPathGradientPresetBlend failed with status '%s'", status))
+	}
+
+	return result, nil
+}
+
+func validateGradientStops(stops []GDIPlusGradientStop) error {
+	if len(stops) < 2 {
+		return newError("a gradient requires at least two stops")
+	}
+	if stops[0].Offset != 0 || stops[len(stops)-1].Offset != 1 {
+		return newError("gradient stops must start at offset 0 and end at offset 1")
+	}
+	for i := 1; i < len(stops); i++ {
+		if stops[i].Offset < stops[i-1].Offset {
+			return newError("gradient stops must be sorted by offset")
+		}
+	}
+
+	return nil
+}
+
+func splitGradientStops(stops []GDIPlusGradientStop, reverse bool) ([]win.ARGB, []float32) {
+	colors := make([]win.ARGB, len(stops))
+	positions := make([]float32, len(stops))
+	for i, stop := range stops {
+		if reverse {
+			j := len(stops) - 1 - i
+			colors[j] = stop.Color
+			positions[j] = 1 - stop.Offset
+		} else {
+			colors[i] = stop.Color
+			positions[i] = stop.Offset
+		}
+	}
+
+	return colors, positions
+}
+
 // Dispose frees system resources associated with b.
 func (b *GDIPlusBrush) Dispose() {
 	if win.GdipDeleteBrush(b.gpBrush) == win.Ok {
@@ -366,6 +900,107 @@ func (b *GDIPlusBrush) Dispose() {
 	}
 }
 
+// GDIPlusPen encapsulates an instance of a GDI+ pen, which is used to draw
+// lines, curves and outlines.
+type GDIPlusPen struct {
+	gpPen *win.GpPen
+}
+
+// NewGDIPlusPen creates a new solid pen of color that is width pixels wide.
+func NewGDIPlusPen(color win.ARGB, width float32) (*GDIPlusPen, error) {
+	if err := ensureGDIPlus(); err != nil {
+		return nil, err
+	}
+
+	result := &GDIPlusPen{}
+	if status := win.GdipCreatePen1(color, width, win.UnitPixel, &result.gpPen); status != win.Ok {
+		return nil, newError(fmt.Sprintf("GdipCreatePen1 failed with status '%s'", status))
+	}
+
+	return result, nil
+}
+
+// NewGDIPlusPenFromBrush creates a new pen that is width pixels wide and
+// paints its strokes using brush, for example to draw gradient outlines. The
+// pen does not reference brush after it has been created.
+func NewGDIPlusPenFromBrush(brush *GDIPlusBrush, width float32) (*GDIPlusPen, error) {
+	result := &GDIPlusPen{}
+	if status := win.GdipCreatePen2(brush.gpBrush, width, win.UnitPixel, &result.gpPen); status != win.Ok {
+		return nil, newError(fmt.Sprintf("GdipCreatePen2 failed with status '%s'", status))
+	}
+
+	return result, nil
+}
+
+// Dispose frees system resources associated with p.
+func (p *GDIPlusPen) Dispose() {
+	if win.GdipDeletePen(p.gpPen) == win.Ok {
+		p.gpPen = nil
+	}
+}
+
+// SetDashStyle sets one of the predefined dash styles of p.
+func (p *GDIPlusPen) SetDashStyle(style win.DashStyle) error {
+	if status := win.GdipSetPenDashStyle(p.gpPen, style); status != win.Ok {
+		return newError(fmt.Sprintf("GdipSetPenDashStyle failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// SetDashPattern sets a custom dash pattern for p. pattern alternates between
+// dash and gap lengths, both measured in multiples of the pen width.
+func (p *GDIPlusPen) SetDashPattern(pattern []float32) error {
+	if len(pattern) == 0 {
+		return p.SetDashStyle(win.DashStyleSolid)
+	}
+
+	if status := win.GdipSetPenDashArray(p.gpPen, &pattern[0], int32(len(pattern))); status != win.Ok {
+		return newError(fmt.Sprintf("GdipSetPenDashArray failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// SetDashOffset sets the distance from the start of a line to the start of
+// the dash pattern of p, in multiples of the pen width.
+func (p *GDIPlusPen) SetDashOffset(offset float32) error {
+	if status := win.GdipSetPenDashOffset(p.gpPen, offset); status != win.Ok {
+		return newError(fmt.Sprintf("GdipSetPenDashOffset failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// SetLineCap sets the caps drawn at the start and end of lines drawn with p,
+// as well as at both ends of each dash.
+func (p *GDIPlusPen) SetLineCap(startCap, endCap win.LineCap, dashCap win.DashCap) error {
+	if status := win.GdipSetPenLineCap197819(p.gpPen, startCap, endCap, dashCap); status != win.Ok {
+		return newError(fmt.Sprintf("GdipSetPenLineCap197819 failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// SetLineJoin sets how p joins the ends of two connected segments.
+func (p *GDIPlusPen) SetLineJoin(join win.LineJoin) error {
+	if status := win.GdipSetPenLineJoin(p.gpPen, join); status != win.Ok {
+		return newError(fmt.Sprintf("GdipSetPenLineJoin failed with status '%s'", status))
+	}
+
+	return nil
+}
+
+// SetMiterLimit limits how far a mitered join of p may extend, in multiples of
+// the pen width. Joins that would extend further are beveled.
+func (p *GDIPlusPen) SetMiterLimit(limit float32) error {
+	if status := win.GdipSetPenMiterLimit(p.gpPen, limit); status != win.Ok {
+		return newError(fmt.Sprintf("GdipSetPenMiterLimit failed with status '%s'", status))
+	}
+
+	return nil
+}
+
 // MakeARGB creates a win.ARGB representing a 32-bit color from alpha, red,
 // green, and blue components.
 func MakeARGB(a byte, r byte, g byte, b byte) win.ARGB {
@@ -376,6 +1011,12 @@ func MakeARGB(a byte, r byte, g byte, b byte) win.ARGB {
 	return result
 }
 
+// ARGBFromColor creates a win.ARGB from color with the given alpha, where 0 is
+// fully transparent and 255 is fully opaque.
+func ARGBFromColor(color wcolor.Color, alpha byte) win.ARGB {
+	return MakeARGB(alpha, color.R(), color.G(), color.B())
+}
+
 // GDIPlusBitmap encapsulates an instance of a GDI+ bitmap.
 type GDIPlusBitmap struct {
 	gpBitmap *win.GpBitmap
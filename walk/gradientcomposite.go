@@ -239,7 +239,13 @@ func (gc *GradientComposite) updateBackground() error {
 		orientation = Horizontal
 	}
 
-	if err := canvas.GradientFillRectanglePixels(gc.color1, gc.color2, orientation, bounds); err != nil {
+	// In mirrored forms Color1 is on the right.
+	color1, color2 := gc.color1, gc.color2
+	if !gc.vertical && windowMirrored(gc) {
+		color1, color2 = color2, color1
+	}
+
+	if err := canvas.GradientFillRectanglePixels(color1, color2, orientation, bounds); err != nil {
 		return err
 	}
 
@@ -253,6 +259,12 @@ func (gc *GradientComposite) updateBackground() error {
 	return nil
 }
 
+func (gc *GradientComposite) applyMirrored(mirrored bool) {
+	if !gc.vertical {
+		gc.updateBackground()
+	}
+}
+
 func (gc *GradientComposite) Dispose() {
 	if gc.brush != nil {
 		gc.SetBackground(nil)
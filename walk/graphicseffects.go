@@ -190,6 +190,96 @@ func (dse *DropShadowEffect) Draw(widget Widget, canvas *Canvas) error {
 	return nil
 }
 
+// FocusRingStyle describes the ring FocusRingEffect draws around the focused
+// widget: an outer band of Primary, Width 1/96" wide, separated from the
+// widget by a 1/96" line of Secondary, so it stands out against both.
+type FocusRingStyle struct {
+	Primary   wcolor.Color
+	Secondary wcolor.Color
+	Width     int
+}
+
+// DefaultFocusRingStyle returns the FocusRingStyle that matches the system
+// focus rectangles: black on white, or white on black while IsDarkMode
+// returns true.
+func DefaultFocusRingStyle() FocusRingStyle {
+	style := FocusRingStyle{
+		Primary:   wcolor.RGB(0x00, 0x00, 0x00),
+		Secondary: wcolor.RGB(0xFF, 0xFF, 0xFF),
+		Width:     2,
+	}
+
+	if IsDarkMode() {
+		style.Primary, style.Secondary = style.Secondary, style.Primary
+	}
+
+	return style
+}
+
+// FocusRingEffect draws a FocusRingStyle around the focused widget.
+//
+// Assign it to FocusEffect before creating widgets:
+//
+//	walk.FocusEffect, _ = walk.NewFocusRingEffect(walk.FocusRingStyle{})
+type FocusRingEffect struct {
+	style FocusRingStyle
+}
+
+// NewFocusRingEffect returns a FocusRingEffect that draws style. The zero
+// FocusRingStyle draws DefaultFocusRingStyle, following the dark mode as it
+// changes.
+func NewFocusRingEffect(style FocusRingStyle) (*FocusRingEffect, error) {
+	return &FocusRingEffect{style: style}, nil
+}
+
+// Style returns the FocusRingStyle the FocusRingEffect draws.
+func (fre *FocusRingEffect) Style() FocusRingStyle {
+	if fre.style == (FocusRingStyle{}) {
+		return DefaultFocusRingStyle()
+	}
+
+	return fre.style
+}
+
+func (fre *FocusRingEffect) Draw(widget Widget, canvas *Canvas) error {
+	style := fre.Style()
+
+	b := widget.BoundsPixels()
+
+	dpi := canvas.DPI()
+	inner := IntFrom96DPI(1, dpi)
+	outer := IntFrom96DPI(maxi(style.Width, 1), dpi)
+
+	ring := func(color wcolor.Color, b Rectangle, width int) error {
+		brush, err := NewSolidColorBrush(color)
+		if err != nil {
+			return err
+		}
+		defer brush.Dispose()
+
+		for _, r := range [...]Rectangle{
+			{b.X - width, b.Y - width, b.Width + 2*width, width},
+			{b.X - width, b.Y + b.Height, b.Width + 2*width, width},
+			{b.X - width, b.Y, width, b.Height},
+			{b.X + b.Width, b.Y, width, b.Height},
+		} {
+			if err := canvas.FillRectanglePixels(brush, r); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := ring(style.Secondary, b, inner); err != nil {
+		return err
+	}
+
+	b = Rectangle{b.X - inner, b.Y - inner, b.Width + 2*inner, b.Height + 2*inner}
+
+	return ring(style.Primary, b, outer)
+}
+
 type widgetGraphicsEffectListObserver interface {
 	onInsertedGraphicsEffect(index int, effect WidgetGraphicsEffect) error
 	onRemovedGraphicsEffect(index int, effect WidgetGraphicsEffect) error
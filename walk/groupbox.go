@@ -284,6 +284,18 @@ func (gb *GroupBox) Children() *WidgetList {
 	return gb.composite.Children()
 }
 
+// TabNavigation returns how the Tab key moves the keyboard focus through the
+// descendants of the GroupBox.
+func (gb *GroupBox) TabNavigation() TabNavigation {
+	return gb.composite.TabNavigation()
+}
+
+// SetTabNavigation sets how the Tab key moves the keyboard focus through the
+// descendants of the GroupBox.
+func (gb *GroupBox) SetTabNavigation(navigation TabNavigation) error {
+	return gb.composite.SetTabNavigation(navigation)
+}
+
 func (gb *GroupBox) Layout() Layout {
 	if gb.composite == nil {
 		// Without this we would get into trouble through the call to
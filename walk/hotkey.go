@@ -0,0 +1,128 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+
+	"github.com/xackery/wlk/win"
+)
+
+// maxHotKeyID is the largest id of a hot key of an application.
+const maxHotKeyID = 0xBFFF
+
+// GlobalHotKey is a Shortcut that triggers a handler even while no window of
+// the application has the keyboard focus, for example in tray applications.
+type GlobalHotKey struct {
+	group    *WindowGroup
+	id       int32
+	shortcut Shortcut
+	handler  func()
+}
+
+// RegisterGlobalHotKey registers shortcut as a system wide hot key that
+// calls handler, until Unregister is called.
+//
+// It must be called on a thread that already has a window, for example a
+// MainWindow or a NotifyIcon, and handler is called by the message loop of
+// that thread. The registration fails if another application already
+// registered the same shortcut.
+func RegisterGlobalHotKey(shortcut Shortcut, handler func()) (*GlobalHotKey, error) {
+	if shortcut.Key == 0 {
+		return nil, newError("shortcut must have a key")
+	}
+	if handler == nil {
+		return nil, newError("handler must not be nil")
+	}
+
+	group := wgm.Group(win.GetCurrentThreadId())
+	if group == nil {
+		return nil, newError("RegisterGlobalHotKey must be called on a thread with a window")
+	}
+
+	// Ids only need to be unique per window, so each group hands out its own
+	// on its thread, reusing those of unregistered hot keys.
+	id := group.freeHotKeyID()
+	if id == 0 {
+		return nil, newError("too many global hot keys")
+	}
+
+	hk := &GlobalHotKey{
+		group:    group,
+		id:       id,
+		shortcut: shortcut,
+		handler:  handler,
+	}
+
+	var mods uint32 = win.MOD_NOREPEAT
+	if shortcut.Modifiers&ModAlt != 0 {
+		mods |= win.MOD_ALT
+	}
+	if shortcut.Modifiers&ModControl != 0 {
+		mods |= win.MOD_CONTROL
+	}
+	if shortcut.Modifiers&ModShift != 0 {
+		mods |= win.MOD_SHIFT
+	}
+	if shortcut.Modifiers&ModWin != 0 {
+		mods |= win.MOD_WIN
+	}
+
+	if !win.RegisterHotKey(group.msgWindow, hk.id, mods, uint32(shortcut.Key)) {
+		return nil, lastError(fmt.Sprintf("RegisterHotKey(%s)", shortcut))
+	}
+
+	if group.hotKeys == nil {
+		group.hotKeys = make(map[int32]*GlobalHotKey)
+	}
+	group.hotKeys[hk.id] = hk
+
+	// Keep the message window alive while the hot key is registered.
+	group.Add(1)
+
+	return hk, nil
+}
+
+// freeHotKeyID returns the smallest id not used by a hot key of the group, or
+// zero if all are in use.
+func (g *WindowGroup) freeHotKeyID() int32 {
+	for id := int32(1); id <= maxHotKeyID; id++ {
+		if _, ok := g.hotKeys[id]; !ok {
+			return id
+		}
+	}
+
+	return 0
+}
+
+// Shortcut returns the shortcut of the hot key.
+func (hk *GlobalHotKey) Shortcut() Shortcut {
+	return hk.shortcut
+}
+
+// Unregister unregisters the hot key. It must be called on the thread that
+// registered it.
+func (hk *GlobalHotKey) Unregister() error {
+	if hk.group == nil {
+		return nil
+	}
+
+	group := hk.group
+	hk.group = nil
+
+	delete(group.hotKeys, hk.id)
+
+	var err error
+	if !win.UnregisterHotKey(group.msgWindow, hk.id) {
+		err = lastError("UnregisterHotKey")
+	}
+
+	group.Done()
+
+	return err
+}
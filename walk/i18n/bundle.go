@@ -0,0 +1,261 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Bundle translates messages using the catalogs of several languages. It is
+// safe for concurrent use.
+type Bundle struct {
+	mutex    sync.RWMutex
+	catalogs map[string]*Catalog // by language
+	language string              // as set by SetLanguage
+	chain    []*Catalog          // the catalogs to look up messages in, in order
+}
+
+// NewBundle returns a new, empty bundle, which uses the languages of the
+// operating system until SetLanguage is called.
+func NewBundle() *Bundle {
+	return &Bundle{catalogs: make(map[string]*Catalog)}
+}
+
+// Add adds the catalog c. If the bundle already has a catalog of the same
+// language, the messages of c are merged into it, replacing existing ones.
+func (b *Bundle) Add(c *Catalog) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if existing, ok := b.catalogs[c.language]; ok {
+		existing.merge(c)
+	} else {
+		b.catalogs[c.language] = c
+	}
+
+	b.resolve()
+}
+
+// Parse parses the catalog data of the format given by the extension of
+// name, .po, .mo or .json, and adds it. If the catalog does not specify its
+// language, it is taken from name, the base name of which is a language tag
+// like "de" or "pt_BR", optionally preceded by a domain and a dot, like
+// "app.de.po".
+func (b *Bundle) Parse(name string, data []byte) error {
+	ext := strings.ToLower(path.Ext(name))
+	language := strings.TrimSuffix(path.Base(filepath.ToSlash(name)), path.Ext(name))
+	if i := strings.LastIndexByte(language, '.'); i >= 0 {
+		language = language[i+1:]
+	}
+
+	var c *Catalog
+	var err error
+	switch ext {
+	case ".po":
+		c, err = ParsePO(bytes.NewReader(data), language)
+
+	case ".mo":
+		c, err = ParseMO(data, language)
+
+	case ".json":
+		c, err = ParseJSON(data, language)
+
+	default:
+		return fmt.Errorf("i18n: unsupported catalog format '%s'", ext)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	b.Add(c)
+
+	return nil
+}
+
+// LoadFile loads the catalog file at filePath, see Parse.
+func (b *Bundle) LoadFile(filePath string) error {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	return b.Parse(filePath, data)
+}
+
+// LoadFS loads all catalog files in the directory dir of fsys, for example an
+// embed.FS, see Parse. Files of other formats are ignored.
+func (b *Bundle) LoadFS(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		switch strings.ToLower(path.Ext(entry.Name())) {
+		case ".po", ".mo", ".json":
+		default:
+			continue
+		}
+
+		name := path.Join(dir, entry.Name())
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+
+		if err := b.Parse(name, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Languages returns the languages of the catalogs of b, sorted.
+func (b *Bundle) Languages() []string {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	languages := make([]string, 0, len(b.catalogs))
+	for language := range b.catalogs {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	return languages
+}
+
+// SetLanguage sets the language to translate into. Messages missing from its
+// catalog are looked up in the catalogs of the languages of the operating
+// system and are otherwise left untranslated. An empty language selects the
+// languages of the operating system.
+func (b *Bundle) SetLanguage(language string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.language = NormalizeLanguage(language)
+	b.resolve()
+}
+
+// Language returns the language of the catalog messages are first looked up
+// in, or an empty string if no catalog matches the selected languages.
+func (b *Bundle) Language() string {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	if len(b.chain) == 0 {
+		return ""
+	}
+
+	return b.chain[0].language
+}
+
+// resolve updates the chain of catalogs to look up messages in. The mutex
+// must be held.
+func (b *Bundle) resolve() {
+	requested := SystemLanguages()
+	if b.language != "" {
+		requested = append([]string{b.language}, requested...)
+	}
+
+	b.chain = matchCatalogs(b.catalogs, requested)
+}
+
+// matchCatalogs returns the catalogs matching the requested languages, in
+// order. A language matches the catalog of the same language, then that of
+// its base language, then those of other variants of its base language.
+func matchCatalogs(catalogs map[string]*Catalog, requested []string) []*Catalog {
+	var chain []*Catalog
+	seen := make(map[*Catalog]bool)
+
+	add := func(c *Catalog) {
+		if c != nil && !seen[c] {
+			seen[c] = true
+			chain = append(chain, c)
+		}
+	}
+
+	for _, language := range requested {
+		language = NormalizeLanguage(language)
+		base := BaseLanguage(language)
+
+		add(catalogs[language])
+		add(catalogs[base])
+
+		var variants []string
+		for l := range catalogs {
+			if BaseLanguage(l) == base {
+				variants = append(variants, l)
+			}
+		}
+		sort.Strings(variants)
+		for _, l := range variants {
+			add(catalogs[l])
+		}
+	}
+
+	return chain
+}
+
+// Tr returns the translation of source. The optional context, only the first
+// of which is used, disambiguates identical sources with different meanings,
+// like msgctxt in gettext. Tr has the signature of walk.TranslationFunction.
+func (b *Bundle) Tr(source string, context ...string) string {
+	var ctx string
+	if len(context) > 0 {
+		ctx = context[0]
+	}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for _, c := range b.chain {
+		if t, ok := c.Lookup(ctx, source); ok {
+			return t
+		}
+	}
+
+	return source
+}
+
+// TrN returns the translation of the message singular with plural forms, in
+// the form for the count n, and replaces the placeholder {n} in it with n.
+// Untranslated messages use singular for a count of 1 and plural otherwise.
+func (b *Bundle) TrN(singular, plural string, n int, context ...string) string {
+	var ctx string
+	if len(context) > 0 {
+		ctx = context[0]
+	}
+
+	args := Args{"n": strconv.Itoa(n)}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for _, c := range b.chain {
+		if t, ok := c.LookupPlural(ctx, singular, int64(n)); ok {
+			return Format(t, args)
+		}
+	}
+
+	if n == 1 {
+		return Format(singular, args)
+	}
+
+	return Format(plural, args)
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package i18n implements message catalogs for translating user interfaces.
+//
+// Catalogs are loaded from gettext .po and .mo files or from JSON files, see
+// ParsePO, ParseMO and ParseJSON, and are combined into a Bundle, which
+// translates messages into the selected language, falling back to the
+// languages of the operating system and finally to the untranslated source.
+// Plural forms follow the Plural-Forms header of gettext catalogs and the CLDR
+// plural rules otherwise.
+//
+// walk.Application.SetTranslations installs a Bundle as the translation
+// function of walk.
+package i18n
+
+// contextSeparator separates the context from the message id in the keys of
+// catalogs, as in gettext .mo files.
+const contextSeparator = "\x04"
+
+func messageKey(context, id string) string {
+	if context == "" {
+		return id
+	}
+
+	return context + contextSeparator + id
+}
+
+// Catalog holds the translations of messages into one language.
+type Catalog struct {
+	language    string
+	pluralIndex func(n int64) int
+	messages    map[string][]string
+}
+
+// NewCatalog returns a new, empty catalog for language, whose plural forms
+// are ordered by the CLDR plural categories of the language, see
+// PluralCategories.
+func NewCatalog(language string) *Catalog {
+	language = NormalizeLanguage(language)
+
+	return &Catalog{
+		language:    language,
+		pluralIndex: cldrPluralIndex(language),
+		messages:    make(map[string][]string),
+	}
+}
+
+func cldrPluralIndex(language string) func(n int64) int {
+	rule := pluralRuleFor(language)
+
+	return func(n int64) int {
+		category := rule.category(IntOperands(n))
+		for i, c := range rule.categories {
+			if c == category {
+				return i
+			}
+		}
+
+		return len(rule.categories) - 1
+	}
+}
+
+// Language returns the language of c.
+func (c *Catalog) Language() string {
+	return c.language
+}
+
+// Len returns the number of messages in c.
+func (c *Catalog) Len() int {
+	return len(c.messages)
+}
+
+// Add adds the translation of the message id in context, which may be empty,
+// to c. A message with plural forms has a translation for each of them.
+// Empty translations are ignored, so the message remains untranslated.
+func (c *Catalog) Add(context, id string, translations ...string) {
+	for _, t := range translations {
+		if t == "" {
+			return
+		}
+	}
+	if id == "" || len(translations) == 0 {
+		return
+	}
+
+	c.messages[messageKey(context, id)] = translations
+}
+
+// merge adds the messages of other to c, replacing existing ones.
+func (c *Catalog) merge(other *Catalog) {
+	for key, translations := range other.messages {
+		c.messages[key] = translations
+	}
+}
+
+// Lookup returns the translation of the message id in context.
+func (c *Catalog) Lookup(context, id string) (string, bool) {
+	translations, ok := c.messages[messageKey(context, id)]
+	if !ok {
+		return "", false
+	}
+
+	return translations[0], true
+}
+
+// LookupPlural returns the plural form for the count n of the translation of
+// the message id in context.
+func (c *Catalog) LookupPlural(context, id string, n int64) (string, bool) {
+	translations, ok := c.messages[messageKey(context, id)]
+	if !ok {
+		return "", false
+	}
+
+	i := c.pluralIndex(n)
+	if i < 0 || i >= len(translations) {
+		i = len(translations) - 1
+	}
+
+	return translations[i], true
+}
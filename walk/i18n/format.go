@@ -0,0 +1,59 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Args are the arguments of the placeholders of a message, see Format.
+type Args map[string]interface{}
+
+// Format replaces the placeholders in message, like {name}, with the values of
+// args, formatted with fmt.Sprint. Placeholders without an argument are left
+// as they are, so translators can spot them. A literal brace is written as
+// {{ or }}.
+func Format(message string, args Args) string {
+	if !strings.ContainsAny(message, "{}") {
+		return message
+	}
+
+	var sb strings.Builder
+	for len(message) > 0 {
+		i := strings.IndexAny(message, "{}")
+		if i < 0 {
+			sb.WriteString(message)
+			break
+		}
+
+		sb.WriteString(message[:i])
+		brace := message[i]
+		message = message[i+1:]
+
+		// Doubled braces are escapes.
+		if len(message) > 0 && message[0] == brace {
+			sb.WriteByte(brace)
+			message = message[1:]
+			continue
+		}
+
+		end := strings.IndexByte(message, '}')
+		if brace == '}' || end < 0 {
+			sb.WriteByte(brace)
+			continue
+		}
+
+		name := message[:end]
+		if value, ok := args[name]; ok {
+			sb.WriteString(fmt.Sprint(value))
+		} else {
+			sb.WriteString("{" + name + "}")
+		}
+		message = message[end+1:]
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,426 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestPluralCategory(t *testing.T) {
+	tests := []struct {
+		language string
+		number   string
+		want     Plural
+	}{
+		{"en", "1", One},
+		{"en", "0", Other},
+		{"en", "1.0", Other},
+		{"en-US", "2", Other},
+		{"fr", "0", One},
+		{"fr", "1.5", One},
+		{"fr", "2", Other},
+		{"fr", "1000000", Many},
+		{"ru", "1", One},
+		{"ru", "21", One},
+		{"ru", "11", Many},
+		{"ru", "3", Few},
+		{"ru", "14", Many},
+		{"ru", "1.5", Other},
+		{"pl", "1", One},
+		{"pl", "22", Few},
+		{"pl", "21", Many},
+		{"cs", "3", Few},
+		{"cs", "0.5", Many},
+		{"ar", "0", Zero},
+		{"ar", "2", Two},
+		{"ar", "103", Few},
+		{"ar", "111", Many},
+		{"ar", "100", Other},
+		{"ja", "1", Other},
+		{"hr", "1.1", One},
+		{"sl", "102", Two},
+	}
+
+	for _, test := range tests {
+		ops, err := ParseOperands(test.number)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := PluralCategory(test.language, ops); got != test.want {
+			t.Errorf("PluralCategory(%s, %s): got %v, want %v", test.language, test.number, got, test.want)
+		}
+	}
+}
+
+func TestParseOperands(t *testing.T) {
+	ops, err := ParseOperands("-12.340")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (Operands{N: 12.34, I: 12, V: 3, W: 2, F: 340, T: 34}); ops != want {
+		t.Errorf("got %+v, want %+v", ops, want)
+	}
+
+	if _, err := ParseOperands("1x"); err == nil {
+		t.Error("ParseOperands(1x): got nil error")
+	}
+}
+
+func TestPluralForms(t *testing.T) {
+	tests := []struct {
+		forms string
+		n     []int64
+		want  []int
+	}{
+		{"nplurals=2; plural=(n != 1);", []int64{0, 1, 2}, []int{1, 0, 1}},
+		{"nplurals=1; plural=0;", []int64{0, 1, 5}, []int{0, 0, 0}},
+		{
+			"nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);",
+			[]int64{1, 11, 21, 2, 12, 5, 0},
+			[]int{0, 2, 0, 1, 2, 2, 2},
+		},
+		{"nplurals=2; plural=n>1;", []int64{0, 1, 2}, []int{0, 0, 1}},
+		{"nplurals=2; plural=!(n==1);", []int64{1, 3}, []int{0, 1}},
+		{"nplurals=2; plural=n/0+5;", []int64{1}, []int{1}},
+	}
+
+	for _, test := range tests {
+		index, err := parsePluralForms(test.forms)
+		if err != nil {
+			t.Errorf("%s: %v", test.forms, err)
+			continue
+		}
+
+		for i, n := range test.n {
+			if got := index(n); got != test.want[i] {
+				t.Errorf("%s with n=%d: got %d, want %d", test.forms, n, got, test.want[i])
+			}
+		}
+	}
+
+	for _, forms := range []string{"plural=n!=1;", "nplurals=2; plural=(n", "nplurals=2; plural=n ? 1;", "nplurals=2; plural=x;"} {
+		if _, err := parsePluralForms(forms); err == nil {
+			t.Errorf("%s: got nil error", forms)
+		}
+	}
+}
+
+const testPO = `# German translations
+msgid ""
+msgstr ""
+"Language: de\n"
+"Plural-Forms: nplurals=2; plural=(n != 1);\n"
+
+#: main.go:12
+msgid "Open"
+msgstr "Öffnen"
+
+msgctxt "menu"
+msgid "Open"
+msgstr "Ö&ffnen"
+
+msgid "Multi"
+"line"
+msgstr "Mehr"
+"zeilig\t"
+
+msgid "{n} file"
+msgid_plural "{n} files"
+msgstr[0] "{n} Datei"
+msgstr[1] "{n} Dateien"
+
+#, fuzzy
+msgid "Close"
+msgstr "Schließen"
+
+msgid "Untranslated"
+msgstr ""
+`
+
+func TestParsePO(t *testing.T) {
+	c, err := ParsePO(strings.NewReader(testPO), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Language() != "de" {
+		t.Errorf("language: got %s, want de", c.Language())
+	}
+
+	lookups := []struct {
+		context, id string
+		want        string
+		ok          bool
+	}{
+		{"", "Open", "Öffnen", true},
+		{"menu", "Open", "Ö&ffnen", true},
+		{"", "Multiline", "Mehrzeilig\t", true},
+		{"", "Close", "", false},
+		{"", "Untranslated", "", false},
+	}
+	for _, l := range lookups {
+		if got, ok := c.Lookup(l.context, l.id); got != l.want || ok != l.ok {
+			t.Errorf("Lookup(%q, %q): got %q, %v, want %q, %v", l.context, l.id, got, ok, l.want, l.ok)
+		}
+	}
+
+	for n, want := range map[int64]string{0: "{n} Dateien", 1: "{n} Datei", 2: "{n} Dateien"} {
+		if got, _ := c.LookupPlural("", "{n} file", n); got != want {
+			t.Errorf("LookupPlural(%d): got %q, want %q", n, got, want)
+		}
+	}
+
+	for _, po := range []string{"msgstr \"x\"", "msgid \"a\"\nmsgstr[1] \"b\"", "\"orphan\"", "msgid x"} {
+		if _, err := ParsePO(strings.NewReader(po), "de"); err == nil {
+			t.Errorf("ParsePO(%q): got nil error", po)
+		}
+	}
+}
+
+// buildMO returns a little endian .mo file of the strings in pairs, which
+// alternate between ids and translations.
+func buildMO(pairs ...string) []byte {
+	count := len(pairs) / 2
+	header := make([]byte, 28+count*16)
+
+	le := binary.LittleEndian
+	le.PutUint32(header, 0x950412de)
+	le.PutUint32(header[8:], uint32(count))
+	le.PutUint32(header[12:], 28)
+	le.PutUint32(header[16:], uint32(28+count*8))
+
+	data := header
+	for i, s := range pairs {
+		table := 28
+		if i%2 == 1 {
+			table += count * 8
+		}
+		offset := table + i/2*8
+
+		le.PutUint32(data[offset:], uint32(len(s)))
+		le.PutUint32(data[offset+4:], uint32(len(data)))
+		data = append(data, s...)
+		data = append(data, 0)
+	}
+
+	return data
+}
+
+func TestParseMO(t *testing.T) {
+	data := buildMO(
+		"", "Language: ru\n",
+		"Open", "Открыть",
+		"menu\x04Save", "Сохранить",
+		"{n} file\x00{n} files", "{n} файл\x00{n} файла\x00{n} файлов",
+	)
+
+	c, err := ParseMO(data, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Language() != "ru" {
+		t.Errorf("language: got %s, want ru", c.Language())
+	}
+	if got, _ := c.Lookup("", "Open"); got != "Открыть" {
+		t.Errorf("Lookup(Open): got %q", got)
+	}
+	if got, _ := c.Lookup("menu", "Save"); got != "Сохранить" {
+		t.Errorf("Lookup(menu, Save): got %q", got)
+	}
+
+	// Without Plural-Forms, the CLDR categories of Russian apply, whose order
+	// one, few, many matches the usual gettext order.
+	for n, want := range map[int64]string{1: "{n} файл", 3: "{n} файла", 5: "{n} файлов", 11: "{n} файлов"} {
+		if got, _ := c.LookupPlural("", "{n} file", n); got != want {
+			t.Errorf("LookupPlural(%d): got %q, want %q", n, got, want)
+		}
+	}
+
+	if _, err := ParseMO(data[:20], ""); err == nil {
+		t.Error("ParseMO of truncated data: got nil error")
+	}
+	if _, err := ParseMO(append([]byte{0, 0, 0, 0}, data[4:]...), ""); err == nil {
+		t.Error("ParseMO with bad magic: got nil error")
+	}
+
+	// A header claiming more strings than the data holds must fail before
+	// anything is allocated for them.
+	hostile := buildMO()
+	binary.LittleEndian.PutUint32(hostile[8:], 0x7fffffff)
+	if _, err := ParseMO(hostile, ""); err == nil {
+		t.Error("ParseMO with hostile count: got nil error")
+	}
+	binary.LittleEndian.PutUint32(hostile[8:], 1)
+	binary.LittleEndian.PutUint32(hostile[16:], 0xfffffff0)
+	if _, err := ParseMO(hostile, ""); err == nil {
+		t.Error("ParseMO with translation table out of bounds: got nil error")
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	c, err := ParseJSON([]byte(`{
+		"language": "pl",
+		"messages": {
+			"Open": "Otwórz",
+			"{n} file": {"one": "{n} plik", "few": "{n} pliki", "other": "{n} plików"}
+		},
+		"contexts": {"menu": {"Open": "&Otwórz"}}
+	}`), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, _ := c.Lookup("menu", "Open"); got != "&Otwórz" {
+		t.Errorf("Lookup(menu, Open): got %q", got)
+	}
+
+	// many is missing, so other is used.
+	for n, want := range map[int64]string{1: "{n} plik", 2: "{n} pliki", 5: "{n} plików"} {
+		if got, _ := c.LookupPlural("", "{n} file", n); got != want {
+			t.Errorf("LookupPlural(%d): got %q, want %q", n, got, want)
+		}
+	}
+
+	for _, doc := range []string{
+		`{`,
+		`{"messages": {"a": 1}}`,
+		`{"messages": {"a": {"one": "x"}}}`,
+		`{"messages": {"a": {"lots": "x", "other": "y"}}}`,
+	} {
+		if _, err := ParseJSON([]byte(doc), "en"); err == nil {
+			t.Errorf("ParseJSON(%s): got nil error", doc)
+		}
+	}
+}
+
+func TestBundle(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locale/de.po":       {Data: []byte(testPO)},
+		"locale/app.fr.json": {Data: []byte(`{"messages": {"Open": "Ouvrir", "Save": "Enregistrer"}}`)},
+		"locale/pt_BR.json":  {Data: []byte(`{"messages": {"Open": "Abrir"}}`)},
+		"locale/readme.txt":  {Data: []byte("ignored")},
+		"locale/sub/de.json": {Data: []byte(`{`)},
+		"locale/de-AT.json":  {Data: []byte(`{"messages": {"Save": "Sichern"}}`)},
+	}
+
+	b := NewBundle()
+	if err := b.LoadFS(fsys, "locale"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := strings.Join(b.Languages(), ","), "de,de-AT,fr,pt-BR"; got != want {
+		t.Errorf("Languages: got %s, want %s", got, want)
+	}
+
+	tests := []struct {
+		language string
+		source   string
+		context  []string
+		want     string
+	}{
+		{"de", "Open", nil, "Öffnen"},
+		{"de", "Open", []string{"menu"}, "Ö&ffnen"},
+		{"de-DE", "Open", nil, "Öffnen"},
+		{"de", "Save", nil, "Sichern"},
+		{"fr_FR.UTF-8", "Save", nil, "Enregistrer"},
+		{"pt", "Open", nil, "Abrir"},
+		{"fr", "Missing", nil, "Missing"},
+	}
+
+	for _, test := range tests {
+		b.SetLanguage(test.language)
+
+		if got := b.Tr(test.source, test.context...); got != test.want {
+			t.Errorf("%s: Tr(%s): got %q, want %q", test.language, test.source, got, test.want)
+		}
+	}
+
+	b.SetLanguage("de")
+	if got := b.Language(); got != "de" {
+		t.Errorf("Language: got %s, want de", got)
+	}
+	if got := b.TrN("{n} file", "{n} files", 3); got != "3 Dateien" {
+		t.Errorf("TrN: got %q", got)
+	}
+
+	b.SetLanguage("ja")
+	if got := b.TrN("{n} file", "{n} files", 1); b.Language() == "" && got != "1 file" {
+		t.Errorf("untranslated TrN: got %q", got)
+	}
+
+	if err := b.Parse("de.xliff", nil); err == nil {
+		t.Error("Parse of unsupported format: got nil error")
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		message string
+		want    string
+	}{
+		{"plain", "plain"},
+		{"Hello {name}!", "Hello World!"},
+		{"{count} items", "3 items"},
+		{"{missing}", "{missing}"},
+		{"{{literal}} }}", "{literal} }"},
+		{"unclosed {name", "unclosed {name"},
+		{"stray } brace", "stray } brace"},
+	}
+
+	args := Args{"name": "World", "count": 3}
+	for _, test := range tests {
+		if got := Format(test.message, args); got != test.want {
+			t.Errorf("Format(%q): got %q, want %q", test.message, got, test.want)
+		}
+	}
+}
+
+func TestNormalizeLanguage(t *testing.T) {
+	tests := map[string]string{
+		"pt_BR.UTF-8":     "pt-BR",
+		"EN-us":           "en-US",
+		"zh-hant-tw":      "zh-Hant-TW",
+		"de_DE@euro":      "de-DE",
+		"C":               "",
+		"sr_RS@latin":     "sr-RS",
+		"es-419":          "es-419",
+		"x-pig-latin":     "x-pig-latin",
+		"":                "",
+		"nb_NO.ISO8859-1": "nb-NO",
+	}
+
+	for s, want := range tests {
+		if got := NormalizeLanguage(s); got != want {
+			t.Errorf("NormalizeLanguage(%q): got %q, want %q", s, got, want)
+		}
+	}
+}
+
+func TestIsRightToLeft(t *testing.T) {
+	tests := map[string]bool{
+		"ar":      true,
+		"ar-EG":   true,
+		"he_IL":   true,
+		"fa-IR":   true,
+		"pa-Arab": true,
+		"ku-Latn": false,
+		"az-Cyrl": false,
+		"en-US":   false,
+		"de":      false,
+		"":        false,
+	}
+
+	for s, want := range tests {
+		if got := IsRightToLeft(s); got != want {
+			t.Errorf("IsRightToLeft(%q): got %v, want %v", s, got, want)
+		}
+	}
+}
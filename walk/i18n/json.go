@@ -0,0 +1,93 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ParseJSON parses a JSON catalog, which looks like this:
+//
+//	{
+//		"language": "de",
+//		"messages": {
+//			"Open": "Öffnen",
+//			"{n} files": {"one": "{n} Datei", "other": "{n} Dateien"}
+//		},
+//		"contexts": {
+//			"menu": {"Open": "Ö&ffnen"}
+//		}
+//	}
+//
+// Messages with plural forms map the CLDR plural categories of the language
+// to translations; the other category is required. If there is no language
+// member, language is used.
+func ParseJSON(data []byte, language string) (*Catalog, error) {
+	var doc struct {
+		Language string                                `json:"language"`
+		Messages map[string]json.RawMessage            `json:"messages"`
+		Contexts map[string]map[string]json.RawMessage `json:"contexts"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("i18n: invalid JSON catalog: %v", err)
+	}
+
+	if doc.Language != "" {
+		language = doc.Language
+	}
+
+	c := NewCatalog(language)
+	categories := PluralCategories(c.language)
+
+	add := func(context string, messages map[string]json.RawMessage) error {
+		for id, raw := range messages {
+			var translation string
+			if err := json.Unmarshal(raw, &translation); err == nil {
+				c.Add(context, id, translation)
+				continue
+			}
+
+			var forms map[string]string
+			if err := json.Unmarshal(raw, &forms); err != nil {
+				return fmt.Errorf("i18n: message '%s' is neither a string nor plural forms", id)
+			}
+
+			other, ok := forms["other"]
+			if !ok {
+				return fmt.Errorf("i18n: message '%s' lacks the plural form 'other'", id)
+			}
+			for name := range forms {
+				if _, err := ParsePlural(name); err != nil {
+					return fmt.Errorf("i18n: message '%s': %v", id, err)
+				}
+			}
+
+			translations := make([]string, len(categories))
+			for i, category := range categories {
+				if t, ok := forms[category.String()]; ok {
+					translations[i] = t
+				} else {
+					translations[i] = other
+				}
+			}
+
+			c.Add(context, id, translations...)
+		}
+
+		return nil
+	}
+
+	if err := add("", doc.Messages); err != nil {
+		return nil, err
+	}
+	for context, messages := range doc.Contexts {
+		if err := add(context, messages); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
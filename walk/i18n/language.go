@@ -0,0 +1,95 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"strings"
+)
+
+// NormalizeLanguage returns the BCP 47 form of the language tag or POSIX
+// locale name s, for example "pt-BR" for "pt_BR.UTF-8".
+func NormalizeLanguage(s string) string {
+	if i := strings.IndexAny(s, ".@"); i >= 0 {
+		s = s[:i]
+	}
+	if s == "C" || s == "POSIX" {
+		return ""
+	}
+
+	parts := strings.FieldsFunc(s, func(r rune) bool { return r == '-' || r == '_' })
+	for i, part := range parts {
+		switch {
+		case i == 0:
+			parts[i] = strings.ToLower(part)
+
+		case len(part) == 2:
+			// Region
+			parts[i] = strings.ToUpper(part)
+
+		case len(part) == 4:
+			// Script
+			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+
+		default:
+			parts[i] = strings.ToLower(part)
+		}
+	}
+
+	return strings.Join(parts, "-")
+}
+
+// BaseLanguage returns the language subtag of the language tag s, for example
+// "pt" for "pt-BR".
+func BaseLanguage(s string) string {
+	s = NormalizeLanguage(s)
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		return s[:i]
+	}
+
+	return s
+}
+
+var rightToLeftLanguages = map[string]bool{
+	"ar": true, "arc": true, "ckb": true, "dv": true, "fa": true, "he": true,
+	"iw": true, "ks": true, "ps": true, "sd": true, "syr": true, "ug": true,
+	"ur": true, "yi": true,
+}
+
+var rightToLeftScripts = map[string]bool{
+	"Adlm": true, "Arab": true, "Hebr": true, "Nkoo": true, "Rohg": true,
+	"Syrc": true, "Thaa": true,
+}
+
+// IsRightToLeft returns whether the language tag s denotes a language that is
+// written from right to left, like Arabic or Hebrew. An explicit script
+// subtag takes precedence, so "pa-Arab" is right to left and "ku-Latn" is not.
+func IsRightToLeft(s string) bool {
+	s = NormalizeLanguage(s)
+
+	parts := strings.Split(s, "-")
+	if len(parts) > 1 && len(parts[1]) == 4 {
+		return rightToLeftScripts[parts[1]]
+	}
+
+	return rightToLeftLanguages[parts[0]]
+}
+
+// SystemLanguages returns the preferred user interface languages of the
+// operating system, most preferred first. On Windows these are the user's
+// preferred UI languages, elsewhere they are taken from the LANGUAGE,
+// LC_ALL, LC_MESSAGES and LANG environment variables.
+func SystemLanguages() []string {
+	var languages []string
+	seen := make(map[string]bool)
+
+	for _, language := range systemLanguages() {
+		if language = NormalizeLanguage(language); language != "" && !seen[language] {
+			seen[language] = true
+			languages = append(languages, language)
+		}
+	}
+
+	return languages
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// ParseMO parses a compiled gettext .mo file, see ParsePO.
+func ParseMO(data []byte, language string) (*Catalog, error) {
+	if len(data) < 28 {
+		return nil, fmt.Errorf("i18n: .mo file too short")
+	}
+
+	var order binary.ByteOrder
+	switch magic := binary.LittleEndian.Uint32(data); magic {
+	case 0x950412de:
+		order = binary.LittleEndian
+
+	case 0xde120495:
+		order = binary.BigEndian
+
+	default:
+		return nil, fmt.Errorf("i18n: invalid .mo magic number")
+	}
+
+	if revision := order.Uint32(data[4:]) >> 16; revision > 1 {
+		return nil, fmt.Errorf("i18n: unsupported .mo revision %d", revision)
+	}
+
+	count := order.Uint32(data[8:])
+	idTable := order.Uint32(data[12:])
+	translationTable := order.Uint32(data[16:])
+
+	// Both tables hold count entries of 8 bytes each, which must fit into
+	// the data before count can be trusted.
+	for _, table := range []uint32{idTable, translationTable} {
+		if uint64(table)+uint64(count)*8 > uint64(len(data)) {
+			return nil, fmt.Errorf("i18n: .mo string table out of bounds")
+		}
+	}
+
+	stringAt := func(table, i uint32) (string, error) {
+		offset := uint64(table) + uint64(i)*8
+		if offset+8 > uint64(len(data)) {
+			return "", fmt.Errorf("i18n: .mo string table out of bounds")
+		}
+
+		length := uint64(order.Uint32(data[offset:]))
+		start := uint64(order.Uint32(data[offset+4:]))
+		if start+length > uint64(len(data)) {
+			return "", fmt.Errorf("i18n: .mo string out of bounds")
+		}
+
+		return string(data[start : start+length]), nil
+	}
+
+	type moEntry struct {
+		id, translation string
+	}
+
+	entries := make([]moEntry, 0, count)
+	var header string
+
+	for i := uint32(0); i < count; i++ {
+		id, err := stringAt(idTable, i)
+		if err != nil {
+			return nil, err
+		}
+		translation, err := stringAt(translationTable, i)
+		if err != nil {
+			return nil, err
+		}
+
+		if id == "" {
+			header = translation
+			continue
+		}
+
+		entries = append(entries, moEntry{id, translation})
+	}
+
+	c, err := newCatalogWithHeader(header, language)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range entries {
+		// The id holds the context, if any, and the plural id, if any, and
+		// the translation the plural forms, separated by nuls.
+		id := e.id
+		var context string
+		if i := strings.Index(id, contextSeparator); i >= 0 {
+			context, id = id[:i], id[i+1:]
+		}
+		if i := strings.IndexByte(id, 0); i >= 0 {
+			id = id[:i]
+		}
+
+		c.Add(context, id, strings.Split(e.translation, "\x00")...)
+	}
+
+	return c, nil
+}
@@ -0,0 +1,355 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Plural is a CLDR plural category.
+type Plural int
+
+const (
+	Zero Plural = iota
+	One
+	Two
+	Few
+	Many
+	Other
+)
+
+var pluralNames = [...]string{"zero", "one", "two", "few", "many", "other"}
+
+func (p Plural) String() string {
+	if p < Zero || p > Other {
+		return fmt.Sprintf("Plural(%d)", int(p))
+	}
+
+	return pluralNames[p]
+}
+
+// ParsePlural returns the category named s, like "one" or "other".
+func ParsePlural(s string) (Plural, error) {
+	for i, name := range pluralNames {
+		if name == s {
+			return Plural(i), nil
+		}
+	}
+
+	return Other, fmt.Errorf("i18n: invalid plural category '%s'", s)
+}
+
+// Operands are the operands of CLDR plural rules, derived from the decimal
+// representation of a number.
+type Operands struct {
+	N float64 // absolute value
+	I int64   // integer digits
+	V int     // number of visible fraction digits, with trailing zeros
+	W int     // number of visible fraction digits, without trailing zeros
+	F int64   // visible fraction digits, with trailing zeros
+	T int64   // visible fraction digits, without trailing zeros
+}
+
+// IntOperands returns the operands of the integer n.
+func IntOperands(n int64) Operands {
+	if n < 0 {
+		n = -n
+	}
+
+	return Operands{N: float64(n), I: n}
+}
+
+// ParseOperands returns the operands of the decimal number s, like "1.50",
+// whose visible fraction digits affect the plural category in some
+// languages.
+func ParseOperands(s string) (Operands, error) {
+	s = strings.TrimPrefix(s, "-")
+
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return Operands{}, fmt.Errorf("i18n: invalid number '%s'", s)
+	}
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+
+	ops := Operands{N: n}
+	if ops.I, err = strconv.ParseInt(intPart, 10, 64); err != nil {
+		return Operands{}, fmt.Errorf("i18n: invalid number '%s'", s)
+	}
+
+	if fracPart != "" {
+		ops.V = len(fracPart)
+		if ops.F, err = strconv.ParseInt(fracPart, 10, 64); err != nil {
+			return Operands{}, fmt.Errorf("i18n: invalid number '%s'", s)
+		}
+
+		trimmed := strings.TrimRight(fracPart, "0")
+		ops.W = len(trimmed)
+		if trimmed != "" {
+			ops.T, _ = strconv.ParseInt(trimmed, 10, 64)
+		}
+	}
+
+	return ops, nil
+}
+
+func inRange(n, from, to int64) bool {
+	return n >= from && n <= to
+}
+
+type pluralRule struct {
+	categories []Plural
+	category   func(o Operands) Plural
+}
+
+var (
+	ruleOther = pluralRule{
+		[]Plural{Other},
+		func(o Operands) Plural { return Other },
+	}
+
+	// English, German and most other Germanic languages.
+	ruleOneInteger = pluralRule{
+		[]Plural{One, Other},
+		func(o Operands) Plural {
+			if o.I == 1 && o.V == 0 {
+				return One
+			}
+			return Other
+		},
+	}
+
+	// French and Portuguese.
+	ruleOneZeroOrOne = pluralRule{
+		[]Plural{One, Many, Other},
+		func(o Operands) Plural {
+			switch {
+			case o.I == 0 || o.I == 1:
+				return One
+
+			case o.V == 0 && o.I != 0 && o.I%1000000 == 0:
+				return Many
+			}
+			return Other
+		},
+	}
+
+	// Russian, Ukrainian and Belarusian.
+	ruleEastSlavic = pluralRule{
+		[]Plural{One, Few, Many, Other},
+		func(o Operands) Plural {
+			if o.V != 0 {
+				return Other
+			}
+
+			switch i10, i100 := o.I%10, o.I%100; {
+			case i10 == 1 && i100 != 11:
+				return One
+
+			case inRange(i10, 2, 4) && !inRange(i100, 12, 14):
+				return Few
+			}
+			return Many
+		},
+	}
+
+	ruleBosnianCroatianSerbian = pluralRule{
+		[]Plural{One, Few, Other},
+		func(o Operands) Plural {
+			i10, i100, f10, f100 := o.I%10, o.I%100, o.F%10, o.F%100
+
+			switch {
+			case o.V == 0 && i10 == 1 && i100 != 11, f10 == 1 && f100 != 11:
+				return One
+
+			case o.V == 0 && inRange(i10, 2, 4) && !inRange(i100, 12, 14),
+				inRange(f10, 2, 4) && !inRange(f100, 12, 14):
+				return Few
+			}
+			return Other
+		},
+	}
+
+	rulePolish = pluralRule{
+		[]Plural{One, Few, Many, Other},
+		func(o Operands) Plural {
+			if o.V != 0 {
+				return Other
+			}
+
+			switch i10, i100 := o.I%10, o.I%100; {
+			case o.I == 1:
+				return One
+
+			case inRange(i10, 2, 4) && !inRange(i100, 12, 14):
+				return Few
+			}
+			return Many
+		},
+	}
+
+	// Czech and Slovak.
+	ruleCzech = pluralRule{
+		[]Plural{One, Few, Many, Other},
+		func(o Operands) Plural {
+			switch {
+			case o.V != 0:
+				return Many
+
+			case o.I == 1:
+				return One
+
+			case inRange(o.I, 2, 4):
+				return Few
+			}
+			return Other
+		},
+	}
+
+	ruleArabic = pluralRule{
+		[]Plural{Zero, One, Two, Few, Many, Other},
+		func(o Operands) Plural {
+			if o.V != 0 || o.N != float64(o.I) {
+				return Other
+			}
+
+			switch n100 := o.I % 100; {
+			case o.I == 0:
+				return Zero
+
+			case o.I == 1:
+				return One
+
+			case o.I == 2:
+				return Two
+
+			case inRange(n100, 3, 10):
+				return Few
+
+			case inRange(n100, 11, 99):
+				return Many
+			}
+			return Other
+		},
+	}
+
+	ruleHebrew = pluralRule{
+		[]Plural{One, Two, Other},
+		func(o Operands) Plural {
+			switch {
+			case o.I == 1 && o.V == 0, o.I == 0 && o.V != 0:
+				return One
+
+			case o.I == 2 && o.V == 0:
+				return Two
+			}
+			return Other
+		},
+	}
+
+	ruleLithuanian = pluralRule{
+		[]Plural{One, Few, Many, Other},
+		func(o Operands) Plural {
+			switch n10, n100 := o.I%10, o.I%100; {
+			case o.F != 0:
+				return Many
+
+			case n10 == 1 && !inRange(n100, 11, 19):
+				return One
+
+			case inRange(n10, 2, 9) && !inRange(n100, 11, 19):
+				return Few
+			}
+			return Other
+		},
+	}
+
+	ruleRomanian = pluralRule{
+		[]Plural{One, Few, Other},
+		func(o Operands) Plural {
+			switch {
+			case o.I == 1 && o.V == 0:
+				return One
+
+			case o.V != 0, o.I == 0, o.I != 1 && inRange(o.I%100, 1, 19):
+				return Few
+			}
+			return Other
+		},
+	}
+
+	ruleSlovenian = pluralRule{
+		[]Plural{One, Two, Few, Other},
+		func(o Operands) Plural {
+			if o.V != 0 {
+				return Few
+			}
+
+			switch o.I % 100 {
+			case 1:
+				return One
+
+			case 2:
+				return Two
+
+			case 3, 4:
+				return Few
+			}
+			return Other
+		},
+	}
+)
+
+var language2PluralRule = map[string]*pluralRule{
+	"ar": &ruleArabic,
+	"be": &ruleEastSlavic,
+	"bs": &ruleBosnianCroatianSerbian,
+	"cs": &ruleCzech,
+	"fr": &ruleOneZeroOrOne,
+	"he": &ruleHebrew,
+	"hr": &ruleBosnianCroatianSerbian,
+	"id": &ruleOther,
+	"ja": &ruleOther,
+	"km": &ruleOther,
+	"ko": &ruleOther,
+	"lt": &ruleLithuanian,
+	"ms": &ruleOther,
+	"my": &ruleOther,
+	"pl": &rulePolish,
+	"pt": &ruleOneZeroOrOne,
+	"ro": &ruleRomanian,
+	"ru": &ruleEastSlavic,
+	"sk": &ruleCzech,
+	"sl": &ruleSlovenian,
+	"sr": &ruleBosnianCroatianSerbian,
+	"th": &ruleOther,
+	"uk": &ruleEastSlavic,
+	"vi": &ruleOther,
+	"zh": &ruleOther,
+}
+
+func pluralRuleFor(language string) *pluralRule {
+	if rule, ok := language2PluralRule[BaseLanguage(language)]; ok {
+		return rule
+	}
+
+	// The one/other rule of English is shared by most other languages.
+	return &ruleOneInteger
+}
+
+// PluralCategory returns the CLDR plural category of a number with the
+// operands ops in language. Languages without a specific rule use the rule
+// of English.
+func PluralCategory(language string, ops Operands) Plural {
+	return pluralRuleFor(language).category(ops)
+}
+
+// PluralCategories returns the plural categories used by language, in the
+// order of Zero to Other.
+func PluralCategories(language string) []Plural {
+	return pluralRuleFor(language).categories
+}
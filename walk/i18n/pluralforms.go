@@ -0,0 +1,247 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parsePluralForms parses the value of the Plural-Forms header of a gettext
+// catalog, like "nplurals=2; plural=(n != 1);", and returns the plural index
+// function it defines.
+func parsePluralForms(s string) (func(n int64) int, error) {
+	var nplurals int
+	var expr string
+
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+
+		switch strings.TrimSpace(name) {
+		case "nplurals":
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("i18n: invalid nplurals in '%s'", s)
+			}
+			nplurals = n
+
+		case "plural":
+			expr = value
+		}
+	}
+
+	if nplurals == 0 || expr == "" {
+		return nil, fmt.Errorf("i18n: invalid Plural-Forms '%s'", s)
+	}
+
+	p := &pluralParser{s: expr}
+	node, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpace(); p.pos < len(p.s) {
+		return nil, fmt.Errorf("i18n: unexpected '%s' in plural expression", p.s[p.pos:])
+	}
+
+	return func(n int64) int {
+		i := node(n)
+		if i < 0 || i >= int64(nplurals) {
+			return nplurals - 1
+		}
+
+		return int(i)
+	}, nil
+}
+
+type pluralNode func(n int64) int64
+
+func boolValue(b bool) int64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+// pluralParser parses the C expressions of Plural-Forms headers, which are
+// made of n, integer constants, parentheses, the ternary operator and the
+// logical, comparison and arithmetic operators.
+type pluralParser struct {
+	s   string
+	pos int
+}
+
+func (p *pluralParser) skipSpace() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// accept consumes op if it is next.
+func (p *pluralParser) accept(op string) bool {
+	p.skipSpace()
+	if strings.HasPrefix(p.s[p.pos:], op) {
+		p.pos += len(op)
+		return true
+	}
+
+	return false
+}
+
+func (p *pluralParser) parseTernary() (pluralNode, error) {
+	cond, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept("?") {
+		return cond, nil
+	}
+
+	then, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept(":") {
+		return nil, fmt.Errorf("i18n: missing ':' in plural expression")
+	}
+	otherwise, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(n int64) int64 {
+		if cond(n) != 0 {
+			return then(n)
+		}
+		return otherwise(n)
+	}, nil
+}
+
+// pluralOperators are the binary operators by precedence, lowest first. The
+// longer operators come first within a level, so "<=" is not taken for "<".
+var pluralOperators = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<=", ">=", "<", ">"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *pluralParser) parseBinary(level int) (pluralNode, error) {
+	if level == len(pluralOperators) {
+		return p.parseUnary()
+	}
+
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		var op string
+		for _, candidate := range pluralOperators[level] {
+			if p.accept(candidate) {
+				op = candidate
+				break
+			}
+		}
+		if op == "" {
+			return left, nil
+		}
+
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		left = binaryNode(op, left, right)
+	}
+}
+
+func binaryNode(op string, l, r pluralNode) pluralNode {
+	switch op {
+	case "||":
+		return func(n int64) int64 { return boolValue(l(n) != 0 || r(n) != 0) }
+	case "&&":
+		return func(n int64) int64 { return boolValue(l(n) != 0 && r(n) != 0) }
+	case "==":
+		return func(n int64) int64 { return boolValue(l(n) == r(n)) }
+	case "!=":
+		return func(n int64) int64 { return boolValue(l(n) != r(n)) }
+	case "<=":
+		return func(n int64) int64 { return boolValue(l(n) <= r(n)) }
+	case ">=":
+		return func(n int64) int64 { return boolValue(l(n) >= r(n)) }
+	case "<":
+		return func(n int64) int64 { return boolValue(l(n) < r(n)) }
+	case ">":
+		return func(n int64) int64 { return boolValue(l(n) > r(n)) }
+	case "+":
+		return func(n int64) int64 { return l(n) + r(n) }
+	case "-":
+		return func(n int64) int64 { return l(n) - r(n) }
+	case "*":
+		return func(n int64) int64 { return l(n) * r(n) }
+	}
+
+	// Division by zero yields 0 rather than a panic.
+	div := func(n int64) (int64, int64) {
+		d := r(n)
+		if d == 0 {
+			return 0, 1
+		}
+		return l(n), d
+	}
+	if op == "/" {
+		return func(n int64) int64 { a, b := div(n); return a / b }
+	}
+	return func(n int64) int64 { a, b := div(n); return a % b }
+}
+
+func (p *pluralParser) parseUnary() (pluralNode, error) {
+	if p.accept("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(n int64) int64 { return boolValue(operand(n) == 0) }, nil
+	}
+
+	if p.accept("(") {
+		node, err := p.parseTernary()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, fmt.Errorf("i18n: missing ')' in plural expression")
+		}
+		return node, nil
+	}
+
+	if p.accept("n") {
+		return func(n int64) int64 { return n }, nil
+	}
+
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+	if start == p.pos {
+		return nil, fmt.Errorf("i18n: unexpected '%s' in plural expression", p.s[start:])
+	}
+
+	value, err := strconv.ParseInt(p.s[start:p.pos], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("i18n: invalid number in plural expression: %v", err)
+	}
+
+	return func(int64) int64 { return value }, nil
+}
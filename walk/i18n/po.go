@@ -0,0 +1,176 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package i18n
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// poEntry is an entry of a .po file under construction.
+type poEntry struct {
+	context      string
+	id           string
+	idPlural     string
+	translations []string
+	fuzzy        bool
+}
+
+// ParsePO parses a gettext .po file. The language and plural forms of the
+// catalog are taken from the Language and Plural-Forms headers. If there is
+// no Language header, language is used. Fuzzy entries are ignored, like
+// gettext does.
+func ParsePO(r io.Reader, language string) (*Catalog, error) {
+	var entries []*poEntry
+	var entry *poEntry
+	var fuzzy bool
+
+	// target is the string that continuation lines are appended to.
+	var target *string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if lineNo == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+
+		if line == "" {
+			target = nil
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			if strings.HasPrefix(line, "#,") && strings.Contains(line, "fuzzy") {
+				fuzzy = true
+			}
+			target = nil
+			continue
+		}
+
+		if line[0] == '"' {
+			if target == nil {
+				return nil, fmt.Errorf("i18n: line %d: unexpected string", lineNo)
+			}
+
+			s, err := strconv.Unquote(line)
+			if err != nil {
+				return nil, fmt.Errorf("i18n: line %d: invalid string: %v", lineNo, err)
+			}
+			*target += s
+			continue
+		}
+
+		keyword, value, _ := strings.Cut(line, " ")
+		s, err := strconv.Unquote(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("i18n: line %d: invalid string: %v", lineNo, err)
+		}
+
+		// msgctxt or msgid start a new entry, unless msgid follows msgctxt.
+		startsEntry := keyword == "msgctxt" || (keyword == "msgid" && (entry == nil || entry.id != "" || entry.translations != nil))
+		if startsEntry {
+			entry = &poEntry{fuzzy: fuzzy}
+			entries = append(entries, entry)
+			fuzzy = false
+		}
+		if entry == nil {
+			return nil, fmt.Errorf("i18n: line %d: %s without msgid", lineNo, keyword)
+		}
+
+		switch {
+		case keyword == "msgctxt":
+			entry.context = s
+			target = &entry.context
+
+		case keyword == "msgid":
+			entry.id = s
+			target = &entry.id
+
+		case keyword == "msgid_plural":
+			entry.idPlural = s
+			target = &entry.idPlural
+
+		case keyword == "msgstr":
+			entry.translations = append(entry.translations, s)
+			target = &entry.translations[len(entry.translations)-1]
+
+		case strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]"):
+			index, err := strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+			if err != nil || index != len(entry.translations) {
+				return nil, fmt.Errorf("i18n: line %d: invalid %s", lineNo, keyword)
+			}
+			entry.translations = append(entry.translations, s)
+			target = &entry.translations[index]
+
+		default:
+			return nil, fmt.Errorf("i18n: line %d: unknown keyword '%s'", lineNo, keyword)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	var header string
+	for _, e := range entries {
+		if e.id == "" && e.context == "" && len(e.translations) > 0 {
+			header = e.translations[0]
+		}
+	}
+
+	c, err := newCatalogWithHeader(header, language)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range entries {
+		if !e.fuzzy {
+			c.Add(e.context, e.id, e.translations...)
+		}
+	}
+
+	return c, nil
+}
+
+// newCatalogWithHeader returns a new catalog configured by the header of a
+// gettext catalog.
+func newCatalogWithHeader(header, language string) (*Catalog, error) {
+	var pluralForms string
+
+	for _, line := range strings.Split(header, "\n") {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		switch strings.TrimSpace(name) {
+		case "Language":
+			if value != "" {
+				language = value
+			}
+
+		case "Plural-Forms":
+			pluralForms = value
+		}
+	}
+
+	c := NewCatalog(language)
+
+	if pluralForms != "" {
+		pluralIndex, err := parsePluralForms(pluralForms)
+		if err != nil {
+			return nil, err
+		}
+		c.pluralIndex = pluralIndex
+	}
+
+	return c, nil
+}
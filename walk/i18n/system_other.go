@@ -0,0 +1,30 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !windows
+// +build !windows
+
+package i18n
+
+import (
+	"os"
+	"strings"
+)
+
+func systemLanguages() []string {
+	// LANGUAGE is a colon separated list of languages, which takes precedence
+	// over the locale.
+	var languages []string
+	if language := os.Getenv("LANGUAGE"); language != "" {
+		languages = strings.Split(language, ":")
+	}
+
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if locale := os.Getenv(name); locale != "" {
+			return append(languages, locale)
+		}
+	}
+
+	return languages
+}
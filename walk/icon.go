@@ -10,12 +10,14 @@ package walk
 import (
 	"fmt"
 	"image"
+	"io/fs"
 	"path/filepath"
 	"syscall"
 	"unsafe"
 
 	"golang.org/x/sys/windows"
 
+	"github.com/xackery/wlk/walk/resfs"
 	"github.com/xackery/wlk/win"
 )
 
@@ -25,6 +27,7 @@ type Icon struct {
 	filePath  string
 	index     int
 	res       *uint16
+	data      []byte // contents of an .ico file, see NewIconFromFS
 	dpi2hIcon map[int]win.HICON
 	size96dpi Size
 	isStock   bool
@@ -96,6 +99,30 @@ func NewIconFromFileWithSize(filePath string, size Size) (*Icon, error) {
 	return checkNewIcon(&Icon{filePath: filePath, size96dpi: size})
 }
 
+// NewIconFromFS returns a new Icon of default size, using the .ico file name in fsys, which
+// may for example be an embed.FS.
+func NewIconFromFS(fsys fs.FS, name string) (*Icon, error) {
+	return NewIconFromFSWithSize(fsys, name, Size{})
+}
+
+// NewIconFromFSWithSize returns a new Icon of size size, using the .ico file name in fsys.
+func NewIconFromFSWithSize(fsys fs.FS, name string, size Size) (*Icon, error) {
+	data, err := readFS(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, cursor, err := resfs.ParseIconFile(data); err != nil || cursor {
+		return nil, newError(fmt.Sprintf("'%s' is not an icon file", name))
+	}
+
+	if size.Width == 0 || size.Height == 0 {
+		size = DefaultSmallIconSize()
+	}
+
+	return checkNewIcon(&Icon{data: data, size96dpi: size})
+}
+
 // NewIconFromResource returns a new Icon of default size, using the specified icon resource.
 func NewIconFromResource(name string) (*Icon, error) {
 	return NewIconFromResourceWithSize(name, Size{})
@@ -249,6 +276,24 @@ func (i *Icon) handleForDPIWithError(dpi int) (win.HICON, error) {
 		return handle, nil
 	}
 
+	var size Size
+	if i.size96dpi.Width == 0 || i.size96dpi.Height == 0 {
+		size = SizeFrom96DPI(DefaultSmallIconSize(), dpi)
+	} else {
+		size = SizeFrom96DPI(i.size96dpi, dpi)
+	}
+
+	if i.data != nil {
+		hIcon, err := hIconFromIconFile(i.data, size, true)
+		if err != nil {
+			return 0, err
+		}
+
+		i.dpi2hIcon[dpi] = hIcon
+
+		return hIcon, nil
+	}
+
 	var hInst win.HINSTANCE
 	var name *uint16
 	if i.filePath != "" {
@@ -268,13 +313,6 @@ func (i *Icon) handleForDPIWithError(dpi int) (win.HICON, error) {
 		name = i.res
 	}
 
-	var size Size
-	if i.size96dpi.Width == 0 || i.size96dpi.Height == 0 {
-		size = SizeFrom96DPI(DefaultSmallIconSize(), dpi)
-	} else {
-		size = SizeFrom96DPI(i.size96dpi, dpi)
-	}
-
 	var hIcon win.HICON
 
 	if i.hasIndex {
@@ -305,6 +343,33 @@ func (i *Icon) handleForDPIWithError(dpi int) (win.HICON, error) {
 	return hIcon, nil
 }
 
+// hIconFromIconFile creates an icon or cursor of size from the image in the .ico or .cur file
+// data that is best suited for that size.
+func hIconFromIconFile(data []byte, size Size, fIcon bool) (win.HICON, error) {
+	entries, cursor, err := resfs.ParseIconFile(data)
+	if err != nil {
+		return 0, wrapError(err)
+	}
+
+	e := entries[resfs.SelectIconEntry(entries, size.Width)]
+	bits := e.Data
+	if cursor {
+		bits = resfs.CursorResource(e)
+	}
+
+	var fIconArg win.BOOL
+	if fIcon {
+		fIconArg = win.TRUE
+	}
+
+	hIcon := win.CreateIconFromResourceEx(&bits[0], uint32(len(bits)), fIconArg, 0x00030000, int32(size.Width), int32(size.Height), win.LR_DEFAULTCOLOR)
+	if hIcon == 0 {
+		return 0, lastError("CreateIconFromResourceEx")
+	}
+
+	return hIcon, nil
+}
+
 // Dispose releases the operating system resources associated with the Icon.
 func (i *Icon) Dispose() {
 	if i.isStock || len(i.dpi2hIcon) == 0 {
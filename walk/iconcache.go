@@ -18,6 +18,7 @@ func init() {
 type IconCache struct {
 	imageAndDPI2Bitmap map[imageAndDPI]*Bitmap
 	imageAndDPI2Icon   map[imageAndDPI]*Icon
+	themed             map[imageAndDPI]bool // keys of themed image variants
 }
 
 type imageAndDPI struct {
@@ -29,6 +30,7 @@ func NewIconCache() *IconCache {
 	return &IconCache{
 		imageAndDPI2Bitmap: make(map[imageAndDPI]*Bitmap),
 		imageAndDPI2Icon:   make(map[imageAndDPI]*Icon),
+		themed:             make(map[imageAndDPI]bool),
 	}
 }
 
@@ -41,6 +43,28 @@ func (ic *IconCache) Clear() {
 		ico.Dispose()
 		delete(ic.imageAndDPI2Icon, key)
 	}
+	for key := range ic.themed {
+		delete(ic.themed, key)
+	}
+}
+
+// clearThemed removes the bitmaps and icons of the variants of themed images,
+// which are stale when the user switches between light and dark mode.
+func (ic *IconCache) clearThemed() {
+	for key := range ic.themed {
+		if bmp, ok := ic.imageAndDPI2Bitmap[key]; ok {
+			bmp.Dispose()
+			delete(ic.imageAndDPI2Bitmap, key)
+		}
+		if ico, ok := ic.imageAndDPI2Icon[key]; ok {
+			// An Icon variant is cached as itself and owned by its image.
+			if ico != key.image {
+				ico.Dispose()
+			}
+			delete(ic.imageAndDPI2Icon, key)
+		}
+		delete(ic.themed, key)
+	}
 }
 
 func (ic *IconCache) Dispose() {
@@ -48,6 +72,12 @@ func (ic *IconCache) Dispose() {
 }
 
 func (ic *IconCache) Bitmap(image Image, dpi int) (*Bitmap, error) {
+	themed := false
+	if ti, ok := image.(themedImage); ok && ti.currentImage() != nil {
+		image = ti.currentImage()
+		themed = true
+	}
+
 	key := imageAndDPI{image, dpi}
 
 	if bmp, ok := ic.imageAndDPI2Bitmap[key]; ok {
@@ -62,11 +92,20 @@ func (ic *IconCache) Bitmap(image Image, dpi int) (*Bitmap, error) {
 	}
 
 	ic.imageAndDPI2Bitmap[key] = bmp
+	if themed {
+		ic.themed[key] = true
+	}
 
 	return bmp, nil
 }
 
 func (ic *IconCache) Icon(image Image, dpi int) (*Icon, error) {
+	themed := false
+	if ti, ok := image.(themedImage); ok && ti.currentImage() != nil {
+		image = ti.currentImage()
+		themed = true
+	}
+
 	key := imageAndDPI{image, dpi}
 
 	if ico, ok := ic.imageAndDPI2Icon[key]; ok {
@@ -76,6 +115,9 @@ func (ic *IconCache) Icon(image Image, dpi int) (*Icon, error) {
 	if ico, ok := image.(*Icon); ok {
 		if ico.handleForDPI(dpi) != 0 {
 			ic.imageAndDPI2Icon[key] = ico
+			if themed {
+				ic.themed[key] = true
+			}
 			return ico, nil
 		}
 	}
@@ -86,6 +128,9 @@ func (ic *IconCache) Icon(image Image, dpi int) (*Icon, error) {
 	}
 
 	ic.imageAndDPI2Icon[key] = ico
+	if themed {
+		ic.themed[key] = true
+	}
 
 	return ico, nil
 }
@@ -0,0 +1,64 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"testing"
+
+	"github.com/xackery/wlk/common"
+	"github.com/xackery/wlk/win"
+)
+
+func TestIconCacheClearThemed(t *testing.T) {
+	defer func() {
+		common.SetDarkModeAllowed(false)
+		common.SetDarkModeChecked(false)
+		common.SetDarkMode(false)
+	}()
+	common.SetDarkModeAllowed(true)
+	common.SetDarkModeChecked(true)
+	common.SetDarkMode(false)
+
+	// The handles are never used, as the icons are already cached for the DPI.
+	light := &Icon{dpi2hIcon: map[int]win.HICON{96: 1}, size96dpi: Size{16, 16}}
+	dark := &Icon{dpi2hIcon: map[int]win.HICON{96: 2}, size96dpi: Size{16, 16}}
+	plain := &Icon{dpi2hIcon: map[int]win.HICON{96: 3}, size96dpi: Size{16, 16}}
+
+	set := NewIconSet()
+	set.Add("save", light)
+	set.AddDark("save", dark)
+	themed, err := set.Image("save")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ic := NewIconCache()
+	if ico, err := ic.Icon(themed, 96); err != nil || ico != light {
+		t.Fatalf("Icon: got %p, %v, want the light variant", ico, err)
+	}
+	if _, err := ic.Icon(plain, 96); err != nil {
+		t.Fatal(err)
+	}
+
+	common.SetDarkMode(true)
+	ic.clearThemed()
+
+	if _, ok := ic.imageAndDPI2Icon[imageAndDPI{light, 96}]; ok {
+		t.Error("the light variant is still cached")
+	}
+	if _, ok := ic.imageAndDPI2Icon[imageAndDPI{plain, 96}]; !ok {
+		t.Error("an icon that is not themed was dropped")
+	}
+	if len(light.dpi2hIcon) == 0 {
+		t.Error("the light variant, which the icon set owns, was disposed")
+	}
+
+	if ico, err := ic.Icon(themed, 96); err != nil || ico != dark {
+		t.Fatalf("Icon: got %p, %v, want the dark variant", ico, err)
+	}
+}
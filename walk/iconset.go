@@ -0,0 +1,415 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"github.com/xackery/wlk/walk/resfs"
+	"github.com/xackery/wlk/win"
+)
+
+// IconSet maps semantic icon names, like "open", "save" or "warning", to
+// images. Each icon may have a variant for dark mode, which is drawn instead
+// of the regular image while IsDarkMode returns true.
+//
+// Icon sets are registered with RegisterIconSet. Strings passed to ImageFrom
+// and IconFrom, and therefore for example Icon: "save" in package cpl, that
+// name neither a resource nor a file are looked up in the registered sets
+// first and in DefaultIconSet next.
+type IconSet struct {
+	icons map[string]*iconSetEntry
+}
+
+type iconSetEntry struct {
+	light, dark iconSource
+	image       *iconSetImage
+}
+
+// iconSource loads an image on first use.
+type iconSource struct {
+	load func() (Image, error)
+	img  Image
+	err  error
+}
+
+func (src *iconSource) image() (Image, error) {
+	if src.load != nil {
+		src.img, src.err = src.load()
+		src.load = nil
+	}
+
+	return src.img, src.err
+}
+
+// NewIconSet returns a new, empty IconSet.
+func NewIconSet() *IconSet {
+	return &IconSet{icons: make(map[string]*iconSetEntry)}
+}
+
+// NewIconSetFromFS returns a new IconSet holding the images in the directory
+// dir of fsys, which may for example be an embed.FS. Each image is named by
+// its file name without extension, so dir/save.svg provides the icon "save".
+// If there are several files of that name, .svg files are preferred over .ico
+// files, which are preferred over other formats. Images in the subdirectory
+// dark of dir provide the dark mode variants.
+//
+// Raster images may come with DPI variants, see NewBitmapFromFSForDPI.
+// Images are loaded when they are first used.
+func NewIconSetFromFS(fsys fs.FS, dir string) (*IconSet, error) {
+	set := NewIconSet()
+
+	if err := set.addFromFS(fsys, dir, false); err != nil {
+		return nil, err
+	}
+
+	darkDir := path.Join(dir, "dark")
+	if fi, err := fs.Stat(fsys, darkDir); err == nil && fi.IsDir() {
+		if err := set.addFromFS(fsys, darkDir, true); err != nil {
+			return nil, err
+		}
+	}
+
+	return set, nil
+}
+
+func (set *IconSet) addFromFS(fsys fs.FS, dir string, dark bool) error {
+	names, err := resfs.Names(fsys, dir)
+	if err != nil {
+		return err
+	}
+
+	files := make(map[string]string)
+	for _, name := range names {
+		ext := path.Ext(name)
+		iconName := strings.TrimSuffix(name, ext)
+
+		if prev, ok := files[iconName]; ok && iconFileRank(prev) <= iconFileRank(name) {
+			continue
+		}
+		files[iconName] = name
+	}
+
+	for iconName, name := range files {
+		filePath := path.Join(dir, name)
+
+		set.add(iconName, dark, func() (Image, error) {
+			return NewImageFromFS(fsys, filePath)
+		})
+	}
+
+	return nil
+}
+
+// iconFileRank returns the preference of the file name for icon sets, lower is
+// better.
+func iconFileRank(name string) int {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".svg":
+		return 0
+
+	case ".ico":
+		return 1
+	}
+
+	return 2
+}
+
+func (set *IconSet) entry(name string) *iconSetEntry {
+	e := set.icons[name]
+	if e == nil {
+		e = new(iconSetEntry)
+		e.image = &iconSetImage{entry: e}
+		set.icons[name] = e
+	}
+
+	return e
+}
+
+func (set *IconSet) add(name string, dark bool, load func() (Image, error)) {
+	e := set.entry(name)
+	if dark {
+		e.dark = iconSource{load: load}
+	} else {
+		e.light = iconSource{load: load}
+	}
+}
+
+// Add sets the image of the icon name.
+func (set *IconSet) Add(name string, image Image) {
+	set.entry(name).light = iconSource{img: image}
+}
+
+// AddDark sets the dark mode variant of the icon name.
+func (set *IconSet) AddDark(name string, image Image) {
+	set.entry(name).dark = iconSource{img: image}
+}
+
+// AddSVG sets the images of the icon name from SVG documents. dark provides
+// the dark mode variant and may be nil.
+func (set *IconSet) AddSVG(name string, light, dark []byte) error {
+	lightImage, err := NewSVGImageFromBytes(light)
+	if err != nil {
+		return err
+	}
+
+	var darkImage *SVGImage
+	if dark != nil {
+		if darkImage, err = NewSVGImageFromBytes(dark); err != nil {
+			return err
+		}
+	}
+
+	set.Add(name, lightImage)
+	if darkImage != nil {
+		set.AddDark(name, darkImage)
+	}
+
+	return nil
+}
+
+// Has returns whether set provides the icon name.
+func (set *IconSet) Has(name string) bool {
+	e := set.icons[name]
+
+	return e != nil && (e.light.load != nil || e.light.img != nil)
+}
+
+// Names returns the names of the icons in set in lexical order.
+func (set *IconSet) Names() []string {
+	names := make([]string, 0, len(set.icons))
+	for name := range set.icons {
+		if set.Has(name) {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Image returns the icon name. The returned Image switches between the
+// regular and the dark mode variant of the icon when it is drawn. It is owned
+// by set and must not be disposed.
+func (set *IconSet) Image(name string) (Image, error) {
+	if !set.Has(name) {
+		return nil, newError(fmt.Sprintf("icon set has no icon '%s'", name))
+	}
+
+	e := set.icons[name]
+	if _, err := e.light.image(); err != nil {
+		return nil, err
+	}
+
+	return e.image, nil
+}
+
+// iconSetImage is the Image of an icon set entry.
+type iconSetImage struct {
+	entry *iconSetEntry
+}
+
+// currentImage returns the image of the entry that applies to the current
+// mode.
+func (isi *iconSetImage) currentImage() Image {
+	if IsDarkMode() {
+		if img, err := isi.entry.dark.image(); err == nil && img != nil {
+			return img
+		}
+	}
+
+	img, _ := isi.entry.light.image()
+
+	return img
+}
+
+func (isi *iconSetImage) draw(hdc win.HDC, location Point) error {
+	img := isi.currentImage()
+	if img == nil {
+		return newError("icon not available")
+	}
+
+	return img.draw(hdc, location)
+}
+
+func (isi *iconSetImage) drawStretched(hdc win.HDC, bounds Rectangle) error {
+	img := isi.currentImage()
+	if img == nil {
+		return newError("icon not available")
+	}
+
+	return img.drawStretched(hdc, bounds)
+}
+
+// Dispose does nothing, as the images are owned by the icon set.
+func (isi *iconSetImage) Dispose() {
+}
+
+// Size returns the size of the regular variant of the icon in 1/96" units.
+func (isi *iconSetImage) Size() Size {
+	if img, _ := isi.entry.light.image(); img != nil {
+		return img.Size()
+	}
+
+	return Size{}
+}
+
+// themedImage is implemented by images that draw one of several images,
+// depending on the current mode. Caches must key on the current image.
+type themedImage interface {
+	Image
+	currentImage() Image
+}
+
+var (
+	iconSets       []*IconSet
+	defaultIconSet *IconSet
+)
+
+// RegisterIconSet registers set for name lookups. Sets registered later take
+// precedence over sets registered earlier, and all of them take precedence
+// over DefaultIconSet.
+func RegisterIconSet(set *IconSet) {
+	iconSets = append(iconSets, set)
+}
+
+// UnregisterIconSet removes set from name lookups.
+func UnregisterIconSet(set *IconSet) {
+	for i, s := range iconSets {
+		if s == set {
+			iconSets = append(iconSets[:i], iconSets[i+1:]...)
+			return
+		}
+	}
+}
+
+// NamedIcon returns the icon name from the registered icon sets or from
+// DefaultIconSet.
+func NamedIcon(name string) (Image, error) {
+	if set := iconSetFor(name); set != nil {
+		return set.Image(name)
+	}
+
+	return nil, newError(fmt.Sprintf("no icon set provides icon '%s'", name))
+}
+
+func iconSetFor(name string) *IconSet {
+	for i := len(iconSets) - 1; i >= 0; i-- {
+		if iconSets[i].Has(name) {
+			return iconSets[i]
+		}
+	}
+
+	if set := DefaultIconSet(); set.Has(name) {
+		return set
+	}
+
+	return nil
+}
+
+// stockIconSetIcons maps names of DefaultIconSet to IDI_* icons, see stockIcon.
+var stockIconSetIcons = map[string]uintptr{
+	"application": win.IDI_APPLICATION,
+	"error":       win.IDI_ERROR,
+	"information": win.IDI_INFORMATION,
+	"info":        win.IDI_INFORMATION,
+	"question":    win.IDI_QUESTION,
+	"shield":      win.IDI_SHIELD,
+	"warning":     win.IDI_WARNING,
+}
+
+// stockIconSetShellIcons maps names of DefaultIconSet to SIID_* shell stock
+// icons.
+var stockIconSetShellIcons = map[string]int32{
+	"audio":       win.SIID_AUDIOFILES,
+	"computer":    win.SIID_DESKTOPPC,
+	"delete":      win.SIID_DELETE,
+	"document":    win.SIID_DOCNOASSOC,
+	"drive":       win.SIID_DRIVEFIXED,
+	"find":        win.SIID_FIND,
+	"folder":      win.SIID_FOLDER,
+	"folder-open": win.SIID_FOLDEROPEN,
+	"help":        win.SIID_HELP,
+	"image":       win.SIID_IMAGEFILES,
+	"internet":    win.SIID_INTERNET,
+	"key":         win.SIID_KEY,
+	"link":        win.SIID_LINK,
+	"lock":        win.SIID_LOCK,
+	"network":     win.SIID_MYNETWORK,
+	"open":        win.SIID_FOLDEROPEN,
+	"print":       win.SIID_PRINTER,
+	"printer":     win.SIID_PRINTER,
+	"recycle-bin": win.SIID_RECYCLER,
+	"rename":      win.SIID_RENAME,
+	"save":        win.SIID_DRIVE35,
+	"search":      win.SIID_FIND,
+	"settings":    win.SIID_SETTINGS,
+	"share":       win.SIID_SHARE,
+	"software":    win.SIID_SOFTWARE,
+	"users":       win.SIID_USERS,
+	"video":       win.SIID_VIDEOFILES,
+	"zip":         win.SIID_ZIPFILE,
+}
+
+// DefaultIconSet returns the icon set backed by the stock icons of Windows.
+// It provides the icons application, error, information (or info), question,
+// shield and warning, which are the icons of IconApplication and friends, as
+// well as audio, computer, delete, document, drive, find (or search), folder,
+// folder-open (or open), help, image, internet, key, link, lock, network,
+// print (or printer), recycle-bin, rename, save, settings, share, software,
+// users, video and zip from the shell stock icons. As there is no stock icon
+// for saving, save is the floppy disk drive icon. The icons are loaded for each
+// DPI they are drawn at.
+func DefaultIconSet() *IconSet {
+	if defaultIconSet != nil {
+		return defaultIconSet
+	}
+
+	set := NewIconSet()
+
+	for name, id := range stockIconSetIcons {
+		set.Add(name, stockIcon(id))
+	}
+
+	for name, siid := range stockIconSetShellIcons {
+		siid := siid
+		set.add(name, false, func() (Image, error) {
+			return newIconFromShellStockIcon(siid)
+		})
+	}
+
+	defaultIconSet = set
+
+	return set
+}
+
+// newIconFromShellStockIcon returns the shell stock icon siid, one of the
+// win.SIID_* constants. Its size is the default small icon size, and like other
+// Icons it is extracted for each DPI it is requested at, see IconCache.Icon.
+func newIconFromShellStockIcon(siid int32) (*Icon, error) {
+	var sii win.SHSTOCKICONINFO
+	sii.CbSize = uint32(unsafe.Sizeof(sii))
+
+	if hr := win.SHGetStockIconInfo(siid, win.SHGSI_ICONLOCATION, &sii); win.FAILED(hr) {
+		return nil, errorFromHRESULT("SHGetStockIconInfo", hr)
+	}
+
+	filePath := syscall.UTF16ToString(sii.SzPath[:])
+	if filePath == "" {
+		return nil, newError("SHGetStockIconInfo returned no icon location")
+	}
+
+	return checkNewIcon(&Icon{filePath: filePath, index: int(sii.IIcon), hasIndex: true, size96dpi: DefaultSmallIconSize()})
+}
@@ -8,6 +8,8 @@
 package walk
 
 import (
+	"io/fs"
+	"path"
 	"strconv"
 	"strings"
 
@@ -41,7 +43,13 @@ func ImageFrom(src interface{}) (img Image, err error) {
 		img, err = Resources.Image(strconv.Itoa(src))
 
 	case string:
-		img, err = Resources.Image(src)
+		// Resources and files take precedence, so that names like "save"
+		// keep referring to the images of existing applications.
+		if img, err = Resources.Image(src); err != nil {
+			if set := iconSetFor(src); set != nil {
+				img, err = set.Image(src)
+			}
+		}
 
 	default:
 		err = ErrInvalidType
@@ -50,7 +58,8 @@ func ImageFrom(src interface{}) (img Image, err error) {
 	return
 }
 
-// NewImageFromFile loads image from file at 96dpi. Supported types are .ico, .emf, .bmp, .png...
+// NewImageFromFile loads image from file at 96dpi. Supported types are .ico, .emf, .svg, .bmp,
+// .png...
 //
 // Deprecated: Newer applications should use NewImageFromFileForDPI.
 func NewImageFromFile(filePath string) (Image, error) {
@@ -58,17 +67,35 @@ func NewImageFromFile(filePath string) (Image, error) {
 }
 
 // NewImageFromFileForDPI loads image from file at given DPI. Supported types are .ico, .emf,
-// .bmp, .png...
+// .svg, .bmp, .png...
 func NewImageFromFileForDPI(filePath string, dpi int) (Image, error) {
 	if strings.HasSuffix(filePath, ".ico") {
 		return NewIconFromFile(filePath)
 	} else if strings.HasSuffix(filePath, ".emf") {
 		return NewMetafileFromFile(filePath)
+	} else if strings.HasSuffix(strings.ToLower(filePath), ".svg") {
+		return NewSVGImageFromFile(filePath)
 	}
 
 	return NewBitmapFromFileForDPI(filePath, dpi)
 }
 
+// NewImageFromFS loads image from the file name in fsys, which may for example be an embed.FS.
+// .svg files are loaded as SVGImage and .ico files as Icon. Other images are loaded as
+// bitmaps, drawing the DPI variant in fsys best suited for the target, see
+// NewBitmapFromFSForDPI.
+func NewImageFromFS(fsys fs.FS, name string) (Image, error) {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".ico":
+		return NewIconFromFS(fsys, name)
+
+	case ".svg":
+		return NewSVGImageFromFS(fsys, name)
+	}
+
+	return newVariantImageFromFS(fsys, name)
+}
+
 type PaintFuncImage struct {
 	size96dpi   Size
 	paint       PaintFunc // in 1/96" units
@@ -9,11 +9,14 @@ package walk
 
 import (
 	"bufio"
+	"bytes"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/xackery/wlk/walk/settings"
 )
 
 const iniFileTimeStampFormat = "2006-01-02"
@@ -99,8 +102,15 @@ func (ifs *IniFileSettings) SetPortable(portable bool) {
 }
 
 func (ifs *IniFileSettings) FilePath() string {
-	if ifs.portable {
-		absPath, err := filepath.Abs(ifs.fileName)
+	return settingsFilePath(ifs.fileName, ifs.portable)
+}
+
+// settingsFilePath returns the absolute path of the settings file fileName. Portable settings
+// files are relative to the working directory, others to the application data directory of
+// the organization and product of App().
+func settingsFilePath(fileName string, portable bool) string {
+	if portable {
+		absPath, err := filepath.Abs(fileName)
 		if err != nil {
 			return ""
 		}
@@ -117,7 +127,7 @@ func (ifs *IniFileSettings) FilePath() string {
 		appDataPath,
 		App().OrganizationName(),
 		App().ProductName(),
-		ifs.fileName)
+		fileName)
 }
 
 func (ifs *IniFileSettings) fileExists() (bool, error) {
@@ -188,45 +198,48 @@ func (ifs *IniFileSettings) Load() error {
 	})
 }
 
+// Save writes the settings to the file. The file is replaced atomically, so it is left intact
+// if writing fails.
 func (ifs *IniFileSettings) Save() error {
-	return ifs.withFile(os.O_CREATE|os.O_TRUNC|os.O_WRONLY, func(file *os.File) error {
-		bufWriter := bufio.NewWriter(file)
+	var buf bytes.Buffer
 
-		keys := make([]string, 0, len(ifs.key2Record))
+	keys := make([]string, 0, len(ifs.key2Record))
 
-		for key, record := range ifs.key2Record {
-			if ifs.expireDuration <= 0 || record.timestamp.IsZero() || time.Since(record.timestamp) < ifs.expireDuration {
-				keys = append(keys, key)
-			}
+	for key, record := range ifs.key2Record {
+		if ifs.expireDuration <= 0 || record.timestamp.IsZero() || time.Since(record.timestamp) < ifs.expireDuration {
+			keys = append(keys, key)
 		}
+	}
 
-		sort.Strings(keys)
+	sort.Strings(keys)
 
-		for _, key := range keys {
-			record := ifs.key2Record[key]
+	for _, key := range keys {
+		record := ifs.key2Record[key]
 
-			if _, err := bufWriter.WriteString(key); err != nil {
-				return wrapError(err)
-			}
-			if !record.timestamp.IsZero() {
-				if _, err := bufWriter.WriteString("|"); err != nil {
-					return wrapError(err)
-				}
-				if _, err := bufWriter.WriteString(record.timestamp.Format(iniFileTimeStampFormat)); err != nil {
-					return wrapError(err)
-				}
-			}
-			if _, err := bufWriter.WriteString("="); err != nil {
-				return wrapError(err)
-			}
-			if _, err := bufWriter.WriteString(record.value); err != nil {
-				return wrapError(err)
-			}
-			if _, err := bufWriter.WriteString("\r\n"); err != nil {
-				return wrapError(err)
-			}
+		buf.WriteString(key)
+		if !record.timestamp.IsZero() {
+			buf.WriteString("|")
+			buf.WriteString(record.timestamp.Format(iniFileTimeStampFormat))
 		}
+		buf.WriteString("=")
+		buf.WriteString(record.value)
+		buf.WriteString("\r\n")
+	}
 
-		return bufWriter.Flush()
-	})
+	return writeSettingsFile(ifs.FilePath(), buf.Bytes())
+}
+
+// writeSettingsFile atomically replaces the settings file at filePath with data, creating its
+// directory if necessary.
+func writeSettingsFile(filePath string, data []byte) error {
+	dirPath, _ := filepath.Split(filePath)
+	if err := os.MkdirAll(dirPath, 0644); err != nil {
+		return wrapError(err)
+	}
+
+	if err := settings.WriteFileAtomic(filePath, data, 0644); err != nil {
+		return wrapError(err)
+	}
+
+	return nil
 }
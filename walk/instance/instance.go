@@ -0,0 +1,108 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package instance implements the protocol behind
+// walk.Application.EnsureSingleInstance, which lets a second instance of an
+// application hand its command line to the instance that is already running.
+//
+// The first instance to acquire the Lock for an application id becomes the
+// primary instance and listens on a Transport. Later instances encode a
+// Message and send it over the Transport to the primary instance, then exit.
+//
+// # Message format
+//
+// A message is a UTF-8 JSON object:
+//
+//	{"version": 1, "args": ["--open", "report.txt"], "workingDir": "C:\\Users\\me"}
+//
+// args are the command line arguments without the program name and
+// workingDir is the working directory of the sending instance, so relative
+// paths in args can be resolved. Readers ignore unknown members and reject
+// messages with a version greater than Version.
+//
+// On Windows the lock is a named mutex called MutexName(id) and the primary
+// instance listens with a message-only window of the class
+// WindowClassName(id). Messages are sent to it with WM_COPYDATA, with dwData
+// set to CopyDataID.
+package instance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Version is the version of the message format written by Encode.
+const Version = 1
+
+// CopyDataID identifies messages of this package in WM_COPYDATA, "WLKI".
+const CopyDataID = 0x574C4B49
+
+// ErrNotListening is returned by Transport.Send if the primary instance
+// isn't listening yet.
+var ErrNotListening = errors.New("instance: primary instance is not listening")
+
+// Message is sent by a second instance to the primary instance.
+type Message struct {
+	Args       []string
+	WorkingDir string
+}
+
+type wireMessage struct {
+	Version    int      `json:"version"`
+	Args       []string `json:"args"`
+	WorkingDir string   `json:"workingDir"`
+}
+
+// Encode returns msg in the message format.
+func Encode(msg Message) ([]byte, error) {
+	args := msg.Args
+	if args == nil {
+		args = []string{}
+	}
+
+	return json.Marshal(wireMessage{
+		Version:    Version,
+		Args:       args,
+		WorkingDir: msg.WorkingDir,
+	})
+}
+
+// Decode parses data in the message format.
+func Decode(data []byte) (Message, error) {
+	var wm wireMessage
+	if err := json.Unmarshal(data, &wm); err != nil {
+		return Message{}, err
+	}
+	if wm.Version < 1 || wm.Version > Version {
+		return Message{}, fmt.Errorf("instance: unsupported message version %d", wm.Version)
+	}
+
+	return Message{Args: wm.Args, WorkingDir: wm.WorkingDir}, nil
+}
+
+// sanitize replaces characters of id that are not allowed, or not wise, in
+// the names of kernel objects and window classes.
+func sanitize(id string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			return r
+		}
+		return '_'
+	}, id)
+}
+
+// MutexName returns the name of the mutex that is the lock for the
+// application id, local to the user session.
+func MutexName(id string) string {
+	return `Local\wlk-instance-` + sanitize(id)
+}
+
+// WindowClassName returns the window class name of the message-only window
+// the primary instance of the application id listens with.
+func WindowClassName(id string) string {
+	return "wlk-instance-" + sanitize(id)
+}
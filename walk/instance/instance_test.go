@@ -0,0 +1,108 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package instance
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []Message{
+		{Args: []string{"--open", "report.txt"}, WorkingDir: `C:\Users\me`},
+		{Args: []string{"ünïcödé", ""}, WorkingDir: ""},
+		{Args: []string{}},
+	}
+
+	for _, msg := range tests {
+		data, err := Encode(msg)
+		if err != nil {
+			t.Fatalf("Encode(%v): %v", msg, err)
+		}
+
+		got, err := Decode(data)
+		if err != nil {
+			t.Fatalf("Decode(%s): %v", data, err)
+		}
+		if !reflect.DeepEqual(got, msg) {
+			t.Errorf("Decode(Encode(%v)): got %v", msg, got)
+		}
+	}
+
+	if data, _ := Encode(Message{}); string(data) != `{"version":1,"args":[],"workingDir":""}` {
+		t.Errorf("Encode(Message{}): got %s", data)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	for _, data := range []string{
+		``,
+		`[]`,
+		`{"args":["a"]}`,
+		`{"version":2,"args":["a"]}`,
+	} {
+		if _, err := Decode([]byte(data)); err == nil {
+			t.Errorf("Decode(%q): got nil error", data)
+		}
+	}
+
+	msg, err := Decode([]byte(`{"version":1,"args":["a"],"workingDir":"d","future":true}`))
+	if err != nil || !reflect.DeepEqual(msg, Message{Args: []string{"a"}, WorkingDir: "d"}) {
+		t.Errorf("Decode with unknown member: got %v, %v", msg, err)
+	}
+}
+
+func TestNames(t *testing.T) {
+	if got, want := MutexName(`Acme\Tool 2.0`), `Local\wlk-instance-Acme_Tool_2.0`; got != want {
+		t.Errorf("MutexName: got %q, want %q", got, want)
+	}
+	if got, want := WindowClassName("acme-tool"), "wlk-instance-acme-tool"; got != want {
+		t.Errorf("WindowClassName: got %q, want %q", got, want)
+	}
+}
+
+func TestStart(t *testing.T) {
+	local := NewLocal()
+
+	var received []Message
+	primary, err := Start(local.Lock("app"), local.Transport("app"), Message{Args: []string{"first"}}, func(msg Message) {
+		received = append(received, msg)
+	})
+	if err != nil || !primary {
+		t.Fatalf("first Start: got %v, %v, want true, nil", primary, err)
+	}
+
+	second := Message{Args: []string{"open", "b.txt"}, WorkingDir: "/tmp"}
+	primary, err = Start(local.Lock("app"), local.Transport("app"), second, nil)
+	if err != nil || primary {
+		t.Fatalf("second Start: got %v, %v, want false, nil", primary, err)
+	}
+
+	if want := []Message{second}; !reflect.DeepEqual(received, want) {
+		t.Errorf("received: got %v, want %v", received, want)
+	}
+
+	primary, err = Start(local.Lock("other"), local.Transport("other"), Message{}, func(Message) {})
+	if err != nil || !primary {
+		t.Errorf("Start for other id: got %v, %v, want true, nil", primary, err)
+	}
+}
+
+func TestStartNotListening(t *testing.T) {
+	defer func(attempts int, interval time.Duration) {
+		SendAttempts, SendInterval = attempts, interval
+	}(SendAttempts, SendInterval)
+	SendAttempts, SendInterval = 3, time.Millisecond
+
+	local := NewLocal()
+	local.Lock("app").Acquire()
+
+	primary, err := Start(local.Lock("app"), local.Transport("app"), Message{}, nil)
+	if primary || !errors.Is(err, ErrNotListening) {
+		t.Errorf("Start: got %v, %v, want false, ErrNotListening", primary, err)
+	}
+}
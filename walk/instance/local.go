@@ -0,0 +1,96 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package instance
+
+import (
+	"sync"
+)
+
+// Local is an in-process stand-in for the locks and transports of the
+// operating system, to test code using Start without starting processes.
+type Local struct {
+	mutex     sync.Mutex
+	locked    map[string]bool
+	listeners map[string]func([]byte)
+}
+
+// NewLocal returns an empty Local.
+func NewLocal() *Local {
+	return &Local{
+		locked:    make(map[string]bool),
+		listeners: make(map[string]func([]byte)),
+	}
+}
+
+// Lock returns the Lock for the application id.
+func (l *Local) Lock(id string) Lock {
+	return &localLock{l, id, false}
+}
+
+// Transport returns the Transport for the application id.
+func (l *Local) Transport(id string) Transport {
+	return &localTransport{l, id}
+}
+
+type localLock struct {
+	local *Local
+	id    string
+	held  bool
+}
+
+func (ll *localLock) Acquire() (bool, error) {
+	ll.local.mutex.Lock()
+	defer ll.local.mutex.Unlock()
+
+	if ll.local.locked[ll.id] {
+		return false, nil
+	}
+
+	ll.local.locked[ll.id] = true
+	ll.held = true
+
+	return true, nil
+}
+
+func (ll *localLock) Release() error {
+	ll.local.mutex.Lock()
+	defer ll.local.mutex.Unlock()
+
+	if ll.held {
+		delete(ll.local.locked, ll.id)
+		delete(ll.local.listeners, ll.id)
+		ll.held = false
+	}
+
+	return nil
+}
+
+type localTransport struct {
+	local *Local
+	id    string
+}
+
+func (lt *localTransport) Listen(received func([]byte)) error {
+	lt.local.mutex.Lock()
+	defer lt.local.mutex.Unlock()
+
+	lt.local.listeners[lt.id] = received
+
+	return nil
+}
+
+func (lt *localTransport) Send(data []byte) error {
+	lt.local.mutex.Lock()
+	received := lt.local.listeners[lt.id]
+	lt.local.mutex.Unlock()
+
+	if received == nil {
+		return ErrNotListening
+	}
+
+	received(append([]byte(nil), data...))
+
+	return nil
+}
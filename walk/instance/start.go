@@ -0,0 +1,78 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package instance
+
+import (
+	"errors"
+	"time"
+)
+
+// Lock decides which instance is the primary one.
+type Lock interface {
+	// Acquire returns true if the caller is the first to acquire the lock.
+	// The lock is held until the process exits or Release is called.
+	Acquire() (bool, error)
+
+	Release() error
+}
+
+// Transport carries encoded messages from second instances to the primary
+// instance.
+type Transport interface {
+	// Listen makes the primary instance receive messages, which are passed
+	// to received.
+	Listen(received func(data []byte)) error
+
+	// Send sends data to the primary instance. It returns ErrNotListening
+	// if the primary instance doesn't listen yet.
+	Send(data []byte) error
+}
+
+// SendAttempts and SendInterval control how long a second instance retries
+// while the primary instance has acquired the lock but doesn't listen yet.
+var (
+	SendAttempts = 20
+	SendInterval = 50 * time.Millisecond
+)
+
+// Start acquires lock. The primary instance listens on transport and passes
+// received messages to received, which must not be nil, and Start returns
+// true. A second instance sends msg to the primary instance and Start
+// returns false.
+//
+// Messages that can't be decoded are dropped.
+func Start(lock Lock, transport Transport, msg Message, received func(Message)) (primary bool, err error) {
+	if primary, err = lock.Acquire(); err != nil {
+		return false, err
+	}
+
+	if primary {
+		err := transport.Listen(func(data []byte) {
+			if msg, err := Decode(data); err == nil {
+				received(msg)
+			}
+		})
+		if err != nil {
+			lock.Release()
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	data, err := Encode(msg)
+	if err != nil {
+		return false, err
+	}
+
+	for i := 0; ; i++ {
+		err = transport.Send(data)
+		if !errors.Is(err, ErrNotListening) || i+1 >= SendAttempts {
+			return false, err
+		}
+
+		time.Sleep(SendInterval)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/xackery/wlk/walk/settings"
+)
+
+// JSONFileSettings is a Settings backend that stores its values in a JSON file. The slash
+// separated keys used for window state, like "MainWindow/Splitter", become nested sections
+// of the file. Values may contain any characters.
+type JSONFileSettings struct {
+	fileName       string
+	store          *settings.Store
+	expireDuration time.Duration
+	portable       bool
+}
+
+// NewJSONFileSettings returns a new JSONFileSettings for the file fileName, see FilePath.
+func NewJSONFileSettings(fileName string) *JSONFileSettings {
+	return &JSONFileSettings{
+		fileName: fileName,
+		store:    settings.NewStore(),
+	}
+}
+
+func (jfs *JSONFileSettings) Get(key string) (string, bool) {
+	record, ok := jfs.store.Get(key)
+	return record.Value, ok
+}
+
+func (jfs *JSONFileSettings) Timestamp(key string) (time.Time, bool) {
+	record, ok := jfs.store.Get(key)
+	return record.Timestamp, ok
+}
+
+func (jfs *JSONFileSettings) Put(key, value string) error {
+	return jfs.put(key, value, false)
+}
+
+func (jfs *JSONFileSettings) PutExpiring(key, value string) error {
+	return jfs.put(key, value, true)
+}
+
+func (jfs *JSONFileSettings) put(key, value string, expiring bool) error {
+	if err := settings.ValidateSectionKey(key); err != nil {
+		return wrapError(err)
+	}
+
+	var timestamp time.Time
+	if expiring {
+		timestamp = time.Now()
+	}
+
+	if err := jfs.store.Put(key, settings.Record{Value: value, Timestamp: timestamp}); err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+func (jfs *JSONFileSettings) Remove(key string) error {
+	jfs.store.Remove(key)
+
+	return nil
+}
+
+func (jfs *JSONFileSettings) ExpireDuration() time.Duration {
+	return jfs.expireDuration
+}
+
+func (jfs *JSONFileSettings) SetExpireDuration(expireDuration time.Duration) {
+	jfs.expireDuration = expireDuration
+}
+
+func (jfs *JSONFileSettings) Portable() bool {
+	return jfs.portable
+}
+
+func (jfs *JSONFileSettings) SetPortable(portable bool) {
+	jfs.portable = portable
+}
+
+// FilePath returns the path of the settings file. Portable settings files are relative to
+// the working directory, others to the application data directory of the organization and
+// product of App().
+func (jfs *JSONFileSettings) FilePath() string {
+	return settingsFilePath(jfs.fileName, jfs.portable)
+}
+
+// Load reads the settings from the file, replacing the values in memory. A missing file is
+// not an error.
+func (jfs *JSONFileSettings) Load() error {
+	data, err := os.ReadFile(jfs.FilePath())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return wrapError(err)
+	}
+
+	store, err := settings.DecodeJSON(data)
+	if err != nil {
+		return wrapError(err)
+	}
+
+	jfs.store = store
+
+	return nil
+}
+
+// Save writes the settings to the file, leaving out expired values. The file is replaced
+// atomically, so it is left intact if writing fails.
+func (jfs *JSONFileSettings) Save() error {
+	data, err := settings.EncodeJSON(jfs.store, jfs.store.Keys(jfs.expireDuration, time.Now()))
+	if err != nil {
+		return wrapError(err)
+	}
+
+	return writeSettingsFile(jfs.FilePath(), data)
+}
@@ -9,6 +9,9 @@ package walk
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/xackery/wlk/win"
 )
@@ -373,23 +376,29 @@ var key2string = map[Key]string{
 type Modifiers byte
 
 func (m Modifiers) String() string {
-	return modifiers2string[m]
+	var parts []string
+
+	for _, mod := range [...]Modifiers{ModAlt, ModControl, ModShift, ModWin} {
+		if m&mod != 0 {
+			parts = append(parts, modifier2string[mod])
+		}
+	}
+
+	return strings.Join(parts, "+")
 }
 
-var modifiers2string = map[Modifiers]string{
-	ModShift:                       "Shift",
-	ModControl:                     "Ctrl",
-	ModControl | ModShift:          "Ctrl+Shift",
-	ModAlt:                         "Alt",
-	ModAlt | ModShift:              "Alt+Shift",
-	ModAlt | ModControl:            "Alt+Ctrl",
-	ModAlt | ModControl | ModShift: "Alt+Ctrl+Shift",
+var modifier2string = map[Modifiers]string{
+	ModShift:   "Shift",
+	ModControl: "Ctrl",
+	ModAlt:     "Alt",
+	ModWin:     "Win",
 }
 
 const (
 	ModShift Modifiers = 1 << iota
 	ModControl
 	ModAlt
+	ModWin
 )
 
 func ModifiersDown() Modifiers {
@@ -404,6 +413,9 @@ func ModifiersDown() Modifiers {
 	if AltDown() {
 		m |= ModAlt
 	}
+	if WinDown() {
+		m |= ModWin
+	}
 
 	return m
 }
@@ -413,6 +425,8 @@ type Shortcut struct {
 	Key       Key
 }
 
+// String returns the shortcut in the format read by ParseShortcut, for
+// example "Ctrl+Shift+K".
 func (s Shortcut) String() string {
 	m := s.Modifiers.String()
 	if m == "" {
@@ -428,6 +442,102 @@ func (s Shortcut) String() string {
 	return b.String()
 }
 
+var (
+	string2modifier = map[string]Modifiers{
+		"shift":   ModShift,
+		"ctrl":    ModControl,
+		"control": ModControl,
+		"alt":     ModAlt,
+		"win":     ModWin,
+		"windows": ModWin,
+	}
+
+	keyAliases = map[string]Key{
+		"enter":     KeyReturn,
+		"esc":       KeyEscape,
+		"backspace": KeyBack,
+		"del":       KeyDelete,
+		"ins":       KeyInsert,
+		"pageup":    KeyPrior,
+		"pgup":      KeyPrior,
+		"pagedown":  KeyNext,
+		"pgdn":      KeyNext,
+		"+":         KeyOEMPlus,
+		"plus":      KeyOEMPlus,
+		"-":         KeyOEMMinus,
+		"minus":     KeyOEMMinus,
+		",":         KeyOEMComma,
+		".":         KeyOEMPeriod,
+	}
+
+	string2keyOnce sync.Once
+	string2key     map[string]Key
+)
+
+// ParseKey returns the Key named s, which is case insensitive and may be a
+// name returned by Key.String or a common alias like "Enter" or "PageUp".
+func ParseKey(s string) (Key, error) {
+	string2keyOnce.Do(func() {
+		string2key = make(map[string]Key, len(key2string)+len(keyAliases))
+
+		for key, name := range key2string {
+			string2key[strings.ToLower(name)] = key
+			for _, alt := range strings.Split(name, " / ") {
+				string2key[strings.ToLower(alt)] = key
+			}
+		}
+		for alias, key := range keyAliases {
+			string2key[alias] = key
+		}
+	})
+
+	if key, ok := string2key[strings.ToLower(strings.TrimSpace(s))]; ok {
+		return key, nil
+	}
+
+	return 0, newError(fmt.Sprintf("unknown key %q", s))
+}
+
+// ParseShortcut parses a shortcut in the format returned by Shortcut.String,
+// for example "Ctrl+Shift+K". Modifiers may come in any order and all names
+// are case insensitive, see ParseKey for the names of keys. The empty string
+// is the zero Shortcut.
+func ParseShortcut(s string) (Shortcut, error) {
+	var shortcut Shortcut
+
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return shortcut, nil
+	}
+
+	parts := strings.Split(s, "+")
+	if strings.HasSuffix(s, "++") || s == "+" {
+		// The key is the plus key.
+		parts = append(parts[:len(parts)-2], "+")
+	}
+
+	for i, part := range parts {
+		if i == len(parts)-1 {
+			key, err := ParseKey(part)
+			if err != nil {
+				return Shortcut{}, err
+			}
+
+			shortcut.Key = key
+			break
+		}
+
+		mod, ok := string2modifier[strings.ToLower(strings.TrimSpace(part))]
+		if !ok {
+			return Shortcut{}, newError(fmt.Sprintf("invalid modifier %q in shortcut %q", part, s))
+		}
+
+		shortcut.Modifiers |= mod
+	}
+
+	return shortcut, nil
+}
+
 func AltDown() bool {
 	return win.GetKeyState(int32(KeyAlt))>>15 != 0
 }
@@ -439,3 +549,7 @@ func ControlDown() bool {
 func ShiftDown() bool {
 	return win.GetKeyState(int32(KeyShift))>>15 != 0
 }
+
+func WinDown() bool {
+	return win.GetKeyState(int32(KeyLWin))>>15 != 0 || win.GetKeyState(int32(KeyRWin))>>15 != 0
+}
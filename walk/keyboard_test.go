@@ -0,0 +1,67 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"testing"
+)
+
+func TestParseShortcut(t *testing.T) {
+	testCases := []struct {
+		text string
+		want Shortcut
+	}{
+		{"", Shortcut{}},
+		{"K", Shortcut{0, KeyK}},
+		{"Ctrl+Shift+K", Shortcut{ModControl | ModShift, KeyK}},
+		{"shift+ctrl+k", Shortcut{ModControl | ModShift, KeyK}},
+		{"Control + Alt + Delete", Shortcut{ModControl | ModAlt, KeyDelete}},
+		{"Win+Alt+F4", Shortcut{ModWin | ModAlt, KeyF4}},
+		{"Ctrl+Enter", Shortcut{ModControl, KeyReturn}},
+		{"Ctrl+PageDown", Shortcut{ModControl, KeyNext}},
+		{"Ctrl++", Shortcut{ModControl, KeyOEMPlus}},
+		{"Ctrl+-", Shortcut{ModControl, KeyOEMMinus}},
+		{"Alt+Menu", Shortcut{ModAlt, KeyMenu}},
+	}
+
+	for _, c := range testCases {
+		got, err := ParseShortcut(c.text)
+		if err != nil {
+			t.Errorf("ParseShortcut(%q): error %v", c.text, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("ParseShortcut(%q): got %v, want %v", c.text, got, c.want)
+		}
+	}
+}
+
+func TestParseShortcutErrors(t *testing.T) {
+	for _, text := range []string{"Ctrl+", "Hyper+K", "Ctrl+Nope", "K+Ctrl"} {
+		if _, err := ParseShortcut(text); err == nil {
+			t.Errorf("ParseShortcut(%q): got no error", text)
+		}
+	}
+}
+
+func TestShortcutStringRoundTrip(t *testing.T) {
+	for key := range key2string {
+		for mods := Modifiers(0); mods <= ModShift|ModControl|ModAlt|ModWin; mods++ {
+			s := Shortcut{mods, key}
+
+			got, err := ParseShortcut(s.String())
+			if err != nil {
+				t.Errorf("ParseShortcut(%q): error %v", s.String(), err)
+				continue
+			}
+			if got != s {
+				t.Errorf("ParseShortcut(%q): got %v, want %v", s.String(), got, s)
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"time"
+)
+
+// LayeredSettings combines several Settings into one. Values are looked up in each layer in
+// turn, so the layers are ordered from the most to the least specific, for example user
+// settings followed by machine wide defaults:
+//
+//	NewLayeredSettings(
+//		NewJSONFileSettings("settings.json"),
+//		NewRegistrySettingsWithKey(LocalMachineKey(), `Software\Org\Product`))
+//
+// Changes only affect the first layer, and only the first layer is saved, as the other
+// layers are typically read-only defaults. After removing a value from the first layer,
+// Get returns the default again.
+type LayeredSettings struct {
+	layers []Settings
+}
+
+// NewLayeredSettings returns a new LayeredSettings made of layers, the first of which takes
+// all changes. There must be at least one layer.
+func NewLayeredSettings(layers ...Settings) *LayeredSettings {
+	return &LayeredSettings{layers: layers}
+}
+
+// Layers returns the layers of ls.
+func (ls *LayeredSettings) Layers() []Settings {
+	return ls.layers
+}
+
+func (ls *LayeredSettings) Get(key string) (string, bool) {
+	for _, layer := range ls.layers {
+		if value, ok := layer.Get(key); ok {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+func (ls *LayeredSettings) Timestamp(key string) (time.Time, bool) {
+	for _, layer := range ls.layers {
+		if _, ok := layer.Get(key); ok {
+			return layer.Timestamp(key)
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (ls *LayeredSettings) Put(key, value string) error {
+	return ls.layers[0].Put(key, value)
+}
+
+func (ls *LayeredSettings) PutExpiring(key, value string) error {
+	return ls.layers[0].PutExpiring(key, value)
+}
+
+func (ls *LayeredSettings) Remove(key string) error {
+	return ls.layers[0].Remove(key)
+}
+
+func (ls *LayeredSettings) ExpireDuration() time.Duration {
+	return ls.layers[0].ExpireDuration()
+}
+
+func (ls *LayeredSettings) SetExpireDuration(expireDuration time.Duration) {
+	ls.layers[0].SetExpireDuration(expireDuration)
+}
+
+// Load loads all layers.
+func (ls *LayeredSettings) Load() error {
+	for _, layer := range ls.layers {
+		if err := layer.Load(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Save saves the first layer.
+func (ls *LayeredSettings) Save() error {
+	return ls.layers[0].Save()
+}
@@ -227,6 +227,10 @@ func layoutTree(root ContainerLayoutItem, size Size, cancel chan struct{}, done
 
 				items := container.PerformLayout()
 
+				if clib.ctx != nil && clib.ctx.mirrored {
+					mirrorLayoutResultItems(items, size.Width)
+				}
+
 				select {
 				case <-cancel:
 					return
@@ -558,16 +562,35 @@ type HeightForWidther interface {
 type LayoutContext struct {
 	layoutItem2MinSizeEffective map[LayoutItem]Size // in native pixels
 	dpi                         int
+	mirrored                    bool
 }
 
 func (ctx *LayoutContext) DPI() int {
 	return ctx.dpi
 }
 
+// Mirrored returns whether layouts place items from right to left. When it is
+// set, the bounds returned by PerformLayout are flipped horizontally within
+// the client area of the container before they are applied, so a Layout
+// always computes left to right.
+func (ctx *LayoutContext) Mirrored() bool {
+	return ctx.mirrored
+}
+
 func newLayoutContext(handle windows.HWND) *LayoutContext {
 	return &LayoutContext{
 		layoutItem2MinSizeEffective: make(map[LayoutItem]Size),
 		dpi:                         int(win.GetDpiForWindow(handle)),
+		mirrored:                    windowMirrored(windowFromHandle(handle)),
+	}
+}
+
+// mirrorLayoutResultItems flips the bounds of items horizontally within a
+// client area of the specified width.
+func mirrorLayoutResultItems(items []LayoutResultItem, width int) {
+	for i := range items {
+		b := &items[i].Bounds
+		b.X = width - b.X - b.Width
 	}
 }
 
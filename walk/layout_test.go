@@ -0,0 +1,126 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func layoutResultBounds(items []LayoutResultItem) []Rectangle {
+	bounds := make([]Rectangle, len(items))
+	for i, item := range items {
+		bounds[i] = item.Bounds
+	}
+	return bounds
+}
+
+func TestMirrorLayoutResultItems(t *testing.T) {
+	tests := []struct {
+		name  string
+		width int
+		in    []Rectangle
+		want  []Rectangle
+	}{
+		{
+			name:  "margins",
+			width: 100,
+			in:    []Rectangle{{9, 9, 82, 20}, {9, 35, 40, 20}},
+			want:  []Rectangle{{9, 9, 82, 20}, {51, 35, 40, 20}},
+		},
+		{
+			name:  "spacing",
+			width: 100,
+			in:    []Rectangle{{0, 0, 30, 20}, {36, 0, 30, 20}, {72, 0, 28, 20}},
+			want:  []Rectangle{{70, 0, 30, 20}, {34, 0, 30, 20}, {0, 0, 28, 20}},
+		},
+		{
+			name:  "zero width item",
+			width: 100,
+			in:    []Rectangle{{0, 0, 0, 20}, {40, 0, 0, 20}},
+			want:  []Rectangle{{100, 0, 0, 20}, {60, 0, 0, 20}},
+		},
+		{
+			name:  "zero width container",
+			width: 0,
+			in:    []Rectangle{{0, 0, 0, 0}, {0, 0, 10, 10}},
+			want:  []Rectangle{{0, 0, 0, 0}, {-10, 0, 10, 10}},
+		},
+		{
+			name:  "empty",
+			width: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := make([]LayoutResultItem, len(tt.in))
+			for i, b := range tt.in {
+				items[i].Bounds = b
+			}
+
+			mirrorLayoutResultItems(items, tt.width)
+			if got := layoutResultBounds(items); !reflect.DeepEqual(got, tt.want) && len(got)+len(tt.want) > 0 {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+
+			// Mirroring again restores the bounds.
+			mirrorLayoutResultItems(items, tt.width)
+			if got := layoutResultBounds(items); !reflect.DeepEqual(got, tt.in) && len(got)+len(tt.in) > 0 {
+				t.Errorf("mirrored twice: got %v, want %v", got, tt.in)
+			}
+		})
+	}
+}
+
+func TestMirrorLayoutResultItemsNested(t *testing.T) {
+	// Bounds are relative to the client area of the container, so a nested
+	// container is mirrored within its own width, whatever the width of the
+	// outer container and whether it was mirrored already.
+	outer := []LayoutResultItem{{Bounds: Rectangle{10, 0, 60, 40}}}
+	inner := []LayoutResultItem{{Bounds: Rectangle{0, 0, 20, 40}}, {Bounds: Rectangle{26, 0, 34, 40}}}
+
+	mirrorLayoutResultItems(outer, 200)
+	if got, want := outer[0].Bounds, (Rectangle{130, 0, 60, 40}); got != want {
+		t.Errorf("outer: got %v, want %v", got, want)
+	}
+
+	mirrorLayoutResultItems(inner, outer[0].Bounds.Width)
+	want := []Rectangle{{40, 0, 20, 40}, {0, 0, 34, 40}}
+	if got := layoutResultBounds(inner); !reflect.DeepEqual(got, want) {
+		t.Errorf("inner: got %v, want %v", got, want)
+	}
+}
+
+func TestSplitterHandleNeighbors(t *testing.T) {
+	// The children of a Splitter alternate between widgets and handles:
+	// widget 0, handle 1, widget 2, handle 3, widget 4.
+	tests := []struct {
+		name               string
+		visible            []bool
+		handle             int
+		mirrored           bool
+		wantPrev, wantNext int
+	}{
+		{"first handle", []bool{true, true, true, true, true}, 1, false, 0, 2},
+		{"second handle", []bool{true, true, true, true, true}, 3, false, 2, 4},
+		{"first handle mirrored", []bool{true, true, true, true, true}, 1, true, 2, 0},
+		{"second handle mirrored", []bool{true, true, true, true, true}, 3, true, 4, 2},
+		{"hidden widget and handle skipped", []bool{true, false, false, true, true}, 3, false, 0, 4},
+		{"hidden widget and handle skipped mirrored", []bool{true, false, false, true, true}, 3, true, 4, 0},
+		{"none before", []bool{false, true, true}, 1, false, -1, 2},
+		{"none before mirrored", []bool{false, true, true}, 1, true, 2, -1},
+	}
+
+	for _, tt := range tests {
+		prev, next := splitterHandleNeighbors(tt.visible, tt.handle, tt.mirrored)
+		if prev != tt.wantPrev || next != tt.wantNext {
+			t.Errorf("%s: got %d, %d, want %d, %d", tt.name, prev, next, tt.wantPrev, tt.wantNext)
+		}
+	}
+}
@@ -274,6 +274,8 @@ func (lb *ListBox) resetItems() error {
 		lb.SendMessage(win.LB_SETHORIZONTALEXTENT, uintptr(sh.Width), 0)
 	}
 
+	lb.notifyAccessibleElementsChanged()
+
 	return nil
 }
 
@@ -347,6 +349,8 @@ func (lb *ListBox) attachModel() {
 		}
 
 		lb.SetCurrentIndex(lb.prevCurIndex)
+
+		lb.notifyAccessibleElementsChanged()
 	}
 	lb.itemChangedHandlerHandle = lb.model.ItemChanged().Attach(itemChangedHandler)
 
@@ -363,6 +367,8 @@ func (lb *ListBox) attachModel() {
 		lb.lastWidthsMeasuredFor = append(lb.lastWidthsMeasuredFor[:from], append(make([]int, to-from+1), lb.lastWidthsMeasuredFor[from:]...)...)
 
 		lb.ensureVisibleItemsHeightUpToDate()
+
+		lb.notifyAccessibleElementsChanged()
 	})
 
 	lb.itemsRemovedHandlerHandle = lb.model.ItemsRemoved().Attach(func(from, to int) {
@@ -378,6 +384,8 @@ func (lb *ListBox) attachModel() {
 		lb.lastWidthsMeasuredFor = append(lb.lastWidthsMeasuredFor[:from], lb.lastWidthsMeasuredFor[to:]...)
 
 		lb.ensureVisibleItemsHeightUpToDate()
+
+		lb.notifyAccessibleElementsChanged()
 	})
 }
 
@@ -609,6 +617,8 @@ func (lb *ListBox) SetCurrentIndex(value int) error {
 
 		lb.prevCurIndex = value
 		lb.currentIndexChangedPublisher.Publish()
+
+		lb.notifyAccessibleElementsChanged()
 	}
 
 	return nil
@@ -638,6 +648,8 @@ func (lb *ListBox) SetSelectedIndexes(indexes []int) {
 		lb.SendMessage(win.LB_SETSEL, win.TRUE, uintptr(uint32(v)))
 	}
 	lb.selectedIndexesChangedPublisher.Publish()
+
+	lb.notifyAccessibleElementsChanged()
 }
 
 func (lb *ListBox) CurrentIndexChanged() *Event {
@@ -826,6 +838,7 @@ func (lb *ListBox) WndProc(hwnd windows.HWND, msg uint32, wParam, lParam uintptr
 			lb.currentValue = lb.Property("Value").Get()
 			lb.currentIndexChangedPublisher.Publish()
 			lb.selectedIndexesChangedPublisher.Publish()
+			lb.notifyAccessibleElementsChanged()
 
 		case win.LBN_DBLCLK:
 			lb.itemActivatedPublisher.Publish()
@@ -855,6 +868,63 @@ func (lb *ListBox) WndProc(hwnd windows.HWND, msg uint32, wParam, lParam uintptr
 	return lb.WidgetBase.WndProc(hwnd, msg, wParam, lParam)
 }
 
+// AccessibleElements exposes the items of a ListBox with an ItemStyler, which
+// paints them by itself, to screen readers.
+func (lb *ListBox) AccessibleElements() []*AccessibleElement {
+	if lb.styler == nil || lb.model == nil {
+		return nil
+	}
+
+	count := lb.model.ItemCount()
+	multi := lb.multiSelection()
+
+	elements := make([]*AccessibleElement, count)
+	for i := 0; i < count; i++ {
+		index := i
+
+		var rc win.RECT
+		lb.SendMessage(win.LB_GETITEMRECT, uintptr(i), uintptr(unsafe.Pointer(&rc)))
+
+		elements[i] = &AccessibleElement{
+			ID:         i + 1,
+			Role:       AccRoleListItem,
+			Name:       lb.itemString(i),
+			Bounds:     rectangleFromRECT(rc),
+			Selectable: true,
+			Selected:   int32(lb.SendMessage(win.LB_GETSEL, uintptr(i), 0)) > 0,
+			Select: func() {
+				if multi {
+					lb.SetSelectedIndexes([]int{index})
+				} else {
+					lb.SetCurrentIndex(index)
+				}
+			},
+		}
+		if multi {
+			elements[i].AddToSelection = func() {
+				lb.SetSelectedIndexes(append(lb.SelectedIndexes(), index))
+			}
+		}
+	}
+
+	return elements
+}
+
+func (lb *ListBox) multiSelection() bool {
+	style, err := win.GetWindowLong(lb.hWnd, win.GWL_STYLE)
+	if err != nil {
+		return false
+	}
+
+	return style&(win.LBS_MULTIPLESEL|win.LBS_EXTENDEDSEL) != 0
+}
+
+func (lb *ListBox) notifyAccessibleElementsChanged() {
+	if lb.styler != nil {
+		lb.Accessibility().NotifyElementsChanged()
+	}
+}
+
 func (lb *ListBox) invalidateItem(index int) {
 	var rc win.RECT
 	lb.SendMessage(win.LB_GETITEMRECT, uintptr(index), uintptr(unsafe.Pointer(&rc)))
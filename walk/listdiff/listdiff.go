@@ -0,0 +1,102 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package listdiff computes the edits that turn one list into another, so
+// views of a list, like the widgets of a walk.Repeater, can keep the parts
+// that show elements that are still there.
+//
+//	listdiff.Diff([]string{"a", "b", "c"}, []string{"c", "a", "d"})
+//	// Remove 1 (b), Move 1 to 0 (c), Insert 2 (d)
+package listdiff
+
+// Kind is the kind of an Edit.
+type Kind int
+
+const (
+	// Remove removes the element at From.
+	Remove Kind = iota
+
+	// Insert inserts the element at To of the new list, at To.
+	Insert
+
+	// Move moves the element at From to To. To is less than From.
+	Move
+)
+
+// Edit is a change to a list. The indexes refer to the list as it is when
+// the Edit is applied, after the Edits before it.
+type Edit struct {
+	Kind Kind
+	From int
+	To   int
+}
+
+// Diff returns the Edits that turn prev into next, when applied in order.
+// Elements are matched by equality, duplicates in order. Matched elements are
+// moved where needed rather than removed and inserted.
+//
+// All Removes come first, in descending order of From. They are followed by
+// the Inserts and Moves, in ascending order of To.
+func Diff[T comparable](prev, next []T) []Edit {
+	// prevIndexes holds the indexes in prev of each element, in order.
+	prevIndexes := make(map[T][]int)
+	for i, v := range prev {
+		prevIndexes[v] = append(prevIndexes[v], i)
+	}
+
+	// match holds, for each element of next, its index in prev or -1.
+	match := make([]int, len(next))
+	kept := make([]bool, len(prev))
+	for i, v := range next {
+		match[i] = -1
+
+		if indexes := prevIndexes[v]; len(indexes) > 0 {
+			match[i] = indexes[0]
+			kept[indexes[0]] = true
+			prevIndexes[v] = indexes[1:]
+		}
+	}
+
+	var edits []Edit
+
+	for i := len(prev) - 1; i >= 0; i-- {
+		if !kept[i] {
+			edits = append(edits, Edit{Kind: Remove, From: i})
+		}
+	}
+
+	// cur holds the indexes in prev of the elements of the list being edited,
+	// -1 for inserted ones.
+	cur := make([]int, 0, len(next))
+	for i, k := range kept {
+		if k {
+			cur = append(cur, i)
+		}
+	}
+
+	for to, from := range match {
+		if from == -1 {
+			cur = append(cur, 0)
+			copy(cur[to+1:], cur[to:])
+			cur[to] = -1
+
+			edits = append(edits, Edit{Kind: Insert, To: to})
+			continue
+		}
+
+		at := to
+		for cur[at] != from {
+			at++
+		}
+
+		if at != to {
+			copy(cur[to+1:at+1], cur[to:at])
+			cur[to] = from
+
+			edits = append(edits, Edit{Kind: Move, From: at, To: to})
+		}
+	}
+
+	return edits
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package listdiff
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// apply applies edits to prev, inserting the elements of next.
+func apply(t *testing.T, prev, next []string, edits []Edit) []string {
+	t.Helper()
+
+	list := append([]string(nil), prev...)
+	for _, e := range edits {
+		switch e.Kind {
+		case Remove:
+			list = append(list[:e.From], list[e.From+1:]...)
+
+		case Insert:
+			if e.To > len(list) {
+				t.Fatalf("%+v: out of range for %v", e, list)
+			}
+			list = append(list[:e.To], append([]string{next[e.To]}, list[e.To:]...)...)
+
+		case Move:
+			if e.To >= e.From {
+				t.Fatalf("%+v: moves forward", e)
+			}
+			v := list[e.From]
+			list = append(list[:e.From], list[e.From+1:]...)
+			list = append(list[:e.To], append([]string{v}, list[e.To:]...)...)
+		}
+	}
+
+	return list
+}
+
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, " ")
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name       string
+		prev, next string
+		want       []Edit
+	}{
+		{"unchanged", "a b c", "a b c", nil},
+		{"insert", "a c", "a b c", []Edit{{Kind: Insert, To: 1}}},
+		{"insert at end", "a b", "a b c d", []Edit{{Kind: Insert, To: 2}, {Kind: Insert, To: 3}}},
+		{"remove", "a b c", "a c", []Edit{{Kind: Remove, From: 1}}},
+		{"remove several", "a b c d", "b", []Edit{{Kind: Remove, From: 3}, {Kind: Remove, From: 2}, {Kind: Remove, From: 0}}},
+		{"reset", "a b", "c d", []Edit{{Kind: Remove, From: 1}, {Kind: Remove, From: 0}, {Kind: Insert, To: 0}, {Kind: Insert, To: 1}}},
+		{"clear", "a b", "", []Edit{{Kind: Remove, From: 1}, {Kind: Remove, From: 0}}},
+		{"fill", "", "a", []Edit{{Kind: Insert, To: 0}}},
+		{"swap", "a b", "b a", []Edit{{Kind: Move, From: 1, To: 0}}},
+		{"reverse", "a b c", "c b a", []Edit{{Kind: Move, From: 2, To: 0}, {Kind: Move, From: 2, To: 1}}},
+		{"move to end", "a b c", "b c a", []Edit{{Kind: Move, From: 1, To: 0}, {Kind: Move, From: 2, To: 1}}},
+		{"mixed", "a b c", "c a d", []Edit{{Kind: Remove, From: 1}, {Kind: Move, From: 1, To: 0}, {Kind: Insert, To: 2}}},
+		{"duplicates", "a a b", "b a", []Edit{{Kind: Remove, From: 1}, {Kind: Move, From: 1, To: 0}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev, next := split(tt.prev), split(tt.next)
+
+			edits := Diff(prev, next)
+			if !reflect.DeepEqual(edits, tt.want) {
+				t.Errorf("got %+v, want %+v", edits, tt.want)
+			}
+
+			if got := apply(t, prev, next, edits); !reflect.DeepEqual(got, next) && len(got)+len(next) > 0 {
+				t.Errorf("applied: got %v, want %v", got, next)
+			}
+		})
+	}
+}
+
+func TestDiffKeepsMatchedElements(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	letters := strings.Split("a b c d e f", " ")
+
+	randomList := func() []string {
+		list := make([]string, r.Intn(8))
+		for i := range list {
+			list[i] = letters[r.Intn(len(letters))]
+		}
+		return list
+	}
+
+	for i := 0; i < 1000; i++ {
+		prev, next := randomList(), randomList()
+		edits := Diff(prev, next)
+
+		if got := apply(t, prev, next, edits); !reflect.DeepEqual(got, next) && len(got)+len(next) > 0 {
+			t.Fatalf("%v to %v: got %v with %+v", prev, next, got, edits)
+		}
+
+		// Each element that is in both lists must survive.
+		counts := make(map[string]int)
+		for _, v := range prev {
+			counts[v]++
+		}
+		common := 0
+		for _, v := range next {
+			if counts[v] > 0 {
+				counts[v]--
+				common++
+			}
+		}
+
+		inserts := 0
+		for _, e := range edits {
+			if e.Kind == Insert {
+				inserts++
+			}
+		}
+		if want := len(next) - common; inserts != want {
+			t.Fatalf("%v to %v: got %d inserts, want %d", prev, next, inserts, want)
+		}
+	}
+}
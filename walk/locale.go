@@ -0,0 +1,208 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"strconv"
+	"syscall"
+	"time"
+	"unicode/utf16"
+
+	"github.com/xackery/wlk/walk/locale"
+	"github.com/xackery/wlk/win"
+)
+
+// Locale holds the conventions for formatting numbers, currency amounts, percentages, dates
+// and times, see package locale.
+type Locale = locale.Locale
+
+// Named date and time formats, which DateLabel and TableViewColumn accept in addition to
+// layouts of package time. They format with the patterns of the current locale.
+const (
+	ShortDateFormat     = "ShortDate"
+	LongDateFormat      = "LongDate"
+	TimeFormat          = "Time"
+	ShortTimeFormat     = "ShortTime"
+	ShortDateTimeFormat = "ShortDateTime"
+)
+
+// Named number formats, which TableViewColumn accepts for floating point values.
+const (
+	CurrencyFormat = "Currency"
+	PercentFormat  = "Percent"
+)
+
+var currentLocale *Locale
+
+// UserLocale returns the locale of the user's regional settings.
+func UserLocale() *Locale {
+	return localeFromWindows(nil)
+}
+
+// NewLocale returns the Windows locale named name, like "de-DE".
+func NewLocale(name string) (*Locale, error) {
+	namePtr, err := syscall.UTF16PtrFromString(name)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	var buf [win.LOCALE_NAME_MAX_LENGTH]uint16
+	if win.GetLocaleInfoEx(namePtr, win.LOCALE_SNAME, &buf[0], int32(len(buf))) == 0 {
+		return nil, lastError("GetLocaleInfoEx")
+	}
+
+	return localeFromWindows(namePtr), nil
+}
+
+// localeFromWindows reads the Windows locale named name, or the user default locale if name
+// is nil.
+func localeFromWindows(name *uint16) *Locale {
+	l := locale.Invariant()
+
+	str := func(lctype win.LCTYPE, value *string) {
+		buf := make([]uint16, 128)
+		for {
+			n := win.GetLocaleInfoEx(name, lctype, &buf[0], int32(len(buf)))
+			if n > 0 {
+				*value = string(utf16.Decode(buf[:n-1]))
+				return
+			}
+			if win.GetLastError() != win.ERROR_INSUFFICIENT_BUFFER || len(buf) > 4096 {
+				return
+			}
+			buf = make([]uint16, len(buf)*2)
+		}
+	}
+
+	num := func(lctype win.LCTYPE, value *int) {
+		var s string
+		str(lctype, &s)
+		if n, err := strconv.Atoi(s); err == nil {
+			*value = n
+		}
+	}
+
+	str(win.LOCALE_SNAME, &l.Name)
+	str(win.LOCALE_SDECIMAL, &l.DecimalSeparator)
+	str(win.LOCALE_STHOUSAND, &l.GroupSeparator)
+	var grouping string
+	str(win.LOCALE_SGROUPING, &grouping)
+	l.Grouping = locale.ParseGrouping(grouping)
+	str(win.LOCALE_SNEGATIVESIGN, &l.NegativeSign)
+	num(win.LOCALE_INEGNUMBER, &l.NegativeNumberPattern)
+
+	str(win.LOCALE_SCURRENCY, &l.CurrencySymbol)
+	num(win.LOCALE_ICURRDIGITS, &l.CurrencyDecimals)
+	str(win.LOCALE_SMONDECIMALSEP, &l.CurrencyDecimalSeparator)
+	str(win.LOCALE_SMONTHOUSANDSEP, &l.CurrencyGroupSeparator)
+	num(win.LOCALE_ICURRENCY, &l.CurrencyPositivePattern)
+	num(win.LOCALE_INEGCURR, &l.CurrencyNegativePattern)
+
+	str(win.LOCALE_SPERCENT, &l.PercentSymbol)
+	num(win.LOCALE_IPOSITIVEPERCENT, &l.PercentPositivePattern)
+	num(win.LOCALE_INEGATIVEPERCENT, &l.PercentNegativePattern)
+
+	str(win.LOCALE_SSHORTDATE, &l.ShortDatePattern)
+	str(win.LOCALE_SLONGDATE, &l.LongDatePattern)
+	str(win.LOCALE_STIMEFORMAT, &l.TimePattern)
+	str(win.LOCALE_SSHORTTIME, &l.ShortTimePattern)
+	str(win.LOCALE_S1159, &l.AMDesignator)
+	str(win.LOCALE_S2359, &l.PMDesignator)
+
+	for i := 0; i < 12; i++ {
+		str(win.LOCALE_SMONTHNAME1+win.LCTYPE(i), &l.MonthNames[i])
+		str(win.LOCALE_SABBREVMONTHNAME1+win.LCTYPE(i), &l.AbbreviatedMonthNames[i])
+	}
+
+	// Windows starts the week with Monday, time.Weekday with Sunday.
+	for i := 0; i < 7; i++ {
+		day := (i + 1) % 7
+		str(win.LOCALE_SDAYNAME1+win.LCTYPE(i), &l.DayNames[day])
+		str(win.LOCALE_SABBREVDAYNAME1+win.LCTYPE(i), &l.AbbreviatedDayNames[day])
+	}
+
+	return l
+}
+
+// CurrentLocale returns the locale walk formats and parses numbers, dates and times with.
+// It is the locale of the user's regional settings, unless changed with SetCurrentLocale.
+func CurrentLocale() *Locale {
+	if currentLocale == nil {
+		setLocale(UserLocale())
+	}
+
+	return currentLocale
+}
+
+// SetCurrentLocale sets the locale walk formats and parses numbers, dates and times with, and
+// updates the NumberEdit, NumberLabel, DateLabel and TableView widgets of all live forms.
+// A nil l selects the locale of the user's regional settings again. It must be called on
+// the UI thread.
+func SetCurrentLocale(l *Locale) {
+	if l == nil {
+		l = UserLocale()
+	}
+
+	setLocale(l)
+
+	type localeChanger interface {
+		applyLocale()
+	}
+
+	for _, wb := range hwnd2WindowBase {
+		if lc, ok := wb.window.(localeChanger); ok {
+			lc.applyLocale()
+		}
+	}
+}
+
+func setLocale(l *Locale) {
+	currentLocale = l
+
+	// NumberEdit processes input by UTF-16 code unit.
+	decimalSepS = l.DecimalSeparator
+	decimalSepUint16, groupSepUint16 = 0, 0
+	if s := syscall.StringToUTF16(l.DecimalSeparator); len(s) > 1 {
+		decimalSepUint16 = s[0]
+	}
+	if s := syscall.StringToUTF16(l.GroupSeparator); len(s) > 1 {
+		groupSepUint16 = s[0]
+	}
+}
+
+// formatTime formats t with format, which is one of the named date and time formats or a
+// layout of package time. An empty format is ShortDateFormat, and the default format "%v"
+// of TableViewColumn is ShortDateTimeFormat, or ShortDateFormat for times at midnight.
+func formatTime(t time.Time, format string) string {
+	l := CurrentLocale()
+
+	switch format {
+	case "%v":
+		if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 {
+			return l.FormatShortDate(t)
+		}
+		return l.FormatShortDate(t) + " " + l.FormatShortTime(t)
+
+	case "", ShortDateFormat:
+		return l.FormatShortDate(t)
+
+	case LongDateFormat:
+		return l.FormatLongDate(t)
+
+	case TimeFormat:
+		return l.FormatTime(t)
+
+	case ShortTimeFormat:
+		return l.FormatShortTime(t)
+
+	case ShortDateTimeFormat:
+		return l.FormatShortDate(t) + " " + l.FormatShortTime(t)
+	}
+
+	return t.Format(format)
+}
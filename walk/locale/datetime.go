@@ -0,0 +1,159 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package locale
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatDateTime formats t with the Windows date and time pattern pattern,
+// in which these sequences are replaced, and text in single quotes is copied
+// verbatim, with ” standing for a single quote:
+//
+//	d, dd         day of the month, without and with a leading zero
+//	ddd, dddd     abbreviated and full name of the day of the week
+//	M, MM         month, without and with a leading zero
+//	MMM, MMMM     abbreviated and full name of the month
+//	y, yy         year in the century, without and with a leading zero
+//	yyyy, yyyyy   year, with four and five digits
+//	h, hh         hour in the 12 hour format, without and with a leading zero
+//	H, HH         hour in the 24 hour format, without and with a leading zero
+//	m, mm         minute, without and with a leading zero
+//	s, ss         second, without and with a leading zero
+//	t, tt         first character of and full AM or PM designator
+//	g, gg         era, which is left out
+func (l *Locale) FormatDateTime(t time.Time, pattern string) string {
+	var sb strings.Builder
+
+	pad := func(n, width int) {
+		s := strconv.Itoa(n)
+		for i := len(s); i < width; i++ {
+			sb.WriteByte('0')
+		}
+		sb.WriteString(s)
+	}
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+
+		if c == '\'' {
+			i++
+			for i < len(pattern) {
+				if pattern[i] == '\'' {
+					if i+1 < len(pattern) && pattern[i+1] == '\'' {
+						sb.WriteByte('\'')
+						i += 2
+						continue
+					}
+					break
+				}
+				sb.WriteByte(pattern[i])
+				i++
+			}
+			i++
+			continue
+		}
+
+		count := 1
+		for i+count < len(pattern) && pattern[i+count] == c {
+			count++
+		}
+
+		switch c {
+		case 'd':
+			switch count {
+			case 1, 2:
+				pad(t.Day(), count)
+			case 3:
+				sb.WriteString(l.AbbreviatedDayNames[t.Weekday()])
+			default:
+				sb.WriteString(l.DayNames[t.Weekday()])
+			}
+
+		case 'M':
+			switch count {
+			case 1, 2:
+				pad(int(t.Month()), count)
+			case 3:
+				sb.WriteString(l.AbbreviatedMonthNames[t.Month()-1])
+			default:
+				sb.WriteString(l.MonthNames[t.Month()-1])
+			}
+
+		case 'y':
+			switch count {
+			case 1, 2:
+				pad(t.Year()%100, count)
+			default:
+				pad(t.Year(), 4+count/5)
+			}
+
+		case 'h':
+			hour := t.Hour() % 12
+			if hour == 0 {
+				hour = 12
+			}
+			pad(hour, minInt(count, 2))
+
+		case 'H':
+			pad(t.Hour(), minInt(count, 2))
+
+		case 'm':
+			pad(t.Minute(), minInt(count, 2))
+
+		case 's':
+			pad(t.Second(), minInt(count, 2))
+
+		case 't':
+			designator := l.AMDesignator
+			if t.Hour() >= 12 {
+				designator = l.PMDesignator
+			}
+			if count == 1 && designator != "" {
+				designator = string([]rune(designator)[:1])
+			}
+			sb.WriteString(designator)
+
+		case 'g':
+
+		default:
+			sb.WriteString(pattern[i : i+count])
+		}
+
+		i += count
+	}
+
+	return sb.String()
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// FormatShortDate formats the date of t with ShortDatePattern.
+func (l *Locale) FormatShortDate(t time.Time) string {
+	return l.FormatDateTime(t, l.ShortDatePattern)
+}
+
+// FormatLongDate formats the date of t with LongDatePattern.
+func (l *Locale) FormatLongDate(t time.Time) string {
+	return l.FormatDateTime(t, l.LongDatePattern)
+}
+
+// FormatTime formats the time of t with TimePattern.
+func (l *Locale) FormatTime(t time.Time) string {
+	return l.FormatDateTime(t, l.TimePattern)
+}
+
+// FormatShortTime formats the time of t with ShortTimePattern.
+func (l *Locale) FormatShortTime(t time.Time) string {
+	return l.FormatDateTime(t, l.ShortTimePattern)
+}
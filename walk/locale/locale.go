@@ -0,0 +1,334 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package locale implements locale aware formatting and parsing of numbers,
+// currency amounts, percentages, dates and times.
+//
+// A Locale is typically read from the settings of the user with
+// walk.UserLocale, or built explicitly from Invariant.
+package locale
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Locale holds the conventions of a language and region for formatting
+// numbers, currency amounts, percentages, dates and times.
+//
+// The patterns follow the conventions of Windows, see the documentation of
+// the fields.
+type Locale struct {
+	// Name is the name of the locale, like "de-DE".
+	Name string
+
+	// DecimalSeparator separates the integer part from the fraction.
+	DecimalSeparator string
+
+	// GroupSeparator separates groups of digits of the integer part.
+	GroupSeparator string
+
+	// Grouping holds the sizes of digit groups, starting with the group
+	// next to the decimal separator. The last size repeats, so it is [3]
+	// for 1,234,567 and [3 2] for 12,34,567. Empty means no grouping.
+	Grouping []int
+
+	// NegativeSign is the negative sign, usually "-".
+	NegativeSign string
+
+	// NegativeNumberPattern places the sign of negative numbers:
+	// 0 (1.1), 1 -1.1, 2 - 1.1, 3 1.1-, 4 1.1 -.
+	NegativeNumberPattern int
+
+	// CurrencySymbol is the local currency symbol, like "€".
+	CurrencySymbol string
+
+	// CurrencyDecimals is the number of fraction digits of currency amounts.
+	CurrencyDecimals int
+
+	// CurrencyDecimalSeparator and CurrencyGroupSeparator are the
+	// separators of currency amounts.
+	CurrencyDecimalSeparator string
+	CurrencyGroupSeparator   string
+
+	// CurrencyPositivePattern places the currency symbol of positive
+	// amounts: 0 $1.1, 1 1.1$, 2 $ 1.1, 3 1.1 $.
+	CurrencyPositivePattern int
+
+	// CurrencyNegativePattern places the currency symbol and sign of
+	// negative amounts: 0 ($1.1), 1 -$1.1, 2 $-1.1, 3 $1.1-, 4 (1.1$),
+	// 5 -1.1$, 6 1.1-$, 7 1.1$-, 8 -1.1 $, 9 -$ 1.1, 10 1.1 $-, 11 $ 1.1-,
+	// 12 $ -1.1, 13 1.1- $, 14 ($ 1.1), 15 (1.1 $).
+	CurrencyNegativePattern int
+
+	// PercentSymbol is the percent symbol, usually "%".
+	PercentSymbol string
+
+	// PercentPositivePattern places the percent symbol of positive
+	// percentages: 0 1.1 %, 1 1.1%, 2 %1.1, 3 % 1.1.
+	PercentPositivePattern int
+
+	// PercentNegativePattern places the percent symbol and sign of negative
+	// percentages: 0 -1.1 %, 1 -1.1%, 2 -%1.1, 3 %-1.1, 4 %1.1-, 5 1.1-%,
+	// 6 1.1%-, 7 -% 1.1, 8 1.1 %-, 9 % 1.1-, 10 % -1.1, 11 1.1- %.
+	PercentNegativePattern int
+
+	// ShortDatePattern, LongDatePattern, TimePattern and ShortTimePattern
+	// are date and time patterns, see FormatDateTime.
+	ShortDatePattern string
+	LongDatePattern  string
+	TimePattern      string
+	ShortTimePattern string
+
+	// AMDesignator and PMDesignator are appended to times in the 12 hour
+	// format.
+	AMDesignator string
+	PMDesignator string
+
+	// MonthNames and AbbreviatedMonthNames are the names of the months,
+	// starting with January.
+	MonthNames            [12]string
+	AbbreviatedMonthNames [12]string
+
+	// DayNames and AbbreviatedDayNames are the names of the days of the
+	// week, starting with Sunday like time.Weekday.
+	DayNames            [7]string
+	AbbreviatedDayNames [7]string
+}
+
+// Invariant returns a new locale with the culture independent conventions of
+// the invariant locale of Windows, which are those of English without a
+// region.
+func Invariant() *Locale {
+	return &Locale{
+		DecimalSeparator:         ".",
+		GroupSeparator:           ",",
+		Grouping:                 []int{3},
+		NegativeSign:             "-",
+		NegativeNumberPattern:    1,
+		CurrencySymbol:           "¤",
+		CurrencyDecimals:         2,
+		CurrencyDecimalSeparator: ".",
+		CurrencyGroupSeparator:   ",",
+		PercentSymbol:            "%",
+		ShortDatePattern:         "MM/dd/yyyy",
+		LongDatePattern:          "dddd, dd MMMM yyyy",
+		TimePattern:              "HH:mm:ss",
+		ShortTimePattern:         "HH:mm",
+		AMDesignator:             "AM",
+		PMDesignator:             "PM",
+		MonthNames: [12]string{
+			"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+		AbbreviatedMonthNames: [12]string{
+			"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+			"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+		},
+		DayNames: [7]string{
+			"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+		},
+		AbbreviatedDayNames: [7]string{
+			"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat",
+		},
+	}
+}
+
+// ParseGrouping parses a grouping in the format of Windows, like "3;0" or
+// "3;2;0", into the sizes of Locale.Grouping.
+func ParseGrouping(s string) []int {
+	var grouping []int
+	for _, part := range strings.Split(s, ";") {
+		size, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || size <= 0 {
+			break
+		}
+		grouping = append(grouping, size)
+	}
+
+	return grouping
+}
+
+// group inserts sep between the digit groups of the integer digits.
+func group(digits, sep string, grouping []int) string {
+	if len(grouping) == 0 || sep == "" {
+		return digits
+	}
+
+	var groups []string
+	for i := 0; len(digits) > 0; i++ {
+		size := grouping[len(grouping)-1]
+		if i < len(grouping) {
+			size = grouping[i]
+		}
+		if size >= len(digits) {
+			groups = append(groups, digits)
+			break
+		}
+
+		groups = append(groups, digits[len(digits)-size:])
+		digits = digits[:len(digits)-size]
+	}
+
+	var sb strings.Builder
+	for i := len(groups) - 1; i >= 0; i-- {
+		sb.WriteString(groups[i])
+		if i > 0 {
+			sb.WriteString(sep)
+		}
+	}
+
+	return sb.String()
+}
+
+// formatDecimal formats the decimal number s, as formatted by strconv or
+// big.Rat.FloatString, with the separators decimalSep and groupSep. It
+// returns the absolute value and whether the number is negative and not
+// zero.
+func (l *Locale) formatDecimal(s, decimalSep, groupSep string, grouped bool) (abs string, negative bool) {
+	if strings.HasPrefix(s, "-") {
+		s = s[1:]
+		negative = strings.ContainsAny(s, "123456789")
+	}
+
+	intPart, frac, _ := strings.Cut(s, ".")
+	if grouped {
+		intPart = group(intPart, groupSep, l.Grouping)
+	}
+	if frac != "" {
+		return intPart + decimalSep + frac, negative
+	}
+
+	return intPart, negative
+}
+
+func isSpecial(f float64) bool {
+	return math.IsNaN(f) || math.IsInf(f, 0)
+}
+
+// FormatFloat formats f with prec fraction digits and the decimal separator of
+// l. Negative numbers get a leading "-", whatever the negative number pattern
+// of l, so the result is suitable for editing.
+func (l *Locale) FormatFloat(f float64, prec int) string {
+	return l.formatSimple(strconv.FormatFloat(f, 'f', prec, 64), false)
+}
+
+// FormatFloatGrouped is like FormatFloat, but groups the digits of the
+// integer part.
+func (l *Locale) FormatFloatGrouped(f float64, prec int) string {
+	return l.formatSimple(strconv.FormatFloat(f, 'f', prec, 64), true)
+}
+
+// FormatBigRat formats r with prec fraction digits like FormatFloat, grouping
+// the digits of the integer part if grouped is true.
+func (l *Locale) FormatBigRat(r *big.Rat, prec int, grouped bool) string {
+	return l.formatSimple(r.FloatString(prec), grouped)
+}
+
+func (l *Locale) formatSimple(s string, grouped bool) string {
+	switch s {
+	case "NaN", "-Inf", "+Inf":
+		return s
+	}
+
+	abs, negative := l.formatDecimal(s, l.DecimalSeparator, l.GroupSeparator, grouped)
+	if negative {
+		return "-" + abs
+	}
+
+	return abs
+}
+
+// FormatNumber formats f with prec grouped fraction digits for display,
+// placing the sign of negative numbers according to NegativeNumberPattern.
+func (l *Locale) FormatNumber(f float64, prec int) string {
+	if isSpecial(f) {
+		return strconv.FormatFloat(f, 'f', prec, 64)
+	}
+
+	abs, negative := l.formatDecimal(strconv.FormatFloat(f, 'f', prec, 64), l.DecimalSeparator, l.GroupSeparator, true)
+	if !negative {
+		return abs
+	}
+
+	return l.expand(negativeNumberPatterns, l.NegativeNumberPattern, abs, "")
+}
+
+// FormatCurrency formats the currency amount f with CurrencyDecimals
+// fraction digits and the currency symbol of l.
+func (l *Locale) FormatCurrency(f float64) string {
+	if isSpecial(f) {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+
+	abs, negative := l.formatDecimal(strconv.FormatFloat(f, 'f', l.CurrencyDecimals, 64), l.CurrencyDecimalSeparator, l.CurrencyGroupSeparator, true)
+	if negative {
+		return l.expand(currencyNegativePatterns, l.CurrencyNegativePattern, abs, l.CurrencySymbol)
+	}
+
+	return l.expand(currencyPositivePatterns, l.CurrencyPositivePattern, abs, l.CurrencySymbol)
+}
+
+// FormatPercent formats f, which is in percent, so 12.5 for 12.5 %, with prec
+// fraction digits and the percent symbol of l.
+func (l *Locale) FormatPercent(f float64, prec int) string {
+	if isSpecial(f) {
+		return strconv.FormatFloat(f, 'f', prec, 64)
+	}
+
+	abs, negative := l.formatDecimal(strconv.FormatFloat(f, 'f', prec, 64), l.DecimalSeparator, l.GroupSeparator, true)
+	if negative {
+		return l.expand(percentNegativePatterns, l.PercentNegativePattern, abs, l.PercentSymbol)
+	}
+
+	return l.expand(percentPositivePatterns, l.PercentPositivePattern, abs, l.PercentSymbol)
+}
+
+// The patterns of the Windows locale settings. In them, n stands for the
+// number, $ for the currency or percent symbol and - for the negative sign.
+var (
+	negativeNumberPatterns   = []string{"(n)", "-n", "- n", "n-", "n -"}
+	currencyPositivePatterns = []string{"$n", "n$", "$ n", "n $"}
+	currencyNegativePatterns = []string{
+		"($n)", "-$n", "$-n", "$n-", "(n$)", "-n$", "n-$", "n$-",
+		"-n $", "-$ n", "n $-", "$ n-", "$ -n", "n- $", "($ n)", "(n $)",
+	}
+	percentPositivePatterns = []string{"n $", "n$", "$n", "$ n"}
+	percentNegativePatterns = []string{
+		"-n $", "-n$", "-$n", "$-n", "$n-", "n-$", "n$-", "-$ n", "n $-", "$ n-", "$ -n", "n- $",
+	}
+)
+
+// expand expands the pattern with index i of patterns for the number n and
+// the symbol symbol. Invalid indexes select the first pattern.
+func (l *Locale) expand(patterns []string, i int, n, symbol string) string {
+	if i < 0 || i >= len(patterns) {
+		i = 0
+	}
+
+	var sb strings.Builder
+	for _, r := range patterns[i] {
+		switch r {
+		case 'n':
+			sb.WriteString(n)
+
+		case '$':
+			sb.WriteString(symbol)
+
+		case '-':
+			sb.WriteString(l.NegativeSign)
+
+		case ' ':
+			// Windows uses a no-break space, so the number is not wrapped.
+			sb.WriteRune('\u00a0')
+
+		default:
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package locale
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func german() *Locale {
+	l := Invariant()
+	l.Name = "de-DE"
+	l.DecimalSeparator = ","
+	l.GroupSeparator = "."
+	l.CurrencySymbol = "€"
+	l.CurrencyDecimalSeparator = ","
+	l.CurrencyGroupSeparator = "."
+	l.CurrencyPositivePattern = 3
+	l.CurrencyNegativePattern = 8
+	l.ShortDatePattern = "dd.MM.yyyy"
+	l.LongDatePattern = "dddd, d. MMMM yyyy"
+	l.DayNames[1] = "Montag"
+	l.MonthNames[0] = "Januar"
+	return l
+}
+
+func french() *Locale {
+	l := Invariant()
+	l.DecimalSeparator = ","
+	l.GroupSeparator = " "
+	l.PercentSymbol = "%"
+	return l
+}
+
+func indian() *Locale {
+	l := Invariant()
+	l.Name = "en-IN"
+	l.Grouping = []int{3, 2}
+	l.NegativeNumberPattern = 0
+	l.CurrencySymbol = "₹"
+	l.CurrencyNegativePattern = 12
+	return l
+}
+
+func TestFormat(t *testing.T) {
+	de, fr, in, inv := german(), french(), indian(), Invariant()
+
+	tests := []struct {
+		got, want string
+	}{
+		{inv.FormatFloat(1234.5, 2), "1234.50"},
+		{inv.FormatFloatGrouped(1234567.891, 2), "1,234,567.89"},
+		{inv.FormatFloatGrouped(-123, 0), "-123"},
+		{inv.FormatFloatGrouped(-0.001, 2), "0.00"},
+		{de.FormatFloatGrouped(-1234567.5, 1), "-1.234.567,5"},
+		{fr.FormatFloatGrouped(1234.5, 1), "1 234,5"},
+		{in.FormatFloatGrouped(12345678, 0), "1,23,45,678"},
+		{in.FormatNumber(-1234, 1), "(1,234.0)"},
+		{de.FormatNumber(-1234, 0), "-1.234"},
+		{inv.FormatNumber(999, 0), "999"},
+		{de.FormatCurrency(1234.5), "1.234,50\u00a0€"},
+		{de.FormatCurrency(-1234.5), "-1.234,50\u00a0€"},
+		{in.FormatCurrency(-5), "₹\u00a0-5.00"},
+		{inv.FormatPercent(12.5, 1), "12.5\u00a0%"},
+		{inv.FormatPercent(-12.6, 0), "-13\u00a0%"},
+		{de.FormatBigRat(big.NewRat(-10, 3), 3, true), "-3,333"},
+		{inv.FormatFloatGrouped(12345, 2), "12,345.00"},
+		{Invariant().FormatNumber(1.5, -1), "1.5"},
+	}
+
+	for i, test := range tests {
+		if test.got != test.want {
+			t.Errorf("%d: got %q, want %q", i, test.got, test.want)
+		}
+	}
+
+	noGrouping := Invariant()
+	noGrouping.Grouping = nil
+	if got := noGrouping.FormatFloatGrouped(1234567, 0); got != "1234567" {
+		t.Errorf("without grouping: got %q", got)
+	}
+}
+
+func TestParseFloat(t *testing.T) {
+	de, fr, in, inv := german(), french(), indian(), Invariant()
+
+	tests := []struct {
+		l    *Locale
+		s    string
+		want float64
+	}{
+		{inv, "1,234.5", 1234.5},
+		{inv, " 42 ", 42},
+		{inv, "-1.5", -1.5},
+		{inv, "1.5-", -1.5},
+		{inv, "(1.5)", -1.5},
+		{inv, ".5", 0.5},
+		{inv, "+3", 3},
+		{de, "1.234,5", 1234.5},
+		{de, "1.234", 1234},
+		{de, "-1.234,50 €", -1234.5},
+		{de, "€1,5", 1.5},
+		{fr, "1 234,5", 1234.5},
+		{fr, "1 234,5", 1234.5},
+		{fr, "1.5", 1.5},
+		{fr, "12,5 %", 12.5},
+		{in, "₹\u00a0-5.00", -5},
+		{in, "1,23,45,678", 12345678},
+	}
+
+	for _, test := range tests {
+		got, err := test.l.ParseFloat(test.s)
+		if err != nil {
+			t.Errorf("ParseFloat(%q): %v", test.s, err)
+		} else if got != test.want {
+			t.Errorf("ParseFloat(%q): got %v, want %v", test.s, got, test.want)
+		}
+	}
+
+	for _, s := range []string{"", "abc", "1-2", "--1", "(-1)", "1.2.3x", "-"} {
+		if _, err := inv.ParseFloat(s); err == nil {
+			t.Errorf("ParseFloat(%q): got nil error", s)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	locales := []*Locale{Invariant(), german(), french(), indian()}
+	values := []float64{0, 1, -1, 0.5, -1234567.89, 1e9, 12.34}
+
+	for _, l := range locales {
+		for _, v := range values {
+			for _, s := range []string{
+				l.FormatFloat(v, 2),
+				l.FormatFloatGrouped(v, 2),
+				l.FormatNumber(v, 2),
+				l.FormatCurrency(v),
+				l.FormatPercent(v, 2),
+			} {
+				got, err := l.ParseFloat(s)
+				if err != nil || got != v {
+					t.Errorf("%s: ParseFloat(%q): got %v, %v, want %v", l.Name, s, got, err, v)
+				}
+			}
+		}
+	}
+}
+
+func TestParseGrouping(t *testing.T) {
+	tests := map[string][]int{
+		"3;0":   {3},
+		"3;2;0": {3, 2},
+		"3":     {3},
+		"0":     nil,
+		"":      nil,
+	}
+
+	for s, want := range tests {
+		if got := ParseGrouping(s); !reflect.DeepEqual(got, want) {
+			t.Errorf("ParseGrouping(%q): got %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestFormatDateTime(t *testing.T) {
+	de, inv := german(), Invariant()
+	date := time.Date(2026, time.January, 5, 14, 7, 9, 0, time.UTC)
+
+	tests := []struct {
+		l       *Locale
+		pattern string
+		want    string
+	}{
+		{de, de.ShortDatePattern, "05.01.2026"},
+		{de, de.LongDatePattern, "Montag, 5. Januar 2026"},
+		{inv, "d/M/yy", "5/1/26"},
+		{inv, "ddd MMM y", "Mon Jan 26"},
+		{inv, "h:mm tt", "2:07 PM"},
+		{inv, "hh:mm:ss t", "02:07:09 P"},
+		{inv, "HH'h'mm", "14h07"},
+		{inv, "'It''s' H", "It's 14"},
+		{inv, "yyyyy gg", "02026 "},
+	}
+
+	for _, test := range tests {
+		if got := test.l.FormatDateTime(date, test.pattern); got != test.want {
+			t.Errorf("FormatDateTime(%q): got %q, want %q", test.pattern, got, test.want)
+		}
+	}
+
+	midnight := time.Date(2026, time.March, 1, 0, 30, 0, 0, time.UTC)
+	if got := inv.FormatDateTime(midnight, "h tt"); got != "12 AM" {
+		t.Errorf("midnight: got %q", got)
+	}
+	if got := inv.FormatShortTime(midnight); got != "00:30" {
+		t.Errorf("FormatShortTime: got %q", got)
+	}
+}
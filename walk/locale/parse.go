@@ -0,0 +1,160 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package locale
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseFloat parses s, a number formatted by one of the Format methods of l
+// or typed by a user, so that ParseFloat(FormatX(f)) returns f rounded to the
+// formatted precision.
+//
+// It is forgiving: white space, group separators and the currency and
+// percent symbols are ignored, a negative sign may precede or follow the
+// number and parentheses denote a negative number. If the decimal separator
+// of l is not ".", and "." is not a group separator, "." is accepted as
+// decimal separator as well.
+func (l *Locale) ParseFloat(s string) (float64, error) {
+	orig := s
+	invalid := func() (float64, error) {
+		return 0, fmt.Errorf("locale: invalid number '%s'", orig)
+	}
+
+	s = strings.TrimSpace(s)
+	switch s {
+	case "NaN", "Inf", "+Inf", "-Inf":
+		return strconv.ParseFloat(s, 64)
+	}
+
+	for _, symbol := range []string{l.CurrencySymbol, l.PercentSymbol} {
+		if symbol != "" {
+			s = strings.Replace(s, symbol, "", 1)
+		}
+	}
+
+	if l.NegativeSign != "" && l.NegativeSign != "-" {
+		s = strings.ReplaceAll(s, l.NegativeSign, "-")
+	}
+	s = strings.ReplaceAll(s, "\u2212", "-")
+
+	// Remove white space, which includes no-break spaces used as group
+	// separators or between the number and symbols.
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '\u00a0' || r == '\u202f' {
+			return -1
+		}
+		return r
+	}, s)
+
+	var negative bool
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = s[1 : len(s)-1]
+	}
+
+	switch strings.Count(s, "-") {
+	case 0:
+
+	case 1:
+		if negative {
+			return invalid()
+		}
+
+		switch {
+		case strings.HasPrefix(s, "-"):
+			s = s[1:]
+
+		case strings.HasSuffix(s, "-"):
+			s = s[:len(s)-1]
+
+		default:
+			return invalid()
+		}
+		negative = true
+
+	default:
+		return invalid()
+	}
+	s = strings.TrimPrefix(s, "+")
+
+	intPart, frac := l.splitDecimal(s)
+
+	for _, sep := range l.groupSeparators() {
+		intPart = strings.ReplaceAll(intPart, sep, "")
+	}
+
+	if intPart == "" && frac == "" {
+		return invalid()
+	}
+	for _, part := range []string{intPart, frac} {
+		for _, r := range part {
+			if r < '0' || r > '9' {
+				return invalid()
+			}
+		}
+	}
+
+	if intPart == "" {
+		intPart = "0"
+	}
+	if negative {
+		intPart = "-" + intPart
+	}
+
+	f, err := strconv.ParseFloat(intPart+"."+frac, 64)
+	if err != nil {
+		return invalid()
+	}
+
+	return f, nil
+}
+
+func (l *Locale) groupSeparators() []string {
+	var seps []string
+	for _, sep := range []string{l.GroupSeparator, l.CurrencyGroupSeparator} {
+		if sep != "" && strings.TrimSpace(sep) != "" {
+			seps = append(seps, sep)
+		}
+	}
+
+	return seps
+}
+
+// splitDecimal splits s at its decimal separator, the last one if there are
+// several.
+func (l *Locale) splitDecimal(s string) (intPart, frac string) {
+	var decimalSeps []string
+	for _, sep := range []string{l.DecimalSeparator, l.CurrencyDecimalSeparator} {
+		if sep != "" {
+			decimalSeps = append(decimalSeps, sep)
+		}
+	}
+
+	index, length := -1, 0
+	for _, sep := range decimalSeps {
+		if i := strings.LastIndex(s, sep); i > index {
+			index, length = i, len(sep)
+		}
+	}
+	if index >= 0 {
+		return s[:index], s[index+length:]
+	}
+
+	for _, sep := range l.groupSeparators() {
+		if sep == "." {
+			return s, ""
+		}
+	}
+
+	if i := strings.LastIndexByte(s, '.'); i >= 0 {
+		return s[:i], s[i+1:]
+	}
+
+	return s, ""
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"time"
+
+	"github.com/xackery/wlk/walk/settings"
+)
+
+// MemorySettings is a Settings backend that keeps its values in memory only. Load and Save do
+// nothing. It is meant for tests and for applications that must not persist any state.
+type MemorySettings struct {
+	store          *settings.Store
+	expireDuration time.Duration
+}
+
+// NewMemorySettings returns a new, empty MemorySettings.
+func NewMemorySettings() *MemorySettings {
+	return &MemorySettings{store: settings.NewStore()}
+}
+
+func (ms *MemorySettings) Get(key string) (string, bool) {
+	record, ok := ms.store.Get(key)
+	return record.Value, ok
+}
+
+func (ms *MemorySettings) Timestamp(key string) (time.Time, bool) {
+	record, ok := ms.store.Get(key)
+	return record.Timestamp, ok
+}
+
+func (ms *MemorySettings) Put(key, value string) error {
+	return ms.put(key, value, false)
+}
+
+func (ms *MemorySettings) PutExpiring(key, value string) error {
+	return ms.put(key, value, true)
+}
+
+func (ms *MemorySettings) put(key, value string, expiring bool) error {
+	var timestamp time.Time
+	if expiring {
+		timestamp = time.Now()
+	}
+
+	if err := ms.store.Put(key, settings.Record{Value: value, Timestamp: timestamp}); err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+func (ms *MemorySettings) Remove(key string) error {
+	ms.store.Remove(key)
+
+	return nil
+}
+
+func (ms *MemorySettings) ExpireDuration() time.Duration {
+	return ms.expireDuration
+}
+
+func (ms *MemorySettings) SetExpireDuration(expireDuration time.Duration) {
+	ms.expireDuration = expireDuration
+}
+
+// Keys returns the keys of all values in lexical order.
+func (ms *MemorySettings) Keys() []string {
+	return ms.store.Keys(0, time.Time{})
+}
+
+func (ms *MemorySettings) Load() error {
+	return nil
+}
+
+func (ms *MemorySettings) Save() error {
+	return nil
+}
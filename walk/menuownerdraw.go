@@ -44,6 +44,7 @@ type MenuItemDrawContext struct {
 	ThemeFont    *Font     // The Font that the theme expects to be used for this item in its current state.
 	Rectangle    Rectangle // Bounds of the content within Canvas.
 	Padding      int       // Theme-compliant spacing that may be used for positioning between sub-components of the menu content.
+	RightToLeft  bool      // Whether the content should be drawn from right to left, see FormBase.SetMirrored.
 }
 
 // menuItemLayout contains the computed bounds for each component of an
@@ -391,6 +392,22 @@ func (ml *menuItemLayout) layout(sm *menuSharedMetrics, rect *win.RECT) {
 	stripMargins(&ml.chevronRect, sm.chevronMargins)
 }
 
+// mirror flips the layout horizontally within rect, for menus of mirrored forms.
+func (ml *menuItemLayout) mirror(rect *win.RECT) {
+	for _, r := range []*win.RECT{
+		&ml.checkboxRect,
+		&ml.checkboxBgRect,
+		&ml.contentRect,
+		&ml.gutterRect,
+		&ml.selectionRect,
+		&ml.separatorRect,
+		&ml.chevronRect,
+		&ml.chevronClipRect,
+	} {
+		r.Left, r.Right = rect.Left+rect.Right-r.Right, rect.Left+rect.Right-r.Left
+	}
+}
+
 // ownerDrawnMenuItemInfo is the per-item data that must be associated with any
 // menu item.
 type ownerDrawnMenuItemInfo struct {
@@ -563,6 +580,11 @@ func (odi *ownerDrawnMenuItemInfo) onDraw(w Window, dis *win.DRAWITEMSTRUCT) {
 
 	odi.layout.layout(sm, &dis.RcItem)
 
+	rtl := windowMirrored(w)
+	if rtl {
+		odi.layout.mirror(&dis.RcItem)
+	}
+
 	isSubMenu := odi.action.menu != nil
 	if isSubMenu {
 		// Windows unconditionally tries to draw an unthemed submenu chevron atop
@@ -638,6 +660,7 @@ func (odi *ownerDrawnMenuItemInfo) onDraw(w Window, dis *win.DRAWITEMSTRUCT) {
 		BoldFont:     sm.fontBold,
 		Rectangle:    rectangleFromRECT(odi.layout.contentRect),
 		Padding:      int(sm.contentMargins.LeftWidth),
+		RightToLeft:  rtl,
 	}
 
 	if odi.action.Default() {
@@ -677,7 +700,12 @@ func (defaultActionOwnerDrawHandler) OnMeasure(action *Action, mctx *MenuItemMea
 
 // OnDraw by default draws both the menu text and the accelerator text, if any.
 func (defaultActionOwnerDrawHandler) OnDraw(action *Action, dctx *MenuItemDrawContext) {
-	flags := uint32(win.DT_LEFT | win.DT_SINGLELINE)
+	textAlign, accelAlign := uint32(win.DT_LEFT), uint32(win.DT_RIGHT)
+	if dctx.RightToLeft {
+		textAlign, accelAlign = win.DT_RIGHT|win.DT_RTLREADING, win.DT_LEFT
+	}
+
+	flags := textAlign | win.DT_SINGLELINE
 	if (dctx.State & win.ODS_NOACCEL) != 0 {
 		flags |= win.DT_HIDEPREFIX
 	}
@@ -685,7 +713,7 @@ func (defaultActionOwnerDrawHandler) OnDraw(action *Action, dctx *MenuItemDrawCo
 	dctx.Theme.DrawText(dctx.Canvas, dctx.ThemeFont, win.MENU_POPUPITEM, dctx.ThemeStateID, action.Text(), flags, dctx.Rectangle, nil)
 
 	if action.shortcut.Key != 0 {
-		flags = win.DT_RIGHT | win.DT_SINGLELINE | win.DT_HIDEPREFIX
+		flags = accelAlign | win.DT_SINGLELINE | win.DT_HIDEPREFIX
 		dctx.Theme.DrawText(dctx.Canvas, dctx.ThemeFont, win.MENU_POPUPITEM, dctx.ThemeStateID, action.shortcut.String(), flags, dctx.Rectangle, nil)
 	}
 }
@@ -10,6 +10,7 @@ package walk
 import (
 	"testing"
 
+	"github.com/xackery/wlk/win"
 	"golang.org/x/sys/windows"
 )
 
@@ -36,3 +37,24 @@ func TestFindExplicitMnemonic(t *testing.T) {
 		}
 	}
 }
+
+func TestMenuItemLayoutMirror(t *testing.T) {
+	item := win.RECT{Left: 10, Top: 0, Right: 110, Bottom: 20}
+
+	var ml menuItemLayout
+	ml.gutterRect = win.RECT{Left: 10, Top: 0, Right: 30, Bottom: 20}
+	ml.contentRect = win.RECT{Left: 30, Top: 2, Right: 110, Bottom: 18}
+	ml.chevronRect = win.RECT{Left: 100, Top: 5, Right: 105, Bottom: 15}
+
+	ml.mirror(&item)
+
+	if want := (win.RECT{Left: 90, Top: 0, Right: 110, Bottom: 20}); ml.gutterRect != want {
+		t.Errorf("gutterRect: got %v, want %v", ml.gutterRect, want)
+	}
+	if want := (win.RECT{Left: 10, Top: 2, Right: 90, Bottom: 18}); ml.contentRect != want {
+		t.Errorf("contentRect: got %v, want %v", ml.contentRect, want)
+	}
+	if want := (win.RECT{Left: 15, Top: 5, Right: 20, Bottom: 15}); ml.chevronRect != want {
+		t.Errorf("chevronRect: got %v, want %v", ml.chevronRect, want)
+	}
+}
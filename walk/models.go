@@ -325,6 +325,7 @@ type CellStyle struct {
 	hdc             win.HDC
 	dpi             int
 	canvas          *Canvas
+	rightToLeft     bool
 	BackgroundColor wcolor.Color
 	TextColor       wcolor.Color
 	Font            *Font
@@ -354,6 +355,14 @@ func (cs *CellStyle) BoundsPixels() Rectangle {
 	return cs.bounds
 }
 
+// RightToLeft returns whether the TableView is mirrored, see
+// FormBase.SetMirrored. The Canvas of a mirrored TableView is mirrored too, so
+// drawing at the start of BoundsPixels ends up on the right, but text that is
+// aligned explicitly may have to be aligned the other way.
+func (cs *CellStyle) RightToLeft() bool {
+	return cs.rightToLeft
+}
+
 func (cs *CellStyle) Canvas() *Canvas {
 	if cs.canvas != nil {
 		cs.canvas.dpi = cs.dpi
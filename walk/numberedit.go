@@ -11,8 +11,6 @@ import (
 	"bytes"
 	"fmt"
 	"math"
-	"strconv"
-	"strings"
 	"syscall"
 	"unsafe"
 
@@ -577,6 +575,13 @@ func (nle *numberLineEdit) setTextFromValue(value float64) error {
 	return nle.SetText(nle.buf.String())
 }
 
+// applyLocale formats the value with the current locale, unless it is being edited.
+func (nle *numberLineEdit) applyLocale() {
+	if !nle.inEditMode {
+		nle.setTextFromValue(nle.value)
+	}
+}
+
 func (nle *numberLineEdit) endEdit() error {
 	if err := nle.setTextFromValue(nle.value); err != nil {
 		return err
@@ -654,14 +659,14 @@ func (nle *numberLineEdit) tryUpdateValue(setText bool) bool {
 	t := nle.textUTF16()
 	t = t[len(nle.prefix) : len(t)-len(nle.suffix)]
 
-	text := strings.Replace(syscall.UTF16ToString(t), decimalSepS, ".", 1)
+	text := syscall.UTF16ToString(t)
 
 	switch text {
-	case "", ".":
+	case "", decimalSepS:
 		text = "0"
 	}
 
-	if value, err := strconv.ParseFloat(text, 64); err == nil {
+	if value, err := ParseFloat(text); err == nil {
 		if nle.minValue == nle.maxValue || value >= nle.minValue && value <= nle.maxValue {
 			return nle.setValue(value, setText) == nil
 		}
@@ -148,10 +148,14 @@ func (nl *NumberLabel) SetValue(value float64) error {
 	return nil
 }
 
+func (nl *NumberLabel) applyLocale() {
+	nl.updateText()
+}
+
 func (nl *NumberLabel) updateText() (changed bool, err error) {
 	var sb strings.Builder
 
-	sb.WriteString(FormatFloatGrouped(nl.value, nl.decimals))
+	sb.WriteString(CurrentLocale().FormatNumber(nl.value, nl.decimals))
 
 	if nl.suffix != "" {
 		sb.WriteString(nl.suffix)
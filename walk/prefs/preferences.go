@@ -0,0 +1,324 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package prefs
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// DefaultVersionKey is the settings key the schema version is stored under,
+// unless changed with SetVersionKey.
+const DefaultVersionKey = "preferences.version"
+
+// Migration upgrades the stored preferences from one schema version to the
+// next, typically by renaming keys or converting values in store.
+type Migration func(store Store) error
+
+// Preferences loads a preferences struct from a Store and saves it back.
+type Preferences struct {
+	target     reflect.Value
+	fields     []Field
+	store      Store
+	version    int
+	versionKey string
+	migrations map[int]Migration
+	saved      map[string]string // formatted values as of the last Load or Save
+	changed    map[string]*Event
+}
+
+// New returns a new Preferences for target, a pointer to a preferences
+// struct, stored in store. version is the current schema version, see
+// RegisterMigration.
+func New(target interface{}, store Store, version int) (*Preferences, error) {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("prefs: target must be a non-nil pointer to a struct")
+	}
+
+	fields, err := Fields(v.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Preferences{
+		target:     v.Elem(),
+		fields:     fields,
+		store:      store,
+		version:    version,
+		versionKey: DefaultVersionKey,
+		migrations: make(map[int]Migration),
+		changed:    make(map[string]*Event),
+	}
+
+	for _, f := range fields {
+		p.changed[f.Key] = new(Event)
+	}
+
+	return p, nil
+}
+
+// Target returns the pointer to the preferences struct.
+func (p *Preferences) Target() interface{} {
+	return p.target.Addr().Interface()
+}
+
+// Fields returns the fields of the preferences struct.
+func (p *Preferences) Fields() []Field {
+	return p.fields
+}
+
+// Field returns the field of key, or nil.
+func (p *Preferences) Field(key string) *Field {
+	for i := range p.fields {
+		if p.fields[i].Key == key {
+			return &p.fields[i]
+		}
+	}
+
+	return nil
+}
+
+// Store returns the store of p.
+func (p *Preferences) Store() Store {
+	return p.store
+}
+
+// Version returns the current schema version.
+func (p *Preferences) Version() int {
+	return p.version
+}
+
+// VersionKey returns the settings key the schema version is stored under.
+func (p *Preferences) VersionKey() string {
+	return p.versionKey
+}
+
+// SetVersionKey sets the settings key the schema version is stored under.
+func (p *Preferences) SetVersionKey(key string) {
+	p.versionKey = key
+}
+
+// RegisterMigration registers m to upgrade the stored preferences from schema
+// version fromVersion to fromVersion+1. Load runs the migrations from the
+// stored version up to the current version in order. Stored preferences
+// without a version are at version 0.
+func (p *Preferences) RegisterMigration(fromVersion int, m Migration) {
+	p.migrations[fromVersion] = m
+}
+
+// Changed returns the event published when the value of the field key
+// changes through Load or Save, or nil if there is no such field.
+func (p *Preferences) Changed(key string) *Event {
+	return p.changed[key]
+}
+
+// StoredVersion returns the schema version of the preferences in the store.
+func (p *Preferences) StoredVersion() (int, error) {
+	s, ok := p.store.Get(p.versionKey)
+	if !ok {
+		return 0, nil
+	}
+
+	version, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("prefs: invalid version '%s'", s)
+	}
+
+	return version, nil
+}
+
+// Load migrates the stored preferences to the current schema version, if
+// necessary, and reads them into the preferences struct. Fields that are not
+// stored, or whose stored value is invalid, get their default value.
+//
+// If values differ from those of the previous Load or Save, their Changed
+// events are published.
+func (p *Preferences) Load() error {
+	version, err := p.StoredVersion()
+	if err != nil {
+		return err
+	}
+
+	if version < p.version {
+		for v := version; v < p.version; v++ {
+			if m := p.migrations[v]; m != nil {
+				if err := m(p.store); err != nil {
+					return fmt.Errorf("prefs: migration from version %d failed: %w", v, err)
+				}
+			}
+		}
+
+		if err := p.store.Put(p.versionKey, strconv.Itoa(p.version)); err != nil {
+			return err
+		}
+	}
+
+	var firstErr error
+	for i := range p.fields {
+		f := &p.fields[i]
+
+		value := f.Default
+		if s, ok := p.store.Get(f.Key); ok {
+			if err := f.check(s); err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+			} else {
+				value = s
+			}
+		}
+
+		p.setField(f, value)
+	}
+
+	p.commit()
+
+	return firstErr
+}
+
+// check returns an error if s is not a valid value of f.
+func (f *Field) check(s string) error {
+	v := reflect.New(f.Type).Elem()
+	if err := parseValue(v, s); err != nil {
+		return fmt.Errorf("prefs: invalid value '%s' of key '%s': %w", s, f.Key, err)
+	}
+
+	if f.Choices != nil {
+		found := false
+		for _, c := range f.Choices {
+			if c == s {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("prefs: value '%s' of key '%s' is not one of the choices", s, f.Key)
+		}
+	}
+
+	if f.HasRange {
+		var n float64
+		switch {
+		case f.IsFloat():
+			n = v.Float()
+
+		case v.CanInt():
+			n = float64(v.Int())
+
+		default:
+			n = float64(v.Uint())
+		}
+
+		if n < f.MinValue || n > f.MaxValue {
+			return fmt.Errorf("prefs: value '%s' of key '%s' is out of range", s, f.Key)
+		}
+	}
+
+	return nil
+}
+
+func (p *Preferences) setField(f *Field, s string) {
+	v := p.target.Field(f.index)
+	if s == "" && f.Type.Kind() != reflect.String {
+		v.Set(reflect.Zero(f.Type))
+		return
+	}
+
+	parseValue(v, s)
+}
+
+// Save writes the preferences struct to the store, along with the schema
+// version, and saves the store if it has a Save method. The Changed events of
+// values that differ from those of the previous Load or Save are published.
+func (p *Preferences) Save() error {
+	for i := range p.fields {
+		f := &p.fields[i]
+
+		if err := p.store.Put(f.Key, formatValue(p.target.Field(f.index))); err != nil {
+			return err
+		}
+	}
+
+	if err := p.store.Put(p.versionKey, strconv.Itoa(p.version)); err != nil {
+		return err
+	}
+
+	if saver, ok := p.store.(interface{ Save() error }); ok {
+		if err := saver.Save(); err != nil {
+			return err
+		}
+	}
+
+	p.commit()
+
+	return nil
+}
+
+// Reset sets all fields of the preferences struct to their default values.
+// Like other changes, it takes effect in the store with the next Save.
+func (p *Preferences) Reset() {
+	for i := range p.fields {
+		p.setField(&p.fields[i], p.fields[i].Default)
+	}
+}
+
+// commit records the current values and publishes the Changed events of the
+// fields whose values changed.
+func (p *Preferences) commit() {
+	first := p.saved == nil
+	previous := p.saved
+
+	p.saved = make(map[string]string, len(p.fields))
+	for _, f := range p.fields {
+		p.saved[f.Key] = formatValue(p.target.Field(f.index))
+	}
+
+	if first {
+		return
+	}
+
+	for _, f := range p.fields {
+		if p.saved[f.Key] != previous[f.Key] {
+			p.changed[f.Key].publish(f.Key)
+		}
+	}
+}
+
+// ChangeHandler is called with the key of the preference that changed.
+type ChangeHandler func(key string)
+
+// Event notifies handlers of changes to a preference.
+type Event struct {
+	handlers []ChangeHandler
+}
+
+// Attach adds handler to e and returns a handle for Detach.
+func (e *Event) Attach(handler ChangeHandler) int {
+	for i, h := range e.handlers {
+		if h == nil {
+			e.handlers[i] = handler
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handler)
+
+	return len(e.handlers) - 1
+}
+
+// Detach removes the handler of handle from e.
+func (e *Event) Detach(handle int) {
+	e.handlers[handle] = nil
+}
+
+func (e *Event) publish(key string) {
+	for _, handler := range e.handlers {
+		if handler != nil {
+			handler(key)
+		}
+	}
+}
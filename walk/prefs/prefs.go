@@ -0,0 +1,277 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package prefs implements typed application preferences on top of the
+// string based walk.Settings.
+//
+// Preferences are declared as a struct, whose exported fields carry a pref
+// tag naming their settings key:
+//
+//	type Prefs struct {
+//		Autosave bool    `pref:"general.autosave" default:"true" label:"Autosave"`
+//		Interval int     `pref:"general.interval" default:"5" label:"Interval" min:"1" max:"60"`
+//		Theme    string  `pref:"appearance.theme" default:"system" choices:"system,light,dark"`
+//		Zoom     float64 `pref:"appearance.zoom" default:"1"`
+//	}
+//
+// The part of the key before the last dot is the category of the field,
+// which cpl.NewPreferencesDialog uses to group fields into tabs. Supported
+// field types are bool, string and the integer and floating point types.
+package prefs
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Store is the storage of preferences. walk.Settings implements it. If a
+// Store also has a method Save() error, it is called by Preferences.Save.
+type Store interface {
+	Get(key string) (string, bool)
+	Put(key, value string) error
+	Remove(key string) error
+}
+
+// Field describes a field of a preferences struct.
+type Field struct {
+	// Key is the settings key, from the pref tag.
+	Key string
+
+	// Name is the name of the struct field.
+	Name string
+
+	// Label is the text describing the field, from the label tag. It
+	// defaults to Name.
+	Label string
+
+	// Category is the category of the field, from the category tag. It
+	// defaults to the part of Key before the last dot, with its first letter
+	// upper cased.
+	Category string
+
+	// Description is an optional longer description, from the description
+	// tag.
+	Description string
+
+	// Default is the default value, from the default tag.
+	Default string
+
+	// Choices are the allowed values of a string field, from the comma
+	// separated choices tag.
+	Choices []string
+
+	// MinValue and MaxValue are the range of a numeric field, from the min
+	// and max tags. HasRange reports whether either tag is present. Without
+	// the tags, the range spans all float64 values.
+	MinValue, MaxValue float64
+	HasRange           bool
+
+	// Type is the type of the struct field.
+	Type reflect.Type
+
+	index int
+}
+
+// IsNumber returns whether f is an integer or floating point field.
+func (f *Field) IsNumber() bool {
+	switch f.Type.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// IsFloat returns whether f is a floating point field.
+func (f *Field) IsFloat() bool {
+	kind := f.Type.Kind()
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+// Fields returns the fields of the preferences struct type t, in declaration
+// order. Fields without a pref tag are ignored.
+func Fields(t reflect.Type) ([]Field, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("prefs: %s is not a struct type", t)
+	}
+
+	var fields []Field
+	keys := make(map[string]bool)
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		key, ok := sf.Tag.Lookup("pref")
+		if !ok {
+			continue
+		}
+		if sf.PkgPath != "" {
+			return nil, fmt.Errorf("prefs: field %s is not exported", sf.Name)
+		}
+		if key == "" || keys[key] {
+			return nil, fmt.Errorf("prefs: field %s has an empty or duplicate key", sf.Name)
+		}
+		keys[key] = true
+
+		f := Field{
+			Key:         key,
+			Name:        sf.Name,
+			Label:       sf.Tag.Get("label"),
+			Category:    sf.Tag.Get("category"),
+			Description: sf.Tag.Get("description"),
+			Default:     sf.Tag.Get("default"),
+			Type:        sf.Type,
+			index:       i,
+		}
+
+		if f.Label == "" {
+			f.Label = sf.Name
+		}
+		if f.Category == "" {
+			f.Category = categoryOf(key)
+		}
+
+		switch f.Type.Kind() {
+		case reflect.Bool, reflect.String:
+		default:
+			if !f.IsNumber() {
+				return nil, fmt.Errorf("prefs: field %s has unsupported type %s", sf.Name, sf.Type)
+			}
+		}
+
+		if choices, ok := sf.Tag.Lookup("choices"); ok {
+			if f.Type.Kind() != reflect.String {
+				return nil, fmt.Errorf("prefs: field %s has choices but is not a string", sf.Name)
+			}
+			f.Choices = strings.Split(choices, ",")
+		}
+
+		if err := f.parseRange(sf.Tag); err != nil {
+			return nil, err
+		}
+
+		// Check the default value now rather than on every Load.
+		if err := parseValue(reflect.New(f.Type).Elem(), f.Default); err != nil && f.Default != "" {
+			return nil, fmt.Errorf("prefs: field %s has invalid default: %v", sf.Name, err)
+		}
+
+		fields = append(fields, f)
+	}
+
+	return fields, nil
+}
+
+func (f *Field) parseRange(tag reflect.StructTag) error {
+	f.MinValue, f.MaxValue = -math.MaxFloat64, math.MaxFloat64
+
+	for _, r := range []struct {
+		name  string
+		value *float64
+	}{
+		{"min", &f.MinValue},
+		{"max", &f.MaxValue},
+	} {
+		s, ok := tag.Lookup(r.name)
+		if !ok {
+			continue
+		}
+		if !f.IsNumber() {
+			return fmt.Errorf("prefs: field %s has %s but is not a number", f.Name, r.name)
+		}
+
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("prefs: field %s has invalid %s: %v", f.Name, r.name, err)
+		}
+		*r.value = v
+		f.HasRange = true
+	}
+
+	return nil
+}
+
+func categoryOf(key string) string {
+	i := strings.LastIndexByte(key, '.')
+	if i <= 0 {
+		return ""
+	}
+
+	category := key[:i]
+	if j := strings.LastIndexByte(category, '.'); j >= 0 {
+		category = category[j+1:]
+	}
+
+	return strings.ToUpper(category[:1]) + category[1:]
+}
+
+// formatValue formats v, the value of a preferences field.
+func formatValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+
+	case reflect.String:
+		return v.String()
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32)
+
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	}
+
+	return ""
+}
+
+// parseValue parses s into v, the value of a preferences field.
+func parseValue(v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+
+	case reflect.String:
+		v.SetString(s)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	}
+
+	return nil
+}
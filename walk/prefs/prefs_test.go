@@ -0,0 +1,234 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package prefs
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type mapStore struct {
+	values map[string]string
+	saves  int
+}
+
+func newMapStore(values map[string]string) *mapStore {
+	if values == nil {
+		values = make(map[string]string)
+	}
+
+	return &mapStore{values: values}
+}
+
+func (s *mapStore) Get(key string) (string, bool) {
+	v, ok := s.values[key]
+	return v, ok
+}
+
+func (s *mapStore) Put(key, value string) error {
+	s.values[key] = value
+	return nil
+}
+
+func (s *mapStore) Remove(key string) error {
+	delete(s.values, key)
+	return nil
+}
+
+func (s *mapStore) Save() error {
+	s.saves++
+	return nil
+}
+
+type testPrefs struct {
+	Autosave bool    `pref:"general.autosave" default:"true" label:"Autosave"`
+	Interval int     `pref:"general.interval" default:"5" min:"1" max:"60"`
+	Theme    string  `pref:"appearance.theme" default:"system" choices:"system,light,dark"`
+	Zoom     float64 `pref:"appearance.zoom" default:"1" category:"View"`
+	Limit    uint8   `pref:"limit"`
+	Ignored  string
+}
+
+func TestFields(t *testing.T) {
+	fields, err := Fields(reflect.TypeOf(testPrefs{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type summary struct {
+		Key, Name, Label, Category string
+		HasRange                   bool
+	}
+	var got []summary
+	for _, f := range fields {
+		got = append(got, summary{f.Key, f.Name, f.Label, f.Category, f.HasRange})
+	}
+	want := []summary{
+		{"general.autosave", "Autosave", "Autosave", "General", false},
+		{"general.interval", "Interval", "Interval", "General", true},
+		{"appearance.theme", "Theme", "Theme", "Appearance", false},
+		{"appearance.zoom", "Zoom", "Zoom", "View", false},
+		{"limit", "Limit", "Limit", "", false},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if !reflect.DeepEqual(fields[2].Choices, []string{"system", "light", "dark"}) {
+		t.Errorf("choices: got %q", fields[2].Choices)
+	}
+	if fields[1].MinValue != 1 || fields[1].MaxValue != 60 {
+		t.Errorf("range: got %v..%v, want 1..60", fields[1].MinValue, fields[1].MaxValue)
+	}
+
+	for _, v := range []interface{}{
+		struct {
+			A []int `pref:"a"`
+		}{},
+		struct {
+			A bool `pref:"a" default:"maybe"`
+		}{},
+		struct {
+			A int `pref:"a"`
+			B int `pref:"a"`
+		}{},
+		struct {
+			A bool `pref:"a" min:"1"`
+		}{},
+		struct {
+			A int `pref:"a" choices:"1,2"`
+		}{},
+		0,
+	} {
+		if _, err := Fields(reflect.TypeOf(v)); err == nil {
+			t.Errorf("Fields(%T): got nil error", v)
+		}
+	}
+}
+
+func TestLoadSave(t *testing.T) {
+	store := newMapStore(map[string]string{
+		"general.autosave": "false",
+		"general.interval": "99",
+		"appearance.theme": "dark",
+	})
+
+	var p testPrefs
+	prefs, err := New(&p, store, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := prefs.Load(); err == nil {
+		t.Error("Load with out of range interval: got nil error")
+	}
+
+	want := testPrefs{Autosave: false, Interval: 5, Theme: "dark", Zoom: 1}
+	if p != want {
+		t.Errorf("Load: got %+v, want %+v", p, want)
+	}
+
+	var changed []string
+	for _, f := range prefs.Fields() {
+		prefs.Changed(f.Key).Attach(func(key string) {
+			changed = append(changed, key)
+		})
+	}
+
+	p.Zoom = 1.5
+	p.Theme = "light"
+	if err := prefs.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"appearance.theme", "appearance.zoom"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("Changed after Save: got %q, want %q", changed, want)
+	}
+	if store.values["appearance.zoom"] != "1.5" || store.values["general.interval"] != "5" || store.values[DefaultVersionKey] != "1" {
+		t.Errorf("Save: got %v", store.values)
+	}
+	if store.saves != 1 {
+		t.Errorf("Save: got %d store saves, want 1", store.saves)
+	}
+
+	changed = nil
+	store.values["general.autosave"] = "true"
+	if err := prefs.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"general.autosave"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("Changed after Load: got %q, want %q", changed, want)
+	}
+
+	prefs.Reset()
+	if p.Theme != "system" || p.Zoom != 1 || p.Limit != 0 {
+		t.Errorf("Reset: got %+v", p)
+	}
+
+	if prefs.Changed("missing") != nil {
+		t.Error("Changed of unknown key: got non-nil event")
+	}
+}
+
+func TestMigrations(t *testing.T) {
+	store := newMapStore(map[string]string{
+		"autosave": "false",
+		"theme":    "Dark",
+	})
+
+	var p testPrefs
+	prefs, err := New(&p, store, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ran []int
+	prefs.RegisterMigration(0, func(s Store) error {
+		ran = append(ran, 0)
+		v, _ := s.Get("autosave")
+		s.Put("general.autosave", v)
+		return s.Remove("autosave")
+	})
+	prefs.RegisterMigration(1, func(s Store) error {
+		ran = append(ran, 1)
+		v, _ := s.Get("theme")
+		s.Remove("theme")
+		return s.Put("appearance.theme", map[string]string{"Dark": "dark"}[v])
+	})
+
+	if err := prefs.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ran, []int{0, 1}) {
+		t.Errorf("migrations: got %v, want [0 1]", ran)
+	}
+	if p.Autosave || p.Theme != "dark" {
+		t.Errorf("migrated values: got %+v", p)
+	}
+	if v, _ := prefs.StoredVersion(); v != 2 {
+		t.Errorf("stored version: got %d, want 2", v)
+	}
+
+	ran = nil
+	if err := prefs.Load(); err != nil || ran != nil {
+		t.Errorf("second Load: got error %v and migrations %v", err, ran)
+	}
+
+	failing := errors.New("failing")
+	store.values[DefaultVersionKey] = "1"
+	prefs.RegisterMigration(1, func(Store) error { return failing })
+	if err := prefs.Load(); !errors.Is(err, failing) {
+		t.Errorf("failing migration: got %v", err)
+	}
+}
+
+func TestNewInvalidTarget(t *testing.T) {
+	for _, target := range []interface{}{nil, testPrefs{}, new(int), (*testPrefs)(nil)} {
+		if _, err := New(target, newMapStore(nil), 0); err == nil {
+			t.Errorf("New(%T): got nil error", target)
+		}
+	}
+}
@@ -9,6 +9,7 @@ package walk
 
 import (
 	"fmt"
+	"reflect"
 )
 
 var (
@@ -58,13 +59,19 @@ func (p *property) Set(value interface{}) error {
 		return ErrPropertyReadOnly
 	}
 
-	if oldValue := p.get(); value == oldValue {
+	if oldValue := p.get(); isComparable(value) && value == oldValue {
 		return nil
 	}
 
 	return p.set(value)
 }
 
+// isComparable reports whether v can be compared with ==, which panics for
+// values like slices.
+func isComparable(v interface{}) bool {
+	return v == nil || reflect.TypeOf(v).Comparable()
+}
+
 func (p *property) Changed() *Event {
 	return p.changed
 }
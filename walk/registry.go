@@ -8,97 +8,573 @@
 package walk
 
 import (
+	"encoding/binary"
+	"fmt"
+	"runtime"
 	"syscall"
 	"unsafe"
 
 	"github.com/xackery/wlk/win"
+	"golang.org/x/sys/windows"
 )
 
+// RegistryKey is a key of the Windows registry. The predefined root keys are returned by
+// ClassesRootKey, CurrentUserKey and LocalMachineKey; their subkeys are opened with
+// OpenKey or CreateKey and must be closed with Close.
 type RegistryKey struct {
 	hKey win.HKEY
+	open bool // whether hKey was opened by us and must be closed
 }
 
 func ClassesRootKey() *RegistryKey {
-	return &RegistryKey{win.HKEY_CLASSES_ROOT}
+	return &RegistryKey{hKey: win.HKEY_CLASSES_ROOT}
 }
 
 func CurrentUserKey() *RegistryKey {
-	return &RegistryKey{win.HKEY_CURRENT_USER}
+	return &RegistryKey{hKey: win.HKEY_CURRENT_USER}
 }
 
 func LocalMachineKey() *RegistryKey {
-	return &RegistryKey{win.HKEY_LOCAL_MACHINE}
+	return &RegistryKey{hKey: win.HKEY_LOCAL_MACHINE}
 }
 
-func RegistryKeyString(rootKey *RegistryKey, subKeyPath, valueName string) (value string, err error) {
+func registryError(funcName string, ret int32) error {
+	return newError(fmt.Sprintf("%s: %s", funcName, syscall.Errno(ret)))
+}
+
+// OpenKey opens the existing subkey at path below k for reading.
+func (k *RegistryKey) OpenKey(path string) (*RegistryKey, error) {
+	return k.OpenKeyWithAccess(path, win.KEY_READ)
+}
+
+// OpenKeyWithAccess opens the existing subkey at path below k with the access rights
+// access, for example win.KEY_READ|win.KEY_WRITE.
+func (k *RegistryKey) OpenKeyWithAccess(path string, access win.REGSAM) (*RegistryKey, error) {
 	var hKey win.HKEY
-	if win.RegOpenKeyEx(
-		rootKey.hKey,
-		syscall.StringToUTF16Ptr(subKeyPath),
+	if ret := win.RegOpenKeyEx(k.hKey, syscall.StringToUTF16Ptr(path), 0, access, &hKey); ret != win.ERROR_SUCCESS {
+		return nil, registryError("RegOpenKeyEx", ret)
+	}
+
+	return &RegistryKey{hKey: hKey, open: true}, nil
+}
+
+// CreateKey opens the subkey at path below k for reading and writing, creating it and any
+// missing parent keys if necessary.
+func (k *RegistryKey) CreateKey(path string) (*RegistryKey, error) {
+	var hKey win.HKEY
+	if ret := win.RegCreateKeyEx(
+		k.hKey,
+		syscall.StringToUTF16Ptr(path),
 		0,
-		win.KEY_READ,
-		&hKey) != win.ERROR_SUCCESS {
+		nil,
+		win.REG_OPTION_NON_VOLATILE,
+		win.KEY_READ|win.KEY_WRITE,
+		nil,
+		&hKey,
+		nil); ret != win.ERROR_SUCCESS {
+
+		return nil, registryError("RegCreateKeyEx", ret)
+	}
+
+	return &RegistryKey{hKey: hKey, open: true}, nil
+}
+
+// DeleteKey deletes the subkey at path below k, along with all of its subkeys and values.
+// Deleting a key that does not exist is not an error.
+func (k *RegistryKey) DeleteKey(path string) error {
+	if path == "" {
+		return newError("DeleteKey: empty path")
+	}
+
+	if ret := win.RegDeleteTree(k.hKey, syscall.StringToUTF16Ptr(path)); ret != win.ERROR_SUCCESS && ret != win.ERROR_FILE_NOT_FOUND {
+		return registryError("RegDeleteTree", ret)
+	}
+
+	return nil
+}
 
-		return "", newError("RegistryKeyString: Failed to open subkey.")
+// HasKey returns whether the subkey at path below k exists.
+func (k *RegistryKey) HasKey(path string) bool {
+	key, err := k.OpenKeyWithAccess(path, win.KEY_QUERY_VALUE)
+	if err != nil {
+		return false
 	}
-	defer win.RegCloseKey(hKey)
+	key.Close()
+
+	return true
+}
+
+// Close closes k. Closing a root key has no effect.
+func (k *RegistryKey) Close() error {
+	if !k.open {
+		return nil
+	}
+
+	k.open = false
+	if ret := win.RegCloseKey(k.hKey); ret != win.ERROR_SUCCESS {
+		return registryError("RegCloseKey", ret)
+	}
+
+	return nil
+}
+
+// queryValue returns the type and data of the value name of k.
+func (k *RegistryKey) queryValue(name string) (uint32, []byte, error) {
+	namePtr := syscall.StringToUTF16Ptr(name)
 
 	var typ uint32
-	var data []uint16
-	var bufSize uint32
+	buf := make([]byte, 64)
+	for {
+		bufSize := uint32(len(buf))
 
-	if win.ERROR_SUCCESS != win.RegQueryValueEx(
-		hKey,
-		syscall.StringToUTF16Ptr(valueName),
-		nil,
-		&typ,
-		nil,
-		&bufSize) {
+		switch ret := win.RegQueryValueEx(k.hKey, namePtr, nil, &typ, &buf[0], &bufSize); ret {
+		case win.ERROR_SUCCESS:
+			return typ, buf[:bufSize], nil
 
-		return "", newError("RegQueryValueEx #1")
+		case win.ERROR_MORE_DATA:
+			buf = make([]byte, bufSize)
+
+		default:
+			return 0, nil, registryError("RegQueryValueEx", ret)
+		}
 	}
+}
 
-	data = make([]uint16, bufSize/2+1)
+func (k *RegistryKey) setValue(name string, typ uint64, data []byte) error {
+	var dataPtr *byte
+	if len(data) > 0 {
+		dataPtr = &data[0]
+	}
 
-	if win.ERROR_SUCCESS != win.RegQueryValueEx(
-		hKey,
-		syscall.StringToUTF16Ptr(valueName),
-		nil,
-		&typ,
-		(*byte)(unsafe.Pointer(&data[0])),
-		&bufSize) {
+	if ret := win.RegSetValueEx(k.hKey, syscall.StringToUTF16Ptr(name), 0, typ, dataPtr, uint32(len(data))); ret != win.ERROR_SUCCESS {
+		return registryError("RegSetValueEx", ret)
+	}
 
-		return "", newError("RegQueryValueEx #2")
+	return nil
+}
+
+// utf16Bytes returns the bytes of s, which is UTF-16 data as stored in the registry.
+func utf16Bytes(s []uint16) []byte {
+	if len(s) == 0 {
+		return nil
 	}
 
-	return syscall.UTF16ToString(data), nil
+	return unsafe.Slice((*byte)(unsafe.Pointer(&s[0])), len(s)*2)
 }
 
-func RegistryKeyUint32(rootKey *RegistryKey, subKeyPath, valueName string) (value uint32, err error) {
+// bytesUTF16 returns data, which is UTF-16 data as stored in the registry, as uint16s.
+func bytesUTF16(data []byte) []uint16 {
+	s := make([]uint16, len(data)/2)
+	for i := range s {
+		s[i] = binary.LittleEndian.Uint16(data[i*2:])
+	}
+
+	return s
+}
+
+// String returns the REG_SZ or REG_EXPAND_SZ value name of k. Environment variables in a
+// REG_EXPAND_SZ value are not expanded, see ExpandString.
+func (k *RegistryKey) String(name string) (string, error) {
+	typ, data, err := k.queryValue(name)
+	if err != nil {
+		return "", err
+	}
+	if typ != uint32(win.REG_SZ) && typ != uint32(win.REG_EXPAND_SZ) {
+		return "", newError(fmt.Sprintf("registry value '%s' is not a string", name))
+	}
+
+	return syscall.UTF16ToString(bytesUTF16(data)), nil
+}
+
+// ExpandString returns the REG_SZ or REG_EXPAND_SZ value name of k, with environment
+// variables like %SystemRoot% expanded.
+func (k *RegistryKey) ExpandString(name string) (string, error) {
+	s, err := k.String(name)
+	if err != nil {
+		return "", err
+	}
+
+	src, err := syscall.UTF16PtrFromString(s)
+	if err != nil {
+		return "", wrapError(err)
+	}
+
+	buf := make([]uint16, len(s)+1)
+	for {
+		n, err := windows.ExpandEnvironmentStrings(src, &buf[0], uint32(len(buf)))
+		if err != nil {
+			return "", wrapError(err)
+		}
+		if int(n) <= len(buf) {
+			return syscall.UTF16ToString(buf), nil
+		}
+
+		buf = make([]uint16, n)
+	}
+}
+
+// Strings returns the REG_MULTI_SZ value name of k.
+func (k *RegistryKey) Strings(name string) ([]string, error) {
+	typ, data, err := k.queryValue(name)
+	if err != nil {
+		return nil, err
+	}
+	if typ != uint32(win.REG_MULTI_SZ) {
+		return nil, newError(fmt.Sprintf("registry value '%s' is not a multi-string", name))
+	}
+
+	// The strings are separated by nuls and terminated by an empty string.
+	var values []string
+	s := bytesUTF16(data)
+	for len(s) > 0 && s[0] != 0 {
+		end := 0
+		for end < len(s) && s[end] != 0 {
+			end++
+		}
+
+		values = append(values, syscall.UTF16ToString(s[:end]))
+
+		if end == len(s) {
+			break
+		}
+		s = s[end+1:]
+	}
+
+	return values, nil
+}
+
+// Uint32 returns the REG_DWORD value name of k.
+func (k *RegistryKey) Uint32(name string) (uint32, error) {
+	typ, data, err := k.queryValue(name)
+	if err != nil {
+		return 0, err
+	}
+	if typ != uint32(win.REG_DWORD) || len(data) != 4 {
+		return 0, newError(fmt.Sprintf("registry value '%s' is not a DWORD", name))
+	}
+
+	return binary.LittleEndian.Uint32(data), nil
+}
+
+// Uint64 returns the REG_QWORD or REG_DWORD value name of k.
+func (k *RegistryKey) Uint64(name string) (uint64, error) {
+	typ, data, err := k.queryValue(name)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case typ == uint32(win.REG_QWORD) && len(data) == 8:
+		return binary.LittleEndian.Uint64(data), nil
+
+	case typ == uint32(win.REG_DWORD) && len(data) == 4:
+		return uint64(binary.LittleEndian.Uint32(data)), nil
+	}
+
+	return 0, newError(fmt.Sprintf("registry value '%s' is not a QWORD", name))
+}
+
+// Binary returns the data of the value name of k, whatever its type.
+func (k *RegistryKey) Binary(name string) ([]byte, error) {
+	_, data, err := k.queryValue(name)
+	return data, err
+}
+
+func (k *RegistryKey) setString(name, value string, typ uint64) error {
+	s, err := syscall.UTF16FromString(value)
+	if err != nil {
+		return wrapError(err)
+	}
+
+	return k.setValue(name, typ, utf16Bytes(s))
+}
+
+// SetString sets the value name of k to the REG_SZ value.
+func (k *RegistryKey) SetString(name, value string) error {
+	return k.setString(name, value, win.REG_SZ)
+}
+
+// SetExpandString sets the value name of k to the REG_EXPAND_SZ value, which may contain
+// environment variables like %SystemRoot%.
+func (k *RegistryKey) SetExpandString(name, value string) error {
+	return k.setString(name, value, win.REG_EXPAND_SZ)
+}
+
+// SetStrings sets the value name of k to the REG_MULTI_SZ values, none of which may be
+// empty or contain nuls.
+func (k *RegistryKey) SetStrings(name string, values []string) error {
+	var s []uint16
+	for _, value := range values {
+		if value == "" {
+			return newError("SetStrings: empty string")
+		}
+
+		v, err := syscall.UTF16FromString(value)
+		if err != nil {
+			return wrapError(err)
+		}
+		s = append(s, v...)
+	}
+	s = append(s, 0)
+
+	return k.setValue(name, win.REG_MULTI_SZ, utf16Bytes(s))
+}
+
+// SetUint32 sets the value name of k to the REG_DWORD value.
+func (k *RegistryKey) SetUint32(name string, value uint32) error {
+	var data [4]byte
+	binary.LittleEndian.PutUint32(data[:], value)
+
+	return k.setValue(name, win.REG_DWORD, data[:])
+}
+
+// SetUint64 sets the value name of k to the REG_QWORD value.
+func (k *RegistryKey) SetUint64(name string, value uint64) error {
+	var data [8]byte
+	binary.LittleEndian.PutUint64(data[:], value)
+
+	return k.setValue(name, win.REG_QWORD, data[:])
+}
+
+// SetBinary sets the value name of k to the REG_BINARY value.
+func (k *RegistryKey) SetBinary(name string, value []byte) error {
+	return k.setValue(name, win.REG_BINARY, value)
+}
+
+// DeleteValue deletes the value name of k. Deleting a value that does not exist is not an
+// error.
+func (k *RegistryKey) DeleteValue(name string) error {
+	if ret := win.RegDeleteValue(k.hKey, syscall.StringToUTF16Ptr(name)); ret != win.ERROR_SUCCESS && ret != win.ERROR_FILE_NOT_FOUND {
+		return registryError("RegDeleteValue", ret)
+	}
+
+	return nil
+}
+
+// SubKeyNames returns the names of the subkeys of k.
+func (k *RegistryKey) SubKeyNames() ([]string, error) {
+	// Key names are limited to 255 characters.
+	buf := make([]uint16, 256)
+
+	var names []string
+	for index := uint32(0); ; index++ {
+		bufLen := uint32(len(buf))
+
+		switch ret := win.RegEnumKeyEx(k.hKey, index, &buf[0], &bufLen, nil, nil, nil, nil); ret {
+		case win.ERROR_SUCCESS:
+			names = append(names, syscall.UTF16ToString(buf[:bufLen]))
+
+		case win.ERROR_NO_MORE_ITEMS:
+			return names, nil
+
+		default:
+			return nil, registryError("RegEnumKeyEx", ret)
+		}
+	}
+}
+
+// ValueNames returns the names of the values of k.
+func (k *RegistryKey) ValueNames() ([]string, error) {
+	// Value names are limited to 16383 characters.
+	buf := make([]uint16, 16384)
+
+	var names []string
+	for index := uint32(0); ; index++ {
+		bufLen := uint32(len(buf))
+
+		switch ret := win.RegEnumValue(k.hKey, index, &buf[0], &bufLen, nil, nil, nil, nil); ret {
+		case win.ERROR_SUCCESS:
+			names = append(names, syscall.UTF16ToString(buf[:bufLen]))
+
+		case win.ERROR_NO_MORE_ITEMS:
+			return names, nil
+
+		default:
+			return nil, registryError("RegEnumValue", ret)
+		}
+	}
+}
+
+// RegistryWatcher publishes an event when a registry key changes, see RegistryKey.Watch.
+type RegistryWatcher struct {
+	hKey             win.HKEY
+	group            *WindowGroup
+	changeEvent      windows.Handle
+	stopEvent        windows.Handle
+	done             chan struct{}
+	running          bool
+	closed           bool
+	changedPublisher EventPublisher
+}
+
+// Watch starts watching k for changes to its values and subkeys, including those of the
+// subkeys' descendants if subtree is true. The Changed event of the returned watcher is
+// published on the calling thread, which must run a message loop, typically the UI thread.
+//
+// Watch and RegistryWatcher.Close must be called on the same thread.
+func (k *RegistryKey) Watch(subtree bool) (*RegistryWatcher, error) {
+	// The watcher uses its own handle to the key, so k may be closed while watching.
 	var hKey win.HKEY
-	if win.RegOpenKeyEx(
-		rootKey.hKey,
-		syscall.StringToUTF16Ptr(subKeyPath),
-		0,
-		win.KEY_READ,
-		&hKey) != win.ERROR_SUCCESS {
+	if ret := win.RegOpenKeyEx(k.hKey, nil, 0, win.KEY_NOTIFY, &hKey); ret != win.ERROR_SUCCESS {
+		return nil, registryError("RegOpenKeyEx", ret)
+	}
 
-		return 0, newError("RegistryKeyUint32: Failed to open subkey.")
+	changeEvent, err := windows.CreateEvent(nil, 0, 0, nil)
+	if err != nil {
+		win.RegCloseKey(hKey)
+		return nil, wrapError(err)
 	}
-	defer win.RegCloseKey(hKey)
 
-	bufSize := uint32(4)
+	stopEvent, err := windows.CreateEvent(nil, 1, 0, nil)
+	if err != nil {
+		windows.CloseHandle(changeEvent)
+		win.RegCloseKey(hKey)
+		return nil, wrapError(err)
+	}
 
-	if win.ERROR_SUCCESS != win.RegQueryValueEx(
-		hKey,
-		syscall.StringToUTF16Ptr(valueName),
-		nil,
-		nil,
-		(*byte)(unsafe.Pointer(&value)),
-		&bufSize) {
+	w := &RegistryWatcher{
+		hKey:        hKey,
+		group:       wgm.CreateGroup(win.GetCurrentThreadId()),
+		changeEvent: changeEvent,
+		stopEvent:   stopEvent,
+		done:        make(chan struct{}),
+	}
+
+	// The first notification is requested here, so changes made right after Watch returns
+	// are not missed.
+	if err := w.notify(subtree); err != nil {
+		w.Close()
+		return nil, err
+	}
+
+	w.running = true
+	go w.run(subtree)
+
+	return w, nil
+}
+
+func (w *RegistryWatcher) notify(subtree bool) error {
+	var watchSubtree win.BOOL
+	if subtree {
+		watchSubtree = win.TRUE
+	}
+
+	const filter = win.REG_NOTIFY_CHANGE_NAME | win.REG_NOTIFY_CHANGE_LAST_SET
+
+	if ret := win.RegNotifyChangeKeyValue(w.hKey, watchSubtree, filter, win.HANDLE(w.changeEvent), win.TRUE); ret != win.ERROR_SUCCESS {
+		return registryError("RegNotifyChangeKeyValue", ret)
+	}
+
+	return nil
+}
+
+func (w *RegistryWatcher) run(subtree bool) {
+	// A notification is canceled when the thread that requested it exits, so the
+	// goroutine keeps its thread while watching.
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	defer close(w.done)
+
+	handles := []windows.Handle{w.changeEvent, w.stopEvent}
+	for {
+		event, err := windows.WaitForMultipleObjects(handles, false, windows.INFINITE)
+		if err != nil || event != windows.WAIT_OBJECT_0 {
+			return
+		}
+
+		w.group.Synchronize(func() {
+			if !w.closed {
+				w.changedPublisher.Publish()
+			}
+		})
+
+		if w.notify(subtree) != nil {
+			return
+		}
+	}
+}
+
+// Changed returns the event published when the watched key changes.
+func (w *RegistryWatcher) Changed() *Event {
+	return w.changedPublisher.Event()
+}
+
+// Close stops watching.
+func (w *RegistryWatcher) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	if w.running {
+		windows.SetEvent(w.stopEvent)
+		<-w.done
+	}
+
+	win.RegCloseKey(w.hKey)
+	windows.CloseHandle(w.changeEvent)
+	windows.CloseHandle(w.stopEvent)
+
+	w.group.Done()
+
+	return nil
+}
+
+func RegistryKeyString(rootKey *RegistryKey, subKeyPath, valueName string) (value string, err error) {
+	key, err := rootKey.OpenKey(subKeyPath)
+	if err != nil {
+		return "", err
+	}
+	defer key.Close()
+
+	return key.String(valueName)
+}
+
+func RegistryKeyUint32(rootKey *RegistryKey, subKeyPath, valueName string) (value uint32, err error) {
+	key, err := rootKey.OpenKey(subKeyPath)
+	if err != nil {
+		return 0, err
+	}
+	defer key.Close()
+
+	return key.Uint32(valueName)
+}
+
+// RegistryKeySetString sets the REG_SZ value valueName of the key at subKeyPath below rootKey,
+// creating the key if necessary.
+func RegistryKeySetString(rootKey *RegistryKey, subKeyPath, valueName, value string) error {
+	key, err := rootKey.CreateKey(subKeyPath)
+	if err != nil {
+		return err
+	}
+	defer key.Close()
+
+	return key.SetString(valueName, value)
+}
+
+// RegistryKeyDeleteValue deletes the value valueName of the key at subKeyPath below rootKey.
+func RegistryKeyDeleteValue(rootKey *RegistryKey, subKeyPath, valueName string) error {
+	key, err := rootKey.OpenKeyWithAccess(subKeyPath, win.KEY_WRITE)
+	if err != nil {
+		return err
+	}
+	defer key.Close()
+
+	return key.DeleteValue(valueName)
+}
+
+// RegistryKeyExists returns whether the key at subKeyPath below rootKey exists.
+func RegistryKeyExists(rootKey *RegistryKey, subKeyPath string) bool {
+	return rootKey.HasKey(subKeyPath)
+}
 
-		return 0, newError("RegQueryValueEx")
+// RegistryKeyValueNames returns the names of the values of the key at subKeyPath below rootKey.
+func RegistryKeyValueNames(rootKey *RegistryKey, subKeyPath string) ([]string, error) {
+	key, err := rootKey.OpenKey(subKeyPath)
+	if err != nil {
+		return nil, err
 	}
+	defer key.Close()
 
-	return
+	return key.ValueNames()
 }
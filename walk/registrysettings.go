@@ -0,0 +1,216 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"time"
+
+	"github.com/xackery/wlk/walk/settings"
+)
+
+// registrySettingsTimestampsKey is the subkey that holds the timestamps of expiring values.
+const registrySettingsTimestampsKey = "Timestamps"
+
+// RegistrySettings is a Settings backend that stores its values as REG_SZ values of a
+// registry key, by default HKEY_CURRENT_USER\Software\<Organization>\<Product>, using the
+// organization and product name of App(). The timestamps of expiring values are kept in
+// the subkey Timestamps.
+type RegistrySettings struct {
+	rootKey        *RegistryKey
+	keyPath        string
+	store          *settings.Store
+	expireDuration time.Duration
+}
+
+// NewRegistrySettings returns a new RegistrySettings for the default key.
+func NewRegistrySettings() *RegistrySettings {
+	return NewRegistrySettingsWithKey(CurrentUserKey(), "")
+}
+
+// NewRegistrySettingsWithKey returns a new RegistrySettings for the key at keyPath below
+// rootKey. If keyPath is empty, Software\<Organization>\<Product> is used. Using
+// LocalMachineKey, a RegistrySettings may provide machine wide defaults, see
+// LayeredSettings.
+func NewRegistrySettingsWithKey(rootKey *RegistryKey, keyPath string) *RegistrySettings {
+	return &RegistrySettings{
+		rootKey: rootKey,
+		keyPath: keyPath,
+		store:   settings.NewStore(),
+	}
+}
+
+// KeyPath returns the path of the registry key the settings are stored in.
+func (rs *RegistrySettings) KeyPath() string {
+	if rs.keyPath != "" {
+		return rs.keyPath
+	}
+
+	return `Software\` + App().OrganizationName() + `\` + App().ProductName()
+}
+
+func (rs *RegistrySettings) timestampsKeyPath() string {
+	return rs.KeyPath() + `\` + registrySettingsTimestampsKey
+}
+
+func (rs *RegistrySettings) Get(key string) (string, bool) {
+	record, ok := rs.store.Get(key)
+	return record.Value, ok
+}
+
+func (rs *RegistrySettings) Timestamp(key string) (time.Time, bool) {
+	record, ok := rs.store.Get(key)
+	return record.Timestamp, ok
+}
+
+func (rs *RegistrySettings) Put(key, value string) error {
+	return rs.put(key, value, false)
+}
+
+func (rs *RegistrySettings) PutExpiring(key, value string) error {
+	return rs.put(key, value, true)
+}
+
+func (rs *RegistrySettings) put(key, value string, expiring bool) error {
+	var timestamp time.Time
+	if expiring {
+		timestamp = time.Now()
+	}
+
+	if err := rs.store.Put(key, settings.Record{Value: value, Timestamp: timestamp}); err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+func (rs *RegistrySettings) Remove(key string) error {
+	rs.store.Remove(key)
+
+	return nil
+}
+
+func (rs *RegistrySettings) ExpireDuration() time.Duration {
+	return rs.expireDuration
+}
+
+func (rs *RegistrySettings) SetExpireDuration(expireDuration time.Duration) {
+	rs.expireDuration = expireDuration
+}
+
+// Load reads the settings from the registry, replacing the values in memory. A missing key is
+// not an error.
+func (rs *RegistrySettings) Load() error {
+	store := settings.NewStore()
+
+	keyPath := rs.KeyPath()
+	if RegistryKeyExists(rs.rootKey, keyPath) {
+		names, err := RegistryKeyValueNames(rs.rootKey, keyPath)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+
+			value, err := RegistryKeyString(rs.rootKey, keyPath, name)
+			if err != nil {
+				return err
+			}
+
+			store.Put(name, settings.Record{Value: value})
+		}
+	}
+
+	timestampsKeyPath := rs.timestampsKeyPath()
+	if RegistryKeyExists(rs.rootKey, timestampsKeyPath) {
+		names, err := RegistryKeyValueNames(rs.rootKey, timestampsKeyPath)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range names {
+			record, ok := store.Get(name)
+			if !ok {
+				continue
+			}
+
+			value, err := RegistryKeyString(rs.rootKey, timestampsKeyPath, name)
+			if err != nil {
+				return err
+			}
+
+			if record.Timestamp, err = time.Parse(time.RFC3339, value); err != nil {
+				record.Timestamp = time.Now()
+			}
+
+			store.Put(name, record)
+		}
+	}
+
+	rs.store = store
+
+	return nil
+}
+
+// Save writes the settings to the registry and deletes values that have been removed or have
+// expired.
+func (rs *RegistrySettings) Save() error {
+	keyPath, timestampsKeyPath := rs.KeyPath(), rs.timestampsKeyPath()
+
+	keys := rs.store.Keys(rs.expireDuration, time.Now())
+	live := make(map[string]bool, len(keys))
+	expiring := make(map[string]bool)
+
+	for _, key := range keys {
+		live[key] = true
+		record, _ := rs.store.Get(key)
+
+		if err := RegistryKeySetString(rs.rootKey, keyPath, key, record.Value); err != nil {
+			return err
+		}
+
+		if !record.Timestamp.IsZero() {
+			expiring[key] = true
+
+			if err := RegistryKeySetString(rs.rootKey, timestampsKeyPath, key, record.Timestamp.Format(time.RFC3339)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := rs.deleteValuesExcept(keyPath, live); err != nil {
+		return err
+	}
+
+	return rs.deleteValuesExcept(timestampsKeyPath, expiring)
+}
+
+func (rs *RegistrySettings) deleteValuesExcept(keyPath string, keep map[string]bool) error {
+	if !RegistryKeyExists(rs.rootKey, keyPath) {
+		return nil
+	}
+
+	names, err := RegistryKeyValueNames(rs.rootKey, keyPath)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if name == "" || keep[name] {
+			continue
+		}
+
+		if err := RegistryKeyDeleteValue(rs.rootKey, keyPath, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
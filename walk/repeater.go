@@ -0,0 +1,431 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"reflect"
+
+	"github.com/xackery/wlk/walk/listdiff"
+	"github.com/xackery/wlk/win"
+)
+
+// Repeater is a Composite with a child widget per item of its ItemsSource.
+//
+// The widgets are created by the function passed to SetItemWidgetCreator. As
+// the model publishes ItemsInserted, ItemsRemoved, ItemChanged and ItemsReset,
+// the Repeater creates, updates and disposes just the widgets of the affected
+// items. On ItemsReset, the widgets of items that are still there are kept and
+// moved to their new places.
+//
+// Each item widget gets a DataBinder with the item as DataSource, unless it is
+// a Container with a DataBinder of its own, so properties bound to paths like
+// "Name" refer to the fields of the item. The DataBinder submits changes to
+// the item as they happen.
+type Repeater struct {
+	*Composite
+	itemsSource                 interface{}
+	model                       ListModel
+	items                       []*repeaterItem
+	itemWidgetCreator           func(index int) (Widget, error)
+	itemsSourceChangedPublisher EventPublisher
+	itemsResetHandlerHandle     int
+	itemChangedHandlerHandle    int
+	itemsInsertedHandlerHandle  int
+	itemsRemovedHandlerHandle   int
+}
+
+type repeaterItem struct {
+	key        interface{}
+	widget     Widget
+	dataBinder *DataBinder
+}
+
+// NewRepeater returns a new Repeater in parent.
+func NewRepeater(parent Container) (*Repeater, error) {
+	return NewRepeaterWithStyle(parent, 0)
+}
+
+// NewRepeaterWithStyle returns a new Repeater in parent, with the additional
+// window style style.
+func NewRepeaterWithStyle(parent Container, style uint32) (*Repeater, error) {
+	composite, err := NewCompositeWithStyle(parent, style)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Repeater{Composite: composite}
+
+	succeeded := false
+	defer func() {
+		if !succeeded {
+			r.Dispose()
+		}
+	}()
+
+	if err := InitWrapperWindow(r); err != nil {
+		return nil, err
+	}
+
+	r.MustRegisterProperty("ItemsSource", NewProperty(
+		func() interface{} {
+			return r.ItemsSource()
+		},
+		func(v interface{}) error {
+			return r.SetItemsSource(v)
+		},
+		r.itemsSourceChangedPublisher.Event()))
+
+	succeeded = true
+
+	return r, nil
+}
+
+func (r *Repeater) Dispose() {
+	if r.model != nil {
+		r.detachModel()
+		r.model = nil
+	}
+
+	for _, item := range r.items {
+		if item.dataBinder != nil {
+			item.dataBinder.SetBoundWidgets(nil)
+		}
+	}
+	r.items = nil
+
+	r.Composite.Dispose()
+}
+
+// ItemsSource returns the items of the Repeater, as passed to SetItemsSource.
+func (r *Repeater) ItemsSource() interface{} {
+	return r.itemsSource
+}
+
+// SetItemsSource sets the items of the Repeater and recreates the item
+// widgets.
+//
+// It is required that itemsSource either implements walk.ListModel or
+// walk.ReflectListModel or be a slice of pointers to struct or a []string.
+func (r *Repeater) SetItemsSource(itemsSource interface{}) error {
+	model, ok := itemsSource.(ListModel)
+	if !ok && itemsSource != nil {
+		var err error
+		if model, err = newReflectListModel(itemsSource); err != nil {
+			return err
+		}
+	}
+
+	if r.model != nil {
+		r.detachModel()
+	}
+
+	r.itemsSource = itemsSource
+	r.model = model
+
+	if model != nil {
+		r.attachModel()
+	}
+
+	err := r.resetItems()
+
+	r.itemsSourceChangedPublisher.Publish()
+
+	return err
+}
+
+// ItemWidgetCreator returns the function that creates the item widgets.
+func (r *Repeater) ItemWidgetCreator() func(index int) (Widget, error) {
+	return r.itemWidgetCreator
+}
+
+// SetItemWidgetCreator sets the function that creates the widget of the item
+// at index, as a child of the Repeater, and recreates the item widgets.
+func (r *Repeater) SetItemWidgetCreator(creator func(index int) (Widget, error)) error {
+	r.itemWidgetCreator = creator
+
+	return r.resetItems()
+}
+
+// ItemCount returns the number of items.
+func (r *Repeater) ItemCount() int {
+	if r.model == nil {
+		return 0
+	}
+
+	return r.model.ItemCount()
+}
+
+// Item returns the item at index. For a ListModel that is not a
+// ReflectListModel, it is the Value of the model.
+func (r *Repeater) Item(index int) interface{} {
+	if m, ok := r.model.(*reflectListModel); ok {
+		return m.value.Index(index).Interface()
+	}
+
+	return r.model.Value(index)
+}
+
+// ItemWidget returns the widget of the item at index, or nil if there is no
+// such widget.
+func (r *Repeater) ItemWidget(index int) Widget {
+	if index < 0 || index >= len(r.items) {
+		return nil
+	}
+
+	return r.items[index].widget
+}
+
+// ItemDataBinder returns the DataBinder of the item at index, or nil if there
+// is none, like for items that are not pointers to struct.
+func (r *Repeater) ItemDataBinder(index int) *DataBinder {
+	if index < 0 || index >= len(r.items) {
+		return nil
+	}
+
+	return r.items[index].dataBinder
+}
+
+func (r *Repeater) attachModel() {
+	r.itemsResetHandlerHandle = r.model.ItemsReset().Attach(func() {
+		r.resetItems()
+	})
+
+	r.itemChangedHandlerHandle = r.model.ItemChanged().Attach(func(index int) {
+		r.updateItem(index)
+	})
+
+	r.itemsInsertedHandlerHandle = r.model.ItemsInserted().Attach(func(from, to int) {
+		if !r.Suspended() {
+			r.SetSuspended(true)
+			defer r.SetSuspended(false)
+		}
+
+		for i := from; i <= to; i++ {
+			if err := r.insertItem(i); err != nil {
+				return
+			}
+		}
+	})
+
+	r.itemsRemovedHandlerHandle = r.model.ItemsRemoved().Attach(func(from, to int) {
+		if !r.Suspended() {
+			r.SetSuspended(true)
+			defer r.SetSuspended(false)
+		}
+
+		for i := to; i >= from; i-- {
+			r.removeItem(i)
+		}
+	})
+}
+
+func (r *Repeater) detachModel() {
+	r.model.ItemsReset().Detach(r.itemsResetHandlerHandle)
+	r.model.ItemChanged().Detach(r.itemChangedHandlerHandle)
+	r.model.ItemsInserted().Detach(r.itemsInsertedHandlerHandle)
+	r.model.ItemsRemoved().Detach(r.itemsRemovedHandlerHandle)
+}
+
+func (r *Repeater) resetItems() error {
+	if !r.Suspended() {
+		r.SetSuspended(true)
+		defer r.SetSuspended(false)
+	}
+
+	if r.itemWidgetCreator == nil {
+		for i := len(r.items) - 1; i >= 0; i-- {
+			r.removeItem(i)
+		}
+
+		return nil
+	}
+
+	// The keys are numbered, so they can be diffed as ints.
+	ids := make(map[interface{}]int)
+	id := func(key interface{}) int {
+		if _, ok := ids[key]; !ok {
+			ids[key] = len(ids)
+		}
+		return ids[key]
+	}
+
+	prev := make([]int, len(r.items))
+	for i, item := range r.items {
+		prev[i] = id(item.key)
+	}
+
+	next := make([]int, r.ItemCount())
+	for i := range next {
+		next[i] = id(repeaterItemKey(r.Item(i)))
+	}
+
+	for _, edit := range listdiff.Diff(prev, next) {
+		switch edit.Kind {
+		case listdiff.Remove:
+			r.removeItem(edit.From)
+
+		case listdiff.Insert:
+			if err := r.insertItem(edit.To); err != nil {
+				return err
+			}
+
+		case listdiff.Move:
+			r.moveItem(edit.From, edit.To)
+		}
+	}
+
+	// The items that were kept may have changed nonetheless.
+	for i, item := range r.items {
+		if item.dataBinder != nil {
+			if err := r.bindItem(item, r.Item(i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// repeaterItemKey returns the key that identifies item across resets. Items
+// are identified by value, or by address if their values cannot be compared.
+func repeaterItemKey(item interface{}) interface{} {
+	v := reflect.ValueOf(item)
+	if !v.IsValid() || v.Type().Comparable() {
+		return item
+	}
+
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Func:
+		return v.Pointer()
+	}
+
+	// Such an item is never matched, so its widget is recreated.
+	return new(int)
+}
+
+func (r *Repeater) insertItem(index int) error {
+	if r.itemWidgetCreator == nil || index > len(r.items) {
+		return nil
+	}
+
+	widget, err := r.itemWidgetCreator(index)
+	if err != nil {
+		return err
+	}
+	if widget == nil {
+		return newError("item widget creator returned nil")
+	}
+
+	i := r.Children().Index(widget)
+	if i == -1 {
+		widget.Dispose()
+		return newError("item widget must be a child of the Repeater")
+	}
+	r.placeWidget(i, index)
+
+	value := r.Item(index)
+	item := &repeaterItem{key: repeaterItemKey(value), widget: widget}
+
+	r.items = append(r.items, nil)
+	copy(r.items[index+1:], r.items[index:])
+	r.items[index] = item
+
+	r.RequestLayout()
+
+	return r.bindItem(item, value)
+}
+
+// moveItem moves the item at from to index to.
+func (r *Repeater) moveItem(from, to int) {
+	item := r.items[from]
+	r.items = append(r.items[:from], r.items[from+1:]...)
+	r.items = append(r.items, nil)
+	copy(r.items[to+1:], r.items[to:])
+	r.items[to] = item
+
+	r.placeWidget(r.Children().Index(item.widget), to)
+
+	r.RequestLayout()
+}
+
+// placeWidget moves the child at from to index to, in the children and in
+// the tab order, which follow the order of the items.
+func (r *Repeater) placeWidget(from, to int) {
+	children := r.Children()
+	if from != to {
+		children.move(from, to)
+	}
+
+	after := win.HWND_TOP
+	if to > 0 {
+		after = children.At(to - 1).Handle()
+	}
+	win.SetWindowPos(children.At(to).Handle(), after, 0, 0, 0, 0, win.SWP_NOMOVE|win.SWP_NOSIZE|win.SWP_NOACTIVATE)
+}
+
+func (r *Repeater) removeItem(index int) {
+	if index >= len(r.items) {
+		return
+	}
+
+	item := r.items[index]
+	r.items = append(r.items[:index], r.items[index+1:]...)
+
+	if item.dataBinder != nil {
+		item.dataBinder.SetBoundWidgets(nil)
+	}
+
+	item.widget.Dispose()
+}
+
+func (r *Repeater) updateItem(index int) error {
+	if index >= len(r.items) {
+		return nil
+	}
+
+	item := r.items[index]
+	if item.dataBinder == nil {
+		// Without a DataBinder, the widget may only reflect the item by
+		// recreating it.
+		r.removeItem(index)
+		return r.insertItem(index)
+	}
+
+	return r.bindItem(item, r.Item(index))
+}
+
+// bindItem sets value as the DataSource of the DataBinder of item, which it
+// creates if needed. Values that cannot be DataSources are left alone.
+func (r *Repeater) bindItem(item *repeaterItem, value interface{}) error {
+	if t := reflect.TypeOf(value); t == nil || t.Kind() != reflect.Map && (t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct) {
+		return nil
+	}
+
+	if item.dataBinder == nil {
+		if c, ok := item.widget.(Container); ok && c.DataBinder() != nil {
+			return nil
+		}
+
+		db := NewDataBinder()
+		db.SetAutoSubmit(true)
+
+		if c, ok := item.widget.(Container); ok {
+			c.SetDataBinder(db)
+		} else {
+			db.SetBoundWidgets([]Widget{item.widget})
+		}
+
+		item.dataBinder = db
+	}
+
+	if err := item.dataBinder.SetDataSource(value); err != nil {
+		return err
+	}
+
+	return item.dataBinder.Reset()
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package resfs
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// IconEntry describes one image of an .ico or .cur file.
+type IconEntry struct {
+	Width, Height int
+
+	// BitCount is the color depth of the image. It is 0 for cursors and for
+	// icons that do not declare it in their directory.
+	BitCount int
+
+	// HotspotX and HotspotY are the hotspot of a cursor image.
+	HotspotX, HotspotY int
+
+	// Data holds the image, either a DIB without file header or a PNG file.
+	Data []byte
+}
+
+var errInvalidIconFile = errors.New("resfs: invalid icon file")
+
+// ParseIconFile parses the directory of an .ico or .cur file. cursor reports
+// whether data is a cursor file. The Data of the returned entries refers to
+// data.
+func ParseIconFile(data []byte) (entries []IconEntry, cursor bool, err error) {
+	if len(data) < 6 {
+		return nil, false, errInvalidIconFile
+	}
+
+	le := binary.LittleEndian
+	if le.Uint16(data[0:]) != 0 {
+		return nil, false, errInvalidIconFile
+	}
+
+	switch le.Uint16(data[2:]) {
+	case 1:
+	case 2:
+		cursor = true
+	default:
+		return nil, false, errInvalidIconFile
+	}
+
+	count := int(le.Uint16(data[4:]))
+	if count == 0 || len(data) < 6+16*count {
+		return nil, false, errInvalidIconFile
+	}
+
+	entries = make([]IconEntry, count)
+	for i := range entries {
+		dir := data[6+16*i:]
+
+		e := IconEntry{Width: int(dir[0]), Height: int(dir[1])}
+		// A size of 0 in the directory means 256 pixels.
+		if e.Width == 0 {
+			e.Width = 256
+		}
+		if e.Height == 0 {
+			e.Height = 256
+		}
+
+		if cursor {
+			e.HotspotX, e.HotspotY = int(le.Uint16(dir[4:])), int(le.Uint16(dir[6:]))
+		} else {
+			e.BitCount = int(le.Uint16(dir[6:]))
+		}
+
+		size, offset := le.Uint32(dir[8:]), le.Uint32(dir[12:])
+		if uint64(offset)+uint64(size) > uint64(len(data)) || size == 0 {
+			return nil, false, errInvalidIconFile
+		}
+		e.Data = data[offset : offset+size]
+
+		entries[i] = e
+	}
+
+	return entries, cursor, nil
+}
+
+// SelectIconEntry returns the index of the entry best suited to be drawn width
+// pixels wide. That is the smallest entry at least width pixels wide, or the
+// largest entry if none is wide enough. Among entries of equal width, the one
+// of the highest color depth is preferred.
+func SelectIconEntry(entries []IconEntry, width int) int {
+	best := -1
+	for i, e := range entries {
+		if best < 0 {
+			best = i
+			continue
+		}
+
+		b := entries[best]
+		switch {
+		case e.Width == b.Width:
+			if e.BitCount <= b.BitCount {
+				continue
+			}
+
+		case b.Width < width:
+			if e.Width < b.Width {
+				continue
+			}
+
+		case e.Width < width || e.Width > b.Width:
+			continue
+		}
+
+		best = i
+	}
+
+	return best
+}
+
+// CursorResource converts e, which must be an entry of a cursor file, into the
+// format expected by CreateIconFromResourceEx, which prefixes the image with
+// its hotspot.
+func CursorResource(e IconEntry) []byte {
+	data := make([]byte, 4+len(e.Data))
+	binary.LittleEndian.PutUint16(data[0:], uint16(e.HotspotX))
+	binary.LittleEndian.PutUint16(data[2:], uint16(e.HotspotY))
+	copy(data[4:], e.Data)
+
+	return data
+}
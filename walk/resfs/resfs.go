@@ -0,0 +1,254 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package resfs locates resources such as images in an fs.FS, including their
+// DPI variants.
+//
+// Next to a resource named icon.png, a file system may contain variants for
+// higher pixel densities, named either by scale factor (icon@2x.png,
+// icon@1.5x.png) or by pixel width (icon-32.png, icon-32x32.png). Find
+// collects the variants of a resource and Select picks the one best suited
+// for a DPI.
+package resfs
+
+import (
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Variant is a file that provides a resource at a specific pixel density.
+type Variant struct {
+	// Name is the path of the file within its file system.
+	Name string
+
+	// Scale is the scale factor of a variant named like icon@2x.png, or 1 for
+	// the plain resource name. It is 0 for variants named by pixel width.
+	Scale float64
+
+	// Width is the pixel width of a variant named like icon-32.png, or 0.
+	Width int
+}
+
+// Density returns the pixel density of v relative to 96 DPI. width96 is the
+// width of the resource in 1/96" units and is needed for variants named by
+// pixel width; if it is 0, such variants are assumed to be at 96 DPI.
+func (v Variant) Density(width96 int) float64 {
+	if v.Scale > 0 {
+		return v.Scale
+	}
+	if width96 <= 0 {
+		return 1
+	}
+
+	return float64(v.Width) / float64(width96)
+}
+
+// DPI returns the DPI v is intended for, see Density.
+func (v Variant) DPI(width96 int) int {
+	return int(v.Density(width96)*96 + 0.5)
+}
+
+// Clean converts name, which may use backslashes or a leading slash, into a
+// path valid for fs.FS. ok is false if name cannot be expressed as such.
+func Clean(name string) (cleaned string, ok bool) {
+	name = path.Clean("/" + strings.ReplaceAll(name, `\`, "/"))
+	name = strings.TrimPrefix(name, "/")
+	if name == "" {
+		name = "."
+	}
+
+	return name, fs.ValidPath(name) && name != "."
+}
+
+// Find returns the variants of the resource name in fsys, ordered by
+// ascending density. The plain name itself is included if it exists. The
+// error wraps fs.ErrNotExist if there are no variants at all.
+func Find(fsys fs.FS, name string) ([]Variant, error) {
+	cleaned, ok := Clean(name)
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	dir, file := path.Split(cleaned)
+	dir = strings.TrimSuffix(dir, "/")
+	if dir == "" {
+		dir = "."
+	}
+
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ext := path.Ext(file)
+	stem := strings.TrimSuffix(file, ext)
+
+	var variants []Variant
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+
+		if v, ok := parseVariant(e.Name(), stem, ext); ok {
+			v.Name = path.Join(dir, e.Name())
+			variants = append(variants, v)
+		}
+	}
+
+	if len(variants) == 0 {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+
+	width96 := BaseWidth(variants)
+	sort.SliceStable(variants, func(i, j int) bool {
+		return variants[i].Density(width96) < variants[j].Density(width96)
+	})
+
+	return variants, nil
+}
+
+// Names returns the names of the resources in the directory dir of fsys in
+// lexical order. Files that look like variants are reported by the name of
+// the resource they belong to, so a directory holding open.png, open@2x.png
+// and save-16.png yields open.png and save.png.
+func Names(fsys fs.FS, dir string) ([]string, error) {
+	if dir == "" {
+		dir = "."
+	}
+
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+
+		name := resourceName(e.Name())
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// resourceName returns the name of the resource file is a variant of, or file
+// itself.
+func resourceName(file string) string {
+	ext := path.Ext(file)
+	base := file[:len(file)-len(ext)]
+
+	if i := strings.LastIndexAny(base, "@-"); i > 0 {
+		if _, ok := parseVariant(file, base[:i], ext); ok {
+			return base[:i] + ext
+		}
+	}
+
+	return file
+}
+
+// parseVariant reports whether file is a variant of the resource stem+ext.
+// Extensions are compared case-insensitively, as they are on Windows.
+func parseVariant(file, stem, ext string) (Variant, bool) {
+	if !strings.EqualFold(path.Ext(file), ext) {
+		return Variant{}, false
+	}
+	base := file[:len(file)-len(path.Ext(file))]
+
+	if base == stem {
+		return Variant{Scale: 1}, true
+	}
+
+	if !strings.HasPrefix(base, stem) || len(base) < len(stem)+2 {
+		return Variant{}, false
+	}
+	suffix := base[len(stem):]
+
+	switch suffix[0] {
+	case '@':
+		if !strings.HasSuffix(suffix, "x") {
+			return Variant{}, false
+		}
+		scale, err := strconv.ParseFloat(suffix[1:len(suffix)-1], 64)
+		if err != nil || scale <= 0 {
+			return Variant{}, false
+		}
+		return Variant{Scale: scale}, true
+
+	case '-':
+		size := suffix[1:]
+		if w, h, ok := strings.Cut(size, "x"); ok {
+			if w != h {
+				return Variant{}, false
+			}
+			size = w
+		}
+		width, err := strconv.Atoi(size)
+		if err != nil || width <= 0 {
+			return Variant{}, false
+		}
+		return Variant{Width: width}, true
+	}
+
+	return Variant{}, false
+}
+
+// BaseWidth returns the width of the smallest variant named by pixel width,
+// which is what Select assumes to be the width at 96 DPI if the actual width
+// is unknown. It returns 0 if there is no such variant.
+func BaseWidth(variants []Variant) int {
+	width := 0
+	for _, v := range variants {
+		if v.Width > 0 && (width == 0 || v.Width < width) {
+			width = v.Width
+		}
+	}
+
+	return width
+}
+
+// Select returns the variant best suited to be drawn at dpi. That is the
+// variant of the lowest density that is at least the density required by dpi,
+// so images are scaled down rather than up, or the variant of the highest
+// density if none is sufficient. width96 is the width of the resource in 1/96"
+// units, or 0 if it is unknown.
+func Select(variants []Variant, dpi, width96 int) Variant {
+	if width96 <= 0 {
+		width96 = BaseWidth(variants)
+	}
+
+	want := float64(dpi) / 96
+	const epsilon = 1e-9
+
+	var best Variant
+	bestDensity := -1.0
+	for _, v := range variants {
+		d := v.Density(width96)
+		switch {
+		case bestDensity < 0:
+		case bestDensity+epsilon < want:
+			// The current best is insufficient, so any denser variant wins.
+			if d <= bestDensity {
+				continue
+			}
+		case d+epsilon < want || d >= bestDensity:
+			continue
+		}
+
+		best, bestDensity = v, d
+	}
+
+	return best
+}
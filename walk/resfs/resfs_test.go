@@ -0,0 +1,247 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package resfs
+
+import (
+	"encoding/binary"
+	"errors"
+	"io/fs"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestClean(t *testing.T) {
+	testCases := []struct {
+		name string
+		want string
+		ok   bool
+	}{
+		{"icon.png", "icon.png", true},
+		{`img\icon.png`, "img/icon.png", true},
+		{"/img/./icon.png", "img/icon.png", true},
+		{"../icon.png", "icon.png", true},
+		{"", ".", false},
+	}
+
+	for _, c := range testCases {
+		got, ok := Clean(c.name)
+		if got != c.want || ok != c.ok {
+			t.Errorf("Clean(%q): got (%q, %v), want (%q, %v)", c.name, got, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	fsys := fstest.MapFS{
+		"img/open.png":       {},
+		"img/open@2x.png":    {},
+		"img/open@1.5x.PNG":  {},
+		"img/open@x.png":     {},
+		"img/open.svg":       {},
+		"img/opener.png":     {},
+		"img/save-16.png":    {},
+		"img/save-32x32.png": {},
+		"img/save-24x16.png": {},
+	}
+
+	got, err := Find(fsys, `img\open.png`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Variant{
+		{Name: "img/open.png", Scale: 1},
+		{Name: "img/open@1.5x.PNG", Scale: 1.5},
+		{Name: "img/open@2x.png", Scale: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Find(open.png): got %+v, want %+v", got, want)
+	}
+
+	got, err = Find(fsys, "img/save.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []Variant{
+		{Name: "img/save-16.png", Width: 16},
+		{Name: "img/save-32x32.png", Width: 32},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Find(save.png): got %+v, want %+v", got, want)
+	}
+
+	if _, err := Find(fsys, "img/missing.png"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Find(missing.png): got error %v, want fs.ErrNotExist", err)
+	}
+	if _, err := Find(fsys, "nodir/open.png"); err == nil {
+		t.Error("Find(nodir/open.png): got nil error")
+	}
+}
+
+func TestNames(t *testing.T) {
+	fsys := fstest.MapFS{
+		"icons/open.png":         {},
+		"icons/open@2x.png":      {},
+		"icons/save-16.png":      {},
+		"icons/save-32x32.png":   {},
+		"icons/save.svg":         {},
+		"icons/zoom-in.svg":      {},
+		"icons/@2x.png":          {},
+		"icons/dark/open.png":    {},
+		"icons/dark/open@2x.png": {},
+	}
+
+	got, err := Names(fsys, "icons")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"@2x.png", "open.png", "save.png", "save.svg", "zoom-in.svg"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Names(icons): got %q, want %q", got, want)
+	}
+
+	if _, err := Names(fsys, "missing"); err == nil {
+		t.Error("Names(missing): got nil error")
+	}
+}
+
+func TestSelect(t *testing.T) {
+	scaled := []Variant{
+		{Name: "a.png", Scale: 1},
+		{Name: "a@1.5x.png", Scale: 1.5},
+		{Name: "a@2x.png", Scale: 2},
+	}
+	sized := []Variant{
+		{Name: "b-16.png", Width: 16},
+		{Name: "b-32.png", Width: 32},
+		{Name: "b-48.png", Width: 48},
+	}
+
+	testCases := []struct {
+		variants []Variant
+		dpi      int
+		width96  int
+		want     string
+	}{
+		{scaled, 96, 0, "a.png"},
+		{scaled, 120, 0, "a@1.5x.png"},
+		{scaled, 144, 0, "a@1.5x.png"},
+		{scaled, 168, 0, "a@2x.png"},
+		{scaled, 288, 0, "a@2x.png"},
+		{scaled, 72, 0, "a.png"},
+		{sized, 96, 0, "b-16.png"},
+		{sized, 144, 0, "b-32.png"},
+		{sized, 192, 0, "b-32.png"},
+		{sized, 240, 0, "b-48.png"},
+		{sized, 96, 24, "b-32.png"},
+		{nil, 96, 0, ""},
+	}
+
+	for _, c := range testCases {
+		if got := Select(c.variants, c.dpi, c.width96); got.Name != c.want {
+			t.Errorf("Select(%v, %d, %d): got %q, want %q", c.variants, c.dpi, c.width96, got.Name, c.want)
+		}
+	}
+}
+
+func TestVariantDPI(t *testing.T) {
+	if got := (Variant{Scale: 1.25}).DPI(0); got != 120 {
+		t.Errorf("DPI of @1.25x: got %d, want 120", got)
+	}
+	if got := (Variant{Width: 48}).DPI(16); got != 288 {
+		t.Errorf("DPI of -48 at width 16: got %d, want 288", got)
+	}
+}
+
+// iconFile builds an .ico or .cur file whose images consist of a single byte
+// holding their index.
+func iconFile(cursor bool, sizes ...int) []byte {
+	le := binary.LittleEndian
+
+	data := make([]byte, 6+16*len(sizes))
+	le.PutUint16(data[2:], 1)
+	if cursor {
+		le.PutUint16(data[2:], 2)
+	}
+	le.PutUint16(data[4:], uint16(len(sizes)))
+
+	for i, size := range sizes {
+		dir := data[6+16*i:]
+		dir[0], dir[1] = byte(size), byte(size)
+		if cursor {
+			le.PutUint16(dir[4:], uint16(i+1))
+			le.PutUint16(dir[6:], uint16(i+2))
+		} else {
+			le.PutUint16(dir[6:], 32)
+		}
+		le.PutUint32(dir[8:], 1)
+		le.PutUint32(dir[12:], uint32(len(data)))
+		data = append(data, byte(i))
+	}
+
+	return data
+}
+
+func TestParseIconFile(t *testing.T) {
+	entries, cursor, err := ParseIconFile(iconFile(false, 16, 32, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cursor {
+		t.Error("got cursor, want icon")
+	}
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries, want 3", len(entries))
+	}
+	for i, want := range []int{16, 32, 256} {
+		e := entries[i]
+		if e.Width != want || e.Height != want || e.BitCount != 32 || !reflect.DeepEqual(e.Data, []byte{byte(i)}) {
+			t.Errorf("entry %d: got %+v", i, e)
+		}
+	}
+
+	entries, cursor, err = ParseIconFile(iconFile(true, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cursor || entries[0].HotspotX != 1 || entries[0].HotspotY != 2 {
+		t.Errorf("cursor: got %v, %+v", cursor, entries[0])
+	}
+	if got, want := CursorResource(entries[0]), []byte{1, 0, 2, 0, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CursorResource: got %v, want %v", got, want)
+	}
+
+	truncated := iconFile(false, 16)
+	for _, data := range [][]byte{nil, {0, 0, 3, 0, 1, 0}, truncated[:len(truncated)-1]} {
+		if _, _, err := ParseIconFile(data); err == nil {
+			t.Errorf("ParseIconFile(%v): got nil error", data)
+		}
+	}
+}
+
+func TestSelectIconEntry(t *testing.T) {
+	entries := []IconEntry{
+		{Width: 32, BitCount: 8},
+		{Width: 16, BitCount: 32},
+		{Width: 48, BitCount: 32},
+		{Width: 32, BitCount: 32},
+	}
+
+	for _, c := range []struct{ width, want int }{
+		{16, 1},
+		{20, 3},
+		{32, 3},
+		{40, 2},
+		{256, 2},
+	} {
+		if got := SelectIconEntry(entries, c.width); got != c.want {
+			t.Errorf("SelectIconEntry(%d): got %d, want %d", c.width, got, c.want)
+		}
+	}
+
+	if got := SelectIconEntry(nil, 16); got != -1 {
+		t.Errorf("SelectIconEntry of no entries: got %d, want -1", got)
+	}
+}
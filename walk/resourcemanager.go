@@ -9,15 +9,26 @@ package walk
 
 import (
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/xackery/wlk/win"
 )
 
 func init() {
 	Resources.rootDirPath, _ = os.Getwd()
-	Resources.bitmaps = make(map[string]*Bitmap)
+	Resources.bitmaps = make(map[bitmapKey]*Bitmap)
 	Resources.icons = make(map[string]*Icon)
+	Resources.svgImages = make(map[string]*SVGImage)
+	Resources.images = make(map[string]Image)
+	Resources.cursors = make(map[string]Cursor)
+	Resources.fonts = make(map[string]*FontMemResource)
 }
 
 // Resources is the singleton instance of ResourceManager.
@@ -25,11 +36,27 @@ var Resources ResourceManager
 
 // ResourceManager is a cache for sharing resources like bitmaps and icons.
 // The resources can be either embedded in the running executable
-// file or located below a specified root directory in the file system.
+// file, located below a specified root directory in the file system or
+// located in file systems mounted with MountFS, such as an embed.FS.
 type ResourceManager struct {
 	rootDirPath string
-	bitmaps     map[string]*Bitmap
+	fileSystems []mountedFS
+	bitmaps     map[bitmapKey]*Bitmap
 	icons       map[string]*Icon
+	svgImages   map[string]*SVGImage
+	images      map[string]Image
+	cursors     map[string]Cursor
+	fonts       map[string]*FontMemResource
+}
+
+type mountedFS struct {
+	fsys     fs.FS
+	priority int
+}
+
+type bitmapKey struct {
+	name string
+	dpi  int
 }
 
 // RootDirPath returns the root directory path where resources are to be loaded from.
@@ -49,6 +76,62 @@ func (rm *ResourceManager) SetRootDirPath(rootDirPath string) error {
 	return nil
 }
 
+// MountFS adds fsys, for example an embed.FS, to the file systems resources are loaded from.
+//
+// File systems are searched by descending priority, file systems of equal priority in the
+// order they were mounted. The root directory counts as a file system of priority 0 that was
+// mounted first, so a priority > 0 lets fsys override files on disk and a priority < 0 makes
+// it a fallback. Resources embedded in the executable are searched last.
+//
+// Images in fsys may come with DPI variants, like open.png, open@2x.png or open-32.png,
+// see package resfs. Resources that have already been loaded stay cached.
+func (rm *ResourceManager) MountFS(fsys fs.FS, priority int) {
+	rm.fileSystems = append(rm.fileSystems, mountedFS{fsys, priority})
+
+	sort.SliceStable(rm.fileSystems, func(i, j int) bool {
+		return rm.fileSystems[i].priority > rm.fileSystems[j].priority
+	})
+}
+
+// UnmountFS removes fsys from the file systems resources are loaded from. Only file systems
+// of comparable types, like embed.FS or the result of os.DirFS, can be unmounted.
+func (rm *ResourceManager) UnmountFS(fsys fs.FS) {
+	if fsys == nil || !reflect.TypeOf(fsys).Comparable() {
+		return
+	}
+
+	for i, m := range rm.fileSystems {
+		if reflect.TypeOf(m.fsys) == reflect.TypeOf(fsys) && m.fsys == fsys {
+			rm.fileSystems = append(rm.fileSystems[:i], rm.fileSystems[i+1:]...)
+			return
+		}
+	}
+}
+
+// searchPath returns the file systems to search in order, where nil stands for the root
+// directory.
+func (rm *ResourceManager) searchPath() []fs.FS {
+	result := make([]fs.FS, 0, len(rm.fileSystems)+1)
+
+	rootAdded := false
+	for _, m := range rm.fileSystems {
+		if !rootAdded && m.priority <= 0 {
+			result = append(result, nil)
+			rootAdded = true
+		}
+		result = append(result, m.fsys)
+	}
+	if !rootAdded {
+		result = append(result, nil)
+	}
+
+	return result
+}
+
+func (rm *ResourceManager) filePath(name string) string {
+	return filepath.Join(rm.rootDirPath, name)
+}
+
 // Bitmap loads a bitmap from file or resource identified by name, or an error if it could not be
 // found. When bitmap is loaded, 96dpi is assumed.
 //
@@ -58,25 +141,36 @@ func (rm *ResourceManager) Bitmap(name string) (*Bitmap, error) {
 }
 
 // BitmapForDPI loads a bitmap from file or resource identified by name, or an error if it could
-// not be found. When bitmap is loaded, given DPI is assumed.
+// not be found. When bitmap is loaded, given DPI is assumed. From mounted file systems, the DPI
+// variant best suited for dpi is loaded.
 func (rm *ResourceManager) BitmapForDPI(name string, dpi int) (*Bitmap, error) {
-	if bm := rm.bitmaps[name]; bm != nil {
+	key := bitmapKey{name, dpi}
+	if bm := rm.bitmaps[key]; bm != nil {
 		return bm, nil
 	}
 
-	if bm, err := NewBitmapFromFileForDPI(filepath.Join(rm.rootDirPath, name), dpi); err == nil {
-		rm.bitmaps[name] = bm
-		return bm, nil
+	for _, fsys := range rm.searchPath() {
+		var bm *Bitmap
+		var err error
+		if fsys == nil {
+			bm, err = NewBitmapFromFileForDPI(rm.filePath(name), dpi)
+		} else {
+			bm, err = NewBitmapFromFSForDPI(fsys, name, dpi)
+		}
+		if err == nil {
+			rm.bitmaps[key] = bm
+			return bm, nil
+		}
 	}
 
 	if bm, err := NewBitmapFromResourceForDPI(name, dpi); err == nil {
-		rm.bitmaps[name] = bm
+		rm.bitmaps[key] = bm
 		return bm, nil
 	}
 
 	if id, err := strconv.Atoi(name); err == nil {
 		if bm, err := NewBitmapFromResourceIdForDPI(id, dpi); err == nil {
-			rm.bitmaps[name] = bm
+			rm.bitmaps[key] = bm
 			return bm, nil
 		}
 	}
@@ -90,9 +184,18 @@ func (rm *ResourceManager) Icon(name string) (*Icon, error) {
 		return icon, nil
 	}
 
-	if icon, err := NewIconFromFile(filepath.Join(rm.rootDirPath, name)); err == nil {
-		rm.icons[name] = icon
-		return icon, nil
+	for _, fsys := range rm.searchPath() {
+		var icon *Icon
+		var err error
+		if fsys == nil {
+			icon, err = NewIconFromFile(rm.filePath(name))
+		} else {
+			icon, err = NewIconFromFS(fsys, name)
+		}
+		if err == nil {
+			rm.icons[name] = icon
+			return icon, nil
+		}
 	}
 
 	if icon, err := NewIconFromResource(name); err == nil {
@@ -110,12 +213,76 @@ func (rm *ResourceManager) Icon(name string) (*Icon, error) {
 	return nil, rm.notFoundErr("icon", name)
 }
 
+// SVGImage returns the SVGImage identified by name, or an error if it could not be found.
+// Embedded SVG documents must be RT_RCDATA resources.
+func (rm *ResourceManager) SVGImage(name string) (*SVGImage, error) {
+	if si := rm.svgImages[name]; si != nil {
+		return si, nil
+	}
+
+	for _, fsys := range rm.searchPath() {
+		var si *SVGImage
+		var err error
+		if fsys == nil {
+			si, err = NewSVGImageFromFile(rm.filePath(name))
+		} else {
+			si, err = NewSVGImageFromFS(fsys, name)
+		}
+		if err == nil {
+			rm.svgImages[name] = si
+			return si, nil
+		}
+	}
+
+	if si, err := NewSVGImageFromResource(name); err == nil {
+		rm.svgImages[name] = si
+		return si, nil
+	}
+
+	if id, err := strconv.Atoi(name); err == nil {
+		if si, err := NewSVGImageFromResourceId(id); err == nil {
+			rm.svgImages[name] = si
+			return si, nil
+		}
+	}
+
+	return nil, rm.notFoundErr("SVG image", name)
+}
+
 // Image returns the Image identified by name, or an error if it could not be found.
+// Names ending in .svg are loaded as SVGImage. Bitmaps from mounted file systems are
+// drawn using the DPI variant best suited for the target.
 func (rm *ResourceManager) Image(name string) (Image, error) {
+	if strings.HasSuffix(strings.ToLower(name), ".svg") {
+		if si, err := rm.SVGImage(name); err == nil {
+			return si, nil
+		}
+	}
+
 	if icon, err := rm.Icon(name); err == nil {
 		return icon, nil
 	}
 
+	if img := rm.images[name]; img != nil {
+		return img, nil
+	}
+
+	for _, fsys := range rm.searchPath() {
+		if fsys == nil {
+			// Bitmaps on disk are shared with Bitmap, which loads them below.
+			// Fallback mounts, which come after the root directory, are still
+			// searched for DPI variants if there is no such file.
+			if _, err := os.Stat(rm.filePath(name)); err == nil {
+				break
+			}
+			continue
+		}
+		if img, err := newVariantImageFromFS(fsys, name); err == nil {
+			rm.images[name] = img
+			return img, nil
+		}
+	}
+
 	if bm, err := rm.Bitmap(name); err == nil {
 		return bm, nil
 	}
@@ -123,8 +290,90 @@ func (rm *ResourceManager) Image(name string) (Image, error) {
 	return nil, rm.notFoundErr("image", name)
 }
 
+// Cursor returns the Cursor identified by name, or an error if it could not be found.
+// Cursors may be .cur or .ani files or, in mounted file systems, images whose hotspot is
+// their top left corner.
+func (rm *ResourceManager) Cursor(name string) (Cursor, error) {
+	if c := rm.cursors[name]; c != nil {
+		return c, nil
+	}
+
+	for _, fsys := range rm.searchPath() {
+		var c Cursor
+		var err error
+		if fsys == nil {
+			c, err = NewCursorFromFile(rm.filePath(name))
+		} else {
+			c, err = NewCursorFromFS(fsys, name)
+		}
+		if err == nil {
+			rm.cursors[name] = c
+			return c, nil
+		}
+	}
+
+	hInst := win.GetModuleHandle(nil)
+
+	res, err := syscall.UTF16PtrFromString(name)
+	if id, idErr := strconv.Atoi(name); idErr == nil {
+		res, err = win.MAKEINTRESOURCE(uintptr(id)), nil
+	}
+	if err == nil {
+		if hCursor := win.LoadImage(hInst, res, win.IMAGE_CURSOR, 0, 0, win.LR_DEFAULTSIZE); hCursor != 0 {
+			c := customCursor{win.HCURSOR(hCursor)}
+			rm.cursors[name] = c
+			return c, nil
+		}
+	}
+
+	return nil, rm.notFoundErr("cursor", name)
+}
+
+// Font returns the FontMemResource identified by name, or an error if it could not be found.
+// Once loaded, the font can be used by its family name, for example with NewFont.
+func (rm *ResourceManager) Font(name string) (*FontMemResource, error) {
+	if fmr := rm.fonts[name]; fmr != nil {
+		return fmr, nil
+	}
+
+	for _, fsys := range rm.searchPath() {
+		var fmr *FontMemResource
+		var err error
+		if fsys == nil {
+			var data []byte
+			if data, err = os.ReadFile(rm.filePath(name)); err == nil {
+				fmr, err = NewFontMemResourceFromBytes(data)
+			}
+		} else {
+			fmr, err = NewFontMemResourceFromFS(fsys, name)
+		}
+		if err == nil {
+			rm.fonts[name] = fmr
+			return fmr, nil
+		}
+	}
+
+	if fmr, err := NewFontMemResourceByName(name); err == nil {
+		rm.fonts[name] = fmr
+		return fmr, nil
+	}
+
+	if id, err := strconv.Atoi(name); err == nil {
+		if fmr, err := NewFontMemResourceById(id); err == nil {
+			rm.fonts[name] = fmr
+			return fmr, nil
+		}
+	}
+
+	return nil, rm.notFoundErr("font", name)
+}
+
 func (rm *ResourceManager) notFoundErr(typ, name string) error {
-	path := filepath.Clean(filepath.Join(rm.rootDirPath, name))
+	path := filepath.Clean(rm.filePath(name))
+
+	if len(rm.fileSystems) > 0 {
+		return newError(fmt.Sprintf("neither %s resource '%s' nor file '%s' could be found in the executable, on disk or in a mounted file system, or the format is not supported", typ, name, path))
+	}
 
 	return newError(fmt.Sprintf("neither %s resource '%s' nor file '%s' could be found or the image format is not supported", typ, name, path))
 }
@@ -115,6 +115,18 @@ func (sv *ScrollView) Children() *WidgetList {
 	return sv.composite.Children()
 }
 
+// TabNavigation returns how the Tab key moves the keyboard focus through the
+// descendants of the ScrollView.
+func (sv *ScrollView) TabNavigation() TabNavigation {
+	return sv.composite.TabNavigation()
+}
+
+// SetTabNavigation sets how the Tab key moves the keyboard focus through the
+// descendants of the ScrollView.
+func (sv *ScrollView) SetTabNavigation(navigation TabNavigation) error {
+	return sv.composite.SetTabNavigation(navigation)
+}
+
 func (sv *ScrollView) Layout() Layout {
 	if sv.composite == nil {
 		return nil
@@ -307,6 +319,62 @@ func (sv *ScrollView) scroll(sb int32, cmd uint16) int {
 	return -int(pos)
 }
 
+// ensureVisible scrolls the ScrollView so that widget, one of its descendants,
+// is visible, or as much of it as fits, starting at the top left.
+func (sv *ScrollView) ensureVisible(widget Widget) {
+	var r win.RECT
+	if !win.GetWindowRect(widget.Handle(), &r) {
+		return
+	}
+
+	topLeft := win.POINT{X: r.Left, Y: r.Top}
+	bottomRight := win.POINT{X: r.Right, Y: r.Bottom}
+	if !win.ScreenToClient(sv.hWnd, &topLeft) || !win.ScreenToClient(sv.hWnd, &bottomRight) {
+		return
+	}
+
+	h, v := sv.Scrollbars()
+	if h {
+		sv.composite.SetXPixels(sv.scrollIntoView(win.SB_HORZ, topLeft.X, bottomRight.X))
+	}
+	if v {
+		sv.composite.SetYPixels(sv.scrollIntoView(win.SB_VERT, topLeft.Y, bottomRight.Y))
+	}
+}
+
+// scrollIntoView scrolls so that the range from low to high, in client
+// coordinates, is visible and returns the new position in native pixels.
+func (sv *ScrollView) scrollIntoView(sb int32, low, high int32) int {
+	var si win.SCROLLINFO
+	si.CbSize = uint32(unsafe.Sizeof(si))
+	si.FMask = win.SIF_PAGE | win.SIF_POS | win.SIF_RANGE
+
+	win.GetScrollInfo(sv.hWnd, sb, &si)
+
+	pos := si.NPos
+
+	if high > int32(si.NPage) {
+		pos += high - int32(si.NPage)
+		low -= high - int32(si.NPage)
+	}
+	if low < 0 {
+		pos += low
+	}
+
+	if pos > si.NMax+1-int32(si.NPage) {
+		pos = si.NMax + 1 - int32(si.NPage)
+	}
+	if pos < 0 {
+		pos = 0
+	}
+
+	si.FMask = win.SIF_POS
+	si.NPos = pos
+	win.SetScrollInfo(sv.hWnd, sb, &si, true)
+
+	return -int(pos)
+}
+
 func (sv *ScrollView) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 	svli := new(scrollViewLayoutItem)
 	svli.ctx = ctx
@@ -0,0 +1,65 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+type secondInstanceEventHandlerInfo struct {
+	handler SecondInstanceEventHandler
+	once    bool
+}
+
+// SecondInstanceEventHandler is called with the command line arguments,
+// without the program name, and the working directory of a second instance.
+type SecondInstanceEventHandler func(args []string, workingDir string)
+
+type SecondInstanceEvent struct {
+	handlers []secondInstanceEventHandlerInfo
+}
+
+func (e *SecondInstanceEvent) Attach(handler SecondInstanceEventHandler) int {
+	handlerInfo := secondInstanceEventHandlerInfo{handler, false}
+
+	for i, h := range e.handlers {
+		if h.handler == nil {
+			e.handlers[i] = handlerInfo
+			return i
+		}
+	}
+
+	e.handlers = append(e.handlers, handlerInfo)
+
+	return len(e.handlers) - 1
+}
+
+func (e *SecondInstanceEvent) Detach(handle int) {
+	e.handlers[handle].handler = nil
+}
+
+func (e *SecondInstanceEvent) Once(handler SecondInstanceEventHandler) {
+	i := e.Attach(handler)
+	e.handlers[i].once = true
+}
+
+type SecondInstanceEventPublisher struct {
+	event SecondInstanceEvent
+}
+
+func (p *SecondInstanceEventPublisher) Event() *SecondInstanceEvent {
+	return &p.event
+}
+
+func (p *SecondInstanceEventPublisher) Publish(args []string, workingDir string) {
+	for i, h := range p.event.handlers {
+		if h.handler != nil {
+			h.handler(args, workingDir)
+
+			if h.once {
+				p.event.Detach(i)
+			}
+		}
+	}
+}
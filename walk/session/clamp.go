@@ -0,0 +1,119 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package session
+
+// Clamp returns where to restore a window whose normal bounds were recorded
+// on the monitor saved, given the monitors that are connected now, and the
+// monitor it ends up on.
+//
+// A window that is still completely on the work areas of connected monitors
+// keeps its bounds, unless the DPI of its monitor changed, in which case its
+// size is scaled. Otherwise the window is moved to its monitor if that is
+// still connected, or to the connected monitor it overlaps most, or to the
+// primary monitor, keeping its position relative to the work area where
+// possible, and is shrunk and moved to fit into the work area.
+func Clamp(bounds Rect, saved Monitor, monitors []Monitor) (Rect, Monitor) {
+	if len(monitors) == 0 {
+		return bounds, saved
+	}
+
+	target := targetMonitor(bounds, saved, monitors)
+
+	if saved.DPI > 0 && target.DPI > 0 && saved.DPI != target.DPI {
+		bounds.Width = bounds.Width * target.DPI / saved.DPI
+		bounds.Height = bounds.Height * target.DPI / saved.DPI
+	}
+
+	if onWorkAreas(bounds, monitors) {
+		return bounds, target
+	}
+
+	wa := target.WorkArea
+	if wa.Empty() {
+		wa = target.Bounds
+	}
+
+	if bounds.Intersect(wa).Empty() {
+		// Keep the position relative to the work area of the old monitor.
+		if old := saved.WorkArea; !old.Empty() {
+			bounds.X = wa.X + (bounds.X - old.X)
+			bounds.Y = wa.Y + (bounds.Y - old.Y)
+		} else {
+			bounds.X = wa.X + (wa.Width-bounds.Width)/2
+			bounds.Y = wa.Y + (wa.Height-bounds.Height)/2
+		}
+	}
+
+	return fit(bounds, wa), target
+}
+
+// targetMonitor returns the monitor a window with the specified bounds,
+// recorded on the monitor saved, belongs to.
+func targetMonitor(bounds Rect, saved Monitor, monitors []Monitor) Monitor {
+	if saved.ID != "" {
+		for _, m := range monitors {
+			if m.ID == saved.ID {
+				return m
+			}
+		}
+	}
+
+	for _, r := range []Rect{bounds, saved.Bounds} {
+		best, bestArea := -1, 0
+		for i, m := range monitors {
+			if area := r.Intersect(m.Bounds).Area(); area > bestArea {
+				best, bestArea = i, area
+			}
+		}
+		if best >= 0 {
+			return monitors[best]
+		}
+	}
+
+	for _, m := range monitors {
+		if m.Primary {
+			return m
+		}
+	}
+
+	return monitors[0]
+}
+
+// onWorkAreas returns whether bounds is covered completely by the work areas
+// of monitors, which must not overlap.
+func onWorkAreas(bounds Rect, monitors []Monitor) bool {
+	if bounds.Empty() {
+		return false
+	}
+
+	var covered int
+	for _, m := range monitors {
+		covered += bounds.Intersect(m.WorkArea).Area()
+	}
+
+	return covered == bounds.Area()
+}
+
+// fit shrinks r to the size of area if it is larger and then moves it into
+// area.
+func fit(r, area Rect) Rect {
+	r.Width = minInt(r.Width, area.Width)
+	r.Height = minInt(r.Height, area.Height)
+
+	if r.Right() > area.Right() {
+		r.X = area.Right() - r.Width
+	}
+	if r.X < area.X {
+		r.X = area.X
+	}
+	if r.Bottom() > area.Bottom() {
+		r.Y = area.Bottom() - r.Height
+	}
+	if r.Y < area.Y {
+		r.Y = area.Y
+	}
+
+	return r
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package session holds the window state recorded by walk.SessionManager and
+// the pure logic that places restored windows on the monitors that are
+// connected at the time.
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Version is the version of the Snapshot format written by this package.
+const Version = 1
+
+// Rect is a rectangle in native pixels of the virtual screen.
+type Rect struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// Right returns the x coordinate just right of r.
+func (r Rect) Right() int {
+	return r.X + r.Width
+}
+
+// Bottom returns the y coordinate just below r.
+func (r Rect) Bottom() int {
+	return r.Y + r.Height
+}
+
+// Empty returns whether r has no area.
+func (r Rect) Empty() bool {
+	return r.Width <= 0 || r.Height <= 0
+}
+
+// Area returns the area of r, which is 0 for an empty r.
+func (r Rect) Area() int {
+	if r.Empty() {
+		return 0
+	}
+
+	return r.Width * r.Height
+}
+
+// Intersect returns the intersection of r and s, which is empty if they
+// don't overlap.
+func (r Rect) Intersect(s Rect) Rect {
+	x0, y0 := maxInt(r.X, s.X), maxInt(r.Y, s.Y)
+	x1, y1 := minInt(r.Right(), s.Right()), minInt(r.Bottom(), s.Bottom())
+	if x1 <= x0 || y1 <= y0 {
+		return Rect{}
+	}
+
+	return Rect{x0, y0, x1 - x0, y1 - y0}
+}
+
+// Monitor describes a display.
+type Monitor struct {
+	// ID identifies the monitor, on Windows it is the device name, for
+	// example `\\.\DISPLAY1`.
+	ID       string `json:"id"`
+	Bounds   Rect   `json:"bounds"`
+	WorkArea Rect   `json:"workArea"`
+	DPI      int    `json:"dpi"`
+	Primary  bool   `json:"primary,omitempty"`
+}
+
+// WindowState is the state of a form.
+type WindowState struct {
+	// Bounds are the normal, that is not maximized or minimized, bounds.
+	Bounds    Rect `json:"bounds"`
+	Maximized bool `json:"maximized,omitempty"`
+
+	// Monitor is the monitor the form was on when the state was recorded.
+	Monitor Monitor `json:"monitor"`
+
+	// Splitters holds the state of splitters and TableViews holds the state
+	// of table views, both by path of the widget within the form.
+	Splitters  map[string]string `json:"splitters,omitempty"`
+	TableViews map[string]string `json:"tableViews,omitempty"`
+}
+
+// Snapshot is the state of a session of several forms.
+type Snapshot struct {
+	Version int                     `json:"version"`
+	Windows map[string]*WindowState `json:"windows"` // by form name
+}
+
+// NewSnapshot returns an empty Snapshot.
+func NewSnapshot() *Snapshot {
+	return &Snapshot{
+		Version: Version,
+		Windows: make(map[string]*WindowState),
+	}
+}
+
+// Parse parses a Snapshot in the JSON format written by Marshal.
+func Parse(data []byte) (*Snapshot, error) {
+	s := NewSnapshot()
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	if s.Version > Version {
+		return nil, fmt.Errorf("session: unsupported snapshot version %d", s.Version)
+	}
+	if s.Windows == nil {
+		s.Windows = make(map[string]*WindowState)
+	}
+
+	return s, nil
+}
+
+// Marshal returns s in JSON format.
+func (s *Snapshot) Marshal() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
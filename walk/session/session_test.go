@@ -0,0 +1,177 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package session
+
+import (
+	"reflect"
+	"testing"
+)
+
+var (
+	primary = Monitor{
+		ID:       `\\.\DISPLAY1`,
+		Bounds:   Rect{0, 0, 1920, 1080},
+		WorkArea: Rect{0, 0, 1920, 1040},
+		DPI:      96,
+		Primary:  true,
+	}
+	secondary = Monitor{
+		ID:       `\\.\DISPLAY2`,
+		Bounds:   Rect{1920, 0, 2560, 1440},
+		WorkArea: Rect{1920, 0, 2560, 1440},
+		DPI:      144,
+	}
+	left = Monitor{
+		ID:       `\\.\DISPLAY3`,
+		Bounds:   Rect{-1280, 0, 1280, 1024},
+		WorkArea: Rect{-1280, 0, 1280, 1024},
+		DPI:      96,
+	}
+)
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		name        string
+		bounds      Rect
+		saved       Monitor
+		monitors    []Monitor
+		wantBounds  Rect
+		wantMonitor string
+	}{
+		{
+			name:        "unchanged",
+			bounds:      Rect{100, 100, 800, 600},
+			saved:       primary,
+			monitors:    []Monitor{primary, secondary},
+			wantBounds:  Rect{100, 100, 800, 600},
+			wantMonitor: primary.ID,
+		},
+		{
+			name:        "spanning monitors",
+			bounds:      Rect{1500, 100, 800, 600},
+			saved:       primary,
+			monitors:    []Monitor{primary, secondary},
+			wantBounds:  Rect{1500, 100, 800, 600},
+			wantMonitor: primary.ID,
+		},
+		{
+			name:        "below taskbar",
+			bounds:      Rect{100, 700, 800, 600},
+			saved:       primary,
+			monitors:    []Monitor{primary},
+			wantBounds:  Rect{100, 440, 800, 600},
+			wantMonitor: primary.ID,
+		},
+		{
+			name:        "disconnected monitor",
+			bounds:      Rect{2020, 100, 1200, 900},
+			saved:       Monitor{ID: secondary.ID, Bounds: secondary.Bounds, WorkArea: secondary.WorkArea, DPI: 96},
+			monitors:    []Monitor{primary},
+			wantBounds:  Rect{100, 100, 1200, 900},
+			wantMonitor: primary.ID,
+		},
+		{
+			name:        "disconnected monitor, DPI scaled",
+			bounds:      Rect{2020, 100, 1200, 900},
+			saved:       secondary,
+			monitors:    []Monitor{primary},
+			wantBounds:  Rect{100, 100, 800, 600},
+			wantMonitor: primary.ID,
+		},
+		{
+			name:        "disconnected monitor, too large",
+			bounds:      Rect{1920, 0, 2560, 1440},
+			saved:       Monitor{ID: secondary.ID, Bounds: secondary.Bounds, WorkArea: secondary.WorkArea, DPI: 96},
+			monitors:    []Monitor{primary},
+			wantBounds:  Rect{0, 0, 1920, 1040},
+			wantMonitor: primary.ID,
+		},
+		{
+			name:        "monitor moved",
+			bounds:      Rect{-1180, 100, 800, 600},
+			saved:       left,
+			monitors:    []Monitor{primary, {ID: left.ID, Bounds: Rect{1920, 0, 1280, 1024}, WorkArea: Rect{1920, 0, 1280, 1024}, DPI: 96}},
+			wantBounds:  Rect{2020, 100, 800, 600},
+			wantMonitor: left.ID,
+		},
+		{
+			name:        "unknown monitor, overlapping",
+			bounds:      Rect{1800, 100, 800, 600},
+			saved:       Monitor{ID: "gone"},
+			monitors:    []Monitor{primary, secondary},
+			wantBounds:  Rect{1800, 100, 800, 600},
+			wantMonitor: secondary.ID,
+		},
+		{
+			name:        "nowhere",
+			bounds:      Rect{5000, 5000, 400, 300},
+			saved:       Monitor{},
+			monitors:    []Monitor{secondary, primary},
+			wantBounds:  Rect{760, 370, 400, 300},
+			wantMonitor: primary.ID,
+		},
+		{
+			name:        "no monitors",
+			bounds:      Rect{5000, 5000, 400, 300},
+			saved:       primary,
+			wantBounds:  Rect{5000, 5000, 400, 300},
+			wantMonitor: primary.ID,
+		},
+	}
+
+	for _, test := range tests {
+		bounds, monitor := Clamp(test.bounds, test.saved, test.monitors)
+		if bounds != test.wantBounds {
+			t.Errorf("%s: bounds: got %v, want %v", test.name, bounds, test.wantBounds)
+		}
+		if monitor.ID != test.wantMonitor {
+			t.Errorf("%s: monitor: got %q, want %q", test.name, monitor.ID, test.wantMonitor)
+		}
+	}
+}
+
+func TestRectIntersect(t *testing.T) {
+	tests := []struct {
+		r, s, want Rect
+	}{
+		{Rect{0, 0, 10, 10}, Rect{5, 5, 10, 10}, Rect{5, 5, 5, 5}},
+		{Rect{0, 0, 10, 10}, Rect{10, 0, 10, 10}, Rect{}},
+		{Rect{-5, -5, 10, 10}, Rect{0, 0, 2, 2}, Rect{0, 0, 2, 2}},
+	}
+
+	for _, test := range tests {
+		if got := test.r.Intersect(test.s); got != test.want {
+			t.Errorf("%v.Intersect(%v): got %v, want %v", test.r, test.s, got, test.want)
+		}
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	s := NewSnapshot()
+	s.Windows["main"] = &WindowState{
+		Bounds:     Rect{10, 20, 300, 200},
+		Maximized:  true,
+		Monitor:    primary,
+		Splitters:  map[string]string{"main/splitter": "100 200"},
+		TableViews: map[string]string{"main/tv": `{"SortOrder":1}`},
+	}
+
+	data, err := s.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	got, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if !reflect.DeepEqual(got, s) {
+		t.Errorf("Parse: got %+v, want %+v", got, s)
+	}
+
+	if _, err := Parse([]byte(`{"version":99}`)); err == nil {
+		t.Errorf("Parse: got nil error for unsupported version")
+	}
+}
@@ -0,0 +1,432 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"encoding/json"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"github.com/xackery/wlk/walk/session"
+	"github.com/xackery/wlk/win"
+	"golang.org/x/sys/windows"
+)
+
+// SessionManager saves and restores the state of forms, by name, including
+// the monitor they were on, the sizes of their splitters and the states of
+// their table views.
+//
+// Unlike FormBase.RestoreState, restoring a form with a SessionManager keeps
+// it on the work areas of the monitors that are connected at the time, see
+// session.Clamp.
+//
+// Splitters and table views are recorded by their path of names within the
+// form, so they and their ancestors must have names.
+type SessionManager struct {
+	settings Settings
+	key      string
+}
+
+// NewSessionManager returns a SessionManager that stores the state of forms
+// in settings, below key. With nil settings, App().Settings() is used.
+func NewSessionManager(settings Settings, key string) *SessionManager {
+	return &SessionManager{settings: settings, key: key}
+}
+
+func (sm *SessionManager) appSettings() (Settings, error) {
+	if sm.settings != nil {
+		return sm.settings, nil
+	}
+
+	if settings := App().Settings(); settings != nil {
+		return settings, nil
+	}
+
+	return nil, newError("App().Settings() must not be nil")
+}
+
+func (sm *SessionManager) formKey(name string) string {
+	return sm.key + "/forms/" + name
+}
+
+func (sm *SessionManager) sessionKey() string {
+	return sm.key + "/session"
+}
+
+// Capture returns the current state of form.
+func (sm *SessionManager) Capture(form Form) (*session.WindowState, error) {
+	fb := form.AsFormBase()
+
+	var wp win.WINDOWPLACEMENT
+	wp.Length = uint32(unsafe.Sizeof(wp))
+
+	if !win.GetWindowPlacement(fb.hWnd, &wp) {
+		return nil, lastError("GetWindowPlacement")
+	}
+
+	monitor, err := monitorFromHandle(win.MonitorFromWindow(fb.hWnd, win.MONITOR_DEFAULTTONEAREST))
+	if err != nil {
+		return nil, err
+	}
+	monitor.DPI = fb.DPI()
+
+	dx, dy := workspaceOffset(fb.hWnd, monitor)
+	rc := wp.RcNormalPosition
+
+	ws := &session.WindowState{
+		Bounds: session.Rect{
+			X:      int(rc.Left) + dx,
+			Y:      int(rc.Top) + dy,
+			Width:  int(rc.Right - rc.Left),
+			Height: int(rc.Bottom - rc.Top),
+		},
+		Maximized: wp.ShowCmd == win.SW_SHOWMAXIMIZED ||
+			wp.ShowCmd == win.SW_SHOWMINIMIZED && wp.Flags&win.WPF_RESTORETOMAXIMIZED != 0,
+		Monitor:    monitor,
+		Splitters:  make(map[string]string),
+		TableViews: make(map[string]string),
+	}
+
+	var walkErr error
+	walkDescendants(fb.clientComposite, func(w Window) bool {
+		path := w.AsWindowBase().path()
+		if !validStatePath(path) {
+			return true
+		}
+
+		switch w := w.(type) {
+		case *Splitter:
+			ws.Splitters[path] = w.stateString()
+
+		case *TableView:
+			state, err := w.stateString()
+			if err != nil {
+				walkErr = err
+				return false
+			}
+			if state != "" {
+				ws.TableViews[path] = state
+			}
+		}
+
+		return true
+	})
+	if walkErr != nil {
+		return nil, walkErr
+	}
+
+	return ws, nil
+}
+
+// Apply restores form to the state ws, which was returned by Capture.
+func (sm *SessionManager) Apply(form Form, ws *session.WindowState) error {
+	fb := form.AsFormBase()
+
+	monitors, err := Monitors()
+	if err != nil {
+		return err
+	}
+
+	bounds, monitor := session.Clamp(ws.Bounds, ws.Monitor, monitors)
+
+	if layout := fb.Layout(); layout != nil && fb.fixedSize() {
+		layoutItem := CreateLayoutItemsForContainer(fb)
+		minSize := fb.sizeFromClientSizePixels(layoutItem.MinSize())
+
+		bounds.Width, bounds.Height = minSize.Width, minSize.Height
+	}
+
+	var wp win.WINDOWPLACEMENT
+	wp.Length = uint32(unsafe.Sizeof(wp))
+
+	if !win.GetWindowPlacement(fb.hWnd, &wp) {
+		return lastError("GetWindowPlacement")
+	}
+
+	dx, dy := workspaceOffset(fb.hWnd, monitor)
+
+	wp.Flags = 0
+	wp.RcNormalPosition = win.RECT{
+		Left:   int32(bounds.X - dx),
+		Top:    int32(bounds.Y - dy),
+		Right:  int32(bounds.Right() - dx),
+		Bottom: int32(bounds.Bottom() - dy),
+	}
+
+	switch {
+	case ws.Maximized:
+		wp.ShowCmd = win.SW_SHOWMAXIMIZED
+
+	case win.IsWindowVisible(fb.hWnd):
+		wp.ShowCmd = win.SW_SHOWNORMAL
+
+	default:
+		wp.ShowCmd = win.SW_HIDE
+	}
+
+	if !win.SetWindowPlacement(fb.hWnd, &wp) {
+		return lastError("SetWindowPlacement")
+	}
+
+	walkDescendants(fb.clientComposite, func(w Window) bool {
+		path := w.AsWindowBase().path()
+
+		switch w := w.(type) {
+		case *Splitter:
+			if state, ok := ws.Splitters[path]; ok {
+				if _, err = w.restoreStateString(state); err != nil {
+					return false
+				}
+			}
+
+		case *TableView:
+			if state, ok := ws.TableViews[path]; ok {
+				if err = w.restoreStateString(state); err != nil {
+					return false
+				}
+			}
+		}
+
+		return true
+	})
+
+	return err
+}
+
+// SaveForm stores the state of form, which must have a name.
+func (sm *SessionManager) SaveForm(form Form) error {
+	name := form.Name()
+	if name == "" {
+		return newError("form must have a name")
+	}
+
+	ws, err := sm.Capture(form)
+	if err != nil {
+		return err
+	}
+
+	state, err := json.Marshal(ws)
+	if err != nil {
+		return err
+	}
+
+	settings, err := sm.appSettings()
+	if err != nil {
+		return err
+	}
+
+	return settings.PutExpiring(sm.formKey(name), string(state))
+}
+
+// RestoreForm restores the state of form stored with SaveForm. It does
+// nothing if there is none.
+func (sm *SessionManager) RestoreForm(form Form) error {
+	settings, err := sm.appSettings()
+	if err != nil {
+		return err
+	}
+
+	state, ok := settings.Get(sm.formKey(form.Name()))
+	if !ok || state == "" {
+		return nil
+	}
+
+	ws := new(session.WindowState)
+	if err := json.Unmarshal([]byte(state), ws); err != nil {
+		return err
+	}
+
+	return sm.Apply(form, ws)
+}
+
+// Snapshot returns the state of all forms that have a name.
+func (sm *SessionManager) Snapshot() (*session.Snapshot, error) {
+	s := session.NewSnapshot()
+
+	for _, form := range namedForms() {
+		ws, err := sm.Capture(form)
+		if err != nil {
+			return nil, err
+		}
+
+		s.Windows[form.Name()] = ws
+	}
+
+	return s, nil
+}
+
+// RestoreSnapshot restores the forms that are in s to their states. Forms
+// that are not in s and states of forms that don't exist are ignored.
+func (sm *SessionManager) RestoreSnapshot(s *session.Snapshot) error {
+	for _, form := range namedForms() {
+		if ws := s.Windows[form.Name()]; ws != nil {
+			if err := sm.Apply(form, ws); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// SaveSession stores a Snapshot of all forms that have a name.
+func (sm *SessionManager) SaveSession() error {
+	s, err := sm.Snapshot()
+	if err != nil {
+		return err
+	}
+
+	data, err := s.Marshal()
+	if err != nil {
+		return err
+	}
+
+	settings, err := sm.appSettings()
+	if err != nil {
+		return err
+	}
+
+	return settings.PutExpiring(sm.sessionKey(), string(data))
+}
+
+// LoadSession returns the Snapshot stored with SaveSession, or nil if there is
+// none. An application can use it to create the forms of the session before
+// calling RestoreSnapshot.
+func (sm *SessionManager) LoadSession() (*session.Snapshot, error) {
+	settings, err := sm.appSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := settings.Get(sm.sessionKey())
+	if !ok || data == "" {
+		return nil, nil
+	}
+
+	return session.Parse([]byte(data))
+}
+
+// RestoreSession restores the forms that exist to the states stored with
+// SaveSession.
+func (sm *SessionManager) RestoreSession() error {
+	s, err := sm.LoadSession()
+	if err != nil || s == nil {
+		return err
+	}
+
+	return sm.RestoreSnapshot(s)
+}
+
+// namedForms returns the live forms of the calling thread that have a name.
+func namedForms() []Form {
+	var forms []Form
+
+	threadID := windows.GetCurrentThreadId()
+
+	for hwnd, wb := range hwnd2WindowBase {
+		form, ok := wb.window.(Form)
+		if !ok || form.Name() == "" {
+			continue
+		}
+
+		if tid, _ := windows.GetWindowThreadProcessId(hwnd, nil); tid != threadID {
+			continue
+		}
+
+		forms = append(forms, form)
+	}
+
+	return forms
+}
+
+// validStatePath returns whether path is a path that state can be stored
+// for, which requires names for the window and all of its ancestors.
+func validStatePath(path string) bool {
+	return !strings.HasPrefix(path, "/") &&
+		!strings.HasSuffix(path, "/") &&
+		!strings.Contains(path, "//")
+}
+
+// workspaceOffset returns the offset of screen coordinates to the workspace
+// coordinates used by WINDOWPLACEMENT, for a window on monitor.
+func workspaceOffset(hwnd windows.HWND, monitor session.Monitor) (dx, dy int) {
+	if exStyle := win.GetWindowLongPtr(hwnd, win.GWL_EXSTYLE); exStyle&win.WS_EX_TOOLWINDOW != 0 {
+		return 0, 0
+	}
+
+	return monitor.WorkArea.X - monitor.Bounds.X, monitor.WorkArea.Y - monitor.Bounds.Y
+}
+
+// Monitors returns the monitors that are connected.
+func Monitors() ([]session.Monitor, error) {
+	var handles []win.HMONITOR
+
+	if !win.EnumDisplayMonitors(0, nil, enumMonitorsCallbackPtr, uintptr(unsafe.Pointer(&handles))) {
+		return nil, lastError("EnumDisplayMonitors")
+	}
+
+	monitors := make([]session.Monitor, 0, len(handles))
+	for _, h := range handles {
+		m, err := monitorFromHandle(h)
+		if err != nil {
+			return nil, err
+		}
+
+		monitors = append(monitors, m)
+	}
+
+	return monitors, nil
+}
+
+func monitorFromHandle(h win.HMONITOR) (session.Monitor, error) {
+	var mi win.MONITORINFOEX
+	mi.CbSize = uint32(unsafe.Sizeof(mi))
+
+	if !win.GetMonitorInfo(h, &mi.MONITORINFO) {
+		return session.Monitor{}, newError("GetMonitorInfo failed")
+	}
+
+	dpi := uint32(screenDPI())
+	var dpiY uint32
+	if hr := win.GetDpiForMonitor(h, win.MDT_EFFECTIVE_DPI, &dpi, &dpiY); win.FAILED(hr) {
+		dpi = uint32(screenDPI())
+	}
+
+	return session.Monitor{
+		ID:       windows.UTF16ToString(mi.SzDevice[:]),
+		Bounds:   sessionRectFromRECT(mi.RcMonitor),
+		WorkArea: sessionRectFromRECT(mi.RcWork),
+		DPI:      int(dpi),
+		Primary:  mi.DwFlags&win.MONITORINFOF_PRIMARY != 0,
+	}, nil
+}
+
+func sessionRectFromRECT(r win.RECT) session.Rect {
+	return session.Rect{
+		X:      int(r.Left),
+		Y:      int(r.Top),
+		Width:  int(r.Right - r.Left),
+		Height: int(r.Bottom - r.Top),
+	}
+}
+
+func enumMonitorsCallback(hMonitor win.HMONITOR, hdc win.HDC, rc *win.RECT, lParam uintptr) uintptr {
+	handles := (*[]win.HMONITOR)(unsafe.Pointer(lParam))
+	*handles = append(*handles, hMonitor)
+
+	return 1
+}
+
+var enumMonitorsCallbackPtr uintptr
+
+func init() {
+	AppendToWalkInit(func() {
+		enumMonitorsCallbackPtr = syscall.NewCallback(enumMonitorsCallback)
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package settings
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// WriteFileAtomic writes data to the file filePath, creating it with perm if
+// necessary. The data is written to a temporary file in the same directory
+// first, which then replaces filePath, so filePath holds either its previous
+// or its new contents even if the process dies while writing.
+func WriteFileAtomic(filePath string, data []byte, perm fs.FileMode) (err error) {
+	dir, name := filepath.Split(filePath)
+	if dir == "" {
+		dir = "."
+	}
+
+	f, err := os.CreateTemp(dir, name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := f.Name()
+
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(tmpPath)
+		}
+	}()
+
+	if _, err = f.Write(data); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(tmpPath, perm); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, filePath)
+}
@@ -0,0 +1,220 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package settings
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	valueMember     = "$value"
+	timestampMember = "$timestamp"
+)
+
+// node is a section of the JSON encoding.
+type node struct {
+	record   *Record
+	names    []string // of children, in insertion order
+	children map[string]*node
+}
+
+func (n *node) child(name string) *node {
+	if n.children == nil {
+		n.children = make(map[string]*node)
+	}
+
+	c := n.children[name]
+	if c == nil {
+		c = new(node)
+		n.children[name] = c
+		n.names = append(n.names, name)
+	}
+
+	return c
+}
+
+// EncodeJSON encodes the records of keys in s as JSON, with each section of
+// the slash separated keys becoming a nested object. For example, the keys
+// "MainWindow/Splitter" and "MainWindow/TableView" are encoded as
+//
+//	{
+//	  "MainWindow": {
+//	    "Splitter": "...",
+//	    "TableView": "..."
+//	  }
+//	}
+//
+// A record that has a timestamp, or whose key is also a section, is encoded
+// as an object holding its value in the member "$value" and its timestamp in
+// the member "$timestamp". The keys must pass ValidateSectionKey.
+func EncodeJSON(s *Store, keys []string) ([]byte, error) {
+	root := new(node)
+
+	for _, key := range keys {
+		r, ok := s.Get(key)
+		if !ok {
+			continue
+		}
+		if err := ValidateSectionKey(key); err != nil {
+			return nil, fmt.Errorf("invalid key '%s': %w", key, err)
+		}
+
+		n := root
+		for _, section := range strings.Split(key, "/") {
+			n = n.child(section)
+		}
+		n.record = &r
+	}
+
+	var buf bytes.Buffer
+	writeNode(&buf, root, "")
+	buf.WriteByte('\n')
+
+	return buf.Bytes(), nil
+}
+
+func writeNode(buf *bytes.Buffer, n *node, indent string) {
+	if len(n.children) == 0 && n.record != nil && n.record.Timestamp.IsZero() {
+		writeString(buf, n.record.Value)
+		return
+	}
+
+	childIndent := indent + "  "
+	first := true
+	member := func(name string) {
+		if first {
+			buf.WriteString("{\n")
+			first = false
+		} else {
+			buf.WriteString(",\n")
+		}
+		buf.WriteString(childIndent)
+		writeString(buf, name)
+		buf.WriteString(": ")
+	}
+
+	if n.record != nil {
+		member(valueMember)
+		writeString(buf, n.record.Value)
+
+		if !n.record.Timestamp.IsZero() {
+			member(timestampMember)
+			writeString(buf, n.record.Timestamp.Format(time.RFC3339))
+		}
+	}
+
+	for _, name := range n.names {
+		member(name)
+		writeNode(buf, n.children[name], childIndent)
+	}
+
+	if first {
+		buf.WriteString("{}")
+		return
+	}
+
+	buf.WriteString("\n")
+	buf.WriteString(indent)
+	buf.WriteString("}")
+}
+
+func writeString(buf *bytes.Buffer, s string) {
+	data, _ := json.Marshal(s)
+	buf.Write(data)
+}
+
+// DecodeJSON decodes records encoded by EncodeJSON. Numbers and booleans,
+// which may appear in files edited by hand, are read as their JSON text.
+func DecodeJSON(data []byte) (*Store, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var root map[string]interface{}
+	if err := dec.Decode(&root); err != nil {
+		return nil, err
+	}
+
+	s := NewStore()
+	if err := decodeSection(s, "", root); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func decodeSection(s *Store, prefix string, section map[string]interface{}) error {
+	if v, ok := section[valueMember]; ok {
+		value, err := decodeValue(prefix, v)
+		if err != nil {
+			return err
+		}
+
+		r := Record{Value: value}
+
+		if v, ok := section[timestampMember]; ok {
+			str, _ := v.(string)
+			ts, err := time.Parse(time.RFC3339, str)
+			if err != nil {
+				return fmt.Errorf("invalid timestamp of key '%s'", prefix)
+			}
+			r.Timestamp = ts
+		}
+
+		if prefix == "" {
+			return fmt.Errorf("unexpected member '%s' at top level", valueMember)
+		}
+		s.Put(prefix, r)
+	}
+
+	for name, v := range section {
+		if strings.HasPrefix(name, "$") {
+			continue
+		}
+
+		key := name
+		if prefix != "" {
+			key = prefix + "/" + name
+		}
+
+		switch v := v.(type) {
+		case nil:
+			// nop
+
+		case map[string]interface{}:
+			if err := decodeSection(s, key, v); err != nil {
+				return err
+			}
+
+		default:
+			value, err := decodeValue(key, v)
+			if err != nil {
+				return err
+			}
+			s.Put(key, Record{Value: value})
+		}
+	}
+
+	return nil
+}
+
+func decodeValue(key string, v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+
+	case json.Number:
+		return v.String(), nil
+
+	case bool:
+		return strconv.FormatBool(v), nil
+	}
+
+	return "", fmt.Errorf("unsupported value of key '%s'", key)
+}
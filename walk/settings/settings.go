@@ -0,0 +1,103 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package settings implements the storage behind the Settings backends of
+// package walk: an in-memory record store, its encoding as JSON with nested
+// sections and atomic file replacement.
+package settings
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Record is a settings value. Timestamp is the time the value was put if it
+// expires, or the zero time.
+type Record struct {
+	Value     string
+	Timestamp time.Time
+}
+
+// Expired reports whether r has expired at now, given expireDuration. Records
+// without a timestamp never expire, and nothing expires if expireDuration is
+// not positive.
+func (r Record) Expired(expireDuration time.Duration, now time.Time) bool {
+	return expireDuration > 0 && !r.Timestamp.IsZero() && now.Sub(r.Timestamp) >= expireDuration
+}
+
+// Store holds settings records in memory.
+type Store struct {
+	records map[string]Record
+}
+
+// NewStore returns a new, empty Store.
+func NewStore() *Store {
+	return &Store{records: make(map[string]Record)}
+}
+
+var errEmptyKey = errors.New("key must not be empty")
+
+// Get returns the record of key.
+func (s *Store) Get(key string) (Record, bool) {
+	r, ok := s.records[key]
+	return r, ok
+}
+
+// Put sets the record of key.
+func (s *Store) Put(key string, r Record) error {
+	if key == "" {
+		return errEmptyKey
+	}
+
+	s.records[key] = r
+
+	return nil
+}
+
+// Remove removes the record of key, if any.
+func (s *Store) Remove(key string) {
+	delete(s.records, key)
+}
+
+// Len returns the number of records in s.
+func (s *Store) Len() int {
+	return len(s.records)
+}
+
+// Keys returns the keys of s in lexical order, leaving out the records that
+// have expired at now, see Record.Expired.
+func (s *Store) Keys(expireDuration time.Duration, now time.Time) []string {
+	keys := make([]string, 0, len(s.records))
+	for key, r := range s.records {
+		if !r.Expired(expireDuration, now) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// ValidateSectionKey checks that key can be stored in nested sections, see
+// EncodeJSON. key must consist of non-empty sections separated by slashes,
+// none of which starts with '$'.
+func ValidateSectionKey(key string) error {
+	if key == "" {
+		return errEmptyKey
+	}
+
+	for _, section := range strings.Split(key, "/") {
+		if section == "" {
+			return errors.New("key must not start or end with '/' or contain '//'")
+		}
+		if strings.HasPrefix(section, "$") {
+			return errors.New("key sections must not start with '$'")
+		}
+	}
+
+	return nil
+}
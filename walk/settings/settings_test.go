@@ -0,0 +1,165 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package settings
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStoreKeys(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	s := NewStore()
+	s.Put("b", Record{Value: "2"})
+	s.Put("a", Record{Value: "1", Timestamp: now.Add(-time.Hour)})
+	s.Put("c", Record{Value: "3", Timestamp: now.Add(-48 * time.Hour)})
+
+	if err := s.Put("", Record{}); err == nil {
+		t.Error("Put with empty key: got nil error")
+	}
+
+	if got, want := s.Keys(0, now), []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys without expiry: got %q, want %q", got, want)
+	}
+	if got, want := s.Keys(24*time.Hour, now), []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys with expiry: got %q, want %q", got, want)
+	}
+
+	s.Remove("b")
+	if _, ok := s.Get("b"); ok || s.Len() != 2 {
+		t.Errorf("Remove: got len %d, want 2", s.Len())
+	}
+}
+
+func TestValidateSectionKey(t *testing.T) {
+	testCases := []struct {
+		key string
+		ok  bool
+	}{
+		{"MainWindow", true},
+		{"MainWindow/Splitter", true},
+		{"a=b", true},
+		{"", false},
+		{"/MainWindow", false},
+		{"MainWindow/", false},
+		{"a//b", false},
+		{"a/$value", false},
+	}
+
+	for _, c := range testCases {
+		if err := ValidateSectionKey(c.key); (err == nil) != c.ok {
+			t.Errorf("ValidateSectionKey(%q): got %v, want ok %v", c.key, err, c.ok)
+		}
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	ts := time.Date(2026, 10, 18, 12, 30, 0, 0, time.UTC)
+
+	s := NewStore()
+	s.Put("Theme", Record{Value: "dark"})
+	s.Put("MainWindow", Record{Value: "0 0 800 600", Timestamp: ts})
+	s.Put("MainWindow/Splitter", Record{Value: "1=2"})
+	s.Put("MainWindow/Notes", Record{Value: "line 1\r\nline \"2\""})
+
+	data, err := EncodeJSON(s, s.Keys(0, ts))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{
+  "MainWindow": {
+    "$value": "0 0 800 600",
+    "$timestamp": "2026-10-18T12:30:00Z",
+    "Notes": "line 1\r\nline \"2\"",
+    "Splitter": "1=2"
+  },
+  "Theme": "dark"
+}
+`
+	if string(data) != want {
+		t.Errorf("EncodeJSON: got\n%s\nwant\n%s", data, want)
+	}
+
+	decoded, err := DecodeJSON(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.records, s.records) {
+		t.Errorf("DecodeJSON: got %+v, want %+v", decoded.records, s.records)
+	}
+
+	if _, err := EncodeJSON(s, nil); err != nil {
+		t.Errorf("EncodeJSON of no keys: %v", err)
+	}
+
+	s.Put("a//b", Record{Value: "x"})
+	if _, err := EncodeJSON(s, []string{"a//b"}); err == nil {
+		t.Error("EncodeJSON of invalid key: got nil error")
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	s, err := DecodeJSON([]byte(`{"a": {"b": 1.5, "c": true, "d": null}, "e": "x"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]Record{
+		"a/b": {Value: "1.5"},
+		"a/c": {Value: "true"},
+		"e":   {Value: "x"},
+	}
+	if !reflect.DeepEqual(s.records, want) {
+		t.Errorf("got %+v, want %+v", s.records, want)
+	}
+
+	for _, data := range []string{
+		``,
+		`[]`,
+		`{"a": [1]}`,
+		`{"$value": "x"}`,
+		`{"a": {"$value": "x", "$timestamp": "yesterday"}}`,
+	} {
+		if _, err := DecodeJSON([]byte(data)); err == nil {
+			t.Errorf("DecodeJSON(%s): got nil error", data)
+		}
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "settings.json")
+
+	for _, data := range []string{"first", "second"} {
+		if err := WriteFileAtomic(filePath, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile(filePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != data {
+			t.Errorf("got %q, want %q", got, data)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("got %d files, want only the settings file", len(entries))
+	}
+
+	if err := WriteFileAtomic(filepath.Join(dir, "missing", "settings.json"), nil, 0644); err == nil {
+		t.Error("WriteFileAtomic into missing directory: got nil error")
+	}
+}
@@ -0,0 +1,445 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ShortcutInfo describes a shortcut of a ShortcutRegistry.
+type ShortcutInfo struct {
+	ID          string
+	Category    string
+	Description string
+	Shortcut    Shortcut
+	Default     Shortcut
+	Global      bool
+}
+
+// ShortcutConflictError is returned by a ShortcutRegistry when a shortcut is
+// already bound to another entry.
+type ShortcutConflictError struct {
+	Shortcut      Shortcut
+	ID            string
+	ConflictingID string
+}
+
+func (e *ShortcutConflictError) Error() string {
+	return fmt.Sprintf("shortcut %s of %q is already bound to %q", e.Shortcut, e.ID, e.ConflictingID)
+}
+
+type shortcutEntry struct {
+	info    ShortcutInfo
+	action  *Action
+	handler func()
+	hotKey  *GlobalHotKey
+}
+
+// ShortcutRegistry manages the shortcuts of an application, both of actions
+// and global hot keys. It detects conflicting shortcuts, lets users rebind
+// them and persists their bindings.
+//
+// Entries are identified by ids, which must be stable across runs of the
+// application, because the bindings of users are stored by id.
+type ShortcutRegistry struct {
+	settings         Settings
+	key              string
+	entries          map[string]*shortcutEntry
+	order            []string
+	changedPublisher EventPublisher
+}
+
+// NewShortcutRegistry returns a ShortcutRegistry that stores the bindings of
+// users in settings, below key. With nil settings, App().Settings() is used.
+// If that is nil as well, bindings are not persisted.
+func NewShortcutRegistry(settings Settings, key string) *ShortcutRegistry {
+	return &ShortcutRegistry{
+		settings: settings,
+		key:      key,
+		entries:  make(map[string]*shortcutEntry),
+	}
+}
+
+func (sr *ShortcutRegistry) appSettings() Settings {
+	if sr.settings != nil {
+		return sr.settings
+	}
+
+	return App().Settings()
+}
+
+func (sr *ShortcutRegistry) settingsKey(id string) string {
+	return sr.key + "/" + id
+}
+
+// RegisterAction adds action to the registry. Its current shortcut is the
+// default one, which is replaced by the binding stored for id, if any.
+func (sr *ShortcutRegistry) RegisterAction(id, category string, action *Action) error {
+	if action == nil {
+		return newError("action must not be nil")
+	}
+
+	e := &shortcutEntry{
+		info: ShortcutInfo{
+			ID:          id,
+			Category:    category,
+			Description: actionDisplayText(action.Text()),
+			Default:     action.Shortcut(),
+		},
+		action: action,
+	}
+
+	return sr.register(e)
+}
+
+// RegisterGlobal adds a global hot key to the registry, which calls handler
+// when shortcut, or the binding stored for id, is pressed. See
+// RegisterGlobalHotKey for the requirements.
+func (sr *ShortcutRegistry) RegisterGlobal(id, category, description string, shortcut Shortcut, handler func()) error {
+	if handler == nil {
+		return newError("handler must not be nil")
+	}
+
+	e := &shortcutEntry{
+		info: ShortcutInfo{
+			ID:          id,
+			Category:    category,
+			Description: description,
+			Default:     shortcut,
+			Global:      true,
+		},
+		handler: handler,
+	}
+
+	return sr.register(e)
+}
+
+func (sr *ShortcutRegistry) register(e *shortcutEntry) error {
+	id := e.info.ID
+	if id == "" {
+		return newError("id must not be empty")
+	}
+	if _, ok := sr.entries[id]; ok {
+		return newError(fmt.Sprintf("shortcut %q already registered", id))
+	}
+
+	shortcut := e.info.Default
+	if stored, ok := sr.storedShortcut(id); ok && sr.conflict(id, stored) == nil {
+		shortcut = stored
+	}
+	if err := sr.conflict(id, shortcut); err != nil {
+		return err
+	}
+
+	if err := sr.apply(e, shortcut); err != nil {
+		return err
+	}
+
+	sr.entries[id] = e
+	sr.order = append(sr.order, id)
+
+	sr.changedPublisher.Publish()
+
+	return nil
+}
+
+// Unregister removes the entry id from the registry. A global hot key is
+// unregistered, the shortcut of an action is left as it is.
+func (sr *ShortcutRegistry) Unregister(id string) error {
+	e, ok := sr.entries[id]
+	if !ok {
+		return nil
+	}
+
+	delete(sr.entries, id)
+	for i, oid := range sr.order {
+		if oid == id {
+			sr.order = append(sr.order[:i], sr.order[i+1:]...)
+			break
+		}
+	}
+
+	var err error
+	if e.hotKey != nil {
+		err = e.hotKey.Unregister()
+		e.hotKey = nil
+	}
+
+	sr.changedPublisher.Publish()
+
+	return err
+}
+
+func (sr *ShortcutRegistry) storedShortcut(id string) (Shortcut, bool) {
+	settings := sr.appSettings()
+	if settings == nil {
+		return Shortcut{}, false
+	}
+
+	value, ok := settings.Get(sr.settingsKey(id))
+	if !ok {
+		return Shortcut{}, false
+	}
+
+	shortcut, err := ParseShortcut(value)
+	if err != nil {
+		return Shortcut{}, false
+	}
+
+	return shortcut, true
+}
+
+// conflict returns a *ShortcutConflictError if shortcut is bound to an entry
+// other than id.
+func (sr *ShortcutRegistry) conflict(id string, shortcut Shortcut) error {
+	if shortcut.Key == 0 {
+		return nil
+	}
+
+	for _, oid := range sr.order {
+		if oid != id && sr.entries[oid].info.Shortcut == shortcut {
+			return &ShortcutConflictError{Shortcut: shortcut, ID: id, ConflictingID: oid}
+		}
+	}
+
+	return nil
+}
+
+// registerGlobalHotKey is RegisterGlobalHotKey, replaced by tests.
+var registerGlobalHotKey = RegisterGlobalHotKey
+
+func (sr *ShortcutRegistry) apply(e *shortcutEntry, shortcut Shortcut) error {
+	if e.action != nil {
+		if err := e.action.SetShortcut(shortcut); err != nil {
+			return err
+		}
+	} else if e.info.Shortcut != shortcut || e.hotKey == nil {
+		// Register the new hot key before giving up the old one, so that a
+		// shortcut taken by another application leaves the binding intact.
+		var hotKey *GlobalHotKey
+		if shortcut.Key != 0 {
+			var err error
+			if hotKey, err = registerGlobalHotKey(shortcut, e.handler); err != nil {
+				return err
+			}
+		}
+
+		if e.hotKey != nil {
+			if err := e.hotKey.Unregister(); err != nil {
+				if hotKey != nil {
+					hotKey.Unregister()
+				}
+				return err
+			}
+		}
+
+		e.hotKey = hotKey
+	}
+
+	e.info.Shortcut = shortcut
+
+	return nil
+}
+
+// Shortcut returns the shortcut bound to id.
+func (sr *ShortcutRegistry) Shortcut(id string) (Shortcut, bool) {
+	e, ok := sr.entries[id]
+	if !ok {
+		return Shortcut{}, false
+	}
+
+	return e.info.Shortcut, true
+}
+
+// Bind binds shortcut to id and persists the binding. The zero Shortcut
+// removes the shortcut of id. If shortcut is bound to another entry, a
+// *ShortcutConflictError is returned.
+func (sr *ShortcutRegistry) Bind(id string, shortcut Shortcut) error {
+	e, ok := sr.entries[id]
+	if !ok {
+		return newError(fmt.Sprintf("unknown shortcut %q", id))
+	}
+
+	if err := sr.conflict(id, shortcut); err != nil {
+		return err
+	}
+
+	if err := sr.apply(e, shortcut); err != nil {
+		return err
+	}
+
+	if settings := sr.appSettings(); settings != nil {
+		var err error
+		if shortcut == e.info.Default {
+			err = settings.Remove(sr.settingsKey(id))
+		} else {
+			err = settings.Put(sr.settingsKey(id), shortcut.String())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	sr.changedPublisher.Publish()
+
+	return nil
+}
+
+// Reset binds the default shortcut to id.
+func (sr *ShortcutRegistry) Reset(id string) error {
+	e, ok := sr.entries[id]
+	if !ok {
+		return newError(fmt.Sprintf("unknown shortcut %q", id))
+	}
+
+	return sr.Bind(id, e.info.Default)
+}
+
+// ResetAll binds the default shortcuts to all entries.
+//
+// Entries are first unbound, so defaults can be restored that conflict with
+// current bindings of users.
+func (sr *ShortcutRegistry) ResetAll() error {
+	for _, id := range sr.order {
+		if err := sr.apply(sr.entries[id], Shortcut{}); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range sr.order {
+		if err := sr.Bind(id, sr.entries[id].info.Default); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Entries returns the entries of the registry, sorted by category and then
+// in the order they were registered.
+func (sr *ShortcutRegistry) Entries() []ShortcutInfo {
+	infos := make([]ShortcutInfo, len(sr.order))
+	for i, id := range sr.order {
+		infos[i] = sr.entries[id].info
+	}
+
+	sort.SliceStable(infos, func(i, j int) bool {
+		return infos[i].Category < infos[j].Category
+	})
+
+	return infos
+}
+
+// Changed returns the event that is published when entries are added or
+// removed or when shortcuts are bound.
+func (sr *ShortcutRegistry) Changed() *Event {
+	return sr.changedPublisher.Event()
+}
+
+type cheatSheetItem struct {
+	Category string
+	Action   string
+	Shortcut string
+}
+
+// ShowCheatSheet runs a dialog, owned by owner, that lists the entries of the
+// registry that have a shortcut.
+func (sr *ShortcutRegistry) ShowCheatSheet(owner Form) (int, error) {
+	var items []*cheatSheetItem
+	for _, info := range sr.Entries() {
+		if info.Shortcut.Key == 0 {
+			continue
+		}
+
+		items = append(items, &cheatSheetItem{
+			Category: info.Category,
+			Action:   info.Description,
+			Shortcut: info.Shortcut.String(),
+		})
+	}
+
+	dlg, err := NewDialog(owner)
+	if err != nil {
+		return 0, err
+	}
+	defer dlg.Dispose()
+
+	if err := dlg.SetTitle("Keyboard Shortcuts"); err != nil {
+		return 0, err
+	}
+	if err := dlg.SetLayout(NewVBoxLayout()); err != nil {
+		return 0, err
+	}
+	if err := dlg.SetSize(Size{Width: 480, Height: 400}); err != nil {
+		return 0, err
+	}
+
+	tv, err := NewTableView(dlg)
+	if err != nil {
+		return 0, err
+	}
+	tv.SetAlternatingRowBG(true)
+	if err := tv.SetLastColumnStretched(true); err != nil {
+		return 0, err
+	}
+
+	for _, c := range []struct {
+		member string
+		width  int
+	}{
+		{"Category", 120},
+		{"Action", 200},
+		{"Shortcut", 120},
+	} {
+		col := NewTableViewColumn()
+		col.SetDataMember(c.member)
+		if err := col.SetTitle(c.member); err != nil {
+			return 0, err
+		}
+		if err := col.SetWidth(c.width); err != nil {
+			return 0, err
+		}
+		if err := tv.Columns().Add(col); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tv.SetModel(items); err != nil {
+		return 0, err
+	}
+
+	pb, err := NewPushButton(dlg)
+	if err != nil {
+		return 0, err
+	}
+	if err := pb.SetText("Close"); err != nil {
+		return 0, err
+	}
+	pb.Clicked().Attach(func() {
+		dlg.Accept()
+	})
+
+	if err := dlg.SetDefaultButton(pb); err != nil {
+		return 0, err
+	}
+	if err := dlg.SetCancelButton(pb); err != nil {
+		return 0, err
+	}
+
+	return dlg.Run(), nil
+}
+
+// actionDisplayText returns text without the ampersands that mark mnemonics.
+func actionDisplayText(text string) string {
+	text = strings.ReplaceAll(text, "&&", "\x00")
+	text = strings.ReplaceAll(text, "&", "")
+
+	return strings.ReplaceAll(text, "\x00", "&")
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestShortcutRegistryBindKeepsHotKeyOnFailure(t *testing.T) {
+	taken := Shortcut{ModControl | ModAlt, KeyT}
+	errTaken := errors.New("hot key already registered")
+
+	defer func(register func(Shortcut, func()) (*GlobalHotKey, error)) {
+		registerGlobalHotKey = register
+	}(registerGlobalHotKey)
+	registerGlobalHotKey = func(shortcut Shortcut, handler func()) (*GlobalHotKey, error) {
+		if shortcut == taken {
+			return nil, errTaken
+		}
+		return &GlobalHotKey{shortcut: shortcut, handler: handler}, nil
+	}
+
+	sr := NewShortcutRegistry(NewMemorySettings(), "Shortcuts")
+
+	old := Shortcut{ModControl | ModAlt, KeyS}
+	if err := sr.RegisterGlobal("show", "Window", "Show", old, func() {}); err != nil {
+		t.Fatal(err)
+	}
+	hotKey := sr.entries["show"].hotKey
+
+	if err := sr.Bind("show", taken); !errors.Is(err, errTaken) {
+		t.Fatalf("Bind: got error %v, want %v", err, errTaken)
+	}
+
+	if got, _ := sr.Shortcut("show"); got != old {
+		t.Errorf("Shortcut: got %s, want %s", got, old)
+	}
+	if sr.entries["show"].hotKey != hotKey {
+		t.Error("the hot key of the old shortcut was replaced")
+	}
+
+	if err := sr.Bind("show", Shortcut{ModControl | ModAlt, KeyW}); err != nil {
+		t.Fatal(err)
+	}
+	if got := sr.entries["show"].hotKey.Shortcut(); got != (Shortcut{ModControl | ModAlt, KeyW}) {
+		t.Errorf("hot key: got %s, want Ctrl+Alt+W", got)
+	}
+}
+
+func TestFreeHotKeyIDReusesIDs(t *testing.T) {
+	g := &WindowGroup{hotKeys: make(map[int32]*GlobalHotKey)}
+
+	for want := int32(1); want <= 3; want++ {
+		id := g.freeHotKeyID()
+		if id != want {
+			t.Fatalf("got id %d, want %d", id, want)
+		}
+		g.hotKeys[id] = &GlobalHotKey{id: id}
+	}
+
+	delete(g.hotKeys, 2)
+	if id := g.freeHotKeyID(); id != 2 {
+		t.Errorf("got id %d after unregistering 2, want 2", id)
+	}
+
+	for id := int32(1); id <= maxHotKeyID; id++ {
+		g.hotKeys[id] = &GlobalHotKey{id: id}
+	}
+	if id := g.freeHotKeyID(); id != 0 {
+		t.Errorf("got id %d with all ids in use, want 0", id)
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"os"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/xackery/wlk/walk/instance"
+	"github.com/xackery/wlk/win"
+	"golang.org/x/sys/windows"
+)
+
+// instanceReceivedMsg is posted by the instance window to itself, so
+// messages of second instances are published by the message loop instead of
+// while the sender waits in SendMessage.
+const instanceReceivedMsg = win.WM_APP + 1
+
+var (
+	instanceWndProcPtr     uintptr
+	instanceWndProcPtrOnce sync.Once
+	instanceListener       *instanceTransport
+)
+
+// EnsureSingleInstance makes sure only one instance of the application
+// identified by id runs in the user session.
+//
+// The first instance is the primary one, for which EnsureSingleInstance
+// returns true. It receives the command line arguments and working directory
+// of instances started later with SecondInstanceStarted, after which its
+// active form is brought to the front.
+//
+// For any later instance EnsureSingleInstance hands its arguments to the
+// primary instance and returns false, after which the application should
+// exit.
+//
+// EnsureSingleInstance must be called on the thread that runs the forms of
+// the application, after the first of them was created, typically right
+// before Run is called. The messages are received by a message-only window of
+// that thread, so they are published by the message loop of its WindowGroup.
+// See package instance for the message format.
+func (app *Application) EnsureSingleInstance(id string) (primary bool, err error) {
+	if id == "" {
+		return false, newError("EnsureSingleInstance: id must not be empty")
+	}
+	if instanceListener != nil {
+		return false, newError("EnsureSingleInstance: already called")
+	}
+
+	// The listener window must live on the thread whose message loop runs,
+	// which the forms of the application have already locked.
+	if wgm.Group(win.GetCurrentThreadId()) == nil {
+		return false, newError("EnsureSingleInstance must be called on a thread with a window")
+	}
+
+	workingDir, _ := os.Getwd()
+
+	var args []string
+	if len(os.Args) > 1 {
+		args = os.Args[1:]
+	}
+
+	primary, err = instance.Start(
+		&instanceLock{name: instance.MutexName(id)},
+		&instanceTransport{className: instance.WindowClassName(id)},
+		instance.Message{Args: args, WorkingDir: workingDir},
+		func(msg instance.Message) {
+			app.secondInstanceStartedPublisher.Publish(msg.Args, msg.WorkingDir)
+
+			bringActiveFormToFront()
+		})
+	if err != nil {
+		return false, err
+	}
+
+	return primary, nil
+}
+
+// SecondInstanceStarted returns the event that is published in the primary
+// instance when another instance is started, see EnsureSingleInstance.
+func (app *Application) SecondInstanceStarted() *SecondInstanceEvent {
+	return app.secondInstanceStartedPublisher.Event()
+}
+
+// bringActiveFormToFront restores and activates the active form of the
+// calling thread, or any of its visible forms.
+func bringActiveFormToFront() {
+	threadID := win.GetCurrentThreadId()
+
+	var form Form
+	if group := wgm.Group(threadID); group != nil {
+		form = group.ActiveForm()
+	}
+
+	if form == nil {
+		for hwnd, wb := range hwnd2WindowBase {
+			if f, ok := wb.window.(Form); ok && win.IsWindowVisible(hwnd) {
+				if tid, _ := windows.GetWindowThreadProcessId(hwnd, nil); tid == threadID {
+					form = f
+					break
+				}
+			}
+		}
+	}
+
+	if form == nil {
+		return
+	}
+
+	hwnd := form.Handle()
+	if win.IsIconic(hwnd) {
+		win.ShowWindow(hwnd, win.SW_RESTORE)
+	}
+	win.SetForegroundWindow(hwnd)
+}
+
+// instanceLock is an instance.Lock implemented with a named mutex.
+type instanceLock struct {
+	name   string
+	handle windows.Handle
+}
+
+func (l *instanceLock) Acquire() (bool, error) {
+	name, err := syscall.UTF16PtrFromString(l.name)
+	if err != nil {
+		return false, wrapError(err)
+	}
+
+	handle, err := windows.CreateMutex(nil, false, name)
+	if handle == 0 {
+		return false, wrapError(err)
+	}
+	if err == windows.ERROR_ALREADY_EXISTS {
+		windows.CloseHandle(handle)
+		return false, nil
+	}
+
+	l.handle = handle
+
+	return true, nil
+}
+
+func (l *instanceLock) Release() error {
+	if l.handle == 0 {
+		return nil
+	}
+
+	err := windows.CloseHandle(l.handle)
+	l.handle = 0
+	if err != nil {
+		return wrapError(err)
+	}
+
+	return nil
+}
+
+// instanceTransport is an instance.Transport implemented with a message-only
+// window and WM_COPYDATA.
+type instanceTransport struct {
+	className string
+	hwnd      windows.HWND
+	received  func(data []byte)
+	pending   [][]byte
+}
+
+func (t *instanceTransport) Listen(received func(data []byte)) error {
+	instanceWndProcPtrOnce.Do(func() {
+		instanceWndProcPtr = syscall.NewCallback(instanceWndProc)
+	})
+
+	MustRegisterWindowClassWithWndProcPtr(t.className, instanceWndProcPtr)
+
+	t.received = received
+
+	t.hwnd = win.CreateWindowEx(
+		0,
+		syscall.StringToUTF16Ptr(t.className),
+		nil,
+		0,
+		0,
+		0,
+		0,
+		0,
+		win.HWND_MESSAGE,
+		0,
+		0,
+		nil)
+	if t.hwnd == 0 {
+		return lastError("CreateWindowEx")
+	}
+
+	instanceListener = t
+
+	return nil
+}
+
+func (t *instanceTransport) Send(data []byte) error {
+	hwnd := win.FindWindowEx(win.HWND_MESSAGE, 0, syscall.StringToUTF16Ptr(t.className), nil)
+	if hwnd == 0 {
+		return instance.ErrNotListening
+	}
+
+	// We were just started by the user, so we may pass on the right to
+	// activate a window.
+	var pid uint32
+	if _, err := windows.GetWindowThreadProcessId(hwnd, &pid); err == nil {
+		win.AllowSetForegroundWindow(pid)
+	}
+
+	cds := win.COPYDATASTRUCT{
+		DwData: instance.CopyDataID,
+		CbData: uint32(len(data)),
+	}
+	if len(data) > 0 {
+		cds.LpData = uintptr(unsafe.Pointer(&data[0]))
+	}
+
+	var result uintptr
+	if !win.SendMessageTimeout(hwnd, win.WM_COPYDATA, 0, uintptr(unsafe.Pointer(&cds)), win.SMTO_ABORTIFHUNG, 10000, &result) {
+		return lastError("SendMessageTimeout")
+	}
+	if result == 0 {
+		return newError("the primary instance rejected the message")
+	}
+
+	return nil
+}
+
+func instanceWndProc(hwnd windows.HWND, msg uint32, wParam, lParam uintptr) uintptr {
+	t := instanceListener
+	if t == nil || t.hwnd != hwnd {
+		return win.DefWindowProc(hwnd, msg, wParam, lParam)
+	}
+
+	switch msg {
+	case win.WM_COPYDATA:
+		cds := (*win.COPYDATASTRUCT)(unsafe.Pointer(lParam))
+		if cds.DwData != instance.CopyDataID {
+			return 0
+		}
+
+		var data []byte
+		if cds.CbData > 0 {
+			data = append(data, unsafe.Slice((*byte)(unsafe.Pointer(cds.LpData)), cds.CbData)...)
+		}
+
+		t.pending = append(t.pending, data)
+		win.PostMessage(hwnd, instanceReceivedMsg, 0, 0)
+
+		return 1
+
+	case instanceReceivedMsg:
+		pending := t.pending
+		t.pending = nil
+
+		for _, data := range pending {
+			t.received(data)
+		}
+
+		return 0
+	}
+
+	return win.DefWindowProc(hwnd, msg, wParam, lParam)
+}
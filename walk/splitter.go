@@ -191,6 +191,22 @@ func (s *Splitter) SetPersistent(value bool) {
 }
 
 func (s *Splitter) SaveState() error {
+	s.WriteState(s.stateString())
+
+	for _, wb := range s.children.items {
+		if persistable, ok := wb.window.(Persistable); ok {
+			if err := persistable.SaveState(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// stateString returns the sizes of the widgets of the Splitter in the format
+// written by SaveState.
+func (s *Splitter) stateString() string {
 	buf := bytes.NewBuffer(nil)
 
 	count := s.children.Len()
@@ -209,11 +225,25 @@ func (s *Splitter) SaveState() error {
 		buf.WriteString(strconv.FormatInt(int64(size), 10))
 	}
 
-	s.WriteState(buf.String())
+	return buf.String()
+}
+
+func (s *Splitter) RestoreState() error {
+	state, err := s.ReadState()
+	if err != nil {
+		return err
+	}
+	if state == "" {
+		return nil
+	}
+
+	if ok, err := s.restoreStateString(state); err != nil || !ok {
+		return err
+	}
 
 	for _, wb := range s.children.items {
 		if persistable, ok := wb.window.(Persistable); ok {
-			if err := persistable.SaveState(); err != nil {
+			if err := persistable.RestoreState(); err != nil {
 				return err
 			}
 		}
@@ -222,26 +252,18 @@ func (s *Splitter) SaveState() error {
 	return nil
 }
 
-func (s *Splitter) RestoreState() error {
+// restoreStateString sets the sizes of the widgets of the Splitter from a
+// state returned by stateString. It returns false if state doesn't match the
+// widgets.
+func (s *Splitter) restoreStateString(state string) (bool, error) {
 	childCount := s.children.Len()/2 + 1
-	if childCount == 0 {
-		return nil
-	}
-
-	state, err := s.ReadState()
-	if err != nil {
-		return err
-	}
-	if state == "" {
-		return nil
-	}
 
 	sizeStrs := strings.Split(state, " ")
 
 	// FIXME: Solve this in a better way.
 	if len(sizeStrs) != childCount {
 		log.Print("*Splitter.RestoreState: failed due to unexpected child count (FIXME!)")
-		return nil
+		return false, nil
 	}
 
 	layout := s.layout.(*splitterLayout)
@@ -274,7 +296,7 @@ func (s *Splitter) RestoreState() error {
 				// OK, we probably got old style settings which were stored as fractions.
 				fraction, err := strconv.ParseFloat(s, 64)
 				if err != nil {
-					return err
+					return false, err
 				}
 
 				size = int(float64(regularSpace) * fraction)
@@ -286,15 +308,7 @@ func (s *Splitter) RestoreState() error {
 		}
 	}
 
-	for _, wb := range s.children.items {
-		if persistable, ok := wb.window.(Persistable); ok {
-			if err := persistable.RestoreState(); err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
+	return true, nil
 }
 
 func (s *Splitter) Fixed(widget Widget) bool {
@@ -386,18 +400,24 @@ func (s *Splitter) onInsertedWidget(index int, widget Widget) (err error) {
 					return
 				}
 
-				closestVisibleWidget := func(offset, direction int) Widget {
-					index := offset + direction
+				// handleNeighbors returns the visible widgets left and right
+				// of, or above and below, the handle at handleIndex.
+				handleNeighbors := func(handleIndex int) (Widget, Widget) {
+					visible := make([]bool, len(s.children.items))
+					for i, wb := range s.children.items {
+						visible[i] = wb.visible
+					}
 
-					for index >= 0 && index < len(s.children.items) {
-						if wb := s.children.items[index]; wb.visible {
-							return wb.window.(Widget)
-						}
+					prev, next := splitterHandleNeighbors(visible, handleIndex, s.Orientation() == Horizontal && windowMirrored(s))
 
-						index += direction
+					widget := func(index int) Widget {
+						if index == -1 {
+							return nil
+						}
+						return s.children.items[index].window.(Widget)
 					}
 
-					return nil
+					return widget(prev), widget(next)
 				}
 
 				handleIndex := index + 1 - index%2
@@ -422,11 +442,11 @@ func (s *Splitter) onInsertedWidget(index int, widget Widget) (err error) {
 						handleIndex := s.children.Index(s.draggedHandle)
 						bh := s.draggedHandle.BoundsPixels()
 
-						prev := closestVisibleWidget(handleIndex, -1)
+						prev, next := handleNeighbors(handleIndex)
+
 						bp := prev.BoundsPixels()
 						msep := minSizeEffective(createLayoutItemForWidget(prev))
 
-						next := closestVisibleWidget(handleIndex, 1)
 						bn := next.BoundsPixels()
 						msen := minSizeEffective(createLayoutItemForWidget(next))
 
@@ -494,8 +514,7 @@ func (s *Splitter) onInsertedWidget(index int, widget Widget) (err error) {
 						dragHandle := s.draggedHandle
 
 						handleIndex := s.children.Index(dragHandle)
-						prev := closestVisibleWidget(handleIndex, -1)
-						next := closestVisibleWidget(handleIndex, 1)
+						prev, next := handleNeighbors(handleIndex)
 
 						s.draggedHandle = nil
 						dragHandle.SetBackground(NullBrush())
@@ -627,3 +646,26 @@ func (s *Splitter) onClearedWidgets() (err error) {
 func (s *Splitter) CreateLayoutItem(ctx *LayoutContext) LayoutItem {
 	return s.layout.CreateLayoutItem(ctx)
 }
+
+// splitterHandleNeighbors returns the indexes of the visible children left and
+// right of, or above and below, the handle at handleIndex, or -1 where there
+// is none. visible reports which children are visible. In a mirrored
+// horizontal Splitter the child before the handle is on the right.
+func splitterHandleNeighbors(visible []bool, handleIndex int, mirrored bool) (int, int) {
+	closestVisible := func(direction int) int {
+		for index := handleIndex + direction; index >= 0 && index < len(visible); index += direction {
+			if visible[index] {
+				return index
+			}
+		}
+
+		return -1
+	}
+
+	prev, next := closestVisible(-1), closestVisible(1)
+	if mirrored {
+		return next, prev
+	}
+
+	return prev, next
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package svg
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+type point struct {
+	x, y float64
+}
+
+func (p point) add(q point) point {
+	return point{p.x + q.x, p.y + q.y}
+}
+
+func (p point) sub(q point) point {
+	return point{p.x - q.x, p.y - q.y}
+}
+
+func (p point) mul(f float64) point {
+	return point{p.x * f, p.y * f}
+}
+
+func (p point) dot(q point) float64 {
+	return p.x*q.x + p.y*q.y
+}
+
+func (p point) cross(q point) float64 {
+	return p.x*q.y - p.y*q.x
+}
+
+func (p point) len() float64 {
+	return math.Hypot(p.x, p.y)
+}
+
+// normal returns the unit vector perpendicular to p, rotated 90 degrees
+// counter-clockwise in a y-up coordinate system.
+func (p point) normal() point {
+	l := p.len()
+	if l == 0 {
+		return point{}
+	}
+
+	return point{-p.y / l, p.x / l}
+}
+
+// matrix is an affine transformation in the same order as the SVG matrix()
+// function: x' = a*x + c*y + e, y' = b*x + d*y + f.
+type matrix [6]float64
+
+var identity = matrix{1, 0, 0, 1, 0, 0}
+
+// mul returns the transformation that applies n first and then m.
+func (m matrix) mul(n matrix) matrix {
+	return matrix{
+		m[0]*n[0] + m[2]*n[1],
+		m[1]*n[0] + m[3]*n[1],
+		m[0]*n[2] + m[2]*n[3],
+		m[1]*n[2] + m[3]*n[3],
+		m[0]*n[4] + m[2]*n[5] + m[4],
+		m[1]*n[4] + m[3]*n[5] + m[5],
+	}
+}
+
+func (m matrix) apply(p point) point {
+	return point{m[0]*p.x + m[2]*p.y + m[4], m[1]*p.x + m[3]*p.y + m[5]}
+}
+
+// scale returns the average factor by which m scales lengths.
+func (m matrix) scale() float64 {
+	return math.Sqrt(math.Abs(m[0]*m[3] - m[1]*m[2]))
+}
+
+func (m matrix) invert() (matrix, bool) {
+	det := m[0]*m[3] - m[1]*m[2]
+	if det == 0 || math.IsNaN(det) {
+		return identity, false
+	}
+
+	return matrix{
+		m[3] / det,
+		-m[1] / det,
+		-m[2] / det,
+		m[0] / det,
+		(m[2]*m[5] - m[3]*m[4]) / det,
+		(m[1]*m[4] - m[0]*m[5]) / det,
+	}, true
+}
+
+func translate(tx, ty float64) matrix {
+	return matrix{1, 0, 0, 1, tx, ty}
+}
+
+func scale(sx, sy float64) matrix {
+	return matrix{sx, 0, 0, sy, 0, 0}
+}
+
+func rotate(degrees float64) matrix {
+	sin, cos := math.Sincos(degrees * math.Pi / 180)
+	return matrix{cos, sin, -sin, cos, 0, 0}
+}
+
+// parseTransform parses the value of a transform attribute, such as
+// "translate(10 20) rotate(45)".
+func parseTransform(s string) (matrix, error) {
+	m := identity
+
+	s = strings.TrimSpace(s)
+	for s != "" {
+		open := strings.IndexByte(s, '(')
+		end := strings.IndexByte(s, ')')
+		if open < 0 || end < open {
+			return identity, fmt.Errorf("svg: invalid transform %q", s)
+		}
+
+		name := strings.TrimSpace(s[:open])
+		args, err := parseNumberList(s[open+1 : end])
+		if err != nil {
+			return identity, err
+		}
+
+		var t matrix
+		switch {
+		case name == "matrix" && len(args) == 6:
+			t = matrix{args[0], args[1], args[2], args[3], args[4], args[5]}
+
+		case name == "translate" && len(args) == 1:
+			t = translate(args[0], 0)
+
+		case name == "translate" && len(args) == 2:
+			t = translate(args[0], args[1])
+
+		case name == "scale" && len(args) == 1:
+			t = scale(args[0], args[0])
+
+		case name == "scale" && len(args) == 2:
+			t = scale(args[0], args[1])
+
+		case name == "rotate" && len(args) == 1:
+			t = rotate(args[0])
+
+		case name == "rotate" && len(args) == 3:
+			t = translate(args[1], args[2]).mul(rotate(args[0])).mul(translate(-args[1], -args[2]))
+
+		case name == "skewX" && len(args) == 1:
+			t = matrix{1, 0, math.Tan(args[0] * math.Pi / 180), 1, 0, 0}
+
+		case name == "skewY" && len(args) == 1:
+			t = matrix{1, math.Tan(args[0] * math.Pi / 180), 0, 1, 0, 0}
+
+		default:
+			return identity, fmt.Errorf("svg: invalid transform function %s with %d arguments", name, len(args))
+		}
+
+		m = m.mul(t)
+		s = strings.TrimLeft(s[end+1:], " \t\r\n,")
+	}
+
+	return m, nil
+}
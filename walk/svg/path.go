@@ -0,0 +1,428 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package svg
+
+import (
+	"fmt"
+	"math"
+)
+
+type segmentKind uint8
+
+const (
+	moveTo segmentKind = iota
+	lineTo
+	cubicTo
+	closePath
+)
+
+// segment is an absolute path command. Quadratic curves and arcs are converted
+// to cubic Bézier curves while parsing, so only four kinds remain.
+type segment struct {
+	kind segmentKind
+	pts  [3]point
+}
+
+type path []segment
+
+func (p *path) moveTo(pt point) {
+	*p = append(*p, segment{kind: moveTo, pts: [3]point{pt}})
+}
+
+func (p *path) lineTo(pt point) {
+	*p = append(*p, segment{kind: lineTo, pts: [3]point{pt}})
+}
+
+func (p *path) cubicTo(c1, c2, pt point) {
+	*p = append(*p, segment{kind: cubicTo, pts: [3]point{c1, c2, pt}})
+}
+
+func (p *path) close() {
+	*p = append(*p, segment{kind: closePath})
+}
+
+// kappa is the distance of the control points from the end points of a cubic
+// Bézier curve approximating a quarter circle of radius 1.
+const kappa = 0.5522847498
+
+func (p *path) ellipse(cx, cy, rx, ry float64) {
+	kx, ky := rx*kappa, ry*kappa
+
+	p.moveTo(point{cx + rx, cy})
+	p.cubicTo(point{cx + rx, cy + ky}, point{cx + kx, cy + ry}, point{cx, cy + ry})
+	p.cubicTo(point{cx - kx, cy + ry}, point{cx - rx, cy + ky}, point{cx - rx, cy})
+	p.cubicTo(point{cx - rx, cy - ky}, point{cx - kx, cy - ry}, point{cx, cy - ry})
+	p.cubicTo(point{cx + kx, cy - ry}, point{cx + rx, cy - ky}, point{cx + rx, cy})
+	p.close()
+}
+
+func (p *path) rect(x, y, w, h, rx, ry float64) {
+	if rx <= 0 || ry <= 0 {
+		p.moveTo(point{x, y})
+		p.lineTo(point{x + w, y})
+		p.lineTo(point{x + w, y + h})
+		p.lineTo(point{x, y + h})
+		p.close()
+		return
+	}
+
+	kx, ky := rx*kappa, ry*kappa
+
+	p.moveTo(point{x + rx, y})
+	p.lineTo(point{x + w - rx, y})
+	p.cubicTo(point{x + w - rx + kx, y}, point{x + w, y + ry - ky}, point{x + w, y + ry})
+	p.lineTo(point{x + w, y + h - ry})
+	p.cubicTo(point{x + w, y + h - ry + ky}, point{x + w - rx + kx, y + h}, point{x + w - rx, y + h})
+	p.lineTo(point{x + rx, y + h})
+	p.cubicTo(point{x + rx - kx, y + h}, point{x, y + h - ry + ky}, point{x, y + h - ry})
+	p.lineTo(point{x, y + ry})
+	p.cubicTo(point{x, y + ry - ky}, point{x + rx - kx, y}, point{x + rx, y})
+	p.close()
+}
+
+// parsePathData parses the d attribute of a path element. As required by the
+// SVG specification, the path parsed up to the first error is returned along
+// with the error, so it can still be rendered.
+func parsePathData(d string) (path, error) {
+	var p path
+	sc := scanner{s: d}
+
+	var cmd byte
+	var cur, start, lastCtrl point
+	var lastCmd byte
+
+	for {
+		sc.skipSeparators()
+		if sc.done() {
+			return p, nil
+		}
+
+		if c := sc.peek(); isPathCommand(c) {
+			cmd = c
+			sc.pos++
+			sc.skipSeparators()
+		} else if cmd == 0 {
+			return p, fmt.Errorf("svg: path data must start with a command: %q", d)
+		}
+
+		rel := cmd >= 'a'
+		abs := func(pt point) point {
+			if rel {
+				return pt.add(cur)
+			}
+			return pt
+		}
+
+		nums := func(n int) ([]float64, error) {
+			v := make([]float64, n)
+			for i := range v {
+				sc.skipSeparators()
+				f, err := sc.number()
+				if err != nil {
+					return nil, err
+				}
+				v[i] = f
+			}
+			return v, nil
+		}
+
+		upper := cmd &^ 0x20
+
+		switch upper {
+		case 'Z':
+			p.close()
+			cur = start
+			lastCmd = 'Z'
+			// Z takes no arguments; a number directly following it is an error.
+			sc.skipSeparators()
+			if !sc.done() && !isPathCommand(sc.peek()) {
+				return p, fmt.Errorf("svg: unexpected data after closepath in %q", d)
+			}
+			continue
+
+		case 'M':
+			v, err := nums(2)
+			if err != nil {
+				return p, err
+			}
+			cur = abs(point{v[0], v[1]})
+			start = cur
+			p.moveTo(cur)
+			// Subsequent coordinate pairs are implicit lineto commands.
+			if rel {
+				cmd = 'l'
+			} else {
+				cmd = 'L'
+			}
+
+		case 'L':
+			v, err := nums(2)
+			if err != nil {
+				return p, err
+			}
+			cur = abs(point{v[0], v[1]})
+			p.lineTo(cur)
+
+		case 'H':
+			v, err := nums(1)
+			if err != nil {
+				return p, err
+			}
+			if rel {
+				cur.x += v[0]
+			} else {
+				cur.x = v[0]
+			}
+			p.lineTo(cur)
+
+		case 'V':
+			v, err := nums(1)
+			if err != nil {
+				return p, err
+			}
+			if rel {
+				cur.y += v[0]
+			} else {
+				cur.y = v[0]
+			}
+			p.lineTo(cur)
+
+		case 'C', 'S':
+			var c1 point
+			var rest []float64
+			if upper == 'C' {
+				v, err := nums(6)
+				if err != nil {
+					return p, err
+				}
+				c1, rest = abs(point{v[0], v[1]}), v[2:]
+			} else {
+				v, err := nums(4)
+				if err != nil {
+					return p, err
+				}
+				c1, rest = cur, v
+				if lastCmd == 'C' || lastCmd == 'S' {
+					c1 = cur.mul(2).sub(lastCtrl)
+				}
+			}
+			c2, end := abs(point{rest[0], rest[1]}), abs(point{rest[2], rest[3]})
+			p.cubicTo(c1, c2, end)
+			lastCtrl, cur = c2, end
+
+		case 'Q', 'T':
+			var ctrl, end point
+			if upper == 'Q' {
+				v, err := nums(4)
+				if err != nil {
+					return p, err
+				}
+				ctrl, end = abs(point{v[0], v[1]}), abs(point{v[2], v[3]})
+			} else {
+				v, err := nums(2)
+				if err != nil {
+					return p, err
+				}
+				ctrl, end = cur, abs(point{v[0], v[1]})
+				if lastCmd == 'Q' || lastCmd == 'T' {
+					ctrl = cur.mul(2).sub(lastCtrl)
+				}
+			}
+			p.cubicTo(cur.add(ctrl.sub(cur).mul(2.0/3)), end.add(ctrl.sub(end).mul(2.0/3)), end)
+			lastCtrl, cur = ctrl, end
+
+		case 'A':
+			v, err := nums(3)
+			if err != nil {
+				return p, err
+			}
+			sc.skipSeparators()
+			large, err := sc.flag()
+			if err != nil {
+				return p, err
+			}
+			sc.skipSeparators()
+			sweep, err := sc.flag()
+			if err != nil {
+				return p, err
+			}
+			e, err := nums(2)
+			if err != nil {
+				return p, err
+			}
+			end := abs(point{e[0], e[1]})
+			p.arcTo(cur, end, v[0], v[1], v[2], large, sweep)
+			cur = end
+
+		default:
+			return p, fmt.Errorf("svg: unknown path command %q in %q", cmd, d)
+		}
+
+		lastCmd = upper
+	}
+}
+
+// isPathCommand reports whether c starts a path command. The exponent
+// markers e and E are letters too, but only ever appear inside numbers.
+func isPathCommand(c byte) bool {
+	return (c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') && c != 'e' && c != 'E'
+}
+
+// arcTo appends the elliptical arc from from to to, as specified by the SVG
+// arc command, approximated by cubic Bézier curves.
+func (p *path) arcTo(from, to point, rx, ry, xAxisRotation float64, large, sweep bool) {
+	if from == to {
+		return
+	}
+
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 {
+		p.lineTo(to)
+		return
+	}
+
+	// Convert from endpoint to center parameterization, see the
+	// implementation notes of the SVG specification.
+	sin, cos := math.Sincos(xAxisRotation * math.Pi / 180)
+
+	dx, dy := (from.x-to.x)/2, (from.y-to.y)/2
+	x1 := cos*dx + sin*dy
+	y1 := -sin*dx + cos*dy
+
+	if lambda := x1*x1/(rx*rx) + y1*y1/(ry*ry); lambda > 1 {
+		s := math.Sqrt(lambda)
+		rx *= s
+		ry *= s
+	}
+
+	num := rx*rx*ry*ry - rx*rx*y1*y1 - ry*ry*x1*x1
+	den := rx*rx*y1*y1 + ry*ry*x1*x1
+	coef := 0.0
+	if num > 0 && den > 0 {
+		coef = math.Sqrt(num / den)
+	}
+	if large == sweep {
+		coef = -coef
+	}
+
+	cx1 := coef * rx * y1 / ry
+	cy1 := -coef * ry * x1 / rx
+
+	cx := cos*cx1 - sin*cy1 + (from.x+to.x)/2
+	cy := sin*cx1 + cos*cy1 + (from.y+to.y)/2
+
+	angle := func(ux, uy, vx, vy float64) float64 {
+		return math.Atan2(ux*vy-uy*vx, ux*vx+uy*vy)
+	}
+
+	theta := angle(1, 0, (x1-cx1)/rx, (y1-cy1)/ry)
+	delta := angle((x1-cx1)/rx, (y1-cy1)/ry, (-x1-cx1)/rx, (-y1-cy1)/ry)
+	if !sweep && delta > 0 {
+		delta -= 2 * math.Pi
+	} else if sweep && delta < 0 {
+		delta += 2 * math.Pi
+	}
+
+	n := int(math.Ceil(math.Abs(delta) / (math.Pi / 2)))
+	if n < 1 {
+		n = 1
+	}
+	step := delta / float64(n)
+	k := 4.0 / 3 * math.Tan(step/4)
+
+	at := func(t float64) (pt, d point) {
+		st, ct := math.Sincos(t)
+		pt = point{cx + rx*ct*cos - ry*st*sin, cy + rx*ct*sin + ry*st*cos}
+		d = point{-rx*st*cos - ry*ct*sin, -rx*st*sin + ry*ct*cos}
+		return
+	}
+
+	start, d0 := at(theta)
+	for i := 0; i < n; i++ {
+		t := theta + float64(i+1)*step
+		end, d1 := at(t)
+		if i == n-1 {
+			end = to
+		}
+		p.cubicTo(start.add(d0.mul(k)), end.sub(d1.mul(k)), end)
+		start, d0 = end, d1
+	}
+}
+
+// polyline is a flattened subpath.
+type polyline struct {
+	pts    []point
+	closed bool
+}
+
+// flatten approximates p by line segments after transforming it by m. tol is
+// the maximum distance between the curve and its approximation.
+func (p path) flatten(m matrix, tol float64) []polyline {
+	var lines []polyline
+	var cur *polyline
+	var last, start point
+
+	begin := func(pt point) {
+		lines = append(lines, polyline{pts: []point{pt}})
+		cur = &lines[len(lines)-1]
+		start = pt
+	}
+
+	for _, seg := range p {
+		switch seg.kind {
+		case moveTo:
+			last = m.apply(seg.pts[0])
+			begin(last)
+
+		case lineTo:
+			if cur == nil {
+				begin(last)
+			}
+			last = m.apply(seg.pts[0])
+			cur.pts = append(cur.pts, last)
+
+		case cubicTo:
+			if cur == nil {
+				begin(last)
+			}
+			c1, c2, end := m.apply(seg.pts[0]), m.apply(seg.pts[1]), m.apply(seg.pts[2])
+			cur.pts = flattenCubic(cur.pts, last, c1, c2, end, tol)
+			last = end
+
+		case closePath:
+			if cur != nil {
+				cur.closed = true
+			}
+			cur = nil
+			last = start
+		}
+	}
+
+	return lines
+}
+
+func flattenCubic(dst []point, p0, p1, p2, p3 point, tol float64) []point {
+	// The number of segments follows from the maximum second difference of
+	// the control polygon, which bounds the flattening error.
+	dd := math.Max(p0.sub(p1.mul(2)).add(p2).len(), p1.sub(p2.mul(2)).add(p3).len())
+	n := int(math.Ceil(math.Sqrt(0.75 * dd / tol)))
+	if n < 1 {
+		n = 1
+	} else if n > 500 {
+		n = 500
+	}
+
+	for i := 1; i <= n; i++ {
+		t := float64(i) / float64(n)
+		mt := 1 - t
+		a, b, c, d := mt*mt*mt, 3*mt*mt*t, 3*mt*t*t, t*t*t
+		dst = append(dst, point{
+			a*p0.x + b*p1.x + c*p2.x + d*p3.x,
+			a*p0.y + b*p1.y + c*p2.y + d*p3.y,
+		})
+	}
+
+	return dst
+}
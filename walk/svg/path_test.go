@@ -0,0 +1,185 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package svg
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestParseNumberList(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want []float64
+	}{
+		{"1 2 3", []float64{1, 2, 3}},
+		{"1,2 ,3", []float64{1, 2, 3}},
+		{"1-2.5.5", []float64{1, -2.5, 0.5}},
+		{"1e2-3E-1", []float64{100, -0.3}},
+		{"+.5", []float64{0.5}},
+		{"", nil},
+	}
+
+	for _, c := range testCases {
+		got, err := parseNumberList(c.s)
+		if err != nil || !reflect.DeepEqual(got, c.want) {
+			t.Errorf("parseNumberList(%q): got %v, %v, want %v", c.s, got, err, c.want)
+		}
+	}
+
+	if _, err := parseNumberList("1 x"); err == nil {
+		t.Error(`parseNumberList("1 x"): got nil error`)
+	}
+}
+
+func TestParsePathData(t *testing.T) {
+	p, err := parsePathData("M10 20l5 0 0 5H0v-5z m1 1 h2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := path{
+		{kind: moveTo, pts: [3]point{{10, 20}}},
+		{kind: lineTo, pts: [3]point{{15, 20}}},
+		{kind: lineTo, pts: [3]point{{15, 25}}},
+		{kind: lineTo, pts: [3]point{{0, 25}}},
+		{kind: lineTo, pts: [3]point{{0, 20}}},
+		{kind: closePath},
+		{kind: moveTo, pts: [3]point{{11, 21}}},
+		{kind: lineTo, pts: [3]point{{13, 21}}},
+	}
+	if !reflect.DeepEqual(p, want) {
+		t.Errorf("got %v, want %v", p, want)
+	}
+}
+
+func TestParsePathDataCurves(t *testing.T) {
+	// A quadratic curve is converted to the equivalent cubic curve.
+	p, err := parsePathData("M0 0Q3 3 6 0T12 0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p) != 3 || p[1].pts != [3]point{{2, 2}, {4, 2}, {6, 0}} || p[2].pts[2] != (point{12, 0}) {
+		t.Errorf("got %v", p)
+	}
+	if c := p[2].pts[0]; !almostEqual(c.x, 8) || !almostEqual(c.y, -2) {
+		t.Errorf("reflected control point: got %v, want {8 -2}", c)
+	}
+
+	// Arc flags need not be separated from the following numbers.
+	p, err = parsePathData("M0 0a5 5 0 1110 0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if end := p[len(p)-1].pts[2]; end != (point{10, 0}) {
+		t.Errorf("arc end: got %v, want {10 0}", end)
+	}
+}
+
+func TestParsePathDataErrors(t *testing.T) {
+	p, err := parsePathData("M0 0L10 10L20")
+	if err == nil {
+		t.Error("got nil error for truncated path")
+	}
+	if len(p) != 2 {
+		t.Errorf("got %d segments before the error, want 2", len(p))
+	}
+
+	if _, err := parsePathData("10 10"); err == nil {
+		t.Error("got nil error for path without command")
+	}
+
+	// An exponent marker after closepath used to loop forever.
+	for _, d := range []string{"M0 0Ze", "M0 0ZE", "M0 0z e1"} {
+		if _, err := parsePathData(d); err == nil {
+			t.Errorf("got nil error for %q", d)
+		}
+	}
+}
+
+func TestArcFlattening(t *testing.T) {
+	// A half circle of radius 5 around (5, 0), sweeping through positive y.
+	var p path
+	p.moveTo(point{0, 0})
+	p.arcTo(point{0, 0}, point{10, 0}, 5, 5, 0, false, false)
+
+	lines := p.flatten(identity, 0.01)
+	if len(lines) != 1 {
+		t.Fatalf("got %d polylines, want 1", len(lines))
+	}
+
+	maxY := 0.0
+	for _, pt := range lines[0].pts {
+		if r := math.Hypot(pt.x-5, pt.y); math.Abs(r-5) > 0.02 {
+			t.Errorf("point %v is %v away from the center, want 5", pt, r)
+		}
+		maxY = math.Max(maxY, math.Abs(pt.y))
+	}
+	if math.Abs(maxY-5) > 0.02 {
+		t.Errorf("arc extent: got %v, want 5", maxY)
+	}
+}
+
+func TestParseTransform(t *testing.T) {
+	testCases := []struct {
+		s    string
+		in   point
+		want point
+	}{
+		{"translate(10)", point{1, 1}, point{11, 1}},
+		{"translate(10, 20) scale(2)", point{1, 1}, point{12, 22}},
+		{"rotate(90)", point{1, 0}, point{0, 1}},
+		{"rotate(90 5 5)", point{5, 0}, point{10, 5}},
+		{"matrix(1 0 0 1 3 4)", point{0, 0}, point{3, 4}},
+		{"skewX(45)", point{0, 1}, point{1, 1}},
+	}
+
+	for _, c := range testCases {
+		m, err := parseTransform(c.s)
+		if err != nil {
+			t.Errorf("parseTransform(%q): %v", c.s, err)
+			continue
+		}
+		if got := m.apply(c.in); !almostEqual(got.x, c.want.x) || !almostEqual(got.y, c.want.y) {
+			t.Errorf("parseTransform(%q) applied to %v: got %v, want %v", c.s, c.in, got, c.want)
+		}
+	}
+
+	if _, err := parseTransform("wobble(1)"); err == nil {
+		t.Error(`parseTransform("wobble(1)"): got nil error`)
+	}
+}
+
+func TestParseColor(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want rgba
+	}{
+		{"#f00", rgba{1, 0, 0, 1}},
+		{"#00ff0080", rgba{0, 1, 0, 128.0 / 255}},
+		{"rgb(0, 0, 255)", rgba{0, 0, 1, 1}},
+		{"rgba(100%, 0%, 0%, 0.5)", rgba{1, 0, 0, 0.5}},
+		{"White", rgba{1, 1, 1, 1}},
+		{"transparent", rgba{}},
+	}
+
+	for _, c := range testCases {
+		got, err := parseColor(c.s)
+		if err != nil || got != c.want {
+			t.Errorf("parseColor(%q): got %v, %v, want %v", c.s, got, err, c.want)
+		}
+	}
+
+	for _, s := range []string{"#12", "rgb(1,2)", "nocolor"} {
+		if _, err := parseColor(s); err == nil {
+			t.Errorf("parseColor(%q): got nil error", s)
+		}
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package svg
+
+import (
+	"image"
+	"math"
+	"sort"
+)
+
+// subsamples is the number of scanlines sampled per pixel row. Coverage along
+// each scanline is computed exactly, so this only limits the vertical
+// anti-aliasing resolution.
+const subsamples = 16
+
+type edge struct {
+	x0, y0, x1, y1 float64
+	dir            int
+}
+
+type crossing struct {
+	x   float64
+	dir int
+}
+
+// mask holds the coverage of each pixel of a w by h area, in [0, 1].
+type mask struct {
+	w, h   int
+	bounds image.Rectangle
+	cov    []float32
+}
+
+// rasterize computes the anti-aliased coverage of the polygons within a w by h
+// pixel area. Polygons are implicitly closed.
+func rasterize(polys [][]point, w, h int, evenOdd bool) *mask {
+	m := &mask{w: w, h: h, cov: make([]float32, w*h)}
+
+	var edges []edge
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for _, poly := range polys {
+		for i := range poly {
+			a, b := poly[i], poly[(i+1)%len(poly)]
+			if a.y == b.y || math.IsNaN(a.x+a.y+b.x+b.y) {
+				continue
+			}
+
+			e := edge{a.x, a.y, b.x, b.y, 1}
+			if a.y > b.y {
+				e = edge{b.x, b.y, a.x, a.y, -1}
+			}
+			edges = append(edges, e)
+			minY = math.Min(minY, e.y0)
+			maxY = math.Max(maxY, e.y1)
+		}
+	}
+	if len(edges) == 0 {
+		return m
+	}
+
+	sort.Slice(edges, func(i, j int) bool { return edges[i].y0 < edges[j].y0 })
+
+	y0 := clampInt(int(math.Floor(minY)), 0, h)
+	y1 := clampInt(int(math.Ceil(maxY)), 0, h)
+	m.bounds = image.Rect(w, y0, 0, y1)
+
+	var active []edge
+	var crossings []crossing
+	next := 0
+	row := make([]float32, w+1)
+	const weight = 1.0 / subsamples
+
+	for y := y0; y < y1; y++ {
+		// Maintain the edges overlapping this pixel row.
+		kept := active[:0]
+		for _, e := range active {
+			if e.y1 > float64(y) {
+				kept = append(kept, e)
+			}
+		}
+		active = kept
+		for next < len(edges) && edges[next].y0 < float64(y+1) {
+			if edges[next].y1 > float64(y) {
+				active = append(active, edges[next])
+			}
+			next++
+		}
+
+		for i := range row {
+			row[i] = 0
+		}
+		rowMin, rowMax := w, 0
+
+		for s := 0; s < subsamples; s++ {
+			sy := float64(y) + (float64(s)+0.5)/subsamples
+
+			crossings = crossings[:0]
+			for _, e := range active {
+				if sy >= e.y0 && sy < e.y1 {
+					x := e.x0 + (sy-e.y0)*(e.x1-e.x0)/(e.y1-e.y0)
+					crossings = append(crossings, crossing{x, e.dir})
+				}
+			}
+			if len(crossings) < 2 {
+				continue
+			}
+			sort.Slice(crossings, func(i, j int) bool { return crossings[i].x < crossings[j].x })
+
+			winding := 0
+			for i, c := range crossings[:len(crossings)-1] {
+				winding += c.dir
+				inside := winding != 0
+				if evenOdd {
+					inside = winding%2 != 0
+				}
+				if !inside {
+					continue
+				}
+
+				xa, xb := math.Max(c.x, 0), math.Min(crossings[i+1].x, float64(w))
+				if xa >= xb {
+					continue
+				}
+
+				ia, ib := int(xa), int(xb)
+				if ia < rowMin {
+					rowMin = ia
+				}
+				if ib+1 > rowMax {
+					rowMax = ib + 1
+				}
+
+				if ia == ib {
+					row[ia] += float32((xb - xa) * weight)
+					continue
+				}
+				row[ia] += float32((float64(ia+1) - xa) * weight)
+				for x := ia + 1; x < ib; x++ {
+					row[x] += weight
+				}
+				row[ib] += float32((xb - float64(ib)) * weight)
+			}
+		}
+
+		if rowMax > w {
+			rowMax = w
+		}
+		if rowMin < rowMax {
+			copy(m.cov[y*w+rowMin:y*w+rowMax], row[rowMin:rowMax])
+			if rowMin < m.bounds.Min.X {
+				m.bounds.Min.X = rowMin
+			}
+			if rowMax > m.bounds.Max.X {
+				m.bounds.Max.X = rowMax
+			}
+		}
+	}
+
+	if m.bounds.Min.X >= m.bounds.Max.X {
+		m.bounds = image.Rectangle{}
+	}
+
+	return m
+}
+
+// shader returns the non-premultiplied color of a paint at a pixel center.
+type shader func(x, y float64) rgba
+
+// composite blends the shader output, weighted by coverage and opacity, onto
+// dst using the source-over operator.
+func composite(dst *image.RGBA, m *mask, sh shader, opacity float64) {
+	b := m.bounds
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			cov := float64(m.cov[y*m.w+x])
+			if cov <= 0 {
+				continue
+			}
+			if cov > 1 {
+				cov = 1
+			}
+
+			c := sh(float64(x)+0.5, float64(y)+0.5)
+			a := c.a * cov * opacity
+			if a <= 0 {
+				continue
+			}
+
+			i := dst.PixOffset(x+dst.Rect.Min.X, y+dst.Rect.Min.Y)
+			px := dst.Pix[i : i+4 : i+4]
+			inv := 1 - a
+			px[0] = uint8(math.Round(c.r*a*255 + float64(px[0])*inv))
+			px[1] = uint8(math.Round(c.g*a*255 + float64(px[1])*inv))
+			px[2] = uint8(math.Round(c.b*a*255 + float64(px[2])*inv))
+			px[3] = uint8(math.Round(a*255 + float64(px[3])*inv))
+		}
+	}
+}
+
+func clampInt(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+
+	return v
+}
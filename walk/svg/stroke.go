@@ -0,0 +1,274 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package svg
+
+import (
+	"math"
+)
+
+type lineCap uint8
+
+const (
+	capButt lineCap = iota
+	capRound
+	capSquare
+)
+
+type lineJoin uint8
+
+const (
+	joinMiter lineJoin = iota
+	joinRound
+	joinBevel
+)
+
+type strokeStyle struct {
+	width      float64
+	cap        lineCap
+	join       lineJoin
+	miterLimit float64
+	dashes     []float64
+	dashOffset float64
+}
+
+// stroke converts lines into polygons covering the stroke outline. The
+// polygons overlap and are all oriented the same way, so that filling them
+// with the nonzero rule yields their union. tol is the flattening tolerance
+// used for round caps and joins.
+func stroke(lines []polyline, st strokeStyle, tol float64) [][]point {
+	if st.width <= 0 {
+		return nil
+	}
+
+	if len(st.dashes) > 0 {
+		lines = dash(lines, st.dashes, st.dashOffset, tol)
+	}
+
+	hw := st.width / 2
+	var polys [][]point
+	add := func(poly ...point) {
+		if polygonArea(poly) < 0 {
+			for i, j := 0, len(poly)-1; i < j; i, j = i+1, j-1 {
+				poly[i], poly[j] = poly[j], poly[i]
+			}
+		}
+		polys = append(polys, poly)
+	}
+
+	for _, line := range lines {
+		pts := dedupe(line.pts, line.closed)
+
+		if len(pts) == 1 {
+			// A zero length subpath only renders caps.
+			switch st.cap {
+			case capRound:
+				add(circle(pts[0], hw, tol)...)
+
+			case capSquare:
+				p := pts[0]
+				add(point{p.x - hw, p.y - hw}, point{p.x + hw, p.y - hw}, point{p.x + hw, p.y + hw}, point{p.x - hw, p.y + hw})
+			}
+			continue
+		}
+
+		n := len(pts) - 1
+		if line.closed {
+			pts = append(pts, pts[0])
+			n = len(pts) - 1
+		}
+
+		for i := 0; i < n; i++ {
+			a, b := pts[i], pts[i+1]
+			nv := b.sub(a).normal().mul(hw)
+			add(a.add(nv), b.add(nv), b.sub(nv), a.sub(nv))
+		}
+
+		joinAt := func(prev, v, next point) {
+			d1, d2 := v.sub(prev), next.sub(v)
+			cross := d1.cross(d2)
+			if math.Abs(cross) < 1e-12 && d1.dot(d2) > 0 {
+				return
+			}
+
+			if st.join == joinRound {
+				add(circle(v, hw, tol)...)
+				return
+			}
+
+			// The gap to fill is on the outside of the turn.
+			side := 1.0
+			if cross > 0 {
+				side = -1
+			}
+			n1, n2 := d1.normal().mul(side), d2.normal().mul(side)
+			p1, p2 := v.add(n1.mul(hw)), v.add(n2.mul(hw))
+
+			if st.join == joinMiter {
+				m := n1.add(n2)
+				if l := m.len(); l > 0 {
+					m = m.mul(1 / l)
+					if cos := m.dot(n1); cos > 0 && 1/cos <= st.miterLimit {
+						add(v, p1, v.add(m.mul(hw/cos)), p2)
+						return
+					}
+				}
+			}
+
+			add(v, p1, p2)
+		}
+
+		for i := 1; i < n; i++ {
+			joinAt(pts[i-1], pts[i], pts[i+1])
+		}
+
+		if line.closed {
+			joinAt(pts[n-1], pts[0], pts[1])
+			continue
+		}
+
+		capAt := func(p, dir point) {
+			switch st.cap {
+			case capRound:
+				add(circle(p, hw, tol)...)
+
+			case capSquare:
+				d := dir.mul(hw / dir.len())
+				nv := dir.normal().mul(hw)
+				add(p.add(nv), p.add(nv).add(d), p.sub(nv).add(d), p.sub(nv))
+			}
+		}
+
+		capAt(pts[0], pts[0].sub(pts[1]))
+		capAt(pts[n], pts[n].sub(pts[n-1]))
+	}
+
+	return polys
+}
+
+// dedupe removes consecutive duplicate points, including a closing point that
+// duplicates the first one of a closed line.
+func dedupe(pts []point, closed bool) []point {
+	result := make([]point, 0, len(pts))
+	for _, p := range pts {
+		if len(result) == 0 || p.sub(result[len(result)-1]).len() > 1e-9 {
+			result = append(result, p)
+		}
+	}
+
+	if closed && len(result) > 1 && result[0].sub(result[len(result)-1]).len() <= 1e-9 {
+		result = result[:len(result)-1]
+	}
+
+	return result
+}
+
+// dash splits lines into the dashes described by pattern, which alternates
+// between dash and gap lengths. A pattern shorter than the flattening
+// tolerance tol could not be told apart from a solid line and is ignored.
+func dash(lines []polyline, pattern []float64, offset, tol float64) []polyline {
+	if len(pattern)%2 == 1 {
+		pattern = append(append([]float64(nil), pattern...), pattern...)
+	}
+
+	total := 0.0
+	for _, v := range pattern {
+		if v < 0 {
+			return lines
+		}
+		total += v
+	}
+	if total <= 0 || total < tol {
+		return lines
+	}
+
+	var result []polyline
+	for _, line := range lines {
+		pts := line.pts
+		if line.closed && len(pts) > 0 {
+			pts = append(append([]point(nil), pts...), pts[0])
+		}
+
+		// Find the position within the pattern at the start of the line.
+		pos := math.Mod(offset, total)
+		if pos < 0 {
+			pos += total
+		}
+		idx := 0
+		for pos >= pattern[idx] {
+			pos -= pattern[idx]
+			idx = (idx + 1) % len(pattern)
+		}
+		remaining := pattern[idx] - pos
+		on := idx%2 == 0
+
+		var cur []point
+		if on && len(pts) > 0 {
+			cur = []point{pts[0]}
+		}
+
+		for i := 1; i < len(pts); i++ {
+			a, b := pts[i-1], pts[i]
+			segLen := b.sub(a).len()
+			t := 0.0
+			for segLen-t > remaining {
+				t += remaining
+				p := a.add(b.sub(a).mul(t / segLen))
+				if on {
+					result = append(result, polyline{pts: append(cur, p)})
+					cur = nil
+				} else {
+					cur = []point{p}
+				}
+				on = !on
+				idx = (idx + 1) % len(pattern)
+				remaining = pattern[idx]
+			}
+			remaining -= segLen - t
+			if on {
+				cur = append(cur, b)
+			}
+		}
+
+		if on && len(cur) > 1 {
+			result = append(result, polyline{pts: cur})
+		}
+	}
+
+	return result
+}
+
+// circle approximates a circle by a polygon whose edges deviate at most tol
+// from the exact outline.
+func circle(c point, r, tol float64) []point {
+	n := 8
+	if r > tol {
+		n = int(math.Ceil(math.Pi / math.Acos(1-tol/r)))
+	}
+	if n < 8 {
+		n = 8
+	} else if n > 256 {
+		n = 256
+	}
+
+	pts := make([]point, n)
+	for i := range pts {
+		sin, cos := math.Sincos(2 * math.Pi * float64(i) / float64(n))
+		pts[i] = point{c.x + r*cos, c.y + r*sin}
+	}
+
+	return pts
+}
+
+// polygonArea returns the signed area of poly, which is positive for
+// counter-clockwise polygons in a y-up coordinate system.
+func polygonArea(poly []point) float64 {
+	area := 0.0
+	for i := range poly {
+		j := (i + 1) % len(poly)
+		area += poly[i].cross(poly[j])
+	}
+
+	return area / 2
+}
@@ -0,0 +1,602 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package svg parses and rasterizes a practical subset of SVG 1.1, which
+// covers the icons and illustrations typically found in applications.
+//
+// Supported are the path, rect, circle, ellipse, line, polyline and polygon
+// shapes, g and defs containers, the transform attribute, solid colors, linear
+// and radial gradients (including href inheritance), fill rules, stroke width,
+// caps, joins, miter limits and dash arrays, opacity and the viewBox and
+// preserveAspectRatio attributes. Properties may be specified as presentation
+// attributes or in style attributes. Text, images, filters, masks, clip paths,
+// patterns, use elements and style sheets are not supported and are ignored.
+//
+// Package walk builds its SVGImage on top of this package.
+package svg
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"strings"
+)
+
+// Rect is a rectangle in user units.
+type Rect struct {
+	X, Y, Width, Height float64
+}
+
+// Document is a parsed SVG document that can be rasterized at any size.
+type Document struct {
+	// Width and Height hold the intrinsic size of the document in CSS pixels,
+	// i.e. 1/96".
+	Width, Height float64
+
+	// ViewBox is the area of user space that is mapped onto the viewport.
+	ViewBox Rect
+
+	aspect    aspectRatio
+	shapes    []shape
+	gradients map[string]*gradient
+}
+
+type aspectRatio struct {
+	none           bool
+	alignX, alignY float64 // 0 for min, 0.5 for mid, 1 for max
+	slice          bool
+}
+
+type style struct {
+	fill          paint
+	stroke        paint
+	fillOpacity   float64
+	strokeOpacity float64
+	evenOdd       bool
+	strokeStyle   strokeStyle
+	color         rgba
+	visible       bool
+}
+
+var defaultStyle = style{
+	fill:          paint{kind: paintColor, color: black},
+	stroke:        paint{kind: paintNone},
+	fillOpacity:   1,
+	strokeOpacity: 1,
+	strokeStyle:   strokeStyle{width: 1, miterLimit: 4},
+	color:         black,
+	visible:       true,
+}
+
+type shape struct {
+	path    path
+	ctm     matrix
+	style   style
+	opacity float64
+}
+
+// frame holds the state of an open element while parsing.
+type frame struct {
+	style    style
+	ctm      matrix
+	opacity  float64
+	defs     bool
+	gradient *gradient
+}
+
+// Parse parses an SVG document from r.
+func Parse(r io.Reader) (*Document, error) {
+	dec := xml.NewDecoder(r)
+	dec.Strict = false
+	dec.Entity = xml.HTMLEntity
+
+	doc := &Document{gradients: make(map[string]*gradient)}
+
+	var stack []frame
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("svg: %w", err)
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if len(stack) == 0 {
+				if tok.Name.Local != "svg" {
+					return nil, fmt.Errorf("svg: root element is %s, want svg", tok.Name.Local)
+				}
+				if err := doc.parseRoot(tok); err != nil {
+					return nil, err
+				}
+				f, _ := doc.enter(frame{style: defaultStyle, ctm: identity, opacity: 1}, tok)
+				stack = append(stack, f)
+				continue
+			}
+
+			parent := stack[len(stack)-1]
+			f, ok := doc.enter(parent, tok)
+			if !ok {
+				if err := dec.Skip(); err != nil {
+					return nil, fmt.Errorf("svg: %w", err)
+				}
+				continue
+			}
+
+			if err := doc.element(parent, &f, tok); err != nil {
+				return nil, err
+			}
+			stack = append(stack, f)
+
+		case xml.EndElement:
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+
+	if doc.Width <= 0 || doc.Height <= 0 {
+		return nil, errors.New("svg: document has neither a valid size nor a viewBox")
+	}
+
+	return doc, nil
+}
+
+func (d *Document) parseRoot(el xml.StartElement) error {
+	width, height := attr(el, "width"), attr(el, "height")
+
+	if vb := attr(el, "viewBox"); vb != "" {
+		v, err := parseNumberList(vb)
+		if err != nil || len(v) != 4 || v[2] <= 0 || v[3] <= 0 {
+			return fmt.Errorf("svg: invalid viewBox %q", vb)
+		}
+		d.ViewBox = Rect{v[0], v[1], v[2], v[3]}
+	}
+
+	length := func(s string) float64 {
+		if s == "" || strings.HasSuffix(strings.TrimSpace(s), "%") {
+			return 0
+		}
+		v, err := parseLength(s, 0)
+		if err != nil {
+			return 0
+		}
+		return v
+	}
+
+	d.Width, d.Height = length(width), length(height)
+
+	vb := d.ViewBox
+	switch {
+	case vb.Width > 0 && d.Width <= 0 && d.Height <= 0:
+		d.Width, d.Height = vb.Width, vb.Height
+
+	case vb.Width > 0 && d.Width <= 0:
+		d.Width = d.Height * vb.Width / vb.Height
+
+	case vb.Width > 0 && d.Height <= 0:
+		d.Height = d.Width * vb.Height / vb.Width
+
+	case vb.Width <= 0:
+		d.ViewBox = Rect{0, 0, d.Width, d.Height}
+	}
+
+	d.aspect = aspectRatio{alignX: 0.5, alignY: 0.5}
+	if par := strings.Fields(attr(el, "preserveAspectRatio")); len(par) > 0 {
+		if par[0] == "none" {
+			d.aspect.none = true
+		} else if len(par[0]) == 8 {
+			align := map[string]float64{"Min": 0, "Mid": 0.5, "Max": 1}
+			if x, ok := align[par[0][1:4]]; ok {
+				d.aspect.alignX = x
+			}
+			if y, ok := align[par[0][5:8]]; ok {
+				d.aspect.alignY = y
+			}
+		}
+		d.aspect.slice = len(par) > 1 && par[1] == "slice"
+	}
+
+	return nil
+}
+
+// enter computes the frame of el from its parent frame. It returns false if
+// el and its children are not to be processed.
+func (d *Document) enter(parent frame, el xml.StartElement) (frame, bool) {
+	switch el.Name.Local {
+	case "svg", "g", "a", "defs", "path", "rect", "circle", "ellipse", "line", "polyline", "polygon",
+		"linearGradient", "radialGradient", "stop":
+
+	default:
+		return parent, false
+	}
+
+	props := properties(el)
+	if props["display"] == "none" && el.Name.Local != "linearGradient" && el.Name.Local != "radialGradient" {
+		return parent, false
+	}
+
+	f := parent
+	f.style.apply(props)
+
+	if t := attr(el, "transform"); t != "" {
+		if m, err := parseTransform(t); err == nil {
+			f.ctm = f.ctm.mul(m)
+		}
+	}
+
+	if o, ok := props["opacity"]; ok {
+		if v, err := parseNumberOrPercent(o); err == nil {
+			f.opacity *= clamp01(v)
+		}
+	}
+
+	if el.Name.Local == "defs" {
+		f.defs = true
+	}
+
+	return f, true
+}
+
+func (d *Document) element(parent frame, f *frame, el xml.StartElement) error {
+	num := func(name string, ref float64) float64 {
+		s := attr(el, name)
+		if s == "" {
+			return 0
+		}
+		v, err := parseLength(s, ref)
+		if err != nil {
+			return 0
+		}
+		return v
+	}
+
+	vw, vh := d.ViewBox.Width, d.ViewBox.Height
+	diag := math.Hypot(vw, vh) / math.Sqrt2
+
+	var p path
+	switch el.Name.Local {
+	case "path":
+		// Render what could be parsed up to an error, as browsers do.
+		p, _ = parsePathData(attr(el, "d"))
+
+	case "rect":
+		w, h := num("width", vw), num("height", vh)
+		if w <= 0 || h <= 0 {
+			return nil
+		}
+		rx, ry := num("rx", vw), num("ry", vh)
+		if attr(el, "rx") == "" {
+			rx = ry
+		} else if attr(el, "ry") == "" {
+			ry = rx
+		}
+		p.rect(num("x", vw), num("y", vh), w, h, math.Min(rx, w/2), math.Min(ry, h/2))
+
+	case "circle":
+		if r := num("r", diag); r > 0 {
+			p.ellipse(num("cx", vw), num("cy", vh), r, r)
+		}
+
+	case "ellipse":
+		if rx, ry := num("rx", vw), num("ry", vh); rx > 0 && ry > 0 {
+			p.ellipse(num("cx", vw), num("cy", vh), rx, ry)
+		}
+
+	case "line":
+		p.moveTo(point{num("x1", vw), num("y1", vh)})
+		p.lineTo(point{num("x2", vw), num("y2", vh)})
+
+	case "polyline", "polygon":
+		v, _ := parseNumberList(attr(el, "points"))
+		for i := 0; i+1 < len(v); i += 2 {
+			if i == 0 {
+				p.moveTo(point{v[i], v[i+1]})
+			} else {
+				p.lineTo(point{v[i], v[i+1]})
+			}
+		}
+		if el.Name.Local == "polygon" && len(p) > 0 {
+			p.close()
+		}
+
+	case "linearGradient", "radialGradient":
+		g := &gradient{radial: el.Name.Local == "radialGradient", attrs: make(map[string]string)}
+		for _, a := range el.Attr {
+			switch a.Name.Local {
+			case "href":
+				g.href = strings.TrimPrefix(strings.TrimSpace(a.Value), "#")
+
+			case "x1", "y1", "x2", "y2", "cx", "cy", "r", "fx", "fy",
+				"gradientUnits", "gradientTransform", "spreadMethod":
+				g.attrs[a.Name.Local] = a.Value
+			}
+		}
+		if id := attr(el, "id"); id != "" {
+			d.gradients[id] = g
+		}
+		f.gradient = g
+		return nil
+
+	case "stop":
+		g := parent.gradient
+		if g == nil {
+			return nil
+		}
+
+		offset, _ := parseNumberOrPercent(attr(el, "offset"))
+		offset = clamp01(offset)
+		if n := len(g.stops); n > 0 && offset < g.stops[n-1].offset {
+			offset = g.stops[n-1].offset
+		}
+
+		props := properties(el)
+		c := black
+		if s, ok := props["stop-color"]; ok {
+			if s == "currentColor" {
+				c = f.style.color
+			} else if v, err := parseColor(s); err == nil {
+				c = v
+			}
+		}
+		if s, ok := props["stop-opacity"]; ok {
+			if v, err := parseNumberOrPercent(s); err == nil {
+				c.a *= clamp01(v)
+			}
+		}
+
+		g.stops = append(g.stops, gradientStop{offset, c})
+		return nil
+
+	default:
+		return nil
+	}
+
+	if !f.defs && f.style.visible && len(p) > 0 {
+		d.shapes = append(d.shapes, shape{path: p, ctm: f.ctm, style: f.style, opacity: f.opacity})
+	}
+
+	return nil
+}
+
+// properties returns the presentation attributes of el, overridden by the
+// declarations of its style attribute.
+func properties(el xml.StartElement) map[string]string {
+	props := make(map[string]string)
+	for _, a := range el.Attr {
+		if a.Name.Space == "" {
+			props[a.Name.Local] = strings.TrimSpace(a.Value)
+		}
+	}
+
+	for _, decl := range strings.Split(attr(el, "style"), ";") {
+		name, value, ok := strings.Cut(decl, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(value), "!important"))
+		props[strings.TrimSpace(name)] = value
+	}
+
+	return props
+}
+
+// apply applies the inherited properties in props to s.
+func (s *style) apply(props map[string]string) {
+	for name, value := range props {
+		if value == "inherit" {
+			continue
+		}
+
+		switch name {
+		case "fill":
+			if p, err := parsePaint(value); err == nil {
+				s.fill = p
+			}
+
+		case "stroke":
+			if p, err := parsePaint(value); err == nil {
+				s.stroke = p
+			}
+
+		case "color":
+			if c, err := parseColor(value); err == nil {
+				s.color = c
+			}
+
+		case "fill-opacity":
+			if v, err := parseNumberOrPercent(value); err == nil {
+				s.fillOpacity = clamp01(v)
+			}
+
+		case "stroke-opacity":
+			if v, err := parseNumberOrPercent(value); err == nil {
+				s.strokeOpacity = clamp01(v)
+			}
+
+		case "fill-rule":
+			s.evenOdd = value == "evenodd"
+
+		case "stroke-width":
+			if v, err := parseLength(value, 0); err == nil && v >= 0 {
+				s.strokeStyle.width = v
+			}
+
+		case "stroke-linecap":
+			switch value {
+			case "butt":
+				s.strokeStyle.cap = capButt
+			case "round":
+				s.strokeStyle.cap = capRound
+			case "square":
+				s.strokeStyle.cap = capSquare
+			}
+
+		case "stroke-linejoin":
+			switch value {
+			case "miter", "miter-clip", "arcs":
+				s.strokeStyle.join = joinMiter
+			case "round":
+				s.strokeStyle.join = joinRound
+			case "bevel":
+				s.strokeStyle.join = joinBevel
+			}
+
+		case "stroke-miterlimit":
+			if v, err := parseNumberOrPercent(value); err == nil && v >= 1 {
+				s.strokeStyle.miterLimit = v
+			}
+
+		case "stroke-dasharray":
+			if value == "none" {
+				s.strokeStyle.dashes = nil
+			} else if v, err := parseNumberList(strings.ReplaceAll(value, "px", "")); err == nil {
+				s.strokeStyle.dashes = v
+			}
+
+		case "stroke-dashoffset":
+			if v, err := parseLength(value, 0); err == nil {
+				s.strokeStyle.dashOffset = v
+			}
+
+		case "visibility":
+			s.visible = value == "visible"
+		}
+	}
+}
+
+func attr(el xml.StartElement, name string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+
+	return ""
+}
+
+// viewMatrix returns the transformation from user space onto a viewport of
+// width by height pixels, honoring the preserveAspectRatio attribute.
+func (d *Document) viewMatrix(width, height float64) matrix {
+	vb := d.ViewBox
+	sx, sy := width/vb.Width, height/vb.Height
+
+	if !d.aspect.none {
+		if d.aspect.slice {
+			sx = math.Max(sx, sy)
+		} else {
+			sx = math.Min(sx, sy)
+		}
+		sy = sx
+	}
+
+	tx := (width-vb.Width*sx)*d.aspect.alignX - vb.X*sx
+	ty := (height-vb.Height*sy)*d.aspect.alignY - vb.Y*sy
+	if d.aspect.none {
+		tx, ty = -vb.X*sx, -vb.Y*sy
+	}
+
+	return matrix{sx, 0, 0, sy, tx, ty}
+}
+
+// Rasterize renders the document into a new image of width by height pixels.
+// The ViewBox is scaled to fit the image as specified by the
+// preserveAspectRatio attribute of the document.
+func (d *Document) Rasterize(width, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	d.Draw(dst, dst.Bounds())
+	return dst
+}
+
+// Draw renders the document into the r area of dst, blending it with the
+// existing content of dst.
+func (d *Document) Draw(dst *image.RGBA, r image.Rectangle) {
+	r = r.Intersect(dst.Bounds())
+	if r.Empty() {
+		return
+	}
+
+	sub := dst.SubImage(r).(*image.RGBA)
+	view := d.viewMatrix(float64(r.Dx()), float64(r.Dy()))
+
+	for _, sh := range d.shapes {
+		d.drawShape(sub, r.Dx(), r.Dy(), view, sh)
+	}
+}
+
+func (d *Document) drawShape(dst *image.RGBA, w, h int, view matrix, sh shape) {
+	m := view.mul(sh.ctm)
+	s := m.scale()
+	if s == 0 || sh.opacity <= 0 {
+		return
+	}
+
+	// Flatten in user space, so that strokes are shaped correctly under
+	// non-uniform transformations, with a tolerance of 1/5 device pixel.
+	tol := 0.2 / s
+	lines := sh.path.flatten(identity, tol)
+
+	bbox := [4]float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	for _, l := range lines {
+		for _, p := range l.pts {
+			bbox[0], bbox[1] = math.Min(bbox[0], p.x), math.Min(bbox[1], p.y)
+			bbox[2], bbox[3] = math.Max(bbox[2], p.x), math.Max(bbox[3], p.y)
+		}
+	}
+
+	toDevice := func(polys [][]point) [][]point {
+		result := make([][]point, len(polys))
+		for i, poly := range polys {
+			result[i] = make([]point, len(poly))
+			for j, p := range poly {
+				result[i][j] = m.apply(p)
+			}
+		}
+		return result
+	}
+
+	if shader, ok := d.shader(sh.style.fill, sh.style, m, bbox); ok {
+		polys := make([][]point, 0, len(lines))
+		for _, l := range lines {
+			if len(l.pts) > 2 {
+				polys = append(polys, l.pts)
+			}
+		}
+
+		composite(dst, rasterize(toDevice(polys), w, h, sh.style.evenOdd), shader, sh.style.fillOpacity*sh.opacity)
+	}
+
+	if shader, ok := d.shader(sh.style.stroke, sh.style, m, bbox); ok {
+		polys := stroke(lines, sh.style.strokeStyle, tol)
+
+		composite(dst, rasterize(toDevice(polys), w, h, false), shader, sh.style.strokeOpacity*sh.opacity)
+	}
+}
+
+func (d *Document) shader(p paint, st style, ctm matrix, bbox [4]float64) (shader, bool) {
+	switch p.kind {
+	case paintColor, paintCurrentColor:
+		c := p.color
+		if p.kind == paintCurrentColor {
+			c = st.color
+		}
+		return func(x, y float64) rgba { return c }, true
+
+	case paintURL:
+		if rg, ok := d.resolveGradient(p.url); ok {
+			return rg.shader(ctm, bbox)
+		}
+		if p.fallback != nil {
+			c := *p.fallback
+			return func(x, y float64) rgba { return c }, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,245 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package svg
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+)
+
+func mustRasterize(t *testing.T, src string, w, h int) *image.RGBA {
+	t.Helper()
+
+	doc, err := Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	return doc.Rasterize(w, h)
+}
+
+func checkPixel(t *testing.T, img *image.RGBA, x, y int, want color.RGBA) {
+	t.Helper()
+
+	got := img.RGBAAt(x, y)
+	if diff(got.R, want.R) > 2 || diff(got.G, want.G) > 2 || diff(got.B, want.B) > 2 || diff(got.A, want.A) > 2 {
+		t.Errorf("pixel (%d, %d): got %v, want %v", x, y, got, want)
+	}
+}
+
+func diff(a, b uint8) int {
+	if a > b {
+		return int(a - b)
+	}
+	return int(b - a)
+}
+
+var (
+	transparent = color.RGBA{}
+	red         = color.RGBA{255, 0, 0, 255}
+	blue        = color.RGBA{0, 0, 255, 255}
+)
+
+func TestParseSize(t *testing.T) {
+	testCases := []struct {
+		src           string
+		width, height float64
+		viewBox       Rect
+	}{
+		{`<svg width="16" height="24"/>`, 16, 24, Rect{0, 0, 16, 24}},
+		{`<svg viewBox="0 0 32 16"/>`, 32, 16, Rect{0, 0, 32, 16}},
+		{`<svg width="64" viewBox="0 0 32 16"/>`, 64, 32, Rect{0, 0, 32, 16}},
+		{`<svg width="12pt" height="1in" viewBox="1 2 3 4"/>`, 16, 96, Rect{1, 2, 3, 4}},
+		{`<svg width="100%" height="100%" viewBox="0 0 24 24"/>`, 24, 24, Rect{0, 0, 24, 24}},
+	}
+
+	for _, c := range testCases {
+		doc, err := Parse(strings.NewReader(c.src))
+		if err != nil {
+			t.Errorf("Parse(%s): %v", c.src, err)
+			continue
+		}
+		if doc.Width != c.width || doc.Height != c.height || doc.ViewBox != c.viewBox {
+			t.Errorf("Parse(%s): got %vx%v %+v, want %vx%v %+v", c.src, doc.Width, doc.Height, doc.ViewBox, c.width, c.height, c.viewBox)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, src := range []string{
+		``,
+		`<html/>`,
+		`<svg/>`,
+		`<svg viewBox="0 0 0 10"/>`,
+		`<svg width="10" height="10"><rect`,
+	} {
+		if _, err := Parse(strings.NewReader(src)); err == nil {
+			t.Errorf("Parse(%q): got nil error", src)
+		}
+	}
+}
+
+func TestRasterizeRect(t *testing.T) {
+	img := mustRasterize(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 10 10">
+		<rect x="2" y="2" width="6" height="6" fill="red"/>
+	</svg>`, 20, 20)
+
+	checkPixel(t, img, 10, 10, red)
+	checkPixel(t, img, 4, 4, red)
+	checkPixel(t, img, 3, 3, transparent)
+	checkPixel(t, img, 16, 16, transparent)
+}
+
+func TestRasterizeAntiAliasing(t *testing.T) {
+	// The rectangle covers the left half of the pixel column at x=5.
+	img := mustRasterize(t, `<svg width="10" height="10"><rect width="5.5" height="10" fill="#0000ff"/></svg>`, 10, 10)
+
+	checkPixel(t, img, 4, 5, blue)
+	checkPixel(t, img, 5, 5, color.RGBA{0, 0, 128, 128})
+	checkPixel(t, img, 6, 5, transparent)
+}
+
+func TestRasterizeCircleAndFillRule(t *testing.T) {
+	const ring = `<path d="M0 0H20V20H0Z M5 5H15V15H5Z" fill="red" fill-rule="%s"/>`
+
+	img := mustRasterize(t, `<svg width="20" height="20">`+strings.Replace(ring, "%s", "evenodd", 1)+`</svg>`, 20, 20)
+	checkPixel(t, img, 2, 2, red)
+	checkPixel(t, img, 10, 10, transparent)
+
+	img = mustRasterize(t, `<svg width="20" height="20">`+strings.Replace(ring, "%s", "nonzero", 1)+`</svg>`, 20, 20)
+	checkPixel(t, img, 10, 10, red)
+
+	img = mustRasterize(t, `<svg width="20" height="20"><circle cx="10" cy="10" r="8" style="fill: blue"/></svg>`, 20, 20)
+	checkPixel(t, img, 10, 10, blue)
+	checkPixel(t, img, 10, 3, blue)
+	checkPixel(t, img, 1, 1, transparent)
+}
+
+func TestRasterizeStroke(t *testing.T) {
+	img := mustRasterize(t, `<svg width="20" height="20">
+		<line x1="2" y1="10" x2="18" y2="10" stroke="red" stroke-width="4"/>
+	</svg>`, 20, 20)
+
+	checkPixel(t, img, 10, 8, red)
+	checkPixel(t, img, 10, 11, red)
+	checkPixel(t, img, 10, 13, transparent)
+	checkPixel(t, img, 1, 10, transparent)
+
+	img = mustRasterize(t, `<svg width="20" height="20">
+		<line x1="4" y1="10" x2="16" y2="10" stroke="red" stroke-width="4" stroke-linecap="square"/>
+	</svg>`, 20, 20)
+
+	checkPixel(t, img, 2, 10, red)
+	checkPixel(t, img, 17, 10, red)
+	checkPixel(t, img, 0, 10, transparent)
+}
+
+func TestRasterizeDashes(t *testing.T) {
+	img := mustRasterize(t, `<svg width="20" height="4">
+		<path d="M0 2H20" stroke="red" stroke-width="4" stroke-dasharray="5 5"/>
+	</svg>`, 20, 4)
+
+	checkPixel(t, img, 2, 2, red)
+	checkPixel(t, img, 7, 2, transparent)
+	checkPixel(t, img, 12, 2, red)
+	checkPixel(t, img, 17, 2, transparent)
+
+	// A pattern below the flattening tolerance is drawn as a solid line.
+	img = mustRasterize(t, `<svg width="100" height="4">
+		<path d="M0 2H100" stroke="red" stroke-width="4" stroke-dasharray="0.00001"/>
+	</svg>`, 100, 4)
+
+	checkPixel(t, img, 50, 2, red)
+}
+
+func TestRasterizeTransformAndInheritance(t *testing.T) {
+	img := mustRasterize(t, `<svg width="20" height="20">
+		<g fill="blue" transform="translate(10 0)">
+			<rect width="10" height="10"/>
+			<rect y="10" width="10" height="10" fill="red" opacity="0.5"/>
+		</g>
+	</svg>`, 20, 20)
+
+	checkPixel(t, img, 5, 5, transparent)
+	checkPixel(t, img, 15, 5, blue)
+	checkPixel(t, img, 15, 15, color.RGBA{128, 0, 0, 128})
+}
+
+func TestRasterizeHiddenAndDefs(t *testing.T) {
+	img := mustRasterize(t, `<svg width="10" height="10">
+		<defs><rect width="10" height="10" fill="red"/></defs>
+		<rect width="10" height="10" fill="red" display="none"/>
+		<rect width="10" height="10" fill="red" visibility="hidden"/>
+		<text x="0" y="10">ignored</text>
+	</svg>`, 10, 10)
+
+	checkPixel(t, img, 5, 5, transparent)
+}
+
+func TestRasterizeLinearGradient(t *testing.T) {
+	img := mustRasterize(t, `<svg width="100" height="10">
+		<defs>
+			<linearGradient id="base">
+				<stop offset="0" stop-color="red"/>
+				<stop offset="100%" stop-color="blue"/>
+			</linearGradient>
+			<linearGradient id="g" xlink:href="#base" xmlns:xlink="http://www.w3.org/1999/xlink"/>
+		</defs>
+		<rect width="100" height="10" fill="url(#g)"/>
+	</svg>`, 100, 10)
+
+	checkPixel(t, img, 0, 5, color.RGBA{252, 0, 3, 255})
+	checkPixel(t, img, 49, 5, color.RGBA{129, 0, 126, 255})
+	checkPixel(t, img, 99, 5, color.RGBA{3, 0, 252, 255})
+}
+
+func TestRasterizeRadialGradient(t *testing.T) {
+	img := mustRasterize(t, `<svg width="20" height="20">
+		<radialGradient id="g" gradientUnits="userSpaceOnUse" cx="10" cy="10" r="10">
+			<stop offset="0" stop-color="#fff"/>
+			<stop offset="1" stop-color="#000"/>
+		</radialGradient>
+		<rect width="20" height="20" fill="url(#g)"/>
+	</svg>`, 20, 20)
+
+	center := img.RGBAAt(10, 10)
+	edge := img.RGBAAt(10, 0)
+	corner := img.RGBAAt(0, 0)
+	if !(center.R > 220 && edge.R < 30 && corner.R == 0) {
+		t.Errorf("radial gradient: got center %v, edge %v, corner %v", center, edge, corner)
+	}
+}
+
+func TestRasterizePreserveAspectRatio(t *testing.T) {
+	const src = `<svg viewBox="0 0 10 10" preserveAspectRatio="%s"><rect width="10" height="10" fill="red"/></svg>`
+
+	// The square viewBox is centered horizontally within the wide image.
+	img := mustRasterize(t, strings.Replace(src, "%s", "xMidYMid meet", 1), 30, 10)
+	checkPixel(t, img, 5, 5, transparent)
+	checkPixel(t, img, 15, 5, red)
+
+	img = mustRasterize(t, strings.Replace(src, "%s", "xMinYMid meet", 1), 30, 10)
+	checkPixel(t, img, 5, 5, red)
+	checkPixel(t, img, 15, 5, transparent)
+
+	img = mustRasterize(t, strings.Replace(src, "%s", "none", 1), 30, 10)
+	checkPixel(t, img, 5, 5, red)
+	checkPixel(t, img, 25, 5, red)
+}
+
+func TestDrawIntoSubRectangle(t *testing.T) {
+	doc, err := Parse(strings.NewReader(`<svg width="4" height="4"><rect width="4" height="4" fill="red"/></svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	doc.Draw(dst, image.Rect(8, 8, 16, 16))
+
+	checkPixel(t, dst, 4, 4, transparent)
+	checkPixel(t, dst, 12, 12, red)
+}
@@ -0,0 +1,324 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package svg
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// rgba is a non-premultiplied color with components in [0, 1].
+type rgba struct {
+	r, g, b, a float64
+}
+
+var black = rgba{0, 0, 0, 1}
+
+var namedColors = map[string]uint32{
+	"aqua":       0x00ffff,
+	"black":      0x000000,
+	"blue":       0x0000ff,
+	"brown":      0xa52a2a,
+	"crimson":    0xdc143c,
+	"cyan":       0x00ffff,
+	"darkblue":   0x00008b,
+	"darkgray":   0xa9a9a9,
+	"darkgreen":  0x006400,
+	"darkgrey":   0xa9a9a9,
+	"darkred":    0x8b0000,
+	"dimgray":    0x696969,
+	"dimgrey":    0x696969,
+	"fuchsia":    0xff00ff,
+	"gold":       0xffd700,
+	"gray":       0x808080,
+	"green":      0x008000,
+	"grey":       0x808080,
+	"indigo":     0x4b0082,
+	"lightblue":  0xadd8e6,
+	"lightgray":  0xd3d3d3,
+	"lightgrey":  0xd3d3d3,
+	"lime":       0x00ff00,
+	"magenta":    0xff00ff,
+	"maroon":     0x800000,
+	"navy":       0x000080,
+	"olive":      0x808000,
+	"orange":     0xffa500,
+	"pink":       0xffc0cb,
+	"purple":     0x800080,
+	"red":        0xff0000,
+	"silver":     0xc0c0c0,
+	"skyblue":    0x87ceeb,
+	"steelblue":  0x4682b4,
+	"teal":       0x008080,
+	"tomato":     0xff6347,
+	"violet":     0xee82ee,
+	"white":      0xffffff,
+	"whitesmoke": 0xf5f5f5,
+	"yellow":     0xffff00,
+}
+
+// parseColor parses a CSS color in one of the forms #rgb, #rgba, #rrggbb,
+// #rrggbbaa, rgb(), rgba(), transparent or a named color.
+func parseColor(s string) (rgba, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	if strings.HasPrefix(s, "#") {
+		hex := s[1:]
+		v, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return rgba{}, fmt.Errorf("svg: invalid color %q", s)
+		}
+
+		switch len(hex) {
+		case 3:
+			return rgba{nibble(v, 2), nibble(v, 1), nibble(v, 0), 1}, nil
+
+		case 4:
+			return rgba{nibble(v, 3), nibble(v, 2), nibble(v, 1), nibble(v, 0)}, nil
+
+		case 6:
+			return rgba{channel(v, 2), channel(v, 1), channel(v, 0), 1}, nil
+
+		case 8:
+			return rgba{channel(v, 3), channel(v, 2), channel(v, 1), channel(v, 0)}, nil
+		}
+
+		return rgba{}, fmt.Errorf("svg: invalid color %q", s)
+	}
+
+	if strings.HasPrefix(s, "rgb(") || strings.HasPrefix(s, "rgba(") {
+		if !strings.HasSuffix(s, ")") {
+			return rgba{}, fmt.Errorf("svg: invalid color %q", s)
+		}
+
+		args := strings.FieldsFunc(s[strings.IndexByte(s, '(')+1:len(s)-1], func(r rune) bool {
+			return r == ',' || r == ' ' || r == '/'
+		})
+		if len(args) != 3 && len(args) != 4 {
+			return rgba{}, fmt.Errorf("svg: invalid color %q", s)
+		}
+
+		c := rgba{a: 1}
+		for i, arg := range args {
+			var v float64
+			var err error
+			if strings.HasSuffix(arg, "%") {
+				v, err = strconv.ParseFloat(arg[:len(arg)-1], 64)
+				v /= 100
+			} else {
+				v, err = strconv.ParseFloat(arg, 64)
+				if i < 3 {
+					v /= 255
+				}
+			}
+			if err != nil {
+				return rgba{}, fmt.Errorf("svg: invalid color %q", s)
+			}
+
+			v = clamp01(v)
+			switch i {
+			case 0:
+				c.r = v
+			case 1:
+				c.g = v
+			case 2:
+				c.b = v
+			case 3:
+				c.a = v
+			}
+		}
+
+		return c, nil
+	}
+
+	if s == "transparent" {
+		return rgba{}, nil
+	}
+
+	if v, ok := namedColors[s]; ok {
+		return rgba{channel(uint64(v), 2), channel(uint64(v), 1), channel(uint64(v), 0), 1}, nil
+	}
+
+	return rgba{}, fmt.Errorf("svg: unknown color %q", s)
+}
+
+func nibble(v uint64, i int) float64 {
+	return float64(v>>(4*i)&0xf) / 15
+}
+
+func channel(v uint64, i int) float64 {
+	return float64(v>>(8*i)&0xff) / 255
+}
+
+func clamp01(v float64) float64 {
+	if v < 0 || math.IsNaN(v) {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+
+	return v
+}
+
+// parseLength parses an SVG length and converts it to CSS pixels. Percentages
+// are resolved against ref.
+func parseLength(s string, ref float64) (float64, error) {
+	s = strings.TrimSpace(s)
+
+	factor := 1.0
+	for _, u := range [...]struct {
+		suffix string
+		factor float64
+	}{
+		{"px", 1},
+		{"pt", 96.0 / 72},
+		{"pc", 16},
+		{"mm", 96 / 25.4},
+		{"cm", 96 / 2.54},
+		{"in", 96},
+		{"em", 16},
+		{"ex", 8},
+		{"%", ref / 100},
+	} {
+		if strings.HasSuffix(s, u.suffix) {
+			s = strings.TrimSpace(s[:len(s)-len(u.suffix)])
+			factor = u.factor
+			break
+		}
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("svg: invalid length %q", s)
+	}
+
+	return v * factor, nil
+}
+
+// parseNumberOrPercent parses a plain number or a percentage, which is
+// returned as a fraction.
+func parseNumberOrPercent(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(s, "%") {
+		v, err := strconv.ParseFloat(s[:len(s)-1], 64)
+		return v / 100, err
+	}
+
+	return strconv.ParseFloat(s, 64)
+}
+
+func parseNumberList(s string) ([]float64, error) {
+	sc := scanner{s: s}
+
+	var values []float64
+	for {
+		sc.skipSeparators()
+		if sc.done() {
+			return values, nil
+		}
+
+		v, err := sc.number()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+}
+
+// scanner tokenizes the compact number syntax shared by path data, point lists
+// and transforms, where separators may be omitted as in "M1-2.5.5".
+type scanner struct {
+	s   string
+	pos int
+}
+
+func (sc *scanner) done() bool {
+	return sc.pos >= len(sc.s)
+}
+
+func (sc *scanner) peek() byte {
+	if sc.done() {
+		return 0
+	}
+
+	return sc.s[sc.pos]
+}
+
+func (sc *scanner) skipSeparators() {
+	for !sc.done() {
+		switch sc.s[sc.pos] {
+		case ' ', '\t', '\r', '\n', ',':
+			sc.pos++
+
+		default:
+			return
+		}
+	}
+}
+
+func (sc *scanner) number() (float64, error) {
+	start := sc.pos
+
+	if c := sc.peek(); c == '+' || c == '-' {
+		sc.pos++
+	}
+
+	digits, dot := 0, false
+	for !sc.done() {
+		c := sc.s[sc.pos]
+		if c >= '0' && c <= '9' {
+			digits++
+		} else if c == '.' && !dot {
+			dot = true
+		} else {
+			break
+		}
+		sc.pos++
+	}
+
+	if digits > 0 {
+		if c := sc.peek(); c == 'e' || c == 'E' {
+			save := sc.pos
+			sc.pos++
+			if c := sc.peek(); c == '+' || c == '-' {
+				sc.pos++
+			}
+			expDigits := 0
+			for c := sc.peek(); c >= '0' && c <= '9'; c = sc.peek() {
+				sc.pos++
+				expDigits++
+			}
+			if expDigits == 0 {
+				sc.pos = save
+			}
+		}
+	}
+
+	if digits == 0 {
+		sc.pos = start
+		return 0, fmt.Errorf("svg: expected number at offset %d in %q", start, sc.s)
+	}
+
+	return strconv.ParseFloat(sc.s[start:sc.pos], 64)
+}
+
+// flag parses an arc flag, which is a single 0 or 1 that need not be followed
+// by a separator.
+func (sc *scanner) flag() (bool, error) {
+	switch sc.peek() {
+	case '0':
+		sc.pos++
+		return false, nil
+
+	case '1':
+		sc.pos++
+		return true, nil
+	}
+
+	return false, fmt.Errorf("svg: expected flag at offset %d in %q", sc.pos, sc.s)
+}
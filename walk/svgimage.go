@@ -0,0 +1,225 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"github.com/xackery/wlk/cpl/dpicache"
+	"github.com/xackery/wlk/walk/svg"
+	"github.com/xackery/wlk/win"
+)
+
+// SVGImage is an Image backed by an SVG document. Unlike bitmaps, which are
+// stretched when drawn at higher DPI, an SVGImage is rasterized for each DPI
+// it is drawn at, so it stays sharp on any display.
+//
+// See the walk/svg package for the supported subset of SVG.
+type SVGImage struct {
+	doc  *svg.Document
+	size Size // in 1/96" units
+	dpi  int
+	bmp  *Bitmap // nil for the original image, see CopyForDPI
+}
+
+// NewSVGImageFromFile loads an SVGImage from the file at filePath.
+func NewSVGImageFromFile(filePath string) (*SVGImage, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return NewSVGImageFromReader(f)
+}
+
+// NewSVGImageFromFS loads an SVGImage from the file name in fsys.
+func NewSVGImageFromFS(fsys fs.FS, name string) (*SVGImage, error) {
+	data, err := readFS(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSVGImageFromBytes(data)
+}
+
+// NewSVGImageFromBytes creates an SVGImage from the SVG document in data.
+func NewSVGImageFromBytes(data []byte) (*SVGImage, error) {
+	return NewSVGImageFromReader(bytes.NewReader(data))
+}
+
+// NewSVGImageFromReader creates an SVGImage from the SVG document read from r.
+func NewSVGImageFromReader(r io.Reader) (*SVGImage, error) {
+	doc, err := svg.Parse(r)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return NewSVGImageFromDocument(doc), nil
+}
+
+// NewSVGImageFromDocument creates an SVGImage from a parsed SVG document.
+func NewSVGImageFromDocument(doc *svg.Document) *SVGImage {
+	size := Size{
+		Width:  maxi(1, int(math.Round(doc.Width))),
+		Height: maxi(1, int(math.Round(doc.Height))),
+	}
+
+	return &SVGImage{doc: doc, size: size}
+}
+
+// NewSVGImageFromResource loads an SVGImage from the RT_RCDATA resource named
+// name in the executable.
+func NewSVGImageFromResource(name string) (*SVGImage, error) {
+	lpstr, err := syscall.UTF16PtrFromString(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSVGImageFromResource(lpstr)
+}
+
+// NewSVGImageFromResourceId loads an SVGImage from the RT_RCDATA resource
+// with the given id in the executable.
+func NewSVGImageFromResourceId(id int) (*SVGImage, error) {
+	return newSVGImageFromResource(win.MAKEINTRESOURCE(uintptr(id)))
+}
+
+func newSVGImageFromResource(res *uint16) (*SVGImage, error) {
+	hModule := win.HMODULE(win.GetModuleHandle(nil))
+	if hModule == win.HMODULE(0) {
+		return nil, lastError("GetModuleHandle")
+	}
+
+	hres := win.FindResource(hModule, res, win.MAKEINTRESOURCE(10) /*RT_RCDATA*/)
+	if hres == win.HRSRC(0) {
+		return nil, lastError("FindResource")
+	}
+
+	size := win.SizeofResource(hModule, hres)
+	if size == 0 {
+		return nil, lastError("SizeofResource")
+	}
+
+	hResLoad := win.LoadResource(hModule, hres)
+	if hResLoad == win.HGLOBAL(0) {
+		return nil, lastError("LoadResource")
+	}
+
+	ptr := win.LockResource(hResLoad)
+	if ptr == 0 {
+		return nil, lastError("LockResource")
+	}
+
+	// Resource data stays mapped for the lifetime of the module, so it may be
+	// read in place.
+	data := unsafe.Slice((*byte)(unsafe.Pointer(ptr)), size)
+
+	return NewSVGImageFromBytes(data)
+}
+
+// Document returns the SVG document of the image.
+func (si *SVGImage) Document() *svg.Document {
+	return si.doc
+}
+
+// DPI returns the DPI si has been rasterized for, or 0 if si has not been
+// obtained through CopyForDPI.
+func (si *SVGImage) DPI() int {
+	return si.dpi
+}
+
+// CopyForDPI returns a copy of si that holds a rasterization for dpi. It is
+// used through dpicache, so that each DPI is rasterized only once.
+func (si *SVGImage) CopyForDPI(dpi int) *SVGImage {
+	result := &SVGImage{doc: si.doc, size: si.size, dpi: dpi}
+
+	if bmp, err := si.rasterize(SizeFrom96DPI(si.size, dpi), dpi); err == nil {
+		result.bmp = bmp
+		runtime.SetFinalizer(result, (*SVGImage).disposeBitmap)
+	}
+
+	return result
+}
+
+func (si *SVGImage) rasterize(size Size, dpi int) (*Bitmap, error) {
+	if size.Width <= 0 || size.Height <= 0 {
+		return nil, newError("invalid size")
+	}
+
+	return NewBitmapFromImageForDPI(si.doc.Rasterize(size.Width, size.Height), dpi)
+}
+
+func (si *SVGImage) bitmapForDPI(dpi int) (*Bitmap, error) {
+	if bmp := dpicache.InstanceForDPI(si, dpi).bmp; bmp != nil {
+		return bmp, nil
+	}
+
+	return nil, newError("failed to rasterize SVG image")
+}
+
+func (si *SVGImage) draw(hdc win.HDC, location Point) error {
+	bmp, err := si.bitmapForDPI(dpiForHDC(hdc))
+	if err != nil {
+		return err
+	}
+
+	return bmp.draw(hdc, location)
+}
+
+func (si *SVGImage) drawStretched(hdc win.HDC, bounds Rectangle) error {
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return nil
+	}
+
+	// Use the cached rasterization if bounds matches the size of the image at
+	// some DPI, which is the common case. Otherwise rasterize at exactly the
+	// requested size instead of stretching a bitmap.
+	dpi := int(math.Round(float64(bounds.Width) * 96 / float64(si.size.Width)))
+	if dpi > 0 && SizeFrom96DPI(si.size, dpi) == bounds.Size() {
+		bmp, err := si.bitmapForDPI(dpi)
+		if err != nil {
+			return err
+		}
+
+		return bmp.drawStretched(hdc, bounds)
+	}
+
+	bmp, err := si.rasterize(bounds.Size(), dpiForHDC(hdc))
+	if err != nil {
+		return err
+	}
+	defer bmp.Dispose()
+
+	return bmp.drawStretched(hdc, bounds)
+}
+
+// Dispose releases the rasterizations of si.
+func (si *SVGImage) Dispose() {
+	dpicache.Delete(si)
+	si.disposeBitmap()
+}
+
+func (si *SVGImage) disposeBitmap() {
+	if si.bmp != nil {
+		si.bmp.Dispose()
+		si.bmp = nil
+	}
+}
+
+// Size returns the intrinsic size of the image in 1/96" units.
+func (si *SVGImage) Size() Size {
+	return si.size
+}
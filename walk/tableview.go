@@ -683,6 +683,28 @@ func (tv *TableView) RowsPerPage() int {
 	return int(win.SendMessage(tv.hwndNormalLV, win.LVM_GETCOUNTPERPAGE, 0, 0))
 }
 
+func (tv *TableView) applyLocale() {
+	tv.Invalidate()
+}
+
+// applyMirrored lets the list views mirror themselves, including their
+// headers and whatever a CellStyler paints on CellStyle.Canvas.
+func (tv *TableView) applyMirrored(mirrored bool) {
+	tv.style.rightToLeft = mirrored
+
+	for _, hwnd := range []windows.HWND{tv.hWnd, tv.hwndFrozenLV, tv.hwndFrozenHdr, tv.hwndNormalLV, tv.hwndNormalHdr} {
+		exStyle := uint32(win.GetWindowLongPtr(hwnd, win.GWL_EXSTYLE))
+		if mirrored {
+			exStyle |= win.WS_EX_LAYOUTRTL
+		} else {
+			exStyle &^= win.WS_EX_LAYOUTRTL
+		}
+		win.SetWindowLongPtr(hwnd, win.GWL_EXSTYLE, uintptr(exStyle))
+	}
+
+	tv.Invalidate()
+}
+
 func (tv *TableView) Invalidate() error {
 	win.InvalidateRect(tv.hwndFrozenLV, nil, true)
 	win.InvalidateRect(tv.hwndNormalLV, nil, true)
@@ -1643,8 +1665,19 @@ type tableViewColumnState struct {
 
 // SaveState writes the UI state of the *TableView to the settings.
 func (tv *TableView) SaveState() error {
+	state, err := tv.stateString()
+	if err != nil || state == "" {
+		return err
+	}
+
+	return tv.WriteState(state)
+}
+
+// stateString returns the UI state of the *TableView in the format written by
+// SaveState, or an empty string if it has no columns.
+func (tv *TableView) stateString() (string, error) {
 	if tv.columns.Len() == 0 {
-		return nil
+		return "", nil
 	}
 
 	if tv.state == nil {
@@ -1691,14 +1724,14 @@ func (tv *TableView) SaveState() error {
 		lp = uintptr(unsafe.Pointer(&indices[0]))
 
 		if win.SendMessage(tv.hwndFrozenLV, win.LVM_GETCOLUMNORDERARRAY, uintptr(frozenCount), lp) == 0 {
-			return newError("LVM_GETCOLUMNORDERARRAY")
+			return "", newError("LVM_GETCOLUMNORDERARRAY")
 		}
 	}
 	if normalCount > 0 {
 		lp = uintptr(unsafe.Pointer(&indices[frozenCount]))
 
 		if win.SendMessage(tv.hwndNormalLV, win.LVM_GETCOLUMNORDERARRAY, uintptr(normalCount), lp) == 0 {
-			return newError("LVM_GETCOLUMNORDERARRAY")
+			return "", newError("LVM_GETCOLUMNORDERARRAY")
 		}
 	}
 
@@ -1712,10 +1745,10 @@ func (tv *TableView) SaveState() error {
 
 	state, err := json.Marshal(tvs)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	return tv.WriteState(string(state))
+	return string(state), nil
 }
 
 // RestoreState restores the UI state of the *TableView from the settings.
@@ -1728,6 +1761,12 @@ func (tv *TableView) RestoreState() error {
 		return nil
 	}
 
+	return tv.restoreStateString(state)
+}
+
+// restoreStateString restores the UI state of the *TableView from a state
+// returned by stateString.
+func (tv *TableView) restoreStateString(state string) error {
 	tv.SetSuspended(true)
 	defer tv.SetSuspended(false)
 
@@ -2108,22 +2147,14 @@ func (tv *TableView) lvWndProc(origWndProcPtr uintptr, hwnd windows.HWND, msg ui
 						text = val
 
 					case float32:
-						prec := tv.columns.items[col].precision
-						if prec == 0 {
-							prec = 2
-						}
-						text = FormatFloatGrouped(float64(val), prec)
+						text = tv.columns.items[col].formatFloat(float64(val))
 
 					case float64:
-						prec := tv.columns.items[col].precision
-						if prec == 0 {
-							prec = 2
-						}
-						text = FormatFloatGrouped(val, prec)
+						text = tv.columns.items[col].formatFloat(val)
 
 					case time.Time:
 						if val.Year() > 1601 {
-							text = val.Format(tv.columns.items[col].format)
+							text = formatTime(val, tv.columns.items[col].format)
 						}
 
 					case bool:
@@ -94,6 +94,11 @@ func (tvc *TableViewColumn) Format() string {
 }
 
 // SetFormat sets the format string for converting a value into a string.
+//
+// Floating point values are formatted as numbers with the current locale, or as currency
+// amounts or percentages with CurrencyFormat and PercentFormat. Times are formatted with
+// one of the named date and time formats, like ShortDateFormat, or a layout of package
+// time. Other values are formatted with fmt.Sprintf.
 func (tvc *TableViewColumn) SetFormat(format string) (err error) {
 	if format == tvc.format {
 		return nil
@@ -115,6 +120,24 @@ func (tvc *TableViewColumn) SetFormat(format string) (err error) {
 	return tvc.tv.Invalidate()
 }
 
+// formatFloat formats f according to the format and precision of tvc.
+func (tvc *TableViewColumn) formatFloat(f float64) string {
+	prec := tvc.precision
+	if prec == 0 {
+		prec = 2
+	}
+
+	switch tvc.format {
+	case CurrencyFormat:
+		return CurrentLocale().FormatCurrency(f)
+
+	case PercentFormat:
+		return CurrentLocale().FormatPercent(f, prec)
+	}
+
+	return CurrentLocale().FormatNumber(f, prec)
+}
+
 // Name returns the name of this TableViewColumn.
 func (tvc *TableViewColumn) Name() string {
 	return tvc.name
@@ -0,0 +1,212 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/xackery/wlk/walk/taborder"
+	"github.com/xackery/wlk/win"
+	"golang.org/x/sys/windows"
+)
+
+// TabOrder returns the widgets of form the Tab key moves the keyboard focus
+// to, in order. Of a group of RadioButtons, a group of widgets with the same
+// ArrowGroup or a Container with TabNavigationOnce, it returns the widget the
+// focus enters it at.
+func TabOrder(form Form) []Widget {
+	var widgets []Widget
+
+	for _, n := range taborder.Order(tabOrderTree(form)) {
+		widgets = append(widgets, n.Value.(Widget))
+	}
+
+	return widgets
+}
+
+// tabOrderTree returns the tree of the visible and enabled widgets of form.
+func tabOrderTree(form Form) *taborder.Node {
+	return &taborder.Node{
+		Value:    form,
+		Children: tabOrderNodes(form, nil),
+	}
+}
+
+func tabOrderNodes(container Container, preferred Widget) []*taborder.Node {
+	children := container.Children()
+	if children == nil {
+		return nil
+	}
+
+	var nodes []*taborder.Node
+	for i := 0; i < children.Len(); i++ {
+		if n := tabOrderNode(children.At(i), preferred); n != nil {
+			nodes = append(nodes, n)
+		}
+	}
+
+	return nodes
+}
+
+func tabOrderNode(widget Widget, preferred Widget) *taborder.Node {
+	wb := widget.AsWidgetBase()
+	if !wb.hasStyleBits(win.WS_VISIBLE) || !wb.enabled {
+		return nil
+	}
+
+	n := &taborder.Node{
+		Value:     widget,
+		TabIndex:  wb.tabIndex,
+		TabStop:   wb.TabStop(),
+		Group:     wb.arrowGroup,
+		Preferred: widget == preferred,
+	}
+
+	switch w := widget.(type) {
+	case *RadioButton:
+		if n.Group == "" && w.group != nil {
+			n.Group = fmt.Sprintf("RadioButtonGroup %p", w.group)
+		}
+		n.Preferred = n.Preferred || w.Checked()
+
+	case *TabWidget:
+		pages := w.Pages()
+		for i := 0; i < pages.Len(); i++ {
+			if page := tabOrderNode(pages.At(i), preferred); page != nil {
+				n.Children = append(n.Children, page)
+			}
+		}
+
+	case Container:
+		if cb := w.AsContainerBase(); cb != nil {
+			n.Navigation = taborder.Navigation(cb.tabNavigation)
+			if cb.tabNavigation == TabNavigationOnce && cb.lastFocused != nil {
+				preferred = cb.lastFocused
+			}
+		}
+
+		n.Children = tabOrderNodes(w, preferred)
+	}
+
+	return n
+}
+
+// widgetFromHandle returns the Widget of hwnd, or of its closest ancestor that
+// has one.
+func widgetFromHandle(hwnd windows.HWND) Widget {
+	for hwnd != 0 {
+		if widget, ok := windowFromHandle(hwnd).(Widget); ok {
+			return widget
+		}
+
+		hwnd = win.GetParent(hwnd)
+	}
+
+	return nil
+}
+
+// handleTabKey moves the keyboard focus as the Tab or an arrow key of msg
+// asks for, unless the focused control handles the key itself. It reports
+// whether it moved the focus.
+func handleTabKey(msg *win.MSG, key Key, mods Modifiers) bool {
+	var delta int
+	switch key {
+	case KeyTab:
+		if mods&^ModShift != 0 {
+			return false
+		}
+
+	case KeyLeft, KeyUp:
+		delta = -1
+
+	case KeyRight, KeyDown:
+		delta = 1
+
+	default:
+		return false
+	}
+
+	if key != KeyTab && mods != 0 {
+		return false
+	}
+
+	form, ok := windowFromHandle(win.GetAncestor(msg.HWnd, win.GA_ROOT)).(Form)
+	if !ok {
+		return false
+	}
+
+	code := win.SendMessage(msg.HWnd, win.WM_GETDLGCODE, msg.WParam, uintptr(unsafe.Pointer(msg)))
+	if code&win.DLGC_WANTALLKEYS != 0 {
+		return false
+	}
+
+	var current interface{}
+	if widget := widgetFromHandle(msg.HWnd); widget != nil {
+		if _, ok := widget.(*WebView); ok {
+			// The page moves the focus between its own elements.
+			return false
+		}
+
+		current = widget
+	}
+
+	root := tabOrderTree(form)
+
+	var target *taborder.Node
+	if key == KeyTab {
+		if code&win.DLGC_WANTTAB != 0 {
+			return false
+		}
+
+		target = taborder.Next(root, current, mods&ModShift != 0)
+	} else {
+		if code&win.DLGC_WANTARROWS != 0 {
+			return false
+		}
+
+		target = taborder.Arrow(root, current, delta)
+	}
+
+	if target == nil {
+		return false
+	}
+
+	widget := target.Value.(Widget)
+
+	focusTabStop(form, widget)
+
+	if rb, ok := widget.(*RadioButton); ok && key != KeyTab {
+		// Like a click, so the group unchecks the other RadioButtons.
+		rb.SendMessage(win.BM_CLICK, 0, 0)
+	}
+
+	return true
+}
+
+// focusTabStop moves the keyboard focus to widget, scrolls it into view and
+// shows the focus cues of form, as keyboard navigation does.
+func focusTabStop(form Form, widget Widget) {
+	if err := widget.SetFocus(); err != nil {
+		return
+	}
+
+	widget.AsWidgetBase().ForEachAncestor(func(window Window) bool {
+		if sv, ok := window.(*ScrollView); ok {
+			sv.ensureVisible(widget)
+		}
+
+		return window != form
+	})
+
+	if textSel, ok := widget.(textSelectable); ok {
+		textSel.SetTextSelection(0, -1)
+	}
+
+	win.SendMessage(form.Handle(), win.WM_CHANGEUISTATE, uintptr(win.MAKELONG(win.UIS_CLEAR, win.UISF_HIDEFOCUS)), 0)
+}
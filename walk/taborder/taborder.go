@@ -0,0 +1,336 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package taborder computes where the Tab and arrow keys move the keyboard
+// focus within a tree of widgets.
+//
+// The tree is described with Nodes, so the computation does not depend on
+// windows and can be tested on any platform. Walk builds it from a form with
+// the widgets that are visible and enabled:
+//
+//	root := &taborder.Node{Children: []*taborder.Node{
+//		{Value: nameEdit, TabStop: true},
+//		{Value: okButton, TabStop: true, TabIndex: 1},
+//	}}
+//
+//	taborder.Order(root)                 // okButton, nameEdit
+//	taborder.Next(root, okButton, false) // nameEdit
+package taborder
+
+import "sort"
+
+// Navigation is how Tab moves through the descendants of a Node.
+type Navigation int
+
+const (
+	// Continue makes the descendants part of the tab order around the Node.
+	Continue Navigation = iota
+
+	// Cycle makes Tab wrap around within the descendants, once it moved the
+	// focus to one of them.
+	Cycle
+
+	// Once makes the descendants a single stop. Tab moves the focus to the
+	// first Preferred descendant, or else to the first one, and arrow keys
+	// move it between them.
+	Once
+
+	// None leaves the descendants out of the tab order.
+	None
+)
+
+// Node is a widget in the tree the tab order is computed for.
+type Node struct {
+	// Value identifies the widget of the Node.
+	Value interface{}
+
+	// TabIndex orders the Node among its siblings. Nodes with a positive
+	// TabIndex come first, in ascending order, followed by the others in
+	// the order of Children.
+	TabIndex int
+
+	// TabStop reports whether Tab stops at the Node itself.
+	TabStop bool
+
+	// Navigation is how Tab moves through Children.
+	Navigation Navigation
+
+	// Group makes siblings with the same non-empty Group a single stop, like
+	// Once. Arrow keys move the focus between them.
+	Group string
+
+	// Preferred marks where the focus enters a Group or Once scope, such as
+	// the checked radio button of a group, or the last focused widget.
+	Preferred bool
+
+	Children []*Node
+}
+
+// stop is where Tab stops: a single Node, or a Group or Once scope with all
+// the Nodes arrow keys move between.
+type stop struct {
+	entry   *Node
+	members []*Node
+}
+
+func (s stop) contains(n *Node) bool {
+	for _, m := range s.members {
+		if m == n {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sorted returns nodes in tab order.
+func sorted(nodes []*Node) []*Node {
+	s := append([]*Node(nil), nodes...)
+
+	sort.SliceStable(s, func(i, j int) bool {
+		a, b := s[i].TabIndex, s[j].TabIndex
+		if a > 0 && b > 0 {
+			return a < b
+		}
+
+		return a > 0 && b <= 0
+	})
+
+	return s
+}
+
+// stops returns the stops within the Children of n, in tab order.
+func stops(n *Node) []stop {
+	var result []stop
+
+	children := sorted(n.Children)
+
+	for i, c := range children {
+		if c.Group != "" {
+			if groupSeen(children[:i], c.Group) {
+				continue
+			}
+
+			var members []*Node
+			for _, m := range children[i:] {
+				if m.Group == c.Group && m.TabStop {
+					members = append(members, m)
+				}
+			}
+
+			if len(members) > 0 {
+				result = append(result, stop{entry(members), members})
+			}
+			continue
+		}
+
+		if c.TabStop {
+			result = append(result, stop{c, []*Node{c}})
+		}
+
+		switch c.Navigation {
+		case Continue, Cycle:
+			result = append(result, stops(c)...)
+
+		case Once:
+			var members []*Node
+			for _, s := range stops(c) {
+				members = append(members, s.members...)
+			}
+
+			if len(members) > 0 {
+				result = append(result, stop{entry(members), members})
+			}
+		}
+	}
+
+	return result
+}
+
+func groupSeen(nodes []*Node, group string) bool {
+	for _, n := range nodes {
+		if n.Group == group {
+			return true
+		}
+	}
+
+	return false
+}
+
+// entry returns the first Preferred node of members, or else the first one.
+func entry(members []*Node) *Node {
+	for _, m := range members {
+		if m.Preferred {
+			return m
+		}
+	}
+
+	return members[0]
+}
+
+// Order returns the Nodes Tab stops at within root, in order.
+func Order(root *Node) []*Node {
+	var order []*Node
+
+	for _, s := range stops(root) {
+		order = append(order, s.entry)
+	}
+
+	return order
+}
+
+// path returns the Nodes from root down to the Node of value, or nil.
+func path(root *Node, value interface{}) []*Node {
+	if root.Value == value && value != nil {
+		return []*Node{root}
+	}
+
+	for _, c := range root.Children {
+		if p := path(c, value); p != nil {
+			return append([]*Node{root}, p...)
+		}
+	}
+
+	return nil
+}
+
+// preorder returns the Nodes below root in tab order, depth first.
+func preorder(root *Node) []*Node {
+	var nodes []*Node
+
+	for _, c := range sorted(root.Children) {
+		nodes = append(nodes, c)
+		nodes = append(nodes, preorder(c)...)
+	}
+
+	return nodes
+}
+
+// Next returns the Node Tab moves the focus to from the Node of current, or
+// Shift+Tab if backward. It wraps around within root, or within the
+// innermost Cycle scope current is in. It returns nil if there is no stop.
+//
+// If current is not a stop, or not in the tree at all, the focus moves to the
+// stop following or preceding it, or to the first or last stop of root.
+func Next(root *Node, current interface{}, backward bool) *Node {
+	p := path(root, current)
+
+	scope := root
+	for i := len(p) - 2; i >= 0; i-- {
+		if p[i].Navigation == Cycle {
+			scope = p[i]
+			break
+		}
+	}
+
+	ss := stops(scope)
+	if len(ss) == 0 {
+		return nil
+	}
+
+	index := -1
+	if p != nil {
+		for i, s := range ss {
+			if s.contains(p[len(p)-1]) {
+				index = i
+				break
+			}
+		}
+	}
+
+	if index == -1 && p != nil {
+		return nextByPosition(scope, ss, p[len(p)-1], backward)
+	}
+
+	if index == -1 {
+		if backward {
+			return ss[len(ss)-1].entry
+		}
+		return ss[0].entry
+	}
+
+	if backward {
+		index = (index - 1 + len(ss)) % len(ss)
+	} else {
+		index = (index + 1) % len(ss)
+	}
+
+	return ss[index].entry
+}
+
+// nextByPosition returns the entry of the stop following or preceding n, a
+// Node that is no stop, in the depth-first order of scope.
+func nextByPosition(scope *Node, ss []stop, n *Node, backward bool) *Node {
+	position := make(map[*Node]int)
+	for i, node := range preorder(scope) {
+		position[node] = i
+	}
+
+	current := position[n]
+
+	if backward {
+		for i := len(ss) - 1; i >= 0; i-- {
+			if position[ss[i].members[0]] < current {
+				return ss[i].entry
+			}
+		}
+
+		return ss[len(ss)-1].entry
+	}
+
+	for _, s := range ss {
+		if position[s.members[0]] > current {
+			return s.entry
+		}
+	}
+
+	return ss[0].entry
+}
+
+// Arrow returns the Node an arrow key moves the focus to from the Node of
+// current: the next member of its Group or Once scope if delta is positive,
+// or the previous one if it is negative, wrapping around. It returns nil if
+// current is in neither.
+func Arrow(root *Node, current interface{}, delta int) *Node {
+	p := path(root, current)
+	if len(p) < 2 || delta == 0 {
+		return nil
+	}
+
+	n := p[len(p)-1]
+
+	var members []*Node
+	if n.Group != "" {
+		for _, s := range stops(p[len(p)-2]) {
+			if s.contains(n) {
+				members = s.members
+				break
+			}
+		}
+	} else {
+		for i := len(p) - 2; i >= 0; i-- {
+			if p[i].Navigation != Once {
+				continue
+			}
+
+			for _, s := range stops(p[i]) {
+				members = append(members, s.members...)
+			}
+			break
+		}
+	}
+
+	for i, m := range members {
+		if m != n {
+			continue
+		}
+
+		if delta > 0 {
+			return members[(i+1)%len(members)]
+		}
+		return members[(i-1+len(members))%len(members)]
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package taborder
+
+import (
+	"reflect"
+	"testing"
+)
+
+func stopNode(value string) *Node {
+	return &Node{Value: value, TabStop: true}
+}
+
+func container(nav Navigation, children ...*Node) *Node {
+	return &Node{Navigation: nav, Children: children}
+}
+
+func values(nodes []*Node) []string {
+	var vs []string
+	for _, n := range nodes {
+		vs = append(vs, n.Value.(string))
+	}
+	return vs
+}
+
+func value(n *Node) string {
+	if n == nil {
+		return ""
+	}
+	return n.Value.(string)
+}
+
+// form returns a tree like this one:
+//
+//	name, email        edits
+//	scroll (Continue)  a, b
+//	size  (Group)      small, medium (checked), large
+//	pages (Once)       p1, p2
+//	popup (Cycle)      x, y
+//	hidden (None)      z
+//	ok, cancel         buttons, ok with TabIndex 1
+func form() *Node {
+	ok := stopNode("ok")
+	ok.TabIndex = 1
+
+	medium := stopNode("medium")
+	medium.Preferred = true
+
+	size := []*Node{stopNode("small"), medium, stopNode("large")}
+	for _, n := range size {
+		n.Group = "size"
+	}
+
+	root := container(Continue,
+		stopNode("name"),
+		stopNode("email"),
+		container(Continue, stopNode("a"), stopNode("b")),
+		size[0], size[1], size[2],
+		container(Once, stopNode("p1"), stopNode("p2")),
+		container(Cycle, stopNode("x"), stopNode("y")),
+		container(None, stopNode("z")),
+		ok,
+		stopNode("cancel"),
+	)
+
+	return root
+}
+
+func TestOrder(t *testing.T) {
+	testCases := []struct {
+		name string
+		root *Node
+		want []string
+	}{
+		{"empty", container(Continue), nil},
+		{
+			"form",
+			form(),
+			[]string{"ok", "name", "email", "a", "b", "medium", "p1", "x", "y", "cancel"},
+		},
+		{
+			"tab indexes",
+			container(Continue,
+				&Node{Value: "c", TabStop: true},
+				&Node{Value: "b", TabStop: true, TabIndex: 2},
+				&Node{Value: "a", TabStop: true, TabIndex: 1},
+				&Node{Value: "d", TabStop: true, TabIndex: -1},
+			),
+			[]string{"a", "b", "c", "d"},
+		},
+		{
+			"no tab stop",
+			container(Continue, &Node{Value: "label"}, stopNode("edit")),
+			[]string{"edit"},
+		},
+		{
+			"group without preferred",
+			container(Continue,
+				&Node{Value: "r1", TabStop: true, Group: "g"},
+				&Node{Value: "r2", TabStop: true, Group: "g"},
+			),
+			[]string{"r1"},
+		},
+	}
+
+	for _, c := range testCases {
+		if got := values(Order(c.root)); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Order(%s): got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	testCases := []struct {
+		current  interface{}
+		backward bool
+		want     string
+	}{
+		{"ok", false, "name"},
+		{"name", true, "ok"},
+		{"ok", true, "cancel"},
+		{"cancel", false, "ok"},
+		{"email", false, "a"},
+		{"b", false, "medium"},
+		{"small", false, "p1"},
+		{"large", true, "b"},
+		{"p2", false, "x"},
+		{"p2", true, "medium"},
+		{"x", false, "y"},
+		{"y", false, "x"},
+		{"x", true, "y"},
+		{"z", false, "cancel"},
+		{"z", true, "y"},
+		{"unknown", false, "ok"},
+		{"unknown", true, "cancel"},
+		{nil, false, "ok"},
+	}
+
+	root := form()
+
+	for _, c := range testCases {
+		if got := value(Next(root, c.current, c.backward)); got != c.want {
+			t.Errorf("Next(%v, backward %v): got %q, want %q", c.current, c.backward, got, c.want)
+		}
+	}
+
+	if got := Next(container(Continue, container(None, stopNode("z"))), "z", false); got != nil {
+		t.Errorf("Next without stops: got %q, want nil", value(got))
+	}
+}
+
+func TestArrow(t *testing.T) {
+	testCases := []struct {
+		current interface{}
+		delta   int
+		want    string
+	}{
+		{"small", 1, "medium"},
+		{"medium", -1, "small"},
+		{"large", 1, "small"},
+		{"small", -1, "large"},
+		{"p1", 1, "p2"},
+		{"p2", 1, "p1"},
+		{"p1", -1, "p2"},
+		{"name", 1, ""},
+		{"x", 1, ""},
+		{"small", 0, ""},
+		{"unknown", 1, ""},
+	}
+
+	root := form()
+
+	for _, c := range testCases {
+		if got := value(Arrow(root, c.current, c.delta)); got != c.want {
+			t.Errorf("Arrow(%v, %d): got %q, want %q", c.current, c.delta, got, c.want)
+		}
+	}
+}
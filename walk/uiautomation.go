@@ -0,0 +1,1093 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"strconv"
+	"syscall"
+	"unsafe"
+
+	"github.com/xackery/wlk/win"
+	"golang.org/x/sys/windows"
+)
+
+// AccessibleElement describes a part of a custom-painted widget, such as an
+// item or a button it draws, to UI Automation clients like screen readers.
+type AccessibleElement struct {
+	// ID identifies the element within its widget. It must be unique and
+	// nonzero, and should stay the same while the element exists.
+	ID int
+
+	Role  AccRole // AccRoleClient if zero
+	Name  string
+	Value string
+
+	// Bounds is in native pixels, relative to the client area of the widget.
+	Bounds Rectangle
+
+	Disabled bool
+
+	// Checkable elements support the Toggle pattern.
+	Checkable bool
+	Checked   bool
+
+	// Selectable elements support the SelectionItem pattern, and make their
+	// parent support the Selection pattern.
+	Selectable bool
+	Selected   bool
+
+	// MultiSelectable reports whether more than one of Children can be
+	// selected at once.
+	MultiSelectable bool
+
+	Children []*AccessibleElement
+
+	// Invoke, if not nil, makes the element support the Invoke pattern.
+	Invoke func()
+
+	// SetValue, if not nil, makes Value editable through the Value pattern.
+	SetValue func(value string) error
+
+	// Toggle is called to toggle Checkable elements.
+	Toggle func()
+
+	// Select is called to make Selectable elements the selection.
+	Select func()
+
+	// AddToSelection is called to add Selectable elements to the selection
+	// of a MultiSelectable parent, keeping the elements already selected.
+	AddToSelection func()
+}
+
+// AccessibleElementsProvider is implemented by widgets that paint parts UI
+// Automation cannot find by itself.
+//
+// AccessibleElements returns the top-level elements of the widget, or nil to
+// expose the widget as its window class does. Widgets call
+// Accessibility().NotifyElementsChanged when the elements change.
+type AccessibleElementsProvider interface {
+	AccessibleElements() []*AccessibleElement
+}
+
+// accessibleMultiSelector is implemented by widgets whose top-level
+// AccessibleElements can be selected together.
+type accessibleMultiSelector interface {
+	multiSelection() bool
+}
+
+var (
+	uiaSimpleVtbl        *win.IRawElementProviderSimpleVtbl
+	uiaFragmentVtbl      *win.IRawElementProviderFragmentVtbl
+	uiaFragmentRootVtbl  *win.IRawElementProviderFragmentRootVtbl
+	uiaInvokeVtbl        *win.IInvokeProviderVtbl
+	uiaValueVtbl         *win.IValueProviderVtbl
+	uiaToggleVtbl        *win.IToggleProviderVtbl
+	uiaSelectionVtbl     *win.ISelectionProviderVtbl
+	uiaSelectionItemVtbl *win.ISelectionItemProviderVtbl
+)
+
+func init() {
+	AppendToWalkInit(func() {
+		queryInterface := syscall.NewCallback(uia_QueryInterface)
+		addRef := syscall.NewCallback(uia_AddRef)
+		release := syscall.NewCallback(uia_Release)
+
+		uiaSimpleVtbl = &win.IRawElementProviderSimpleVtbl{
+			QueryInterface:             queryInterface,
+			AddRef:                     addRef,
+			Release:                    release,
+			Get_ProviderOptions:        syscall.NewCallback(uia_Simple_Get_ProviderOptions),
+			GetPatternProvider:         syscall.NewCallback(uia_Simple_GetPatternProvider),
+			GetPropertyValue:           syscall.NewCallback(uia_Simple_GetPropertyValue),
+			Get_HostRawElementProvider: syscall.NewCallback(uia_Simple_Get_HostRawElementProvider),
+		}
+		uiaFragmentVtbl = &win.IRawElementProviderFragmentVtbl{
+			QueryInterface:           queryInterface,
+			AddRef:                   addRef,
+			Release:                  release,
+			Navigate:                 syscall.NewCallback(uia_Fragment_Navigate),
+			GetRuntimeId:             syscall.NewCallback(uia_Fragment_GetRuntimeId),
+			Get_BoundingRectangle:    syscall.NewCallback(uia_Fragment_Get_BoundingRectangle),
+			GetEmbeddedFragmentRoots: syscall.NewCallback(uia_Fragment_GetEmbeddedFragmentRoots),
+			SetFocus:                 syscall.NewCallback(uia_Fragment_SetFocus),
+			Get_FragmentRoot:         syscall.NewCallback(uia_Fragment_Get_FragmentRoot),
+		}
+		uiaFragmentRootVtbl = &win.IRawElementProviderFragmentRootVtbl{
+			QueryInterface:           queryInterface,
+			AddRef:                   addRef,
+			Release:                  release,
+			ElementProviderFromPoint: syscall.NewCallback(uia_FragmentRoot_ElementProviderFromPoint),
+			GetFocus:                 syscall.NewCallback(uia_FragmentRoot_GetFocus),
+		}
+		uiaInvokeVtbl = &win.IInvokeProviderVtbl{
+			QueryInterface: queryInterface,
+			AddRef:         addRef,
+			Release:        release,
+			Invoke:         syscall.NewCallback(uia_Invoke_Invoke),
+		}
+		uiaValueVtbl = &win.IValueProviderVtbl{
+			QueryInterface: queryInterface,
+			AddRef:         addRef,
+			Release:        release,
+			SetValue:       syscall.NewCallback(uia_Value_SetValue),
+			Get_Value:      syscall.NewCallback(uia_Value_Get_Value),
+			Get_IsReadOnly: syscall.NewCallback(uia_Value_Get_IsReadOnly),
+		}
+		uiaToggleVtbl = &win.IToggleProviderVtbl{
+			QueryInterface:  queryInterface,
+			AddRef:          addRef,
+			Release:         release,
+			Toggle:          syscall.NewCallback(uia_Toggle_Toggle),
+			Get_ToggleState: syscall.NewCallback(uia_Toggle_Get_ToggleState),
+		}
+		uiaSelectionVtbl = &win.ISelectionProviderVtbl{
+			QueryInterface:          queryInterface,
+			AddRef:                  addRef,
+			Release:                 release,
+			GetSelection:            syscall.NewCallback(uia_Selection_GetSelection),
+			Get_CanSelectMultiple:   syscall.NewCallback(uia_Selection_Get_CanSelectMultiple),
+			Get_IsSelectionRequired: syscall.NewCallback(uia_Selection_Get_IsSelectionRequired),
+		}
+		uiaSelectionItemVtbl = &win.ISelectionItemProviderVtbl{
+			QueryInterface:         queryInterface,
+			AddRef:                 addRef,
+			Release:                release,
+			Select:                 syscall.NewCallback(uia_SelectionItem_Select),
+			AddToSelection:         syscall.NewCallback(uia_SelectionItem_AddToSelection),
+			RemoveFromSelection:    syscall.NewCallback(uia_SelectionItem_RemoveFromSelection),
+			Get_IsSelected:         syscall.NewCallback(uia_SelectionItem_Get_IsSelected),
+			Get_SelectionContainer: syscall.NewCallback(uia_SelectionItem_Get_SelectionContainer),
+		}
+	})
+}
+
+// uiaInterface is the COM object of one interface of a uiaNode. All of them
+// share this layout, so QueryInterface, AddRef and Release are shared, too.
+type uiaInterface struct {
+	lpVtbl unsafe.Pointer
+	node   *uiaNode
+}
+
+// uiaNode provides the window of a WindowBase, if id is zero, or one of its
+// AccessibleElements to UI Automation.
+//
+// Nodes look their element up by id on every call, so they outlive changes of
+// the elements and the window itself. Clients may hold on to nodes after the
+// window is gone, so nodes are reference counted and kept in uiaLiveNodes
+// until they are released for the last time.
+type uiaNode struct {
+	root          *uiaRoot
+	id            int
+	refs          int32
+	simple        uiaInterface
+	fragment      uiaInterface
+	fragmentRoot  uiaInterface
+	invoke        uiaInterface
+	value         uiaInterface
+	toggle        uiaInterface
+	selection     uiaInterface
+	selectionItem uiaInterface
+}
+
+// uiaLiveNodes keeps the nodes referenced by UI Automation alive, as the
+// garbage collector does not know about the pointers handed out to COM.
+var uiaLiveNodes = make(map[*uiaNode]struct{})
+
+// uiaEntry is an element of the last snapshot, along with the values that
+// NotifyElementsChanged compares to raise property change events.
+type uiaEntry struct {
+	element  *AccessibleElement
+	parent   *AccessibleElement // nil for top-level elements
+	name     string
+	value    string
+	checked  bool
+	selected bool
+}
+
+// uiaRoot holds the UI Automation provider of a WindowBase.
+type uiaRoot struct {
+	wb       *WindowBase
+	nodes    map[int]*uiaNode
+	elements []*AccessibleElement
+	entries  map[int]uiaEntry
+}
+
+func newUIARoot(wb *WindowBase) *uiaRoot {
+	r := &uiaRoot{wb: wb, nodes: make(map[int]*uiaNode)}
+
+	r.refresh()
+
+	return r
+}
+
+// uiaRoot returns the UI Automation provider of the window. If it does not
+// exist yet, it is created if create is true or the window needs it to expose
+// AccessibleElements or a live setting.
+func (wb *WindowBase) uiaRoot(create bool) *uiaRoot {
+	if wb.uia != nil {
+		return wb.uia
+	}
+
+	if !create {
+		if wb.acc == nil || wb.acc.liveSetting == AccLiveOff {
+			aep, ok := wb.window.(AccessibleElementsProvider)
+			if !ok || aep.AccessibleElements() == nil {
+				return nil
+			}
+		}
+	}
+
+	wb.uia = newUIARoot(wb)
+
+	return wb.uia
+}
+
+// disposeUIARoot disconnects the providers of the window from UI Automation.
+func (wb *WindowBase) disposeUIARoot() {
+	if wb.uia == nil {
+		return
+	}
+
+	win.UiaReturnRawElementProvider(wb.hWnd, 0, 0, nil)
+
+	for _, n := range wb.uia.nodes {
+		win.UiaDisconnectProvider(n.provider())
+	}
+
+	wb.uia = nil
+}
+
+// refresh takes a snapshot of the AccessibleElements of the window.
+func (r *uiaRoot) refresh() {
+	r.elements = nil
+	if aep, ok := r.wb.window.(AccessibleElementsProvider); ok {
+		r.elements = aep.AccessibleElements()
+	}
+
+	r.entries = make(map[int]uiaEntry)
+
+	var add func(parent *AccessibleElement, elements []*AccessibleElement)
+	add = func(parent *AccessibleElement, elements []*AccessibleElement) {
+		for _, el := range elements {
+			r.entries[el.ID] = uiaEntry{
+				element:  el,
+				parent:   parent,
+				name:     el.Name,
+				value:    el.Value,
+				checked:  el.Checked,
+				selected: el.Selected,
+			}
+
+			add(el, el.Children)
+		}
+	}
+	add(nil, r.elements)
+}
+
+// update takes a new snapshot of the AccessibleElements of the window and
+// raises events for what changed.
+func (r *uiaRoot) update() {
+	old := r.entries
+
+	r.refresh()
+
+	if !win.UiaClientsAreListening() {
+		return
+	}
+
+	changed := len(old) != len(r.entries)
+
+	for id, e := range r.entries {
+		o, ok := old[id]
+		if !ok {
+			changed = true
+			continue
+		}
+		if o.name == e.name && o.value == e.value && o.checked == e.checked && o.selected == e.selected {
+			continue
+		}
+
+		changed = true
+
+		n, ok := r.nodes[id]
+		if !ok {
+			// Clients never saw the element, so they need no events for it.
+			continue
+		}
+
+		if o.name != e.name {
+			raiseUIAStringPropertyChanged(n, win.UIA_NamePropertyId, o.name, e.name)
+		}
+		if o.value != e.value {
+			raiseUIAStringPropertyChanged(n, win.UIA_ValueValuePropertyId, o.value, e.value)
+		}
+		if o.checked != e.checked {
+			var oldValue, newValue win.VARIANT
+			oldValue.SetLong(uiaToggleState(o.checked))
+			newValue.SetLong(uiaToggleState(e.checked))
+			win.UiaRaiseAutomationPropertyChangedEvent(n.provider(), win.UIA_ToggleToggleStatePropertyId, &oldValue, &newValue)
+		}
+		if o.selected != e.selected {
+			var oldValue, newValue win.VARIANT
+			oldValue.SetBool(uiaBool(o.selected))
+			newValue.SetBool(uiaBool(e.selected))
+			win.UiaRaiseAutomationPropertyChangedEvent(n.provider(), win.UIA_SelectionItemIsSelectedPropertyId, &oldValue, &newValue)
+
+			if e.selected {
+				win.UiaRaiseAutomationEvent(n.provider(), win.UIA_SelectionItem_ElementSelectedEventId)
+			}
+		}
+	}
+
+	if len(old) != len(r.entries) {
+		win.UiaRaiseStructureChangedEvent(r.node(0).provider(), win.StructureChangeType_ChildrenInvalidated, nil)
+	} else {
+		for id := range old {
+			if _, ok := r.entries[id]; !ok {
+				win.UiaRaiseStructureChangedEvent(r.node(0).provider(), win.StructureChangeType_ChildrenInvalidated, nil)
+				break
+			}
+		}
+	}
+
+	if changed && r.wb.acc != nil && r.wb.acc.liveSetting != AccLiveOff {
+		win.UiaRaiseAutomationEvent(r.node(0).provider(), win.UIA_LiveRegionChangedEventId)
+	}
+}
+
+func raiseUIAStringPropertyChanged(n *uiaNode, id int32, oldValue, newValue string) {
+	var oldVariant, newVariant win.VARIANT
+	oldVariant.SetBSTR(win.SysAllocString(oldValue))
+	newVariant.SetBSTR(win.SysAllocString(newValue))
+	defer win.SysFreeString(oldVariant.MustBSTR())
+	defer win.SysFreeString(newVariant.MustBSTR())
+
+	win.UiaRaiseAutomationPropertyChangedEvent(n.provider(), id, &oldVariant, &newVariant)
+}
+
+// node returns the node of the element with id, or of the window if id is
+// zero.
+func (r *uiaRoot) node(id int) *uiaNode {
+	if n, ok := r.nodes[id]; ok {
+		return n
+	}
+
+	n := &uiaNode{root: r, id: id}
+	n.simple = uiaInterface{unsafe.Pointer(uiaSimpleVtbl), n}
+	n.fragment = uiaInterface{unsafe.Pointer(uiaFragmentVtbl), n}
+	n.fragmentRoot = uiaInterface{unsafe.Pointer(uiaFragmentRootVtbl), n}
+	n.invoke = uiaInterface{unsafe.Pointer(uiaInvokeVtbl), n}
+	n.value = uiaInterface{unsafe.Pointer(uiaValueVtbl), n}
+	n.toggle = uiaInterface{unsafe.Pointer(uiaToggleVtbl), n}
+	n.selection = uiaInterface{unsafe.Pointer(uiaSelectionVtbl), n}
+	n.selectionItem = uiaInterface{unsafe.Pointer(uiaSelectionItemVtbl), n}
+
+	r.nodes[id] = n
+
+	return n
+}
+
+func (n *uiaNode) provider() *win.IRawElementProviderSimple {
+	return (*win.IRawElementProviderSimple)(unsafe.Pointer(&n.simple))
+}
+
+// entry returns the element of n. ok is false if the element no longer
+// exists, or if n is the node of the window.
+func (n *uiaNode) entry() (e uiaEntry, ok bool) {
+	if n.id == 0 || n.root.wb.hWnd == 0 {
+		return uiaEntry{}, false
+	}
+
+	e, ok = n.root.entries[n.id]
+	return
+}
+
+// children returns the elements below n.
+func (n *uiaNode) children() ([]*AccessibleElement, bool) {
+	if n.id == 0 {
+		return n.root.elements, n.root.wb.hWnd != 0
+	}
+
+	e, ok := n.entry()
+	if !ok {
+		return nil, false
+	}
+
+	return e.element.Children, true
+}
+
+// siblings returns the elements next to the element of n, including itself.
+func (n *uiaNode) siblings() []*AccessibleElement {
+	e, ok := n.entry()
+	if !ok {
+		return nil
+	}
+
+	if e.parent == nil {
+		return n.root.elements
+	}
+
+	return e.parent.Children
+}
+
+// parent returns the node above the element of n.
+func (n *uiaNode) parent() *uiaNode {
+	e, ok := n.entry()
+	if !ok {
+		return nil
+	}
+
+	if e.parent == nil {
+		return n.root.node(0)
+	}
+
+	return n.root.node(e.parent.ID)
+}
+
+// multiSelection reports whether more than one of the children of n can be
+// selected at once.
+func (n *uiaNode) multiSelection() bool {
+	if n.id != 0 {
+		e, _ := n.entry()
+		return e.element != nil && e.element.MultiSelectable
+	}
+
+	if ms, ok := n.root.wb.window.(accessibleMultiSelector); ok && ms.multiSelection() {
+		return true
+	}
+
+	return n.root.wb.acc != nil && n.root.wb.acc.state&(AccStateMultiselectable|AccStateExtselectable) != 0
+}
+
+func uiaBool(value bool) win.VARIANT_BOOL {
+	if value {
+		return win.VARIANT_TRUE
+	}
+
+	return win.VARIANT_FALSE
+}
+
+func uiaBOOL(value bool) win.BOOL {
+	if value {
+		return win.TRUE
+	}
+
+	return win.FALSE
+}
+
+func uiaToggleState(checked bool) int32 {
+	if checked {
+		return win.ToggleState_On
+	}
+
+	return win.ToggleState_Off
+}
+
+func uia_QueryInterface(this *uiaInterface, riid win.REFIID, ppvObject *unsafe.Pointer) uintptr {
+	n := this.node
+
+	children, _ := n.children()
+
+	var selectable bool
+	for _, el := range children {
+		if el.Selectable {
+			selectable = true
+			break
+		}
+	}
+
+	e, _ := n.entry()
+	el := e.element
+
+	if win.EqualREFIID(riid, &win.IID_IUnknown) || win.EqualREFIID(riid, &win.IID_IRawElementProviderSimple) {
+		*ppvObject = unsafe.Pointer(&n.simple)
+	} else if win.EqualREFIID(riid, &win.IID_IRawElementProviderFragment) {
+		*ppvObject = unsafe.Pointer(&n.fragment)
+	} else if n.id == 0 && win.EqualREFIID(riid, &win.IID_IRawElementProviderFragmentRoot) {
+		*ppvObject = unsafe.Pointer(&n.fragmentRoot)
+	} else if el != nil && el.Invoke != nil && win.EqualREFIID(riid, &win.IID_IInvokeProvider) {
+		*ppvObject = unsafe.Pointer(&n.invoke)
+	} else if el != nil && (el.Value != "" || el.SetValue != nil) && win.EqualREFIID(riid, &win.IID_IValueProvider) {
+		*ppvObject = unsafe.Pointer(&n.value)
+	} else if el != nil && el.Checkable && win.EqualREFIID(riid, &win.IID_IToggleProvider) {
+		*ppvObject = unsafe.Pointer(&n.toggle)
+	} else if selectable && win.EqualREFIID(riid, &win.IID_ISelectionProvider) {
+		*ppvObject = unsafe.Pointer(&n.selection)
+	} else if el != nil && el.Selectable && win.EqualREFIID(riid, &win.IID_ISelectionItemProvider) {
+		*ppvObject = unsafe.Pointer(&n.selectionItem)
+	} else {
+		*ppvObject = nil
+		return win.E_NOINTERFACE
+	}
+
+	n.addRef()
+
+	return win.S_OK
+}
+
+func uia_AddRef(this *uiaInterface) uintptr {
+	return uintptr(this.node.addRef())
+}
+
+func uia_Release(this *uiaInterface) uintptr {
+	return uintptr(this.node.release())
+}
+
+// addRef adds a reference to n, which keeps it alive until the last one is
+// released. Interfaces of nodes returned to UI Automation carry a reference.
+func (n *uiaNode) addRef() int32 {
+	n.refs++
+	if n.refs == 1 {
+		uiaLiveNodes[n] = struct{}{}
+	}
+
+	return n.refs
+}
+
+func (n *uiaNode) release() int32 {
+	if n.refs == 0 {
+		return 0
+	}
+
+	n.refs--
+	if n.refs == 0 {
+		delete(uiaLiveNodes, n)
+	}
+
+	return n.refs
+}
+
+func uia_Simple_Get_ProviderOptions(this *uiaInterface, pRetVal *int32) uintptr {
+	*pRetVal = win.ProviderOptions_ServerSideProvider | win.ProviderOptions_UseComThreading
+
+	return win.S_OK
+}
+
+func uia_Simple_GetPatternProvider(this *uiaInterface, patternId int32, pRetVal *unsafe.Pointer) uintptr {
+	*pRetVal = nil
+
+	var iid *win.IID
+	switch patternId {
+	case win.UIA_InvokePatternId:
+		iid = &win.IID_IInvokeProvider
+	case win.UIA_ValuePatternId:
+		iid = &win.IID_IValueProvider
+	case win.UIA_TogglePatternId:
+		iid = &win.IID_IToggleProvider
+	case win.UIA_SelectionPatternId:
+		iid = &win.IID_ISelectionProvider
+	case win.UIA_SelectionItemPatternId:
+		iid = &win.IID_ISelectionItemProvider
+	default:
+		return win.S_OK
+	}
+
+	// Patterns an element does not support are no error, but nil.
+	uia_QueryInterface(this, win.REFIID(iid), pRetVal)
+
+	return win.S_OK
+}
+
+func uia_Simple_GetPropertyValue(this *uiaInterface, propertyId int32, pRetVal *win.VARIANT) uintptr {
+	n := this.node
+
+	*pRetVal = win.VARIANT{}
+
+	if n.id == 0 {
+		if propertyId == win.UIA_LiveSettingPropertyId && n.root.wb.acc != nil && n.root.wb.acc.liveSetting != AccLiveOff {
+			pRetVal.SetLong(int32(n.root.wb.acc.liveSetting))
+		}
+
+		return win.S_OK
+	}
+
+	e, ok := n.entry()
+	if !ok {
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+	el := e.element
+
+	switch propertyId {
+	case win.UIA_ControlTypePropertyId:
+		pRetVal.SetLong(uiaControlType(el.Role))
+
+	case win.UIA_NamePropertyId:
+		pRetVal.SetBSTR(win.SysAllocString(el.Name))
+
+	case win.UIA_AutomationIdPropertyId:
+		pRetVal.SetBSTR(win.SysAllocString(strconv.Itoa(el.ID)))
+
+	case win.UIA_IsEnabledPropertyId:
+		pRetVal.SetBool(uiaBool(!el.Disabled))
+
+	case win.UIA_IsKeyboardFocusablePropertyId, win.UIA_HasKeyboardFocusPropertyId:
+		pRetVal.SetBool(win.VARIANT_FALSE)
+
+	case win.UIA_IsControlElementPropertyId, win.UIA_IsContentElementPropertyId:
+		pRetVal.SetBool(win.VARIANT_TRUE)
+	}
+
+	return win.S_OK
+}
+
+func uia_Simple_Get_HostRawElementProvider(this *uiaInterface, pRetVal **win.IRawElementProviderSimple) uintptr {
+	*pRetVal = nil
+
+	if n := this.node; n.id == 0 && n.root.wb.hWnd != 0 {
+		return uintptr(win.UiaHostProviderFromHwnd(n.root.wb.hWnd, pRetVal))
+	}
+
+	return win.S_OK
+}
+
+func uia_Fragment_Navigate(this *uiaInterface, direction int32, pRetVal *unsafe.Pointer) uintptr {
+	n := this.node
+
+	*pRetVal = nil
+
+	var target *uiaNode
+	switch direction {
+	case win.NavigateDirection_Parent:
+		// UI Automation finds the parent of the window by itself.
+		target = n.parent()
+
+	case win.NavigateDirection_FirstChild, win.NavigateDirection_LastChild:
+		children, ok := n.children()
+		if !ok {
+			return win.UIA_E_ELEMENTNOTAVAILABLE
+		}
+		if len(children) == 0 {
+			break
+		}
+
+		if direction == win.NavigateDirection_FirstChild {
+			target = n.root.node(children[0].ID)
+		} else {
+			target = n.root.node(children[len(children)-1].ID)
+		}
+
+	case win.NavigateDirection_NextSibling, win.NavigateDirection_PreviousSibling:
+		siblings := n.siblings()
+		for i, el := range siblings {
+			if el.ID != n.id {
+				continue
+			}
+
+			if direction == win.NavigateDirection_NextSibling && i+1 < len(siblings) {
+				target = n.root.node(siblings[i+1].ID)
+			} else if direction == win.NavigateDirection_PreviousSibling && i > 0 {
+				target = n.root.node(siblings[i-1].ID)
+			}
+			break
+		}
+	}
+
+	if target != nil {
+		target.addRef()
+		*pRetVal = unsafe.Pointer(&target.fragment)
+	}
+
+	return win.S_OK
+}
+
+func uia_Fragment_GetRuntimeId(this *uiaInterface, pRetVal **win.SAFEARRAY) uintptr {
+	n := this.node
+
+	*pRetVal = nil
+
+	// The window gets its runtime ID from its host provider.
+	if n.id == 0 {
+		return win.S_OK
+	}
+
+	if _, ok := n.entry(); !ok {
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+
+	psa := win.SafeArrayCreateVector(win.VT_I4, 0, 2)
+	if psa == nil {
+		return win.E_OUTOFMEMORY
+	}
+
+	runtimeId := [2]int32{win.UiaAppendRuntimeId, int32(n.id)}
+	for i := range runtimeId {
+		if hr := win.SafeArrayPutElement(psa, int32(i), unsafe.Pointer(&runtimeId[i])); win.FAILED(hr) {
+			win.SafeArrayDestroy(psa)
+			return uintptr(hr)
+		}
+	}
+
+	*pRetVal = psa
+
+	return win.S_OK
+}
+
+func uia_Fragment_Get_BoundingRectangle(this *uiaInterface, pRetVal *win.UiaRect) uintptr {
+	n := this.node
+
+	*pRetVal = win.UiaRect{}
+
+	// The window gets its bounds from its host provider.
+	if n.id == 0 {
+		return win.S_OK
+	}
+
+	e, ok := n.entry()
+	if !ok {
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+
+	b := e.element.Bounds
+	pt := win.POINT{X: int32(b.X), Y: int32(b.Y)}
+	if !win.ClientToScreen(n.root.wb.hWnd, &pt) {
+		return win.E_FAIL
+	}
+
+	*pRetVal = win.UiaRect{
+		Left:   float64(pt.X),
+		Top:    float64(pt.Y),
+		Width:  float64(b.Width),
+		Height: float64(b.Height),
+	}
+
+	return win.S_OK
+}
+
+func uia_Fragment_GetEmbeddedFragmentRoots(this *uiaInterface, pRetVal **win.SAFEARRAY) uintptr {
+	*pRetVal = nil
+
+	return win.S_OK
+}
+
+func uia_Fragment_SetFocus(this *uiaInterface) uintptr {
+	// Elements are not focusable by themselves, so their widget takes the
+	// focus instead.
+	if wb := this.node.root.wb; wb.hWnd != 0 {
+		win.SetFocus(wb.hWnd)
+	}
+
+	return win.S_OK
+}
+
+func uia_Fragment_Get_FragmentRoot(this *uiaInterface, pRetVal *unsafe.Pointer) uintptr {
+	root := this.node.root.node(0)
+	root.addRef()
+	*pRetVal = unsafe.Pointer(&root.fragmentRoot)
+
+	return win.S_OK
+}
+
+// uia_FragmentRoot_ElementProviderFromPoint cannot read its coordinates, as
+// callbacks do not support floating-point arguments. The arguments declared
+// here make 32-bit stdcall pop the two doubles and the result pointer. It
+// fails, so UI Automation takes the point to be on the window itself.
+func uia_FragmentRoot_ElementProviderFromPoint(this *uiaInterface, x0, x1, y0, y1, pRetVal uintptr) uintptr {
+	return win.E_NOTIMPL
+}
+
+func uia_FragmentRoot_GetFocus(this *uiaInterface, pRetVal *unsafe.Pointer) uintptr {
+	*pRetVal = nil
+
+	return win.S_OK
+}
+
+func uia_Invoke_Invoke(this *uiaInterface) uintptr {
+	n := this.node
+
+	e, ok := n.entry()
+	if !ok {
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+	if e.element.Disabled {
+		return win.UIA_E_ELEMENTNOTENABLED
+	}
+
+	// Invoke must not block the client, e.g. by opening a dialog.
+	n.root.wb.Synchronize(e.element.Invoke)
+
+	return win.S_OK
+}
+
+func uia_Value_SetValue(this *uiaInterface, val *uint16) uintptr {
+	e, ok := this.node.entry()
+	if !ok {
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+	if e.element.Disabled {
+		return win.UIA_E_ELEMENTNOTENABLED
+	}
+	if e.element.SetValue == nil {
+		return win.E_ACCESSDENIED
+	}
+
+	if err := e.element.SetValue(windows.UTF16PtrToString(val)); err != nil {
+		return win.E_INVALIDARG
+	}
+
+	return win.S_OK
+}
+
+func uia_Value_Get_Value(this *uiaInterface, pRetVal **uint16) uintptr {
+	e, ok := this.node.entry()
+	if !ok {
+		*pRetVal = nil
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+
+	*pRetVal = win.SysAllocString(e.element.Value)
+
+	return win.S_OK
+}
+
+func uia_Value_Get_IsReadOnly(this *uiaInterface, pRetVal *win.BOOL) uintptr {
+	e, ok := this.node.entry()
+	if !ok {
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+
+	*pRetVal = uiaBOOL(e.element.SetValue == nil || e.element.Disabled)
+
+	return win.S_OK
+}
+
+func uia_Toggle_Toggle(this *uiaInterface) uintptr {
+	n := this.node
+
+	e, ok := n.entry()
+	if !ok {
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+	if e.element.Disabled {
+		return win.UIA_E_ELEMENTNOTENABLED
+	}
+	if e.element.Toggle == nil {
+		return win.E_NOTIMPL
+	}
+
+	n.root.wb.Synchronize(e.element.Toggle)
+
+	return win.S_OK
+}
+
+func uia_Toggle_Get_ToggleState(this *uiaInterface, pRetVal *int32) uintptr {
+	e, ok := this.node.entry()
+	if !ok {
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+
+	*pRetVal = uiaToggleState(e.element.Checked)
+
+	return win.S_OK
+}
+
+func uia_Selection_GetSelection(this *uiaInterface, pRetVal **win.SAFEARRAY) uintptr {
+	n := this.node
+
+	*pRetVal = nil
+
+	children, ok := n.children()
+	if !ok {
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+
+	var selected []*uiaNode
+	for _, el := range children {
+		if el.Selectable && el.Selected {
+			selected = append(selected, n.root.node(el.ID))
+		}
+	}
+
+	psa := win.SafeArrayCreateVector(win.VT_UNKNOWN, 0, uint32(len(selected)))
+	if psa == nil {
+		return win.E_OUTOFMEMORY
+	}
+
+	for i, s := range selected {
+		if hr := win.SafeArrayPutElement(psa, int32(i), unsafe.Pointer(&s.simple)); win.FAILED(hr) {
+			win.SafeArrayDestroy(psa)
+			return uintptr(hr)
+		}
+	}
+
+	*pRetVal = psa
+
+	return win.S_OK
+}
+
+func uia_Selection_Get_CanSelectMultiple(this *uiaInterface, pRetVal *win.BOOL) uintptr {
+	*pRetVal = uiaBOOL(this.node.multiSelection())
+
+	return win.S_OK
+}
+
+func uia_Selection_Get_IsSelectionRequired(this *uiaInterface, pRetVal *win.BOOL) uintptr {
+	*pRetVal = win.FALSE
+
+	return win.S_OK
+}
+
+func uia_SelectionItem_Select(this *uiaInterface) uintptr {
+	n := this.node
+
+	e, ok := n.entry()
+	if !ok {
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+	if e.element.Disabled {
+		return win.UIA_E_ELEMENTNOTENABLED
+	}
+	if e.element.Select == nil {
+		return win.E_NOTIMPL
+	}
+
+	n.root.wb.Synchronize(e.element.Select)
+
+	return win.S_OK
+}
+
+func uia_SelectionItem_AddToSelection(this *uiaInterface) uintptr {
+	n := this.node
+
+	e, ok := n.entry()
+	if !ok {
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+	if e.element.Selected {
+		return win.S_OK
+	}
+	if e.element.Disabled {
+		return win.UIA_E_ELEMENTNOTENABLED
+	}
+
+	if parent := n.parent(); parent == nil || !parent.multiSelection() {
+		// A single selection can only be added to while it is empty.
+		for _, el := range n.siblings() {
+			if el.Selectable && el.Selected {
+				return win.UIA_E_INVALIDOPERATION
+			}
+		}
+
+		return uia_SelectionItem_Select(this)
+	}
+
+	if e.element.AddToSelection == nil {
+		return win.E_NOTIMPL
+	}
+
+	n.root.wb.Synchronize(e.element.AddToSelection)
+
+	return win.S_OK
+}
+
+func uia_SelectionItem_RemoveFromSelection(this *uiaInterface) uintptr {
+	// Elements only support becoming the selection.
+	return win.UIA_E_INVALIDOPERATION
+}
+
+func uia_SelectionItem_Get_IsSelected(this *uiaInterface, pRetVal *win.BOOL) uintptr {
+	e, ok := this.node.entry()
+	if !ok {
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+
+	*pRetVal = uiaBOOL(e.element.Selected)
+
+	return win.S_OK
+}
+
+func uia_SelectionItem_Get_SelectionContainer(this *uiaInterface, pRetVal **win.IRawElementProviderSimple) uintptr {
+	*pRetVal = nil
+
+	parent := this.node.parent()
+	if parent == nil {
+		return win.UIA_E_ELEMENTNOTAVAILABLE
+	}
+
+	parent.addRef()
+	*pRetVal = parent.provider()
+
+	return win.S_OK
+}
+
+// uiaControlType returns the UI Automation control type of role.
+func uiaControlType(role AccRole) int32 {
+	switch role {
+	case AccRolePushbutton, AccRoleButtonMenu, AccRoleOutlineButton:
+		return win.UIA_ButtonControlTypeId
+	case AccRoleCheckbutton:
+		return win.UIA_CheckBoxControlTypeId
+	case AccRoleRadiobutton:
+		return win.UIA_RadioButtonControlTypeId
+	case AccRoleCombobox, AccRoleDroplist:
+		return win.UIA_ComboBoxControlTypeId
+	case AccRoleText:
+		return win.UIA_EditControlTypeId
+	case AccRoleStatictext:
+		return win.UIA_TextControlTypeId
+	case AccRoleLink:
+		return win.UIA_HyperlinkControlTypeId
+	case AccRoleGraphic:
+		return win.UIA_ImageControlTypeId
+	case AccRoleList:
+		return win.UIA_ListControlTypeId
+	case AccRoleListItem:
+		return win.UIA_ListItemControlTypeId
+	case AccRoleMenubar:
+		return win.UIA_MenuBarControlTypeId
+	case AccRoleMenuPopup:
+		return win.UIA_MenuControlTypeId
+	case AccRoleMenuItem:
+		return win.UIA_MenuItemControlTypeId
+	case AccRoleProgressbar:
+		return win.UIA_ProgressBarControlTypeId
+	case AccRoleScrollbar:
+		return win.UIA_ScrollBarControlTypeId
+	case AccRoleSlider:
+		return win.UIA_SliderControlTypeId
+	case AccRoleSpinbutton:
+		return win.UIA_SpinnerControlTypeId
+	case AccRoleStatusbar:
+		return win.UIA_StatusBarControlTypeId
+	case AccRolePageTabList:
+		return win.UIA_TabControlTypeId
+	case AccRolePagetab:
+		return win.UIA_TabItemControlTypeId
+	case AccRoleToolbar:
+		return win.UIA_ToolBarControlTypeId
+	case AccRoleTooltip, AccRoleHelpBalloon:
+		return win.UIA_ToolTipControlTypeId
+	case AccRoleOutline:
+		return win.UIA_TreeControlTypeId
+	case AccRoleOutlineItem:
+		return win.UIA_TreeItemControlTypeId
+	case AccRoleGrouping:
+		return win.UIA_GroupControlTypeId
+	case AccRoleGrip:
+		return win.UIA_ThumbControlTypeId
+	case AccRoleTable:
+		return win.UIA_TableControlTypeId
+	case AccRoleRow:
+		return win.UIA_DataItemControlTypeId
+	case AccRoleDocument:
+		return win.UIA_DocumentControlTypeId
+	case AccRoleSplitButton, AccRoleButtonDropdown, AccRoleButtonDropdownGrid:
+		return win.UIA_SplitButtonControlTypeId
+	case AccRoleWindow, AccRoleDialog:
+		return win.UIA_WindowControlTypeId
+	case AccRolePane, AccRolePropertyPage:
+		return win.UIA_PaneControlTypeId
+	case AccRoleColumnHeader, AccRoleRowHeader:
+		return win.UIA_HeaderItemControlTypeId
+	case AccRoleTitlebar:
+		return win.UIA_TitleBarControlTypeId
+	case AccRoleSeparator:
+		return win.UIA_SeparatorControlTypeId
+	}
+
+	return win.UIA_CustomControlTypeId
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package walk
+
+import (
+	"testing"
+)
+
+func TestUIANodeReferences(t *testing.T) {
+	r := &uiaRoot{nodes: make(map[int]*uiaNode)}
+	n := r.node(1)
+
+	if got := uia_AddRef(&n.simple); got != 1 {
+		t.Errorf("AddRef: got %d, want 1", got)
+	}
+	if got := uia_AddRef(&n.selectionItem); got != 2 {
+		t.Errorf("AddRef: got %d, want 2", got)
+	}
+
+	// Dropping the nodes of the root, as WM_DESTROY does, must not drop
+	// nodes clients still hold.
+	r.nodes = nil
+	if _, ok := uiaLiveNodes[n]; !ok {
+		t.Fatal("referenced node is not kept alive")
+	}
+
+	uia_Release(&n.fragment)
+	if _, ok := uiaLiveNodes[n]; !ok {
+		t.Fatal("node released before its last reference")
+	}
+	if got := uia_Release(&n.simple); got != 0 {
+		t.Errorf("Release: got %d, want 0", got)
+	}
+	if _, ok := uiaLiveNodes[n]; ok {
+		t.Error("node kept alive after its last reference")
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package uitest
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"reflect"
+	"time"
+
+	"github.com/xackery/wlk/walk"
+)
+
+// Driver drives the widgets of a form. Its methods may be called from any
+// goroutine but the one running the message loop of the form.
+type Driver struct {
+	form walk.Form
+
+	// Timeout is how long Find and WaitFor wait, and how long calls wait for
+	// the thread of the form. If it is zero, DefaultTimeout is used.
+	Timeout time.Duration
+}
+
+// New returns a Driver for form.
+func New(form walk.Form) *Driver {
+	return &Driver{form: form}
+}
+
+// Form returns the form that d drives.
+func (d *Driver) Form() walk.Form {
+	return d.form
+}
+
+func (d *Driver) timeout() time.Duration {
+	if d.Timeout > 0 {
+		return d.Timeout
+	}
+
+	return DefaultTimeout
+}
+
+// Do calls f on the thread of the form, through Synchronize, and returns its
+// error. A panic in f is returned as an error as well.
+func (d *Driver) Do(f func() error) error {
+	done := make(chan error, 1)
+
+	d.form.Synchronize(func() {
+		defer func() {
+			if x := recover(); x != nil {
+				done <- fmt.Errorf("uitest: panic: %v", x)
+			}
+		}()
+
+		done <- f()
+	})
+
+	select {
+	case err := <-done:
+		return err
+
+	case <-time.After(d.timeout()):
+		return &TimeoutError{Timeout: d.timeout(), Err: errors.New("the message loop of the form does not run")}
+	}
+}
+
+// Find waits until a single widget of the form matches q and returns it.
+func (d *Driver) Find(q Query) (*Element, error) {
+	return d.find(d.form, q)
+}
+
+// FindAll returns the widgets of the form that match q, without waiting.
+func (d *Driver) FindAll(q Query) ([]*Element, error) {
+	return d.findAll(d.form, q)
+}
+
+func (d *Driver) find(root walk.Window, q Query) (*Element, error) {
+	var e *Element
+
+	err := Wait(d.timeout(), func() (bool, error) {
+		return true, d.Do(func() error {
+			n, err := Find(windowNode{root}, q)
+			if err != nil {
+				return err
+			}
+
+			e = &Element{d: d, w: n.(windowNode).w}
+			return nil
+		})
+	})
+
+	var te *TimeoutError
+	if errors.As(err, &te) && te.Err != nil {
+		// Report why, like a *NotFoundError.
+		return nil, te.Err
+	}
+
+	return e, err
+}
+
+func (d *Driver) findAll(root walk.Window, q Query) ([]*Element, error) {
+	var elems []*Element
+
+	err := d.Do(func() error {
+		for _, n := range FindAll(windowNode{root}, q) {
+			elems = append(elems, &Element{d: d, w: n.(windowNode).w})
+		}
+		return nil
+	})
+
+	return elems, err
+}
+
+// Click clicks the button that matches q, see Element.Click.
+func (d *Driver) Click(q Query) error {
+	e, err := d.Find(q)
+	if err != nil {
+		return err
+	}
+
+	return e.Click()
+}
+
+// WaitFor waits until cond, which is called on the thread of the form,
+// returns true.
+func (d *Driver) WaitFor(cond func() bool) error {
+	return Wait(d.timeout(), func() (bool, error) {
+		var ok bool
+		err := d.Do(func() error {
+			ok = cond()
+			return nil
+		})
+		return ok, err
+	})
+}
+
+// Trigger triggers the action with the text text, without mnemonics, from the
+// menu, the tool bars or the ShortcutActions of the form.
+func (d *Driver) Trigger(text string) error {
+	return d.Do(func() error {
+		var found []*walk.Action
+		for _, a := range d.actions() {
+			if StripMnemonic(a.Text()) == text {
+				found = append(found, a)
+			}
+		}
+
+		switch len(found) {
+		case 0:
+			return fmt.Errorf("uitest: no action %q", text)
+
+		case 1:
+			return triggerAction(found[0])
+		}
+
+		return fmt.Errorf("uitest: %d actions %q", len(found), text)
+	})
+}
+
+// TriggerAction triggers a.
+func (d *Driver) TriggerAction(a *walk.Action) error {
+	return d.Do(func() error {
+		return triggerAction(a)
+	})
+}
+
+func triggerAction(a *walk.Action) error {
+	if !a.Enabled() || !a.Visible() {
+		return fmt.Errorf("uitest: action %q is disabled or invisible", StripMnemonic(a.Text()))
+	}
+
+	a.Trigger()
+
+	return nil
+}
+
+// actions returns the actions of the form, each once.
+func (d *Driver) actions() []*walk.Action {
+	var actions []*walk.Action
+	seen := make(map[*walk.Action]bool)
+
+	var addList func(l *walk.ActionList)
+	addList = func(l *walk.ActionList) {
+		if l == nil {
+			return
+		}
+
+		for i := 0; i < l.Len(); i++ {
+			a := l.At(i)
+			if seen[a] {
+				continue
+			}
+			seen[a] = true
+
+			if m := a.Menu(); m != nil {
+				addList(m.Actions())
+			} else if !a.IsSeparator() {
+				actions = append(actions, a)
+			}
+		}
+	}
+
+	if mw, ok := d.form.(*walk.MainWindow); ok {
+		if m := mw.Menu(); m != nil {
+			addList(m.Actions())
+		}
+	}
+
+	for _, n := range FindAll(windowNode{d.form}, ByType("ToolBar")) {
+		addList(n.(windowNode).w.(*walk.ToolBar).Actions())
+	}
+
+	addList(d.form.AsFormBase().ShortcutActions())
+
+	return actions
+}
+
+// Screenshot returns an image of the form.
+func (d *Driver) Screenshot() (*image.RGBA, error) {
+	var img *image.RGBA
+
+	err := d.Do(func() (err error) {
+		img, err = d.form.Screenshot()
+		return
+	})
+
+	return img, err
+}
+
+// windowNode is the Node of a walk window.
+type windowNode struct {
+	w walk.Window
+}
+
+func (n windowNode) Name() string {
+	return n.w.Name()
+}
+
+func (n windowNode) Type() string {
+	t := reflect.TypeOf(n.w)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
+
+// AccessibleName returns the name set with Accessibility.SetName, or else
+// the text of the widget, which Windows uses by default.
+func (n windowNode) AccessibleName() string {
+	if name := n.w.Accessibility().Name(); name != "" {
+		return name
+	}
+
+	if t, ok := n.w.(interface{ Text() string }); ok {
+		return StripMnemonic(t.Text())
+	}
+
+	return ""
+}
+
+func (n windowNode) Children() []Node {
+	var nodes []Node
+
+	switch w := n.w.(type) {
+	case *walk.TabWidget:
+		pages := w.Pages()
+		for i := 0; i < pages.Len(); i++ {
+			nodes = append(nodes, windowNode{pages.At(i)})
+		}
+
+	case interface{ Children() *walk.WidgetList }:
+		children := w.Children()
+		if children == nil {
+			return nil
+		}
+		for i := 0; i < children.Len(); i++ {
+			nodes = append(nodes, windowNode{children.At(i)})
+		}
+	}
+
+	return nodes
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package uitest
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/xackery/wlk/walk"
+	"github.com/xackery/wlk/win"
+)
+
+// Element is a widget found by a Driver. Its methods run on the thread of the
+// form.
+type Element struct {
+	d *Driver
+	w walk.Window
+}
+
+// Window returns the widget of e. Only use it on the thread of the form, like
+// in a function passed to Driver.Do.
+func (e *Element) Window() walk.Window {
+	return e.w
+}
+
+func (e *Element) String() string {
+	n := windowNode{e.w}
+	if name := n.Name(); name != "" {
+		return fmt.Sprintf("%s %q", n.Type(), name)
+	}
+
+	return n.Type()
+}
+
+// Find waits until a single descendant of e matches q and returns it.
+func (e *Element) Find(q Query) (*Element, error) {
+	return e.d.find(e.w, q)
+}
+
+// FindAll returns the descendants of e that match q, without waiting.
+func (e *Element) FindAll(q Query) ([]*Element, error) {
+	return e.d.findAll(e.w, q)
+}
+
+// Click clicks e, which must be a button, like a PushButton or a CheckBox,
+// that is enabled and visible. The Clicked event is published before Click
+// returns.
+func (e *Element) Click() error {
+	return e.d.Do(func() error {
+		if _, ok := e.w.(interface{ Clicked() *walk.Event }); !ok {
+			return fmt.Errorf("uitest: %s is not a button", e)
+		}
+
+		if err := e.checkUsable(); err != nil {
+			return err
+		}
+
+		e.w.SendMessage(win.BM_CLICK, 0, 0)
+
+		return nil
+	})
+}
+
+// SetText sets the text of e, like for a LineEdit or a TextEdit.
+func (e *Element) SetText(text string) error {
+	return e.d.Do(func() error {
+		t, ok := e.w.(interface{ SetText(string) error })
+		if !ok {
+			return fmt.Errorf("uitest: %s has no text to set", e)
+		}
+
+		if err := e.checkUsable(); err != nil {
+			return err
+		}
+
+		return t.SetText(text)
+	})
+}
+
+// Text returns the text of e.
+func (e *Element) Text() (string, error) {
+	var text string
+
+	err := e.d.Do(func() error {
+		t, ok := e.w.(interface{ Text() string })
+		if !ok {
+			return fmt.Errorf("uitest: %s has no text", e)
+		}
+
+		text = t.Text()
+		return nil
+	})
+
+	return text, err
+}
+
+// Select makes the item at index the current one, like of a ComboBox, a
+// ListBox or a TableView.
+func (e *Element) Select(index int) error {
+	return e.d.Do(func() error {
+		s, ok := e.w.(interface{ SetCurrentIndex(int) error })
+		if !ok {
+			return fmt.Errorf("uitest: %s has no items to select", e)
+		}
+
+		if err := e.checkUsable(); err != nil {
+			return err
+		}
+
+		return s.SetCurrentIndex(index)
+	})
+}
+
+// SelectRows selects rows of e, which must be a TableView. The first row
+// becomes the current one. More than one row requires MultiSelection.
+func (e *Element) SelectRows(rows ...int) error {
+	return e.d.Do(func() error {
+		tv, ok := e.w.(*walk.TableView)
+		if !ok {
+			return fmt.Errorf("uitest: %s is not a TableView", e)
+		}
+
+		if err := e.checkUsable(); err != nil {
+			return err
+		}
+
+		if len(rows) > 1 && !tv.MultiSelection() {
+			return fmt.Errorf("uitest: %s does not allow to select %d rows", e, len(rows))
+		}
+
+		if len(rows) == 0 {
+			return tv.SetCurrentIndex(-1)
+		}
+
+		if err := tv.SetCurrentIndex(rows[0]); err != nil {
+			return err
+		}
+
+		if !tv.MultiSelection() {
+			return nil
+		}
+
+		return tv.SetSelectedIndexes(rows)
+	})
+}
+
+// Checked reports whether e, which must be a button like a CheckBox, is
+// checked.
+func (e *Element) Checked() (bool, error) {
+	var checked bool
+
+	err := e.d.Do(func() error {
+		c, ok := e.w.(interface{ Checked() bool })
+		if !ok {
+			return fmt.Errorf("uitest: %s cannot be checked", e)
+		}
+
+		checked = c.Checked()
+		return nil
+	})
+
+	return checked, err
+}
+
+// Enabled reports whether e is enabled.
+func (e *Element) Enabled() (bool, error) {
+	var enabled bool
+
+	err := e.d.Do(func() error {
+		enabled = e.w.Enabled()
+		return nil
+	})
+
+	return enabled, err
+}
+
+// Visible reports whether e is visible.
+func (e *Element) Visible() (bool, error) {
+	var visible bool
+
+	err := e.d.Do(func() error {
+		visible = e.w.Visible()
+		return nil
+	})
+
+	return visible, err
+}
+
+// Property returns the value of the property name of e, like "Text" or
+// "CurrentIndex".
+func (e *Element) Property(name string) (interface{}, error) {
+	var value interface{}
+
+	err := e.d.Do(func() error {
+		p := e.w.AsWindowBase().Property(name)
+		if p == nil {
+			return fmt.Errorf("uitest: %s has no property %s", e, name)
+		}
+
+		value = p.Get()
+		return nil
+	})
+
+	return value, err
+}
+
+// Screenshot returns an image of e, for CheckGolden.
+func (e *Element) Screenshot() (*image.RGBA, error) {
+	var img *image.RGBA
+
+	err := e.d.Do(func() (err error) {
+		img, err = e.w.Screenshot()
+		return
+	})
+
+	return img, err
+}
+
+// checkUsable returns an error if e cannot take input from the user.
+func (e *Element) checkUsable() error {
+	if !e.w.Enabled() {
+		return fmt.Errorf("uitest: %s is disabled", e)
+	}
+
+	if !e.w.Visible() {
+		return fmt.Errorf("uitest: %s is invisible", e)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uitest
+
+import (
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CompareImages returns the number of pixels of got that differ from want by
+// more than tolerance in any channel, on a scale of 0 to 255. Images of
+// different sizes cannot be compared.
+func CompareImages(got, want image.Image, tolerance uint8) (int, error) {
+	gb, wb := got.Bounds(), want.Bounds()
+	if gb.Dx() != wb.Dx() || gb.Dy() != wb.Dy() {
+		return 0, fmt.Errorf("uitest: image is %dx%d, want %dx%d", gb.Dx(), gb.Dy(), wb.Dx(), wb.Dy())
+	}
+
+	tol := uint32(tolerance) * 0x101
+
+	var n int
+	for y := 0; y < gb.Dy(); y++ {
+		for x := 0; x < gb.Dx(); x++ {
+			r1, g1, b1, a1 := got.At(gb.Min.X+x, gb.Min.Y+y).RGBA()
+			r2, g2, b2, a2 := want.At(wb.Min.X+x, wb.Min.Y+y).RGBA()
+
+			if diff(r1, r2) > tol || diff(g1, g2) > tol || diff(b1, b2) > tol || diff(a1, a2) > tol {
+				n++
+			}
+		}
+	}
+
+	return n, nil
+}
+
+func diff(a, b uint32) uint32 {
+	if a > b {
+		return a - b
+	}
+
+	return b - a
+}
+
+// CheckGolden compares img with the golden PNG image at path, see
+// CompareImages. If update is true, it writes img to path instead, which is
+// how golden images are created.
+//
+// If the images differ, img is written next to the golden image, with the
+// extension ".got.png", for inspection.
+func CheckGolden(path string, img image.Image, tolerance uint8, update bool) error {
+	if update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		return writePNG(path, img)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	want, err := png.Decode(f)
+	if err != nil {
+		return fmt.Errorf("uitest: %s: %s", path, err)
+	}
+
+	n, err := CompareImages(img, want, tolerance)
+	if err == nil && n == 0 {
+		return nil
+	}
+
+	gotPath := strings.TrimSuffix(path, filepath.Ext(path)) + ".got.png"
+	if werr := writePNG(gotPath, img); werr != nil {
+		return werr
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s, see %s", err, gotPath)
+	}
+
+	return fmt.Errorf("uitest: %d pixels differ from %s, see %s", n, path, gotPath)
+}
+
+func writePNG(path string, img image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,239 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package uitest drives walk forms from tests. It finds widgets by Name, type
+// or accessibility name, clicks buttons, sets text, selects TableView rows,
+// triggers Actions, waits for conditions and compares screenshots with golden
+// images.
+//
+// Everything that touches windows is run on the thread of their WindowGroup
+// through Synchronize, so a test drives a form from its own goroutine while
+// the form runs its message loop:
+//
+//	go mw.Run()
+//
+//	d := uitest.New(mw)
+//	name, err := d.Find(uitest.ByName("nameEdit"))
+//	...
+//	err = name.SetText("Ann")
+//	...
+//	err = d.Click(uitest.ByAccessibleName("OK"))
+//
+// Queries work on Nodes, which Driver implements for walk windows. Queries,
+// Wait and the image comparisons do not depend on Windows, so they may be
+// tested on any platform with fake Nodes.
+package uitest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Node is a widget as seen by queries.
+type Node interface {
+	// Name returns the Name of the widget.
+	Name() string
+
+	// Type returns the name of the type of the widget, like "PushButton".
+	Type() string
+
+	// AccessibleName returns the name of the widget that screen readers
+	// announce, without mnemonics, like "OK" for a button with the text
+	// "&OK".
+	AccessibleName() string
+
+	// Children returns the child widgets.
+	Children() []Node
+}
+
+// Query selects Nodes.
+type Query interface {
+	// Match reports whether n is selected.
+	Match(n Node) bool
+
+	// String describes the query, for error messages.
+	String() string
+}
+
+type queryFunc struct {
+	match func(n Node) bool
+	desc  string
+}
+
+func (q *queryFunc) Match(n Node) bool {
+	return q.match(n)
+}
+
+func (q *queryFunc) String() string {
+	return q.desc
+}
+
+// ByName selects the Nodes with the Name name.
+func ByName(name string) Query {
+	return &queryFunc{
+		match: func(n Node) bool { return n.Name() == name },
+		desc:  fmt.Sprintf("Name %q", name),
+	}
+}
+
+// ByType selects the Nodes of the type typeName, like "PushButton".
+func ByType(typeName string) Query {
+	return &queryFunc{
+		match: func(n Node) bool { return n.Type() == typeName },
+		desc:  fmt.Sprintf("type %s", typeName),
+	}
+}
+
+// ByAccessibleName selects the Nodes with the accessible name name.
+func ByAccessibleName(name string) Query {
+	return &queryFunc{
+		match: func(n Node) bool { return n.AccessibleName() == name },
+		desc:  fmt.Sprintf("accessible name %q", name),
+	}
+}
+
+// All selects the Nodes selected by all of queries, like
+// All(ByType("PushButton"), ByAccessibleName("OK")).
+func All(queries ...Query) Query {
+	descs := make([]string, len(queries))
+	for i, q := range queries {
+		descs[i] = q.String()
+	}
+
+	return &queryFunc{
+		match: func(n Node) bool {
+			for _, q := range queries {
+				if !q.Match(n) {
+					return false
+				}
+			}
+			return true
+		},
+		desc: strings.Join(descs, " and "),
+	}
+}
+
+// Within selects the Nodes selected by q that are descendants of a Node
+// selected by parent, like Within(ByName("billing"), ByName("street")).
+func Within(parent, q Query) Query {
+	return &withinQuery{parent, q}
+}
+
+type withinQuery struct {
+	parent, q Query
+}
+
+// Match reports whether n matches the child query. Whether it is inside a
+// Node that matches the parent query depends on the tree, see FindAll.
+func (wq *withinQuery) Match(n Node) bool {
+	return wq.q.Match(n)
+}
+
+func (wq *withinQuery) String() string {
+	return fmt.Sprintf("%s within %s", wq.q, wq.parent)
+}
+
+// NotFoundError is returned if no Node matches a query.
+type NotFoundError struct {
+	Query Query
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("uitest: no widget with %s", e.Query)
+}
+
+// AmbiguousError is returned if more than one Node matches a query that
+// should select a single one.
+type AmbiguousError struct {
+	Query Query
+	Count int
+}
+
+func (e *AmbiguousError) Error() string {
+	return fmt.Sprintf("uitest: %d widgets with %s", e.Count, e.Query)
+}
+
+// Find returns the single Node in the tree of root that matches q. It returns
+// a *NotFoundError or an *AmbiguousError if there is none or more than one.
+func Find(root Node, q Query) (Node, error) {
+	nodes := FindAll(root, q)
+
+	switch len(nodes) {
+	case 0:
+		return nil, &NotFoundError{q}
+
+	case 1:
+		return nodes[0], nil
+	}
+
+	return nil, &AmbiguousError{q, len(nodes)}
+}
+
+// FindAll returns the Nodes in the tree of root, including root, that match q,
+// in depth-first order.
+func FindAll(root Node, q Query) []Node {
+	if root == nil {
+		return nil
+	}
+
+	var nodes []Node
+	var ancestors []Node
+
+	var visit func(n Node)
+	visit = func(n Node) {
+		if match(q, n, ancestors) {
+			nodes = append(nodes, n)
+		}
+
+		ancestors = append(ancestors, n)
+		for _, child := range n.Children() {
+			visit(child)
+		}
+		ancestors = ancestors[:len(ancestors)-1]
+	}
+	visit(root)
+
+	return nodes
+}
+
+// match reports whether n, with ancestors from the root down, matches q.
+func match(q Query, n Node, ancestors []Node) bool {
+	wq, ok := q.(*withinQuery)
+	if !ok {
+		return q.Match(n)
+	}
+
+	if !match(wq.q, n, ancestors) {
+		return false
+	}
+
+	for i, a := range ancestors {
+		if match(wq.parent, a, ancestors[:i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StripMnemonic returns text without the ampersands that mark mnemonics, like
+// "Save As" for "Save &As", and with "&&" turned into "&".
+func StripMnemonic(text string) string {
+	if !strings.Contains(text, "&") {
+		return text
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] == '&' {
+			i++
+			if i == len(text) {
+				break
+			}
+		}
+		sb.WriteByte(text[i])
+	}
+
+	return sb.String()
+}
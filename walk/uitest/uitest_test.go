@@ -0,0 +1,276 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uitest
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type fakeNode struct {
+	name, typ, accName string
+	children           []*fakeNode
+}
+
+func (n *fakeNode) Name() string           { return n.name }
+func (n *fakeNode) Type() string           { return n.typ }
+func (n *fakeNode) AccessibleName() string { return n.accName }
+
+func (n *fakeNode) Children() []Node {
+	nodes := make([]Node, len(n.children))
+	for i, c := range n.children {
+		nodes[i] = c
+	}
+	return nodes
+}
+
+func address(name string) *fakeNode {
+	return &fakeNode{name: name, typ: "Composite", children: []*fakeNode{
+		{name: "street", typ: "LineEdit", accName: "Street"},
+		{name: "city", typ: "LineEdit", accName: "City"},
+	}}
+}
+
+func testTree() *fakeNode {
+	return &fakeNode{name: "mw", typ: "MainWindow", children: []*fakeNode{
+		address("billing"),
+		{name: "outer", typ: "Composite", children: []*fakeNode{
+			address("shipping"),
+		}},
+		{name: "ok", typ: "PushButton", accName: "OK"},
+		{name: "cancel", typ: "PushButton", accName: "Cancel"},
+	}}
+}
+
+func names(nodes []Node) []string {
+	var s []string
+	for _, n := range nodes {
+		s = append(s, n.Name())
+	}
+	return s
+}
+
+func TestFindAll(t *testing.T) {
+	testCases := []struct {
+		q    Query
+		want []string
+	}{
+		{ByName("ok"), []string{"ok"}},
+		{ByName("mw"), []string{"mw"}},
+		{ByName("nope"), nil},
+		{ByType("PushButton"), []string{"ok", "cancel"}},
+		{ByType("LineEdit"), []string{"street", "city", "street", "city"}},
+		{ByAccessibleName("Cancel"), []string{"cancel"}},
+		{All(ByType("PushButton"), ByAccessibleName("OK")), []string{"ok"}},
+		{All(ByType("LineEdit"), ByAccessibleName("OK")), nil},
+		{Within(ByName("billing"), ByName("street")), []string{"street"}},
+		{Within(ByName("outer"), ByType("LineEdit")), []string{"street", "city"}},
+		{Within(ByName("outer"), Within(ByName("shipping"), ByName("city"))), []string{"city"}},
+		{Within(ByName("billing"), Within(ByName("shipping"), ByName("city"))), nil},
+		{Within(ByName("ok"), ByName("ok")), nil},
+	}
+
+	for _, c := range testCases {
+		got := names(FindAll(testTree(), c.q))
+		if len(got) != len(c.want) {
+			t.Errorf("FindAll(%s): got %v, want %v", c.q, got, c.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Errorf("FindAll(%s): got %v, want %v", c.q, got, c.want)
+				break
+			}
+		}
+	}
+}
+
+func TestFindWithin(t *testing.T) {
+	tree := testTree()
+
+	n, err := Find(tree, Within(ByName("shipping"), ByName("street")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != tree.children[1].children[0].children[0] {
+		t.Errorf("Find: got %v, want the street of shipping", n)
+	}
+}
+
+func TestFindErrors(t *testing.T) {
+	_, err := Find(testTree(), ByName("nope"))
+	var nf *NotFoundError
+	if !errors.As(err, &nf) {
+		t.Fatalf("Find: got %v, want a *NotFoundError", err)
+	}
+	if want := `uitest: no widget with Name "nope"`; err.Error() != want {
+		t.Errorf("NotFoundError: got %q, want %q", err, want)
+	}
+
+	_, err = Find(testTree(), ByName("street"))
+	var amb *AmbiguousError
+	if !errors.As(err, &amb) {
+		t.Fatalf("Find: got %v, want an *AmbiguousError", err)
+	}
+	if amb.Count != 2 {
+		t.Errorf("AmbiguousError.Count: got %d, want 2", amb.Count)
+	}
+	if want := `uitest: 2 widgets with Name "street"`; err.Error() != want {
+		t.Errorf("AmbiguousError: got %q, want %q", err, want)
+	}
+
+	if nodes := FindAll(nil, ByName("x")); nodes != nil {
+		t.Errorf("FindAll(nil): got %v, want nil", nodes)
+	}
+}
+
+func TestQueryString(t *testing.T) {
+	testCases := []struct {
+		q    Query
+		want string
+	}{
+		{ByName("ok"), `Name "ok"`},
+		{ByType("PushButton"), "type PushButton"},
+		{ByAccessibleName("OK"), `accessible name "OK"`},
+		{All(ByType("PushButton"), ByName("ok")), `type PushButton and Name "ok"`},
+		{Within(ByName("billing"), ByName("street")), `Name "street" within Name "billing"`},
+	}
+
+	for _, c := range testCases {
+		if got := c.q.String(); got != c.want {
+			t.Errorf("String: got %q, want %q", got, c.want)
+		}
+	}
+}
+
+func TestStripMnemonic(t *testing.T) {
+	testCases := []struct {
+		text, want string
+	}{
+		{"OK", "OK"},
+		{"&OK", "OK"},
+		{"Save &As", "Save As"},
+		{"Salt && Pepper", "Salt & Pepper"},
+		{"&Salt && Pepper", "Salt & Pepper"},
+		{"Trailing&", "Trailing"},
+		{"", ""},
+	}
+
+	for _, c := range testCases {
+		if got := StripMnemonic(c.text); got != c.want {
+			t.Errorf("StripMnemonic(%q): got %q, want %q", c.text, got, c.want)
+		}
+	}
+}
+
+func TestWait(t *testing.T) {
+	var calls int
+	err := Wait(time.Second, func() (bool, error) {
+		calls++
+		if calls < 3 {
+			return false, errors.New("not yet")
+		}
+		return true, nil
+	})
+	if err != nil {
+		t.Errorf("Wait: got %v, want nil", err)
+	}
+	if calls != 3 {
+		t.Errorf("Wait: got %d calls, want 3", calls)
+	}
+}
+
+func TestWaitTimeout(t *testing.T) {
+	errNotYet := errors.New("not yet")
+
+	err := Wait(50*time.Millisecond, func() (bool, error) {
+		return false, errNotYet
+	})
+
+	var te *TimeoutError
+	if !errors.As(err, &te) {
+		t.Fatalf("Wait: got %v, want a *TimeoutError", err)
+	}
+	if !errors.Is(err, errNotYet) {
+		t.Errorf("Wait: got %v, want it to wrap the last error", err)
+	}
+	if want := "uitest: timed out after 50ms: not yet"; err.Error() != want {
+		t.Errorf("TimeoutError: got %q, want %q", err, want)
+	}
+}
+
+func testImage(c color.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 3))
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 4; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestCompareImages(t *testing.T) {
+	want := testImage(color.RGBA{100, 100, 100, 255})
+
+	got := testImage(color.RGBA{100, 100, 100, 255})
+	got.Set(0, 0, color.RGBA{103, 100, 100, 255})
+	got.Set(1, 0, color.RGBA{100, 90, 100, 255})
+
+	testCases := []struct {
+		tolerance uint8
+		want      int
+	}{
+		{0, 2},
+		{3, 1},
+		{10, 0},
+	}
+
+	for _, c := range testCases {
+		n, err := CompareImages(got, want, c.tolerance)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != c.want {
+			t.Errorf("CompareImages(tolerance %d): got %d, want %d", c.tolerance, n, c.want)
+		}
+	}
+
+	if _, err := CompareImages(image.NewRGBA(image.Rect(0, 0, 2, 2)), want, 0); err == nil {
+		t.Error("CompareImages: got nil error for images of different sizes")
+	}
+}
+
+func TestCheckGolden(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "golden", "form.png")
+	img := testImage(color.RGBA{0, 128, 255, 255})
+
+	if err := CheckGolden(path, img, 0, false); err == nil {
+		t.Error("CheckGolden: got nil error without golden image")
+	}
+
+	if err := CheckGolden(path, img, 0, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := CheckGolden(path, img, 0, false); err != nil {
+		t.Errorf("CheckGolden: got %v, want nil", err)
+	}
+
+	other := testImage(color.RGBA{0, 128, 255, 255})
+	other.Set(2, 2, color.RGBA{255, 0, 0, 255})
+
+	if err := CheckGolden(path, other, 0, false); err == nil {
+		t.Error("CheckGolden: got nil error for differing image")
+	}
+
+	if _, err := os.Stat(filepath.Join(filepath.Dir(path), "form.got.png")); err != nil {
+		t.Errorf("CheckGolden: %v", err)
+	}
+}
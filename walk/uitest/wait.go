@@ -0,0 +1,57 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uitest
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultTimeout is how long a Driver waits for widgets and conditions,
+// unless its Timeout is set.
+const DefaultTimeout = 5 * time.Second
+
+// pollInterval is how often Wait checks a condition.
+const pollInterval = 20 * time.Millisecond
+
+// TimeoutError is returned if a condition was not met in time.
+type TimeoutError struct {
+	Timeout time.Duration
+
+	// Err is the last error that cond returned, if any.
+	Err error
+}
+
+func (e *TimeoutError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("uitest: timed out after %s: %s", e.Timeout, e.Err)
+	}
+
+	return fmt.Sprintf("uitest: timed out after %s", e.Timeout)
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// Wait calls cond until it returns true, or until timeout has passed, in
+// which case it returns a *TimeoutError. Errors returned by cond are retried
+// as well, the last one is reported in the TimeoutError.
+func Wait(timeout time.Duration, cond func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		ok, err := cond()
+		if ok && err == nil {
+			return nil
+		}
+
+		if !time.Now().Before(deadline) {
+			return &TimeoutError{timeout, err}
+		}
+
+		time.Sleep(pollInterval)
+	}
+}
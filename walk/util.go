@@ -8,43 +8,21 @@
 package walk
 
 import (
-	"bytes"
 	"math"
 	"math/big"
-	"strconv"
-	"strings"
-	"syscall"
 	"time"
 
 	"github.com/xackery/wlk/win"
 	"golang.org/x/exp/constraints"
 )
 
+// The separators of the current locale, see setLocale.
 var (
-	decimalSepB      byte
 	decimalSepUint16 uint16
 	decimalSepS      string
-	groupSepB        byte
 	groupSepUint16   uint16
-	groupSepS        string
 )
 
-func init() {
-	AppendToWalkInit(func() {
-		var buf [4]uint16
-
-		win.GetLocaleInfo(win.LOCALE_USER_DEFAULT, win.LOCALE_SDECIMAL, &buf[0], int32(len(buf)))
-		decimalSepB = byte(buf[0])
-		decimalSepS = syscall.UTF16ToString(buf[0:1])
-		decimalSepUint16 = buf[0]
-
-		win.GetLocaleInfo(win.LOCALE_USER_DEFAULT, win.LOCALE_STHOUSAND, &buf[0], int32(len(buf)))
-		groupSepB = byte(buf[0])
-		groupSepS = syscall.UTF16ToString(buf[0:1])
-		groupSepUint16 = buf[0]
-	})
-}
-
 func maxi(a, b int) int {
 	if a > b {
 		return a
@@ -144,89 +122,28 @@ func assertTimeOr(value interface{}, defaultValue time.Time) time.Time {
 	return defaultValue
 }
 
+// ParseFloat parses s with the current locale, see Locale.ParseFloat.
 func ParseFloat(s string) (float64, error) {
-	s = strings.TrimSpace(s)
-
-	t := FormatFloatGrouped(1000, 2)
-
-	replaceSep := func(new string, index func(string, func(rune) bool) int) {
-		i := index(t, func(r rune) bool {
-			return r < '0' || r > '9'
-		})
-
-		var sep string
-		if i > -1 {
-			sep = string(t[i])
-		}
-		if sep != "" {
-			s = strings.Replace(s, string(sep), new, -1)
-		}
-	}
-
-	replaceSep("", strings.IndexFunc)
-	replaceSep(".", strings.LastIndexFunc)
-
-	return strconv.ParseFloat(s, 64)
+	return CurrentLocale().ParseFloat(s)
 }
 
+// FormatFloat formats f with prec fraction digits and the decimal separator of the current
+// locale.
 func FormatFloat(f float64, prec int) string {
-	return formatFloatString(strconv.FormatFloat(f, 'f', prec, 64), prec, false)
+	return CurrentLocale().FormatFloat(f, prec)
 }
 
+// FormatFloatGrouped formats f like FormatFloat and groups the digits of the integer part.
 func FormatFloatGrouped(f float64, prec int) string {
-	return formatFloatString(strconv.FormatFloat(f, 'f', maxi(1, prec), 64), prec, true)
+	return CurrentLocale().FormatFloatGrouped(f, prec)
 }
 
 func formatBigRat(r *big.Rat, prec int) string {
-	return formatFloatString(r.FloatString(prec), prec, false)
+	return CurrentLocale().FormatBigRat(r, prec, false)
 }
 
 func formatBigRatGrouped(r *big.Rat, prec int) string {
-	return formatFloatString(r.FloatString(prec), prec, true)
-}
-
-func formatFloatString(s string, prec int, grouped bool) string {
-	switch s {
-	case "NaN", "-Inf", "+Inf":
-		return s
-	}
-
-	s = strings.Replace(s, ".", decimalSepS, 1)
-	if !grouped {
-		return s
-	}
-
-	b := new(bytes.Buffer)
-
-	var firstDigit int
-	if len(s) > 0 && s[0] == '-' {
-		firstDigit = 1
-		b.WriteByte('-')
-		s = s[1:]
-	}
-
-	intLen := len(s) - maxi(1, prec) - 1
-
-	n := intLen % 3
-	if n != 0 {
-		b.WriteString(s[:n])
-	}
-	for i := n; i < intLen; i += 3 {
-		if b.Len() > firstDigit {
-			b.WriteByte(groupSepB)
-		}
-		b.WriteString(s[i : i+3])
-	}
-
-	b.WriteString(s[intLen:])
-
-	s = b.String()
-
-	if prec == 0 {
-		s = s[:len(s)-2]
-	}
-
-	return s
+	return CurrentLocale().FormatBigRat(r, prec, true)
 }
 
 func applyEnabledToDescendants(window Window, enabled bool) {
@@ -48,6 +48,10 @@ type Widget interface {
 	// is not visible.
 	AlwaysConsumeSpace() bool
 
+	// ArrowGroup returns the name of the group arrow keys move the focus
+	// within.
+	ArrowGroup() string
+
 	// AsWidgetBase returns a *WidgetBase that implements Widget.
 	AsWidgetBase() *WidgetBase
 
@@ -77,16 +81,34 @@ type Widget interface {
 	// is not visible.
 	SetAlwaysConsumeSpace(b bool) error
 
+	// SetArrowGroup sets the name of the group arrow keys move the focus
+	// within.
+	SetArrowGroup(group string)
+
 	// SetParent sets the parent of the Widget and adds the Widget to the
 	// Children list of the Container.
 	SetParent(value Container) error
 
+	// SetTabIndex sets the position of the Widget in the tab order of its
+	// siblings.
+	SetTabIndex(index int) error
+
+	// SetTabStop sets if the Tab key moves the keyboard focus to the Widget.
+	SetTabStop(tabStop bool) error
+
 	// SetToolTipText sets the tool tip text of the Widget.
 	SetToolTipText(s string) error
 
 	// SizeHint returns the preferred size in native pixels for the respective type of Widget.
 	SizeHint() Size
 
+	// TabIndex returns the position of the Widget in the tab order of its
+	// siblings.
+	TabIndex() int
+
+	// TabStop returns if the Tab key moves the keyboard focus to the Widget.
+	TabStop() bool
+
 	// ToolTipText returns the tool tip text of the Widget.
 	ToolTipText() string
 }
@@ -97,9 +119,13 @@ type WidgetBase struct {
 	parent                      Container
 	toolTipTextProperty         Property
 	toolTipTextChangedPublisher EventPublisher
+	tabIndexChangedPublisher    EventPublisher
+	tabStopChangedPublisher     EventPublisher
 	graphicsEffects             *WidgetGraphicsEffectList
 	alignment                   Alignment2D
 	alwaysConsumeSpace          bool
+	tabIndex                    int
+	arrowGroup                  string
 }
 
 // InitWidget initializes a Widget.
@@ -151,6 +177,24 @@ func (wb *WidgetBase) init(widget Widget) error {
 
 	wb.MustRegisterProperty("ToolTipText", wb.toolTipTextProperty)
 
+	wb.MustRegisterProperty("TabIndex", NewProperty(
+		func() interface{} {
+			return wb.TabIndex()
+		},
+		func(v interface{}) error {
+			return wb.SetTabIndex(assertIntOr(v, 0))
+		},
+		wb.tabIndexChangedPublisher.Event()))
+
+	wb.MustRegisterProperty("TabStop", NewBoolProperty(
+		func() bool {
+			return wb.TabStop()
+		},
+		func(b bool) error {
+			return wb.SetTabStop(b)
+		},
+		wb.tabStopChangedPublisher.Event()))
+
 	return nil
 }
 
@@ -167,6 +211,10 @@ func (wb *WidgetBase) Dispose() {
 		tt.RemoveTool(wb.window.(Widget))
 	}
 
+	if form := wb.Form(); form != nil {
+		form.AsFormBase().forgetWidget(wb.window.(Widget))
+	}
+
 	wb.WindowBase.Dispose()
 }
 
@@ -415,6 +463,64 @@ func (wb *WidgetBase) SetToolTipText(s string) error {
 	return nil
 }
 
+// TabIndex returns the position of the WidgetBase in the tab order of its
+// siblings.
+func (wb *WidgetBase) TabIndex() int {
+	return wb.tabIndex
+}
+
+// SetTabIndex sets the position of the WidgetBase in the tab order of its
+// siblings.
+//
+// Siblings with a positive TabIndex come first, in ascending order, followed
+// by the others in the order they were added to their parent.
+func (wb *WidgetBase) SetTabIndex(index int) error {
+	if index == wb.tabIndex {
+		return nil
+	}
+
+	wb.tabIndex = index
+
+	wb.tabIndexChangedPublisher.Publish()
+
+	return nil
+}
+
+// TabStop returns if the Tab key moves the keyboard focus to the WidgetBase.
+func (wb *WidgetBase) TabStop() bool {
+	return wb.hasStyleBits(win.WS_TABSTOP)
+}
+
+// SetTabStop sets if the Tab key moves the keyboard focus to the WidgetBase.
+//
+// By default, widgets that take keyboard input are tab stops.
+func (wb *WidgetBase) SetTabStop(tabStop bool) error {
+	if tabStop == wb.TabStop() {
+		return nil
+	}
+
+	if err := wb.ensureStyleBits(win.WS_TABSTOP, tabStop); err != nil {
+		return err
+	}
+
+	wb.tabStopChangedPublisher.Publish()
+
+	return nil
+}
+
+// ArrowGroup returns the name of the group arrow keys move the focus within.
+func (wb *WidgetBase) ArrowGroup() string {
+	return wb.arrowGroup
+}
+
+// SetArrowGroup sets the name of the group arrow keys move the focus within.
+//
+// Siblings with the same ArrowGroup are a single stop in the tab order, like
+// the RadioButtons of a group, which are one implicitly.
+func (wb *WidgetBase) SetArrowGroup(group string) {
+	wb.arrowGroup = group
+}
+
 // GraphicsEffects returns a list of WidgetGraphicsEffects that are applied to the WidgetBase.
 func (wb *WidgetBase) GraphicsEffects() *WidgetGraphicsEffectList {
 	return wb.graphicsEffects
@@ -97,6 +97,14 @@ func (l *WidgetList) insertIntoSlice(index int, item Widget) {
 	l.items[index] = item.AsWidgetBase()
 }
 
+// move moves the item at index from to index to, without notifying the
+// observer.
+func (l *WidgetList) move(from, to int) {
+	item := l.items[from]
+	l.items = append(l.items[:from], l.items[from+1:]...)
+	l.insertIntoSlice(to, item.window.(Widget))
+}
+
 func (l *WidgetList) Insert(index int, item Widget) error {
 	if l.Contains(item) {
 		return newError("cannot insert same widget multiple times")
@@ -451,6 +451,7 @@ type WindowBase struct {
 	visible                     bool
 	enabled                     bool
 	acc                         *Accessibility
+	uia                         *uiaRoot
 	themes                      map[string]*Theme
 	menuSharedMetricsInitialDPI *menuSharedMetrics
 	// onHelp is the possibly nil func passed to WindowBase.SetHelp.
@@ -1270,6 +1271,10 @@ func (wb *WindowBase) setText(text string) error {
 		return err
 	}
 
+	if wb.acc != nil {
+		wb.acc.notifyTextChanged()
+	}
+
 	return nil
 }
 
@@ -2170,10 +2175,7 @@ func (wb *WindowBase) WriteState(state string) error {
 	}
 
 	p := wb.path()
-	if strings.HasPrefix(p, "/") ||
-		strings.HasSuffix(p, "/") ||
-		strings.Contains(p, "//") {
-
+	if !validStatePath(p) {
 		return nil
 	}
 
@@ -2477,6 +2479,12 @@ func (wb *WindowBase) WndProc(hwnd windows.HWND, msg uint32, wParam, lParam uint
 
 		wb.focusedChangedPublisher.Publish()
 
+		if widget, ok := wb.window.(Widget); ok && msg == win.WM_SETFOCUS {
+			if form := wb.Form(); form != nil {
+				form.AsFormBase().setFocusedWidget(widget)
+			}
+		}
+
 	case win.WM_SETCURSOR:
 		if wb.cursor != nil {
 			win.SetCursor(wb.cursor.handle())
@@ -2599,7 +2607,16 @@ func (wb *WindowBase) WndProc(hwnd windows.HWND, msg uint32, wParam, lParam uint
 
 		wb.window.(ApplySysColorser).ApplySysColors()
 
+	case win.WM_GETOBJECT:
+		if int32(lParam) == win.UiaRootObjectId {
+			if root := wb.uiaRoot(false); root != nil {
+				return win.UiaReturnRawElementProvider(hwnd, wParam, lParam, root.node(0).provider())
+			}
+		}
+
 	case win.WM_DESTROY:
+		wb.disposeUIARoot()
+
 		if wb.origWndProcPtr != 0 {
 			// As we subclass all windows of system classes, we prevented the
 			// clean-up code in the WM_NCDESTROY handlers of some windows from
@@ -112,6 +112,7 @@ type WindowGroup struct {
 	oleInit         bool
 	accPropServices *win.IAccPropServices
 	msgWindow       windows.HWND
+	hotKeys         map[int32]*GlobalHotKey // Global hot keys registered for msgWindow, by id
 
 	syncMutex           sync.Mutex
 	syncFuncs           []func()                   // Functions queued to run on the group's thread
@@ -124,6 +125,14 @@ func msgWndProc(hwnd windows.HWND, msg uint32, wParam, lParam uintptr) (result u
 		wg.RunSynchronized()
 		return 0
 	}
+	if msg == win.WM_HOTKEY {
+		if wg := wgm.Group(win.GetCurrentThreadId()); wg != nil {
+			if hk := wg.hotKeys[int32(wParam)]; hk != nil {
+				hk.handler()
+			}
+		}
+		return 0
+	}
 	return win.DefWindowProc(hwnd, msg, wParam, lParam)
 }
 
@@ -354,6 +363,9 @@ func (g *WindowGroup) dispose() {
 		g.accPropServices = nil
 	}
 
+	// The watcher synchronizes with the message window, so it is closed first.
+	closeDarkModeWatcher(g)
+
 	if g.msgWindow != 0 && win.DestroyWindow(g.msgWindow) {
 		g.msgWindow = 0
 	}
@@ -17,6 +17,28 @@ import (
 const KEY_READ REGSAM = 0x20019
 const KEY_WRITE REGSAM = 0x20006
 
+// Registry key access rights
+const (
+	KEY_QUERY_VALUE        REGSAM = 0x0001
+	KEY_SET_VALUE          REGSAM = 0x0002
+	KEY_CREATE_SUB_KEY     REGSAM = 0x0004
+	KEY_ENUMERATE_SUB_KEYS REGSAM = 0x0008
+	KEY_NOTIFY             REGSAM = 0x0010
+	KEY_CREATE_LINK        REGSAM = 0x0020
+	KEY_WOW64_64KEY        REGSAM = 0x0100
+	KEY_WOW64_32KEY        REGSAM = 0x0200
+	KEY_ALL_ACCESS         REGSAM = 0xF003F
+)
+
+// RegNotifyChangeKeyValue filters
+const (
+	REG_NOTIFY_CHANGE_NAME       = 0x00000001
+	REG_NOTIFY_CHANGE_ATTRIBUTES = 0x00000002
+	REG_NOTIFY_CHANGE_LAST_SET   = 0x00000004
+	REG_NOTIFY_CHANGE_SECURITY   = 0x00000008
+	REG_NOTIFY_THREAD_AGNOSTIC   = 0x10000000
+)
+
 const (
 	HKEY_CLASSES_ROOT     HKEY = 0x80000000
 	HKEY_CURRENT_USER     HKEY = 0x80000001
@@ -31,6 +53,18 @@ const (
 	ERROR_NO_MORE_ITEMS = 259
 )
 
+// RegCreateKeyEx options
+const (
+	REG_OPTION_NON_VOLATILE = 0
+	REG_OPTION_VOLATILE     = 1
+)
+
+// RegCreateKeyEx dispositions
+const (
+	REG_CREATED_NEW_KEY     = 1
+	REG_OPENED_EXISTING_KEY = 2
+)
+
 type (
 	ACCESS_MASK uint32
 	HKEY        HANDLE
@@ -62,10 +96,16 @@ var (
 
 	// Functions
 	regCloseKey     *windows.LazyProc
+	regCreateKeyEx  *windows.LazyProc
+	regDeleteTree   *windows.LazyProc
+	regDeleteValue  *windows.LazyProc
+	regEnumKeyEx    *windows.LazyProc
 	regOpenKeyEx    *windows.LazyProc
 	regQueryValueEx *windows.LazyProc
 	regEnumValue    *windows.LazyProc
 	regSetValueEx   *windows.LazyProc
+
+	regNotifyChangeKeyValue *windows.LazyProc
 )
 
 func init() {
@@ -74,10 +114,15 @@ func init() {
 
 	// Functions
 	regCloseKey = libadvapi32.NewProc("RegCloseKey")
+	regCreateKeyEx = libadvapi32.NewProc("RegCreateKeyExW")
+	regDeleteTree = libadvapi32.NewProc("RegDeleteTreeW")
+	regDeleteValue = libadvapi32.NewProc("RegDeleteValueW")
+	regEnumKeyEx = libadvapi32.NewProc("RegEnumKeyExW")
 	regOpenKeyEx = libadvapi32.NewProc("RegOpenKeyExW")
 	regQueryValueEx = libadvapi32.NewProc("RegQueryValueExW")
 	regEnumValue = libadvapi32.NewProc("RegEnumValueW")
 	regSetValueEx = libadvapi32.NewProc("RegSetValueExW")
+	regNotifyChangeKeyValue = libadvapi32.NewProc("RegNotifyChangeKeyValue")
 }
 
 func RegCloseKey(hKey HKEY) int32 {
@@ -89,6 +134,39 @@ func RegCloseKey(hKey HKEY) int32 {
 	return int32(ret)
 }
 
+func RegCreateKeyEx(hKey HKEY, lpSubKey *uint16, reserved uint32, lpClass *uint16, dwOptions uint32, samDesired REGSAM, lpSecurityAttributes *windows.SecurityAttributes, phkResult *HKEY, lpdwDisposition *uint32) int32 {
+	ret, _, _ := syscall.Syscall9(regCreateKeyEx.Addr(), 9,
+		uintptr(hKey),
+		uintptr(unsafe.Pointer(lpSubKey)),
+		uintptr(reserved),
+		uintptr(unsafe.Pointer(lpClass)),
+		uintptr(dwOptions),
+		uintptr(samDesired),
+		uintptr(unsafe.Pointer(lpSecurityAttributes)),
+		uintptr(unsafe.Pointer(phkResult)),
+		uintptr(unsafe.Pointer(lpdwDisposition)))
+
+	return int32(ret)
+}
+
+func RegDeleteTree(hKey HKEY, lpSubKey *uint16) int32 {
+	ret, _, _ := syscall.Syscall(regDeleteTree.Addr(), 2,
+		uintptr(hKey),
+		uintptr(unsafe.Pointer(lpSubKey)),
+		0)
+
+	return int32(ret)
+}
+
+func RegDeleteValue(hKey HKEY, lpValueName *uint16) int32 {
+	ret, _, _ := syscall.Syscall(regDeleteValue.Addr(), 2,
+		uintptr(hKey),
+		uintptr(unsafe.Pointer(lpValueName)),
+		0)
+
+	return int32(ret)
+}
+
 func RegOpenKeyEx(hKey HKEY, lpSubKey *uint16, ulOptions uint32, samDesired REGSAM, phkResult *HKEY) int32 {
 	ret, _, _ := syscall.Syscall6(regOpenKeyEx.Addr(), 5,
 		uintptr(hKey),
@@ -113,6 +191,20 @@ func RegQueryValueEx(hKey HKEY, lpValueName *uint16, lpReserved, lpType *uint32,
 	return int32(ret)
 }
 
+func RegEnumKeyEx(hKey HKEY, index uint32, lpName *uint16, lpcchName *uint32, lpReserved *uint32, lpClass *uint16, lpcchClass *uint32, lpftLastWriteTime *FILETIME) int32 {
+	ret, _, _ := syscall.Syscall9(regEnumKeyEx.Addr(), 8,
+		uintptr(hKey),
+		uintptr(index),
+		uintptr(unsafe.Pointer(lpName)),
+		uintptr(unsafe.Pointer(lpcchName)),
+		uintptr(unsafe.Pointer(lpReserved)),
+		uintptr(unsafe.Pointer(lpClass)),
+		uintptr(unsafe.Pointer(lpcchClass)),
+		uintptr(unsafe.Pointer(lpftLastWriteTime)),
+		0)
+	return int32(ret)
+}
+
 func RegEnumValue(hKey HKEY, index uint32, lpValueName *uint16, lpcchValueName *uint32, lpReserved, lpType *uint32, lpData *byte, lpcbData *uint32) int32 {
 	ret, _, _ := syscall.Syscall9(regEnumValue.Addr(), 8,
 		uintptr(hKey),
@@ -137,3 +229,14 @@ func RegSetValueEx(hKey HKEY, lpValueName *uint16, lpReserved, lpDataType uint64
 		uintptr(cbData))
 	return int32(ret)
 }
+
+func RegNotifyChangeKeyValue(hKey HKEY, bWatchSubtree BOOL, dwNotifyFilter uint32, hEvent HANDLE, fAsynchronous BOOL) int32 {
+	ret, _, _ := syscall.Syscall6(regNotifyChangeKeyValue.Addr(), 5,
+		uintptr(hKey),
+		uintptr(bWatchSubtree),
+		uintptr(dwNotifyFilter),
+		uintptr(hEvent),
+		uintptr(fAsynchronous),
+		0)
+	return int32(ret)
+}
@@ -1100,7 +1100,9 @@ var (
 	intersectClipRect       *windows.LazyProc
 	lineTo                  *windows.LazyProc
 	moveToEx                *windows.LazyProc
+	pie                     *windows.LazyProc
 	playEnhMetaFile         *windows.LazyProc
+	polygon                 *windows.LazyProc
 	polyline                *windows.LazyProc
 	rectangle               *windows.LazyProc
 	removeFontResourceEx    *windows.LazyProc
@@ -1179,7 +1181,9 @@ func init() {
 	intersectClipRect = libgdi32.NewProc("IntersectClipRect")
 	lineTo = libgdi32.NewProc("LineTo")
 	moveToEx = libgdi32.NewProc("MoveToEx")
+	pie = libgdi32.NewProc("Pie")
 	playEnhMetaFile = libgdi32.NewProc("PlayEnhMetaFile")
+	polygon = libgdi32.NewProc("Polygon")
 	polyline = libgdi32.NewProc("Polyline")
 	rectangle = libgdi32.NewProc("Rectangle")
 	removeFontResourceEx = libgdi32.NewProc("RemoveFontResourceExW")
@@ -1712,6 +1716,21 @@ func MoveToEx(hdc HDC, x, y int, lpPoint *POINT) bool {
 	return ret != 0
 }
 
+func Pie(hdc HDC, nLeftRect, nTopRect, nRightRect, nBottomRect, nXRadial1, nYRadial1, nXRadial2, nYRadial2 int32) bool {
+	ret, _, _ := syscall.Syscall9(pie.Addr(), 9,
+		uintptr(hdc),
+		uintptr(nLeftRect),
+		uintptr(nTopRect),
+		uintptr(nRightRect),
+		uintptr(nBottomRect),
+		uintptr(nXRadial1),
+		uintptr(nYRadial1),
+		uintptr(nXRadial2),
+		uintptr(nYRadial2))
+
+	return ret != 0
+}
+
 func PlayEnhMetaFile(hdc HDC, hemf HENHMETAFILE, lpRect *RECT) bool {
 	ret, _, _ := syscall.Syscall(playEnhMetaFile.Addr(), 3,
 		uintptr(hdc),
@@ -1730,6 +1749,15 @@ func Polyline(hdc HDC, lppt unsafe.Pointer, cPoints int32) bool {
 	return ret != 0
 }
 
+func Polygon(hdc HDC, lppt unsafe.Pointer, cPoints int32) bool {
+	ret, _, _ := syscall.Syscall(polygon.Addr(), 3,
+		uintptr(hdc),
+		uintptr(lppt),
+		uintptr(cPoints))
+
+	return ret != 0
+}
+
 func Rectangle_(hdc HDC, nLeftRect, nTopRect, nRightRect, nBottomRect int32) bool {
 	ret, _, _ := syscall.Syscall6(rectangle.Addr(), 5,
 		uintptr(hdc),
@@ -132,10 +132,18 @@ type GpFontFamily struct{}
 type GpGraphics struct{}
 type GpImage struct{}
 type GpImageAttributes struct{}
+type GpLineGradient GpBrush
 type GpPath struct{}
+type GpPathGradient GpBrush
+type GpPen struct{}
 type GpSolidFill GpBrush
 type GpStringFormat struct{}
 
+type GpPointF struct {
+	X float32
+	Y float32
+}
+
 type GpRect struct {
 	X      int32
 	Y      int32
@@ -181,6 +189,25 @@ const (
 	CompositingQualityAssumeLinear   = CompositingQuality(4)
 )
 
+type DashCap int32
+
+const (
+	DashCapFlat     = DashCap(0)
+	DashCapRound    = DashCap(2)
+	DashCapTriangle = DashCap(3)
+)
+
+type DashStyle int32
+
+const (
+	DashStyleSolid      = DashStyle(0)
+	DashStyleDash       = DashStyle(1)
+	DashStyleDot        = DashStyle(2)
+	DashStyleDashDot    = DashStyle(3)
+	DashStyleDashDotDot = DashStyle(4)
+	DashStyleCustom     = DashStyle(5)
+)
+
 type FillMode int32
 
 const (
@@ -213,6 +240,38 @@ const (
 	InterpolationModeHighQualityBicubic  = InterpolationMode(7)
 )
 
+type LineCap int32
+
+const (
+	LineCapFlat          = LineCap(0)
+	LineCapSquare        = LineCap(1)
+	LineCapRound         = LineCap(2)
+	LineCapTriangle      = LineCap(3)
+	LineCapNoAnchor      = LineCap(0x10)
+	LineCapSquareAnchor  = LineCap(0x11)
+	LineCapRoundAnchor   = LineCap(0x12)
+	LineCapDiamondAnchor = LineCap(0x13)
+	LineCapArrowAnchor   = LineCap(0x14)
+)
+
+type LineJoin int32
+
+const (
+	LineJoinMiter        = LineJoin(0)
+	LineJoinBevel        = LineJoin(1)
+	LineJoinRound        = LineJoin(2)
+	LineJoinMiterClipped = LineJoin(3)
+)
+
+type MatrixOrder int32
+
+const (
+	MatrixOrderPrepend = MatrixOrder(0)
+	MatrixOrderAppend  = MatrixOrder(1)
+)
+
+type GraphicsState uint32
+
 type PixelFormat int32
 
 const (
@@ -301,6 +360,16 @@ const (
 	TextRenderingHintClearTypeGridFit         = TextRenderingHint(5)
 )
 
+type WrapMode int32
+
+const (
+	WrapModeTile       = WrapMode(0)
+	WrapModeTileFlipX  = WrapMode(1)
+	WrapModeTileFlipY  = WrapMode(2)
+	WrapModeTileFlipXY = WrapMode(3)
+	WrapModeClamp      = WrapMode(4)
+)
+
 type Unit int32
 
 const (
@@ -313,16 +382,105 @@ const (
 	UnitMillimeter = Unit(6)
 )
 
+// The following functions take floating point arguments by value, which
+// mkwinsyscall cannot express, so they are written out by hand.
 var (
-	gdipBitmapSetResolution = modgdiplus.NewProc("GdipBitmapSetResolution")
-	gdipCreateFont          = modgdiplus.NewProc("GdipCreateFont")
+	gdipAddPathArc              = modgdiplus.NewProc("GdipAddPathArc")
+	gdipAddPathBezier           = modgdiplus.NewProc("GdipAddPathBezier")
+	gdipAddPathEllipse          = modgdiplus.NewProc("GdipAddPathEllipse")
+	gdipAddPathLine             = modgdiplus.NewProc("GdipAddPathLine")
+	gdipAddPathRectangle        = modgdiplus.NewProc("GdipAddPathRectangle")
+	gdipBitmapSetResolution     = modgdiplus.NewProc("GdipBitmapSetResolution")
+	gdipCreateFont              = modgdiplus.NewProc("GdipCreateFont")
+	gdipCreatePen1              = modgdiplus.NewProc("GdipCreatePen1")
+	gdipCreatePen2              = modgdiplus.NewProc("GdipCreatePen2")
+	gdipDrawEllipse             = modgdiplus.NewProc("GdipDrawEllipse")
+	gdipDrawLine                = modgdiplus.NewProc("GdipDrawLine")
+	gdipDrawRectangle           = modgdiplus.NewProc("GdipDrawRectangle")
+	gdipFillEllipse             = modgdiplus.NewProc("GdipFillEllipse")
+	gdipFillRectangle           = modgdiplus.NewProc("GdipFillRectangle")
+	gdipRotateWorldTransform    = modgdiplus.NewProc("GdipRotateWorldTransform")
+	gdipScaleWorldTransform     = modgdiplus.NewProc("GdipScaleWorldTransform")
+	gdipSetPenDashOffset        = modgdiplus.NewProc("GdipSetPenDashOffset")
+	gdipSetPenMiterLimit        = modgdiplus.NewProc("GdipSetPenMiterLimit")
+	gdipTranslateWorldTransform = modgdiplus.NewProc("GdipTranslateWorldTransform")
 )
 
+func f32(f float32) uintptr {
+	return uintptr(math.Float32bits(f))
+}
+
+func GdipAddPathArc(path *GpPath, x, y, width, height, startAngle, sweepAngle float32) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipAddPathArc.Addr(),
+		uintptr(unsafe.Pointer(path)),
+		f32(x),
+		f32(y),
+		f32(width),
+		f32(height),
+		f32(startAngle),
+		f32(sweepAngle),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipAddPathBezier(path *GpPath, x1, y1, x2, y2, x3, y3, x4, y4 float32) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipAddPathBezier.Addr(),
+		uintptr(unsafe.Pointer(path)),
+		f32(x1),
+		f32(y1),
+		f32(x2),
+		f32(y2),
+		f32(x3),
+		f32(y3),
+		f32(x4),
+		f32(y4),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipAddPathEllipse(path *GpPath, x, y, width, height float32) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipAddPathEllipse.Addr(),
+		uintptr(unsafe.Pointer(path)),
+		f32(x),
+		f32(y),
+		f32(width),
+		f32(height),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipAddPathLine(path *GpPath, x1, y1, x2, y2 float32) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipAddPathLine.Addr(),
+		uintptr(unsafe.Pointer(path)),
+		f32(x1),
+		f32(y1),
+		f32(x2),
+		f32(y2),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipAddPathRectangle(path *GpPath, x, y, width, height float32) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipAddPathRectangle.Addr(),
+		uintptr(unsafe.Pointer(path)),
+		f32(x),
+		f32(y),
+		f32(width),
+		f32(height),
+	)
+
+	return GpStatus(ret)
+}
+
 func GdipBitmapSetResolution(bitmap *GpBitmap, xdpi float32, ydpi float32) GpStatus {
 	ret, _, _ := syscall.SyscallN(gdipBitmapSetResolution.Addr(),
 		uintptr(unsafe.Pointer(bitmap)),
-		uintptr(math.Float32bits(xdpi)),
-		uintptr(math.Float32bits(ydpi)),
+		f32(xdpi),
+		f32(ydpi),
 	)
 
 	return GpStatus(ret)
@@ -331,7 +489,7 @@ func GdipBitmapSetResolution(bitmap *GpBitmap, xdpi float32, ydpi float32) GpSta
 func GdipCreateFont(fontFamily *GpFontFamily, emSize float32, style FontStyle, unit Unit, font **GpFont) GpStatus {
 	ret, _, _ := syscall.SyscallN(gdipCreateFont.Addr(),
 		uintptr(unsafe.Pointer(fontFamily)),
-		uintptr(math.Float32bits(emSize)),
+		f32(emSize),
 		uintptr(style),
 		uintptr(unit),
 		uintptr(unsafe.Pointer(font)),
@@ -340,18 +498,160 @@ func GdipCreateFont(fontFamily *GpFontFamily, emSize float32, style FontStyle, u
 	return GpStatus(ret)
 }
 
+func GdipCreatePen1(color ARGB, width float32, unit Unit, pen **GpPen) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipCreatePen1.Addr(),
+		uintptr(color),
+		f32(width),
+		uintptr(unit),
+		uintptr(unsafe.Pointer(pen)),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipCreatePen2(brush *GpBrush, width float32, unit Unit, pen **GpPen) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipCreatePen2.Addr(),
+		uintptr(unsafe.Pointer(brush)),
+		f32(width),
+		uintptr(unit),
+		uintptr(unsafe.Pointer(pen)),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipDrawEllipse(graphics *GpGraphics, pen *GpPen, x, y, width, height float32) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipDrawEllipse.Addr(),
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(pen)),
+		f32(x),
+		f32(y),
+		f32(width),
+		f32(height),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipDrawLine(graphics *GpGraphics, pen *GpPen, x1, y1, x2, y2 float32) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipDrawLine.Addr(),
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(pen)),
+		f32(x1),
+		f32(y1),
+		f32(x2),
+		f32(y2),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipDrawRectangle(graphics *GpGraphics, pen *GpPen, x, y, width, height float32) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipDrawRectangle.Addr(),
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(pen)),
+		f32(x),
+		f32(y),
+		f32(width),
+		f32(height),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipFillEllipse(graphics *GpGraphics, brush *GpBrush, x, y, width, height float32) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipFillEllipse.Addr(),
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(brush)),
+		f32(x),
+		f32(y),
+		f32(width),
+		f32(height),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipFillRectangle(graphics *GpGraphics, brush *GpBrush, x, y, width, height float32) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipFillRectangle.Addr(),
+		uintptr(unsafe.Pointer(graphics)),
+		uintptr(unsafe.Pointer(brush)),
+		f32(x),
+		f32(y),
+		f32(width),
+		f32(height),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipRotateWorldTransform(graphics *GpGraphics, angle float32, order MatrixOrder) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipRotateWorldTransform.Addr(),
+		uintptr(unsafe.Pointer(graphics)),
+		f32(angle),
+		uintptr(order),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipScaleWorldTransform(graphics *GpGraphics, sx, sy float32, order MatrixOrder) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipScaleWorldTransform.Addr(),
+		uintptr(unsafe.Pointer(graphics)),
+		f32(sx),
+		f32(sy),
+		uintptr(order),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipSetPenDashOffset(pen *GpPen, offset float32) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipSetPenDashOffset.Addr(),
+		uintptr(unsafe.Pointer(pen)),
+		f32(offset),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipSetPenMiterLimit(pen *GpPen, miterLimit float32) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipSetPenMiterLimit.Addr(),
+		uintptr(unsafe.Pointer(pen)),
+		f32(miterLimit),
+	)
+
+	return GpStatus(ret)
+}
+
+func GdipTranslateWorldTransform(graphics *GpGraphics, dx, dy float32, order MatrixOrder) GpStatus {
+	ret, _, _ := syscall.SyscallN(gdipTranslateWorldTransform.Addr(),
+		uintptr(unsafe.Pointer(graphics)),
+		f32(dx),
+		f32(dy),
+		uintptr(order),
+	)
+
+	return GpStatus(ret)
+}
+
 //sys GdipAddPathEllipseI(path *GpPath, x int32, y int32, width int32, height int32) (ret GpStatus) = gdiplus.GdipAddPathEllipseI
+//sys GdipAddPathLine2(path *GpPath, points *GpPointF, count int32) (ret GpStatus) = gdiplus.GdipAddPathLine2
+//sys GdipAddPathPolygon(path *GpPath, points *GpPointF, count int32) (ret GpStatus) = gdiplus.GdipAddPathPolygon
 //sys GdipCreateBitmapFromFile(filename *uint16, bitmap **GpBitmap) (ret GpStatus) = gdiplus.GdipCreateBitmapFromFile
 //sys GdipCreateBitmapFromGraphics(width int32, height int32, graphics *GpGraphics, bitmap **GpBitmap) (ret GpStatus) = gdiplus.GdipCreateBitmapFromGraphics
 //sys GdipCreateBitmapFromHBITMAP(hbm HBITMAP, hpal HPALETTE, bitmap **GpBitmap) (ret GpStatus) = gdiplus.GdipCreateBitmapFromHBITMAP
 //sys GdipCreateBitmapFromHICON(hicon HICON, bitmap **GpBitmap) (ret GpStatus) = gdiplus.GdipCreateBitmapFromHICON
 //sys GdipCreateBitmapFromScan0(width int32, height int32, stride int32, format PixelFormat, scan0 *byte, bitmap **GpBitmap) (ret GpStatus) = gdiplus.GdipCreateBitmapFromScan0
 //sys GdipCreateBitmapFromStream(stream *com.IStreamABI, bitmap **GpBitmap) (ret GpStatus) = gdiplus.GdipCreateBitmapFromStream
+//sys GdipClosePathFigure(path *GpPath) (ret GpStatus) = gdiplus.GdipClosePathFigure
 //sys GdipCreateFontFamilyFromName(name *uint16, collection *GpFontCollection, family **GpFontFamily) (ret GpStatus) = gdiplus.GdipCreateFontFamilyFromName
 //sys GdipCreateFromHDC(hdc HDC, graphics **GpGraphics) (ret GpStatus) = gdiplus.GdipCreateFromHDC
 //sys GdipCreateHBITMAPFromBitmap(bitmap *GpBitmap, hbmReturn *HBITMAP, background ARGB) (ret GpStatus) = gdiplus.GdipCreateHBITMAPFromBitmap
 //sys GdipCreateHICONFromBitmap(bitmap *GpBitmap, hbmReturn *HICON) (ret GpStatus) = gdiplus.GdipCreateHICONFromBitmap
+//sys GdipCreateLineBrush(point1 *GpPointF, point2 *GpPointF, color1 ARGB, color2 ARGB, wrapMode WrapMode, lineGradient **GpLineGradient) (ret GpStatus) = gdiplus.GdipCreateLineBrush
 //sys GdipCreatePath(fillMode FillMode, path **GpPath) (ret GpStatus) = gdiplus.GdipCreatePath
+//sys GdipCreatePathGradientFromPath(path *GpPath, polyGradient **GpPathGradient) (ret GpStatus) = gdiplus.GdipCreatePathGradientFromPath
 //sys GdipCreateSolidFill(color ARGB, brush **GpSolidFill) (ret GpStatus) = gdiplus.GdipCreateSolidFill
 //sys GdipCreateStringFormat(flags StringFormatFlags, language LANGID, format **GpStringFormat) (ret GpStatus) = gdiplus.GdipCreateStringFormat
 //sys GdipDeleteBrush(brush *GpBrush) (ret GpStatus) = gdiplus.GdipDeleteBrush
@@ -359,12 +659,15 @@ func GdipCreateFont(fontFamily *GpFontFamily, emSize float32, style FontStyle, u
 //sys GdipDeleteFontFamily(family *GpFontFamily) (ret GpStatus) = gdiplus.GdipDeleteFontFamily
 //sys GdipDeleteGraphics(graphics *GpGraphics) (ret GpStatus) = gdiplus.GdipDeleteGraphics
 //sys GdipDeletePath(path *GpPath) (ret GpStatus) = gdiplus.GdipDeletePath
+//sys GdipDeletePen(pen *GpPen) (ret GpStatus) = gdiplus.GdipDeletePen
 //sys GdipDeleteStringFormat(format *GpStringFormat) (ret GpStatus) = gdiplus.GdipDeleteStringFormat
 //sys GdipDisposeImage(image *GpImage) (ret GpStatus) = gdiplus.GdipDisposeImage
 //sys GdipDrawImageRectI(graphics *GpGraphics, image *GpImage, x int32, y int32, width int32, height int32) (ret GpStatus) = gdiplus.GdipDrawImageRectI
 //sys GdipDrawImageRectRectI(graphics *GpGraphics, image *GpImage, dstX int32, dstY int32, dstWidth int32, dstHeight int32, srcX int32, srcY int32, srcWidth int32, srcHeight int32, srcUnit Unit, imgAttrs *GpImageAttributes, callback uintptr, callbackData uintptr) (ret GpStatus) = gdiplus.GdipDrawImageRectRectI
+//sys GdipDrawPath(graphics *GpGraphics, pen *GpPen, path *GpPath) (ret GpStatus) = gdiplus.GdipDrawPath
 //sys GdipDrawString(graphics *GpGraphics, text *uint16, textLength int32, font *GpFont, rectf *GpRectF, strFmt *GpStringFormat, brush *GpBrush) (ret GpStatus) = gdiplus.GdipDrawString
 //sys GdipFillEllipseI(graphics *GpGraphics, brush *GpBrush, x int32, y int32, width int32, height int32) (ret GpStatus) = gdiplus.GdipFillEllipseI
+//sys GdipFillPath(graphics *GpGraphics, brush *GpBrush, path *GpPath) (ret GpStatus) = gdiplus.GdipFillPath
 //sys GdipGetGenericFontFamilyMonospace(family **GpFontFamily) (ret GpStatus) = gdiplus.GdipGetGenericFontFamilyMonospace
 //sys GdipGetGenericFontFamilySansSerif(family **GpFontFamily) (ret GpStatus) = gdiplus.GdipGetGenericFontFamilySansSerif
 //sys GdipGetGenericFontFamilySerif(family **GpFontFamily) (ret GpStatus) = gdiplus.GdipGetGenericFontFamilySerif
@@ -376,14 +679,29 @@ func GdipCreateFont(fontFamily *GpFontFamily, emSize float32, style FontStyle, u
 //sys GdipGetImageWidth(image *GpImage, width *uint32) (ret GpStatus) = gdiplus.GdipGetImageWidth
 //sys GdipGetCompositingMode(graphics *GpGraphics, compositingMode *CompositingMode) (ret GpStatus) = gdiplus.GdipGetCompositingMode
 //sys GdipGraphicsClear(graphics *GpGraphics, color ARGB) (ret GpStatus) = gdiplus.GdipGraphicsClear
+//sys GdipMeasureString(graphics *GpGraphics, text *uint16, length int32, font *GpFont, layoutRect *GpRectF, stringFormat *GpStringFormat, boundingBox *GpRectF, codepointsFitted *int32, linesFilled *int32) (ret GpStatus) = gdiplus.GdipMeasureString
 //sys GdiplusStartup(token *uintptr, input *GdiplusStartupInput, output *GdiplusStartupOutput) (ret GpStatus) = gdiplus.GdiplusStartup
+//sys GdipResetPath(path *GpPath) (ret GpStatus) = gdiplus.GdipResetPath
 //sys GdipResetClip(graphics *GpGraphics) (ret GpStatus) = gdiplus.GdipResetClip
+//sys GdipResetWorldTransform(graphics *GpGraphics) (ret GpStatus) = gdiplus.GdipResetWorldTransform
+//sys GdipRestoreGraphics(graphics *GpGraphics, state GraphicsState) (ret GpStatus) = gdiplus.GdipRestoreGraphics
+//sys GdipSaveGraphics(graphics *GpGraphics, state *GraphicsState) (ret GpStatus) = gdiplus.GdipSaveGraphics
 //sys GdipSetClipPath(graphics *GpGraphics, path *GpPath, combineMode CombineMode) (ret GpStatus) = gdiplus.GdipSetClipPath
 //sys GdipSetCompositingMode(graphics *GpGraphics, compositingMode CompositingMode) (ret GpStatus) = gdiplus.GdipSetCompositingMode
 //sys GdipSetCompositingQuality(graphics *GpGraphics, compositingQuality CompositingQuality) (ret GpStatus) = gdiplus.GdipSetCompositingQuality
 //sys GdipSetInterpolationMode(graphics *GpGraphics, interpolationMode InterpolationMode) (ret GpStatus) = gdiplus.GdipSetInterpolationMode
+//sys GdipSetLinePresetBlend(brush *GpLineGradient, blend *ARGB, positions *float32, count int32) (ret GpStatus) = gdiplus.GdipSetLinePresetBlend
+//sys GdipSetPathGradientCenterColor(brush *GpPathGradient, color ARGB) (ret GpStatus) = gdiplus.GdipSetPathGradientCenterColor
+//sys GdipSetPathGradientCenterPoint(brush *GpPathGradient, point *GpPointF) (ret GpStatus) = gdiplus.GdipSetPathGradientCenterPoint
+//sys GdipSetPathGradientPresetBlend(brush *GpPathGradient, blend *ARGB, positions *float32, count int32) (ret GpStatus) = gdiplus.GdipSetPathGradientPresetBlend
+//sys GdipSetPathGradientSurroundColorsWithCount(brush *GpPathGradient, colors *ARGB, count *int32) (ret GpStatus) = gdiplus.GdipSetPathGradientSurroundColorsWithCount
+//sys GdipSetPenDashArray(pen *GpPen, dash *float32, count int32) (ret GpStatus) = gdiplus.GdipSetPenDashArray
+//sys GdipSetPenDashStyle(pen *GpPen, dashStyle DashStyle) (ret GpStatus) = gdiplus.GdipSetPenDashStyle
+//sys GdipSetPenLineCap197819(pen *GpPen, startCap LineCap, endCap LineCap, dashCap DashCap) (ret GpStatus) = gdiplus.GdipSetPenLineCap197819
+//sys GdipSetPenLineJoin(pen *GpPen, lineJoin LineJoin) (ret GpStatus) = gdiplus.GdipSetPenLineJoin
 //sys GdipSetPixelOffsetMode(graphics *GpGraphics, pixelOffsetMode PixelOffsetMode) (ret GpStatus) = gdiplus.GdipSetPixelOffsetMode
 //sys GdipSetSmoothingMode(graphics *GpGraphics, smoothingMode SmoothingMode) (ret GpStatus) = gdiplus.GdipSetSmoothingMode
 //sys GdipSetStringFormatAlign(format *GpStringFormat, align StringAlignment) (ret GpStatus) = gdiplus.GdipSetStringFormatAlign
 //sys GdipSetStringFormatLineAlign(format *GpStringFormat, align StringAlignment) (ret GpStatus) = gdiplus.GdipSetStringFormatLineAlign
+//sys GdipStartPathFigure(path *GpPath) (ret GpStatus) = gdiplus.GdipStartPathFigure
 //sys GdipSetTextRenderingHint(graphics *GpGraphics, mode TextRenderingHint) (ret GpStatus) = gdiplus.GdipSetTextRenderingHint
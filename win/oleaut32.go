@@ -424,9 +424,12 @@ var (
 	liboleaut32 *windows.LazyDLL
 
 	// Functions
-	sysAllocString *windows.LazyProc
-	sysFreeString  *windows.LazyProc
-	sysStringLen   *windows.LazyProc
+	safeArrayCreateVector *windows.LazyProc
+	safeArrayDestroy      *windows.LazyProc
+	safeArrayPutElement   *windows.LazyProc
+	sysAllocString        *windows.LazyProc
+	sysFreeString         *windows.LazyProc
+	sysStringLen          *windows.LazyProc
 )
 
 func init() {
@@ -434,11 +437,45 @@ func init() {
 	liboleaut32 = windows.NewLazySystemDLL("oleaut32.dll")
 
 	// Functions
+	safeArrayCreateVector = liboleaut32.NewProc("SafeArrayCreateVector")
+	safeArrayDestroy = liboleaut32.NewProc("SafeArrayDestroy")
+	safeArrayPutElement = liboleaut32.NewProc("SafeArrayPutElement")
 	sysAllocString = liboleaut32.NewProc("SysAllocString")
 	sysFreeString = liboleaut32.NewProc("SysFreeString")
 	sysStringLen = liboleaut32.NewProc("SysStringLen")
 }
 
+func SafeArrayCreateVector(vt VARTYPE, lowerBound int32, elements uint32) *SAFEARRAY {
+	ret, _, _ := syscall.Syscall(safeArrayCreateVector.Addr(), 3,
+		uintptr(vt),
+		uintptr(lowerBound),
+		uintptr(elements))
+
+	return (*SAFEARRAY)(unsafe.Pointer(ret))
+}
+
+func SafeArrayDestroy(psa *SAFEARRAY) HRESULT {
+	ret, _, _ := syscall.Syscall(safeArrayDestroy.Addr(), 1,
+		uintptr(unsafe.Pointer(psa)),
+		0,
+		0)
+
+	return HRESULT(ret)
+}
+
+// SafeArrayPutElement stores the element that v points to at index of the
+// one-dimensional array psa. For arrays of VT_BSTR, VT_UNKNOWN and
+// VT_DISPATCH, v is the element itself, and interfaces are stored with
+// AddRef.
+func SafeArrayPutElement(psa *SAFEARRAY, index int32, v unsafe.Pointer) HRESULT {
+	ret, _, _ := syscall.Syscall(safeArrayPutElement.Addr(), 3,
+		uintptr(unsafe.Pointer(psa)),
+		uintptr(unsafe.Pointer(&index)),
+		uintptr(v))
+
+	return HRESULT(ret)
+}
+
 func SysAllocString(s string) *uint16 /*BSTR*/ {
 	ret, _, _ := syscall.Syscall(sysAllocString.Addr(), 1,
 		uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(s))),
@@ -0,0 +1,57 @@
+// Copyright 2026 The Walk Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// MONITOR_DPI_TYPE
+const (
+	MDT_EFFECTIVE_DPI = 0
+	MDT_ANGULAR_DPI   = 1
+	MDT_RAW_DPI       = 2
+	MDT_DEFAULT       = MDT_EFFECTIVE_DPI
+)
+
+var (
+	// Library
+	libshcore *windows.LazyDLL
+
+	// Functions
+	getDpiForMonitor *windows.LazyProc
+)
+
+func init() {
+	// Library
+	libshcore = windows.NewLazySystemDLL("shcore.dll")
+
+	// Functions
+	getDpiForMonitor = libshcore.NewProc("GetDpiForMonitor")
+}
+
+// GetDpiForMonitor queries the dots per inch of a display. It requires
+// Windows 8.1 or later and returns E_NOTIMPL before.
+func GetDpiForMonitor(hmonitor HMONITOR, dpiType int32, dpiX, dpiY *uint32) HRESULT {
+	if getDpiForMonitor.Find() != nil {
+		return -((E_NOTIMPL ^ 0xFFFFFFFF) + 1)
+	}
+
+	ret, _, _ := syscall.Syscall6(getDpiForMonitor.Addr(), 4,
+		uintptr(hmonitor),
+		uintptr(dpiType),
+		uintptr(unsafe.Pointer(dpiX)),
+		uintptr(unsafe.Pointer(dpiY)),
+		0,
+		0)
+
+	return HRESULT(ret)
+}
@@ -0,0 +1,437 @@
+// Copyright 2026 The win Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build windows
+// +build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// UiaRootObjectId is the object ID of WM_GETOBJECT requests for the UI
+// Automation provider of a window.
+const UiaRootObjectId = -25
+
+// UiaAppendRuntimeId starts the runtime IDs of fragments, so UI Automation
+// makes them unique by appending them to the runtime ID of the host window.
+const UiaAppendRuntimeId = 3
+
+const (
+	UIA_E_ELEMENTNOTENABLED   = 0x80040200
+	UIA_E_ELEMENTNOTAVAILABLE = 0x80040201
+	UIA_E_INVALIDOPERATION    = 0x80131509
+)
+
+// ProviderOptions
+const (
+	ProviderOptions_ClientSideProvider    = 0x1
+	ProviderOptions_ServerSideProvider    = 0x2
+	ProviderOptions_NonClientAreaProvider = 0x4
+	ProviderOptions_OverrideProvider      = 0x8
+	ProviderOptions_ProviderOwnsSetFocus  = 0x10
+	ProviderOptions_UseComThreading       = 0x20
+)
+
+// NavigateDirection
+const (
+	NavigateDirection_Parent          = 0
+	NavigateDirection_NextSibling     = 1
+	NavigateDirection_PreviousSibling = 2
+	NavigateDirection_FirstChild      = 3
+	NavigateDirection_LastChild       = 4
+)
+
+// ToggleState
+const (
+	ToggleState_Off           = 0
+	ToggleState_On            = 1
+	ToggleState_Indeterminate = 2
+)
+
+// LiveSetting
+const (
+	LiveSetting_Off       = 0
+	LiveSetting_Polite    = 1
+	LiveSetting_Assertive = 2
+)
+
+// NotificationKind
+const (
+	NotificationKind_ItemAdded       = 0
+	NotificationKind_ItemRemoved     = 1
+	NotificationKind_ActionCompleted = 2
+	NotificationKind_ActionAborted   = 3
+	NotificationKind_Other           = 4
+)
+
+// NotificationProcessing
+const (
+	NotificationProcessing_ImportantAll          = 0
+	NotificationProcessing_ImportantMostRecent   = 1
+	NotificationProcessing_All                   = 2
+	NotificationProcessing_MostRecent            = 3
+	NotificationProcessing_CurrentThenMostRecent = 4
+)
+
+// StructureChangeType
+const (
+	StructureChangeType_ChildAdded          = 0
+	StructureChangeType_ChildRemoved        = 1
+	StructureChangeType_ChildrenInvalidated = 2
+	StructureChangeType_ChildrenBulkAdded   = 3
+	StructureChangeType_ChildrenBulkRemoved = 4
+	StructureChangeType_ChildrenReordered   = 5
+)
+
+// Pattern IDs
+const (
+	UIA_InvokePatternId        = 10000
+	UIA_SelectionPatternId     = 10001
+	UIA_ValuePatternId         = 10002
+	UIA_SelectionItemPatternId = 10010
+	UIA_TogglePatternId        = 10015
+)
+
+// Event IDs
+const (
+	UIA_StructureChangedEventId                          = 20002
+	UIA_AutomationFocusChangedEventId                    = 20005
+	UIA_Invoke_InvokedEventId                            = 20009
+	UIA_SelectionItem_ElementAddedToSelectionEventId     = 20010
+	UIA_SelectionItem_ElementRemovedFromSelectionEventId = 20011
+	UIA_SelectionItem_ElementSelectedEventId             = 20012
+	UIA_LiveRegionChangedEventId                         = 20024
+	UIA_NotificationEventId                              = 20035
+)
+
+// Property IDs
+const (
+	UIA_RuntimeIdPropertyId               = 30000
+	UIA_BoundingRectanglePropertyId       = 30001
+	UIA_ProcessIdPropertyId               = 30002
+	UIA_ControlTypePropertyId             = 30003
+	UIA_LocalizedControlTypePropertyId    = 30004
+	UIA_NamePropertyId                    = 30005
+	UIA_AcceleratorKeyPropertyId          = 30006
+	UIA_AccessKeyPropertyId               = 30007
+	UIA_HasKeyboardFocusPropertyId        = 30008
+	UIA_IsKeyboardFocusablePropertyId     = 30009
+	UIA_IsEnabledPropertyId               = 30010
+	UIA_AutomationIdPropertyId            = 30011
+	UIA_ClassNamePropertyId               = 30012
+	UIA_HelpTextPropertyId                = 30013
+	UIA_IsControlElementPropertyId        = 30016
+	UIA_IsContentElementPropertyId        = 30017
+	UIA_IsPasswordPropertyId              = 30019
+	UIA_IsOffscreenPropertyId             = 30022
+	UIA_FrameworkIdPropertyId             = 30024
+	UIA_ValueValuePropertyId              = 30045
+	UIA_ValueIsReadOnlyPropertyId         = 30046
+	UIA_SelectionItemIsSelectedPropertyId = 30079
+	UIA_ToggleToggleStatePropertyId       = 30086
+	UIA_LiveSettingPropertyId             = 30135
+)
+
+// Control type IDs
+const (
+	UIA_ButtonControlTypeId      = 50000
+	UIA_CalendarControlTypeId    = 50001
+	UIA_CheckBoxControlTypeId    = 50002
+	UIA_ComboBoxControlTypeId    = 50003
+	UIA_EditControlTypeId        = 50004
+	UIA_HyperlinkControlTypeId   = 50005
+	UIA_ImageControlTypeId       = 50006
+	UIA_ListItemControlTypeId    = 50007
+	UIA_ListControlTypeId        = 50008
+	UIA_MenuControlTypeId        = 50009
+	UIA_MenuBarControlTypeId     = 50010
+	UIA_MenuItemControlTypeId    = 50011
+	UIA_ProgressBarControlTypeId = 50012
+	UIA_RadioButtonControlTypeId = 50013
+	UIA_ScrollBarControlTypeId   = 50014
+	UIA_SliderControlTypeId      = 50015
+	UIA_SpinnerControlTypeId     = 50016
+	UIA_StatusBarControlTypeId   = 50017
+	UIA_TabControlTypeId         = 50018
+	UIA_TabItemControlTypeId     = 50019
+	UIA_TextControlTypeId        = 50020
+	UIA_ToolBarControlTypeId     = 50021
+	UIA_ToolTipControlTypeId     = 50022
+	UIA_TreeControlTypeId        = 50023
+	UIA_TreeItemControlTypeId    = 50024
+	UIA_CustomControlTypeId      = 50025
+	UIA_GroupControlTypeId       = 50026
+	UIA_ThumbControlTypeId       = 50027
+	UIA_DataGridControlTypeId    = 50028
+	UIA_DataItemControlTypeId    = 50029
+	UIA_DocumentControlTypeId    = 50030
+	UIA_SplitButtonControlTypeId = 50031
+	UIA_WindowControlTypeId      = 50032
+	UIA_PaneControlTypeId        = 50033
+	UIA_HeaderControlTypeId      = 50034
+	UIA_HeaderItemControlTypeId  = 50035
+	UIA_TableControlTypeId       = 50036
+	UIA_TitleBarControlTypeId    = 50037
+	UIA_SeparatorControlTypeId   = 50038
+)
+
+var (
+	IID_IRawElementProviderSimple       = IID{0xd6dd68d1, 0x86fd, 0x4332, [8]byte{0x86, 0x66, 0x9a, 0xbe, 0xde, 0xa2, 0xd2, 0x4c}}
+	IID_IRawElementProviderFragment     = IID{0xf7063da8, 0x8359, 0x439c, [8]byte{0x92, 0x97, 0xbb, 0xc5, 0x29, 0x9a, 0x7d, 0x87}}
+	IID_IRawElementProviderFragmentRoot = IID{0x620ce2a5, 0xab8f, 0x40a9, [8]byte{0x86, 0xcb, 0xde, 0x3c, 0x75, 0x59, 0x9b, 0x58}}
+	IID_IInvokeProvider                 = IID{0x54fcb24b, 0xe18e, 0x47a2, [8]byte{0xb4, 0xd3, 0xec, 0xcb, 0xe7, 0x75, 0x99, 0xa2}}
+	IID_IValueProvider                  = IID{0xc7935180, 0x6fb3, 0x4201, [8]byte{0xb1, 0x74, 0x7d, 0xf7, 0x3a, 0xdb, 0xf6, 0x4a}}
+	IID_IToggleProvider                 = IID{0x56d00bd0, 0xc4f4, 0x433c, [8]byte{0xa8, 0x36, 0x1a, 0x52, 0xa5, 0x7e, 0x08, 0x92}}
+	IID_ISelectionProvider              = IID{0xfb8b03af, 0x3bdf, 0x48d4, [8]byte{0xbd, 0x36, 0x1a, 0x65, 0x79, 0x3b, 0xe1, 0x68}}
+	IID_ISelectionItemProvider          = IID{0x2acad808, 0xb2d4, 0x452d, [8]byte{0xa4, 0x07, 0x91, 0xff, 0x1a, 0xd1, 0x67, 0xb2}}
+)
+
+// UiaRect is a rectangle in screen coordinates, in physical pixels.
+type UiaRect struct {
+	Left, Top, Width, Height float64
+}
+
+type IRawElementProviderSimpleVtbl struct {
+	QueryInterface             uintptr
+	AddRef                     uintptr
+	Release                    uintptr
+	Get_ProviderOptions        uintptr
+	GetPatternProvider         uintptr
+	GetPropertyValue           uintptr
+	Get_HostRawElementProvider uintptr
+}
+
+type IRawElementProviderSimple struct {
+	LpVtbl *IRawElementProviderSimpleVtbl
+}
+
+func (obj *IRawElementProviderSimple) Release() uint32 {
+	ret, _, _ := syscall.Syscall(obj.LpVtbl.Release, 1,
+		uintptr(unsafe.Pointer(obj)),
+		0,
+		0)
+
+	return uint32(ret)
+}
+
+type IRawElementProviderFragmentVtbl struct {
+	QueryInterface           uintptr
+	AddRef                   uintptr
+	Release                  uintptr
+	Navigate                 uintptr
+	GetRuntimeId             uintptr
+	Get_BoundingRectangle    uintptr
+	GetEmbeddedFragmentRoots uintptr
+	SetFocus                 uintptr
+	Get_FragmentRoot         uintptr
+}
+
+type IRawElementProviderFragment struct {
+	LpVtbl *IRawElementProviderFragmentVtbl
+}
+
+type IRawElementProviderFragmentRootVtbl struct {
+	QueryInterface           uintptr
+	AddRef                   uintptr
+	Release                  uintptr
+	ElementProviderFromPoint uintptr
+	GetFocus                 uintptr
+}
+
+type IRawElementProviderFragmentRoot struct {
+	LpVtbl *IRawElementProviderFragmentRootVtbl
+}
+
+type IInvokeProviderVtbl struct {
+	QueryInterface uintptr
+	AddRef         uintptr
+	Release        uintptr
+	Invoke         uintptr
+}
+
+type IInvokeProvider struct {
+	LpVtbl *IInvokeProviderVtbl
+}
+
+type IValueProviderVtbl struct {
+	QueryInterface uintptr
+	AddRef         uintptr
+	Release        uintptr
+	SetValue       uintptr
+	Get_Value      uintptr
+	Get_IsReadOnly uintptr
+}
+
+type IValueProvider struct {
+	LpVtbl *IValueProviderVtbl
+}
+
+type IToggleProviderVtbl struct {
+	QueryInterface  uintptr
+	AddRef          uintptr
+	Release         uintptr
+	Toggle          uintptr
+	Get_ToggleState uintptr
+}
+
+type IToggleProvider struct {
+	LpVtbl *IToggleProviderVtbl
+}
+
+type ISelectionProviderVtbl struct {
+	QueryInterface          uintptr
+	AddRef                  uintptr
+	Release                 uintptr
+	GetSelection            uintptr
+	Get_CanSelectMultiple   uintptr
+	Get_IsSelectionRequired uintptr
+}
+
+type ISelectionProvider struct {
+	LpVtbl *ISelectionProviderVtbl
+}
+
+type ISelectionItemProviderVtbl struct {
+	QueryInterface         uintptr
+	AddRef                 uintptr
+	Release                uintptr
+	Select                 uintptr
+	AddToSelection         uintptr
+	RemoveFromSelection    uintptr
+	Get_IsSelected         uintptr
+	Get_SelectionContainer uintptr
+}
+
+type ISelectionItemProvider struct {
+	LpVtbl *ISelectionItemProviderVtbl
+}
+
+var (
+	// Library
+	libuiautomationcore *windows.LazyDLL
+
+	// Functions
+	uiaClientsAreListening                 *windows.LazyProc
+	uiaDisconnectProvider                  *windows.LazyProc
+	uiaHostProviderFromHwnd                *windows.LazyProc
+	uiaRaiseAutomationEvent                *windows.LazyProc
+	uiaRaiseAutomationPropertyChangedEvent *windows.LazyProc
+	uiaRaiseNotificationEvent              *windows.LazyProc
+	uiaRaiseStructureChangedEvent          *windows.LazyProc
+	uiaReturnRawElementProvider            *windows.LazyProc
+)
+
+func init() {
+	// Library
+	libuiautomationcore = windows.NewLazySystemDLL("uiautomationcore.dll")
+
+	// Functions
+	uiaClientsAreListening = libuiautomationcore.NewProc("UiaClientsAreListening")
+	uiaDisconnectProvider = libuiautomationcore.NewProc("UiaDisconnectProvider")
+	uiaHostProviderFromHwnd = libuiautomationcore.NewProc("UiaHostProviderFromHwnd")
+	uiaRaiseAutomationEvent = libuiautomationcore.NewProc("UiaRaiseAutomationEvent")
+	uiaRaiseAutomationPropertyChangedEvent = libuiautomationcore.NewProc("UiaRaiseAutomationPropertyChangedEvent")
+	uiaRaiseNotificationEvent = libuiautomationcore.NewProc("UiaRaiseNotificationEvent")
+	uiaRaiseStructureChangedEvent = libuiautomationcore.NewProc("UiaRaiseStructureChangedEvent")
+	uiaReturnRawElementProvider = libuiautomationcore.NewProc("UiaReturnRawElementProvider")
+}
+
+func UiaClientsAreListening() bool {
+	if uiaClientsAreListening.Find() != nil {
+		return false
+	}
+
+	ret, _, _ := syscall.Syscall(uiaClientsAreListening.Addr(), 0,
+		0,
+		0,
+		0)
+
+	return ret != 0
+}
+
+func UiaDisconnectProvider(provider *IRawElementProviderSimple) HRESULT {
+	if uiaDisconnectProvider.Find() != nil {
+		return -((E_NOTIMPL ^ 0xFFFFFFFF) + 1)
+	}
+
+	ret, _, _ := syscall.Syscall(uiaDisconnectProvider.Addr(), 1,
+		uintptr(unsafe.Pointer(provider)),
+		0,
+		0)
+
+	return HRESULT(ret)
+}
+
+func UiaHostProviderFromHwnd(hwnd windows.HWND, provider **IRawElementProviderSimple) HRESULT {
+	ret, _, _ := syscall.Syscall(uiaHostProviderFromHwnd.Addr(), 2,
+		uintptr(hwnd),
+		uintptr(unsafe.Pointer(provider)),
+		0)
+
+	return HRESULT(ret)
+}
+
+func UiaRaiseAutomationEvent(provider *IRawElementProviderSimple, id int32) HRESULT {
+	ret, _, _ := syscall.Syscall(uiaRaiseAutomationEvent.Addr(), 2,
+		uintptr(unsafe.Pointer(provider)),
+		uintptr(id),
+		0)
+
+	return HRESULT(ret)
+}
+
+// UiaRaiseNotificationEvent is available since Windows 10 version 1709. On
+// older versions, it returns E_NOTIMPL.
+func UiaRaiseNotificationEvent(provider *IRawElementProviderSimple, kind, processing int32, displayString, activityId *uint16 /*BSTR*/) HRESULT {
+	if uiaRaiseNotificationEvent.Find() != nil {
+		return -((E_NOTIMPL ^ 0xFFFFFFFF) + 1)
+	}
+
+	ret, _, _ := syscall.Syscall6(uiaRaiseNotificationEvent.Addr(), 5,
+		uintptr(unsafe.Pointer(provider)),
+		uintptr(kind),
+		uintptr(processing),
+		uintptr(unsafe.Pointer(displayString)),
+		uintptr(unsafe.Pointer(activityId)),
+		0)
+
+	return HRESULT(ret)
+}
+
+func UiaRaiseStructureChangedEvent(provider *IRawElementProviderSimple, changeType int32, runtimeId []int32) HRESULT {
+	var runtimeIdPtr unsafe.Pointer
+	if len(runtimeId) > 0 {
+		runtimeIdPtr = unsafe.Pointer(&runtimeId[0])
+	}
+
+	ret, _, _ := syscall.Syscall6(uiaRaiseStructureChangedEvent.Addr(), 4,
+		uintptr(unsafe.Pointer(provider)),
+		uintptr(changeType),
+		uintptr(runtimeIdPtr),
+		uintptr(len(runtimeId)),
+		0,
+		0)
+
+	return HRESULT(ret)
+}
+
+// UiaReturnRawElementProvider answers WM_GETOBJECT with provider. When the
+// window is destroyed, it must be called with zero wParam and lParam and a
+// nil provider, so UI Automation releases the providers of the window.
+func UiaReturnRawElementProvider(hwnd windows.HWND, wParam, lParam uintptr, provider *IRawElementProviderSimple) uintptr {
+	ret, _, _ := syscall.Syscall6(uiaReturnRawElementProvider.Addr(), 4,
+		uintptr(hwnd),
+		wParam,
+		lParam,
+		uintptr(unsafe.Pointer(provider)),
+		0,
+		0)
+
+	return ret
+}
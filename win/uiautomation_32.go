@@ -0,0 +1,35 @@
+// Copyright 2026 The win Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build (windows && 386) || (windows && arm)
+// +build windows,386 windows,arm
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+func (v *VARIANT) split() (uintptr, uintptr, uintptr, uintptr) {
+	x := (*struct{ a, b, c, d uintptr })(unsafe.Pointer(v))
+	return x.a, x.b, x.c, x.d
+}
+
+// UiaRaiseAutomationPropertyChangedEvent notifies UI Automation clients that
+// the property id of the element of provider changed from oldValue to
+// newValue.
+func UiaRaiseAutomationPropertyChangedEvent(provider *IRawElementProviderSimple, id int32, oldValue, newValue *VARIANT) HRESULT {
+	oldA, oldB, oldC, oldD := oldValue.split()
+	newA, newB, newC, newD := newValue.split()
+	ret, _, _ := syscall.Syscall12(uiaRaiseAutomationPropertyChangedEvent.Addr(), 10,
+		uintptr(unsafe.Pointer(provider)),
+		uintptr(id),
+		oldA, oldB, oldC, oldD,
+		newA, newB, newC, newD,
+		0,
+		0)
+
+	return HRESULT(ret)
+}
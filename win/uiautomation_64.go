@@ -0,0 +1,32 @@
+// Copyright 2026 The win Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build (windows && amd64) || (windows && arm64)
+// +build windows,amd64 windows,arm64
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// UiaRaiseAutomationPropertyChangedEvent notifies UI Automation clients that
+// the property id of the element of provider changed from oldValue to
+// newValue.
+func UiaRaiseAutomationPropertyChangedEvent(provider *IRawElementProviderSimple, id int32, oldValue, newValue *VARIANT) HRESULT {
+	// VARIANTs are passed by value, which the 64-bit calling conventions
+	// implement by reference to a copy.
+	oldCopy, newCopy := *oldValue, *newValue
+
+	ret, _, _ := syscall.Syscall6(uiaRaiseAutomationPropertyChangedEvent.Addr(), 4,
+		uintptr(unsafe.Pointer(provider)),
+		uintptr(id),
+		uintptr(unsafe.Pointer(&oldCopy)),
+		uintptr(unsafe.Pointer(&newCopy)),
+		0,
+		0)
+
+	return HRESULT(ret)
+}
@@ -1574,6 +1574,41 @@ type MONITORINFO struct {
 	DwFlags   uint32
 }
 
+// RegisterHotKey modifiers
+const (
+	MOD_ALT      = 0x0001
+	MOD_CONTROL  = 0x0002
+	MOD_SHIFT    = 0x0004
+	MOD_WIN      = 0x0008
+	MOD_NOREPEAT = 0x4000
+)
+
+// AllowSetForegroundWindow constants
+const (
+	ASFW_ANY = 0xFFFFFFFF
+)
+
+// SendMessageTimeout flags
+const (
+	SMTO_NORMAL             = 0x0000
+	SMTO_BLOCK              = 0x0001
+	SMTO_ABORTIFHUNG        = 0x0002
+	SMTO_NOTIMEOUTIFNOTHUNG = 0x0008
+	SMTO_ERRORONEXIT        = 0x0020
+)
+
+// COPYDATASTRUCT is the lParam of WM_COPYDATA.
+type COPYDATASTRUCT struct {
+	DwData uintptr
+	CbData uint32
+	LpData uintptr
+}
+
+type MONITORINFOEX struct {
+	MONITORINFO
+	SzDevice [CCHDEVICENAME]uint16
+}
+
 type (
 	HACCEL    HANDLE
 	HCURSOR   HANDLE
@@ -1862,6 +1897,7 @@ var (
 
 	// Functions
 	addClipboardFormatListener  *windows.LazyProc
+	allowSetForegroundWindow    *windows.LazyProc
 	adjustWindowRect            *windows.LazyProc
 	attachThreadInput           *windows.LazyProc
 	animateWindow               *windows.LazyProc
@@ -1874,6 +1910,7 @@ var (
 	clientToScreen              *windows.LazyProc
 	closeClipboard              *windows.LazyProc
 	createDialogParam           *windows.LazyProc
+	createIconFromResourceEx    *windows.LazyProc
 	createIconIndirect          *windows.LazyProc
 	createMenu                  *windows.LazyProc
 	createPopupMenu             *windows.LazyProc
@@ -1897,8 +1934,10 @@ var (
 	endDialog                   *windows.LazyProc
 	endPaint                    *windows.LazyProc
 	enumChildWindows            *windows.LazyProc
+	enumDisplayMonitors         *windows.LazyProc
 	fillRect                    *windows.LazyProc
 	findWindow                  *windows.LazyProc
+	findWindowEx                *windows.LazyProc
 	getActiveWindow             *windows.LazyProc
 	getAncestor                 *windows.LazyProc
 	getCaretPos                 *windows.LazyProc
@@ -1965,6 +2004,7 @@ var (
 	postQuitMessage             *windows.LazyProc
 	redrawWindow                *windows.LazyProc
 	registerClassEx             *windows.LazyProc
+	registerHotKey              *windows.LazyProc
 	registerRawInputDevices     *windows.LazyProc
 	registerWindowMessage       *windows.LazyProc
 	releaseCapture              *windows.LazyProc
@@ -1974,6 +2014,7 @@ var (
 	sendDlgItemMessage          *windows.LazyProc
 	sendInput                   *windows.LazyProc
 	sendMessage                 *windows.LazyProc
+	sendMessageTimeout          *windows.LazyProc
 	setActiveWindow             *windows.LazyProc
 	setCapture                  *windows.LazyProc
 	setClipboardData            *windows.LazyProc
@@ -2003,6 +2044,7 @@ var (
 	trackPopupMenuEx            *windows.LazyProc
 	translateMessage            *windows.LazyProc
 	unhookWinEvent              *windows.LazyProc
+	unregisterHotKey            *windows.LazyProc
 	updateWindow                *windows.LazyProc
 	vkKeyScan                   *windows.LazyProc
 	windowFromDC                *windows.LazyProc
@@ -2058,6 +2100,7 @@ func init() {
 
 	// Functions
 	addClipboardFormatListener = libuser32.NewProc("AddClipboardFormatListener")
+	allowSetForegroundWindow = libuser32.NewProc("AllowSetForegroundWindow")
 	adjustWindowRect = libuser32.NewProc("AdjustWindowRect")
 	attachThreadInput = libuser32.NewProc("AttachThreadInput")
 	animateWindow = libuser32.NewProc("AnimateWindow")
@@ -2070,6 +2113,7 @@ func init() {
 	clientToScreen = libuser32.NewProc("ClientToScreen")
 	closeClipboard = libuser32.NewProc("CloseClipboard")
 	createDialogParam = libuser32.NewProc("CreateDialogParamW")
+	createIconFromResourceEx = libuser32.NewProc("CreateIconFromResourceEx")
 	createIconIndirect = libuser32.NewProc("CreateIconIndirect")
 	createMenu = libuser32.NewProc("CreateMenu")
 	createPopupMenu = libuser32.NewProc("CreatePopupMenu")
@@ -2093,8 +2137,10 @@ func init() {
 	endDialog = libuser32.NewProc("EndDialog")
 	endPaint = libuser32.NewProc("EndPaint")
 	enumChildWindows = libuser32.NewProc("EnumChildWindows")
+	enumDisplayMonitors = libuser32.NewProc("EnumDisplayMonitors")
 	fillRect = libuser32.NewProc("FillRect")
 	findWindow = libuser32.NewProc("FindWindowW")
+	findWindowEx = libuser32.NewProc("FindWindowExW")
 	getActiveWindow = libuser32.NewProc("GetActiveWindow")
 	getAncestor = libuser32.NewProc("GetAncestor")
 	getCaretPos = libuser32.NewProc("GetCaretPos")
@@ -2166,6 +2212,7 @@ func init() {
 	postQuitMessage = libuser32.NewProc("PostQuitMessage")
 	redrawWindow = libuser32.NewProc("RedrawWindow")
 	registerClassEx = libuser32.NewProc("RegisterClassExW")
+	registerHotKey = libuser32.NewProc("RegisterHotKey")
 	registerRawInputDevices = libuser32.NewProc("RegisterRawInputDevices")
 	registerWindowMessage = libuser32.NewProc("RegisterWindowMessageW")
 	releaseCapture = libuser32.NewProc("ReleaseCapture")
@@ -2175,6 +2222,7 @@ func init() {
 	sendDlgItemMessage = libuser32.NewProc("SendDlgItemMessageW")
 	sendInput = libuser32.NewProc("SendInput")
 	sendMessage = libuser32.NewProc("SendMessageW")
+	sendMessageTimeout = libuser32.NewProc("SendMessageTimeoutW")
 	setActiveWindow = libuser32.NewProc("SetActiveWindow")
 	setCapture = libuser32.NewProc("SetCapture")
 	setClipboardData = libuser32.NewProc("SetClipboardData")
@@ -2209,12 +2257,24 @@ func init() {
 	trackPopupMenuEx = libuser32.NewProc("TrackPopupMenuEx")
 	translateMessage = libuser32.NewProc("TranslateMessage")
 	unhookWinEvent = libuser32.NewProc("UnhookWinEvent")
+	unregisterHotKey = libuser32.NewProc("UnregisterHotKey")
 	updateWindow = libuser32.NewProc("UpdateWindow")
 	vkKeyScan = libuser32.NewProc("VkKeyScanW")
 	windowFromDC = libuser32.NewProc("WindowFromDC")
 	windowFromPoint = libuser32.NewProc("WindowFromPoint")
 }
 
+// AllowSetForegroundWindow enables the process dwProcessId, or any process
+// for ASFW_ANY, to set the foreground window.
+func AllowSetForegroundWindow(dwProcessId uint32) bool {
+	ret, _, _ := syscall.Syscall(allowSetForegroundWindow.Addr(), 1,
+		uintptr(dwProcessId),
+		0,
+		0)
+
+	return ret != 0
+}
+
 func AddClipboardFormatListener(hwnd windows.HWND) bool {
 	if addClipboardFormatListener.Find() != nil {
 		return false
@@ -2356,6 +2416,21 @@ func CreateDialogParam(instRes HINSTANCE, name *uint16, parent windows.HWND,
 	return windows.HWND(ret)
 }
 
+func CreateIconFromResourceEx(presbits *byte, dwResSize uint32, fIcon BOOL, dwVer uint32, cxDesired, cyDesired int32, flags uint32) HICON {
+	ret, _, _ := syscall.Syscall9(createIconFromResourceEx.Addr(), 7,
+		uintptr(unsafe.Pointer(presbits)),
+		uintptr(dwResSize),
+		uintptr(fIcon),
+		uintptr(dwVer),
+		uintptr(cxDesired),
+		uintptr(cyDesired),
+		uintptr(flags),
+		0,
+		0)
+
+	return HICON(ret)
+}
+
 func CreateIconIndirect(lpiconinfo *ICONINFO) HICON {
 	ret, _, _ := syscall.Syscall(createIconIndirect.Addr(), 1,
 		uintptr(unsafe.Pointer(lpiconinfo)),
@@ -2593,6 +2668,20 @@ func EnumChildWindows(hWndParent windows.HWND, lpEnumFunc, lParam uintptr) bool
 	return ret != 0
 }
 
+// EnumDisplayMonitors calls lpfnEnum, a callback created with
+// syscall.NewCallback, for each monitor that intersects lprcClip.
+func EnumDisplayMonitors(hdc HDC, lprcClip *RECT, lpfnEnum, dwData uintptr) bool {
+	ret, _, _ := syscall.Syscall6(enumDisplayMonitors.Addr(), 4,
+		uintptr(hdc),
+		uintptr(unsafe.Pointer(lprcClip)),
+		lpfnEnum,
+		dwData,
+		0,
+		0)
+
+	return ret != 0
+}
+
 func FillRect(hdc HDC, rect *RECT, hbrush HBRUSH) bool {
 	ret, _, _ := syscall.Syscall(fillRect.Addr(), 3,
 		uintptr(hdc),
@@ -2602,6 +2691,20 @@ func FillRect(hdc HDC, rect *RECT, hbrush HBRUSH) bool {
 	return ret != 0
 }
 
+// FindWindowEx finds a child window of hWndParent, which may be HWND_MESSAGE
+// to find message-only windows, after hWndChildAfter.
+func FindWindowEx(hWndParent, hWndChildAfter windows.HWND, lpszClass, lpszWindow *uint16) windows.HWND {
+	ret, _, _ := syscall.Syscall6(findWindowEx.Addr(), 4,
+		uintptr(hWndParent),
+		uintptr(hWndChildAfter),
+		uintptr(unsafe.Pointer(lpszClass)),
+		uintptr(unsafe.Pointer(lpszWindow)),
+		0,
+		0)
+
+	return windows.HWND(ret)
+}
+
 func FindWindow(lpClassName, lpWindowName *uint16) windows.HWND {
 	ret, _, _ := syscall.Syscall(findWindow.Addr(), 2,
 		uintptr(unsafe.Pointer(lpClassName)),
@@ -2951,7 +3054,7 @@ func GetWindow(hWnd windows.HWND, uCmd uint32) windows.HWND {
 
 // GetWindowLong retrieves information about the window class to which the specified window belongs.
 func GetWindowLong(hWnd windows.HWND, index int32) (uint32, error) {
-	ret, _, err := getWindowLong.Call(2, uintptr(hWnd), uintptr(index))
+	ret, _, err := getWindowLong.Call(uintptr(hWnd), uintptr(index))
 	if ret == 0 {
 		return 0, err
 	}
@@ -3398,6 +3501,48 @@ func SendInput(nInputs uint32, pInputs unsafe.Pointer, cbSize int32) uint32 {
 	return uint32(ret)
 }
 
+// RegisterHotKey defines a system-wide hot key, which posts WM_HOTKEY with
+// wParam id to hWnd.
+func RegisterHotKey(hWnd windows.HWND, id int32, fsModifiers, vk uint32) bool {
+	ret, _, _ := syscall.Syscall6(registerHotKey.Addr(), 4,
+		uintptr(hWnd),
+		uintptr(id),
+		uintptr(fsModifiers),
+		uintptr(vk),
+		0,
+		0)
+
+	return ret != 0
+}
+
+// UnregisterHotKey frees a hot key registered with RegisterHotKey.
+func UnregisterHotKey(hWnd windows.HWND, id int32) bool {
+	ret, _, _ := syscall.Syscall(unregisterHotKey.Addr(), 2,
+		uintptr(hWnd),
+		uintptr(id),
+		0)
+
+	return ret != 0
+}
+
+// SendMessageTimeout sends a message and waits at most uTimeout
+// milliseconds for it to be processed. It returns false if the call failed
+// or timed out.
+func SendMessageTimeout(hWnd windows.HWND, msg uint32, wParam, lParam uintptr, fuFlags, uTimeout uint32, lpdwResult *uintptr) bool {
+	ret, _, _ := syscall.Syscall9(sendMessageTimeout.Addr(), 7,
+		uintptr(hWnd),
+		uintptr(msg),
+		wParam,
+		lParam,
+		uintptr(fuFlags),
+		uintptr(uTimeout),
+		uintptr(unsafe.Pointer(lpdwResult)),
+		0,
+		0)
+
+	return ret != 0
+}
+
 func SendMessage(hWnd windows.HWND, msg uint32, wParam, lParam uintptr) uintptr {
 	ret, _, _ := syscall.Syscall6(sendMessage.Addr(), 4,
 		uintptr(hWnd),
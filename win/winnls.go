@@ -7,6 +7,13 @@
 
 package win
 
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
 const (
 	//  Code Page Default Values.
 	//  Please Use Unicode, either UTF-16 (as in WCHAR) or UTF-8 (code page CP_ACP)
@@ -19,3 +26,56 @@ const (
 	CP_UTF7 = 65000 // UTF-7 translation
 	CP_UTF8 = 65001 // UTF-8 translation
 )
+
+// LCTYPE constants
+const (
+	LOCALE_SNAME             LCTYPE = 0x5c
+	LOCALE_SGROUPING         LCTYPE = 0x10
+	LOCALE_SNEGATIVESIGN     LCTYPE = 0x51
+	LOCALE_INEGNUMBER        LCTYPE = 0x1010
+	LOCALE_SCURRENCY         LCTYPE = 0x14
+	LOCALE_SMONDECIMALSEP    LCTYPE = 0x16
+	LOCALE_SMONTHOUSANDSEP   LCTYPE = 0x17
+	LOCALE_SMONGROUPING      LCTYPE = 0x18
+	LOCALE_ICURRDIGITS       LCTYPE = 0x19
+	LOCALE_ICURRENCY         LCTYPE = 0x1b
+	LOCALE_INEGCURR          LCTYPE = 0x1c
+	LOCALE_SSHORTDATE        LCTYPE = 0x1f
+	LOCALE_SLONGDATE         LCTYPE = 0x20
+	LOCALE_S1159             LCTYPE = 0x28
+	LOCALE_S2359             LCTYPE = 0x29
+	LOCALE_SDAYNAME1         LCTYPE = 0x2a
+	LOCALE_SABBREVDAYNAME1   LCTYPE = 0x31
+	LOCALE_SMONTHNAME1       LCTYPE = 0x38
+	LOCALE_SABBREVMONTHNAME1 LCTYPE = 0x44
+	LOCALE_INEGATIVEPERCENT  LCTYPE = 0x74
+	LOCALE_IPOSITIVEPERCENT  LCTYPE = 0x75
+	LOCALE_SPERCENT          LCTYPE = 0x76
+	LOCALE_SSHORTTIME        LCTYPE = 0x79
+	LOCALE_STIMEFORMAT       LCTYPE = 0x1003
+)
+
+// LOCALE_NAME_MAX_LENGTH is the maximum length of a locale name, including the terminating nul.
+const LOCALE_NAME_MAX_LENGTH = 85
+
+var (
+	getLocaleInfoEx *windows.LazyProc
+)
+
+func init() {
+	getLocaleInfoEx = libkernel32.NewProc("GetLocaleInfoEx")
+}
+
+// GetLocaleInfoEx retrieves information about the locale named lpLocaleName. A nil
+// lpLocaleName selects the user default locale.
+func GetLocaleInfoEx(lpLocaleName *uint16, LCType LCTYPE, lpLCData *uint16, cchData int32) int32 {
+	ret, _, _ := syscall.Syscall6(getLocaleInfoEx.Addr(), 4,
+		uintptr(unsafe.Pointer(lpLocaleName)),
+		uintptr(LCType),
+		uintptr(unsafe.Pointer(lpLCData)),
+		uintptr(cchData),
+		0,
+		0)
+
+	return int32(ret)
+}
@@ -43,74 +43,97 @@ var (
 	modgdiplus  = windows.NewLazySystemDLL("gdiplus.dll")
 	moduxtheme  = windows.NewLazySystemDLL("uxtheme.dll")
 
-	procTaskDialogIndirect                    = modcomctl32.NewProc("TaskDialogIndirect")
-	procGdipAddPathEllipseI                   = modgdiplus.NewProc("GdipAddPathEllipseI")
-	procGdipCreateBitmapFromFile              = modgdiplus.NewProc("GdipCreateBitmapFromFile")
-	procGdipCreateBitmapFromGraphics          = modgdiplus.NewProc("GdipCreateBitmapFromGraphics")
-	procGdipCreateBitmapFromHBITMAP           = modgdiplus.NewProc("GdipCreateBitmapFromHBITMAP")
-	procGdipCreateBitmapFromHICON             = modgdiplus.NewProc("GdipCreateBitmapFromHICON")
-	procGdipCreateBitmapFromScan0             = modgdiplus.NewProc("GdipCreateBitmapFromScan0")
-	procGdipCreateBitmapFromStream            = modgdiplus.NewProc("GdipCreateBitmapFromStream")
-	procGdipCreateFontFamilyFromName          = modgdiplus.NewProc("GdipCreateFontFamilyFromName")
-	procGdipCreateFromHDC                     = modgdiplus.NewProc("GdipCreateFromHDC")
-	procGdipCreateHBITMAPFromBitmap           = modgdiplus.NewProc("GdipCreateHBITMAPFromBitmap")
-	procGdipCreateHICONFromBitmap             = modgdiplus.NewProc("GdipCreateHICONFromBitmap")
-	procGdipCreatePath                        = modgdiplus.NewProc("GdipCreatePath")
-	procGdipCreateSolidFill                   = modgdiplus.NewProc("GdipCreateSolidFill")
-	procGdipCreateStringFormat                = modgdiplus.NewProc("GdipCreateStringFormat")
-	procGdipDeleteBrush                       = modgdiplus.NewProc("GdipDeleteBrush")
-	procGdipDeleteFont                        = modgdiplus.NewProc("GdipDeleteFont")
-	procGdipDeleteFontFamily                  = modgdiplus.NewProc("GdipDeleteFontFamily")
-	procGdipDeleteGraphics                    = modgdiplus.NewProc("GdipDeleteGraphics")
-	procGdipDeletePath                        = modgdiplus.NewProc("GdipDeletePath")
-	procGdipDeleteStringFormat                = modgdiplus.NewProc("GdipDeleteStringFormat")
-	procGdipDisposeImage                      = modgdiplus.NewProc("GdipDisposeImage")
-	procGdipDrawImageRectI                    = modgdiplus.NewProc("GdipDrawImageRectI")
-	procGdipDrawImageRectRectI                = modgdiplus.NewProc("GdipDrawImageRectRectI")
-	procGdipDrawString                        = modgdiplus.NewProc("GdipDrawString")
-	procGdipFillEllipseI                      = modgdiplus.NewProc("GdipFillEllipseI")
-	procGdipGetCompositingMode                = modgdiplus.NewProc("GdipGetCompositingMode")
-	procGdipGetGenericFontFamilyMonospace     = modgdiplus.NewProc("GdipGetGenericFontFamilyMonospace")
-	procGdipGetGenericFontFamilySansSerif     = modgdiplus.NewProc("GdipGetGenericFontFamilySansSerif")
-	procGdipGetGenericFontFamilySerif         = modgdiplus.NewProc("GdipGetGenericFontFamilySerif")
-	procGdipGetImageDimension                 = modgdiplus.NewProc("GdipGetImageDimension")
-	procGdipGetImageGraphicsContext           = modgdiplus.NewProc("GdipGetImageGraphicsContext")
-	procGdipGetImageHeight                    = modgdiplus.NewProc("GdipGetImageHeight")
-	procGdipGetImageHorizontalResolution      = modgdiplus.NewProc("GdipGetImageHorizontalResolution")
-	procGdipGetImageVerticalResolution        = modgdiplus.NewProc("GdipGetImageVerticalResolution")
-	procGdipGetImageWidth                     = modgdiplus.NewProc("GdipGetImageWidth")
-	procGdipGraphicsClear                     = modgdiplus.NewProc("GdipGraphicsClear")
-	procGdipResetClip                         = modgdiplus.NewProc("GdipResetClip")
-	procGdipSetClipPath                       = modgdiplus.NewProc("GdipSetClipPath")
-	procGdipSetCompositingMode                = modgdiplus.NewProc("GdipSetCompositingMode")
-	procGdipSetCompositingQuality             = modgdiplus.NewProc("GdipSetCompositingQuality")
-	procGdipSetInterpolationMode              = modgdiplus.NewProc("GdipSetInterpolationMode")
-	procGdipSetPixelOffsetMode                = modgdiplus.NewProc("GdipSetPixelOffsetMode")
-	procGdipSetSmoothingMode                  = modgdiplus.NewProc("GdipSetSmoothingMode")
-	procGdipSetStringFormatAlign              = modgdiplus.NewProc("GdipSetStringFormatAlign")
-	procGdipSetStringFormatLineAlign          = modgdiplus.NewProc("GdipSetStringFormatLineAlign")
-	procGdipSetTextRenderingHint              = modgdiplus.NewProc("GdipSetTextRenderingHint")
-	procGdiplusStartup                        = modgdiplus.NewProc("GdiplusStartup")
-	procBeginBufferedPaint                    = moduxtheme.NewProc("BeginBufferedPaint")
-	procBufferedPaintInit                     = moduxtheme.NewProc("BufferedPaintInit")
-	procCloseThemeData                        = moduxtheme.NewProc("CloseThemeData")
-	procDrawThemeBackground                   = moduxtheme.NewProc("DrawThemeBackground")
-	procDrawThemeParentBackground             = moduxtheme.NewProc("DrawThemeParentBackground")
-	procDrawThemeTextEx                       = moduxtheme.NewProc("DrawThemeTextEx")
-	procEndBufferedPaint                      = moduxtheme.NewProc("EndBufferedPaint")
-	procGetThemeColor                         = moduxtheme.NewProc("GetThemeColor")
-	procGetThemeEnumValue                     = moduxtheme.NewProc("GetThemeEnumValue")
-	procGetThemeFont                          = moduxtheme.NewProc("GetThemeFont")
-	procGetThemeInt                           = moduxtheme.NewProc("GetThemeInt")
-	procGetThemeMargins                       = moduxtheme.NewProc("GetThemeMargins")
-	procGetThemeMetric                        = moduxtheme.NewProc("GetThemeMetric")
-	procGetThemePartSize                      = moduxtheme.NewProc("GetThemePartSize")
-	procGetThemeSysFont                       = moduxtheme.NewProc("GetThemeSysFont")
-	procGetThemeTextExtent                    = moduxtheme.NewProc("GetThemeTextExtent")
-	procIsAppThemed                           = moduxtheme.NewProc("IsAppThemed")
-	procIsThemeBackgroundPartiallyTransparent = moduxtheme.NewProc("IsThemeBackgroundPartiallyTransparent")
-	procOpenThemeData                         = moduxtheme.NewProc("OpenThemeData")
-	procSetWindowTheme                        = moduxtheme.NewProc("SetWindowTheme")
+	procTaskDialogIndirect                         = modcomctl32.NewProc("TaskDialogIndirect")
+	procGdipAddPathEllipseI                        = modgdiplus.NewProc("GdipAddPathEllipseI")
+	procGdipAddPathLine2                           = modgdiplus.NewProc("GdipAddPathLine2")
+	procGdipAddPathPolygon                         = modgdiplus.NewProc("GdipAddPathPolygon")
+	procGdipClosePathFigure                        = modgdiplus.NewProc("GdipClosePathFigure")
+	procGdipCreateBitmapFromFile                   = modgdiplus.NewProc("GdipCreateBitmapFromFile")
+	procGdipCreateBitmapFromGraphics               = modgdiplus.NewProc("GdipCreateBitmapFromGraphics")
+	procGdipCreateBitmapFromHBITMAP                = modgdiplus.NewProc("GdipCreateBitmapFromHBITMAP")
+	procGdipCreateBitmapFromHICON                  = modgdiplus.NewProc("GdipCreateBitmapFromHICON")
+	procGdipCreateBitmapFromScan0                  = modgdiplus.NewProc("GdipCreateBitmapFromScan0")
+	procGdipCreateBitmapFromStream                 = modgdiplus.NewProc("GdipCreateBitmapFromStream")
+	procGdipCreateFontFamilyFromName               = modgdiplus.NewProc("GdipCreateFontFamilyFromName")
+	procGdipCreateFromHDC                          = modgdiplus.NewProc("GdipCreateFromHDC")
+	procGdipCreateHBITMAPFromBitmap                = modgdiplus.NewProc("GdipCreateHBITMAPFromBitmap")
+	procGdipCreateHICONFromBitmap                  = modgdiplus.NewProc("GdipCreateHICONFromBitmap")
+	procGdipCreateLineBrush                        = modgdiplus.NewProc("GdipCreateLineBrush")
+	procGdipCreatePath                             = modgdiplus.NewProc("GdipCreatePath")
+	procGdipCreatePathGradientFromPath             = modgdiplus.NewProc("GdipCreatePathGradientFromPath")
+	procGdipCreateSolidFill                        = modgdiplus.NewProc("GdipCreateSolidFill")
+	procGdipCreateStringFormat                     = modgdiplus.NewProc("GdipCreateStringFormat")
+	procGdipDeleteBrush                            = modgdiplus.NewProc("GdipDeleteBrush")
+	procGdipDeleteFont                             = modgdiplus.NewProc("GdipDeleteFont")
+	procGdipDeleteFontFamily                       = modgdiplus.NewProc("GdipDeleteFontFamily")
+	procGdipDeleteGraphics                         = modgdiplus.NewProc("GdipDeleteGraphics")
+	procGdipDeletePath                             = modgdiplus.NewProc("GdipDeletePath")
+	procGdipDeletePen                              = modgdiplus.NewProc("GdipDeletePen")
+	procGdipDeleteStringFormat                     = modgdiplus.NewProc("GdipDeleteStringFormat")
+	procGdipDisposeImage                           = modgdiplus.NewProc("GdipDisposeImage")
+	procGdipDrawImageRectI                         = modgdiplus.NewProc("GdipDrawImageRectI")
+	procGdipDrawImageRectRectI                     = modgdiplus.NewProc("GdipDrawImageRectRectI")
+	procGdipDrawPath                               = modgdiplus.NewProc("GdipDrawPath")
+	procGdipDrawString                             = modgdiplus.NewProc("GdipDrawString")
+	procGdipFillEllipseI                           = modgdiplus.NewProc("GdipFillEllipseI")
+	procGdipFillPath                               = modgdiplus.NewProc("GdipFillPath")
+	procGdipGetCompositingMode                     = modgdiplus.NewProc("GdipGetCompositingMode")
+	procGdipGetGenericFontFamilyMonospace          = modgdiplus.NewProc("GdipGetGenericFontFamilyMonospace")
+	procGdipGetGenericFontFamilySansSerif          = modgdiplus.NewProc("GdipGetGenericFontFamilySansSerif")
+	procGdipGetGenericFontFamilySerif              = modgdiplus.NewProc("GdipGetGenericFontFamilySerif")
+	procGdipGetImageDimension                      = modgdiplus.NewProc("GdipGetImageDimension")
+	procGdipGetImageGraphicsContext                = modgdiplus.NewProc("GdipGetImageGraphicsContext")
+	procGdipGetImageHeight                         = modgdiplus.NewProc("GdipGetImageHeight")
+	procGdipGetImageHorizontalResolution           = modgdiplus.NewProc("GdipGetImageHorizontalResolution")
+	procGdipGetImageVerticalResolution             = modgdiplus.NewProc("GdipGetImageVerticalResolution")
+	procGdipGetImageWidth                          = modgdiplus.NewProc("GdipGetImageWidth")
+	procGdipGraphicsClear                          = modgdiplus.NewProc("GdipGraphicsClear")
+	procGdipMeasureString                          = modgdiplus.NewProc("GdipMeasureString")
+	procGdipResetClip                              = modgdiplus.NewProc("GdipResetClip")
+	procGdipResetPath                              = modgdiplus.NewProc("GdipResetPath")
+	procGdipResetWorldTransform                    = modgdiplus.NewProc("GdipResetWorldTransform")
+	procGdipRestoreGraphics                        = modgdiplus.NewProc("GdipRestoreGraphics")
+	procGdipSaveGraphics                           = modgdiplus.NewProc("GdipSaveGraphics")
+	procGdipSetClipPath                            = modgdiplus.NewProc("GdipSetClipPath")
+	procGdipSetCompositingMode                     = modgdiplus.NewProc("GdipSetCompositingMode")
+	procGdipSetCompositingQuality                  = modgdiplus.NewProc("GdipSetCompositingQuality")
+	procGdipSetInterpolationMode                   = modgdiplus.NewProc("GdipSetInterpolationMode")
+	procGdipSetLinePresetBlend                     = modgdiplus.NewProc("GdipSetLinePresetBlend")
+	procGdipSetPathGradientCenterColor             = modgdiplus.NewProc("GdipSetPathGradientCenterColor")
+	procGdipSetPathGradientCenterPoint             = modgdiplus.NewProc("GdipSetPathGradientCenterPoint")
+	procGdipSetPathGradientPresetBlend             = modgdiplus.NewProc("GdipSetPathGradientPresetBlend")
+	procGdipSetPathGradientSurroundColorsWithCount = modgdiplus.NewProc("GdipSetPathGradientSurroundColorsWithCount")
+	procGdipSetPenDashArray                        = modgdiplus.NewProc("GdipSetPenDashArray")
+	procGdipSetPenDashStyle                        = modgdiplus.NewProc("GdipSetPenDashStyle")
+	procGdipSetPenLineCap197819                    = modgdiplus.NewProc("GdipSetPenLineCap197819")
+	procGdipSetPenLineJoin                         = modgdiplus.NewProc("GdipSetPenLineJoin")
+	procGdipSetPixelOffsetMode                     = modgdiplus.NewProc("GdipSetPixelOffsetMode")
+	procGdipSetSmoothingMode                       = modgdiplus.NewProc("GdipSetSmoothingMode")
+	procGdipSetStringFormatAlign                   = modgdiplus.NewProc("GdipSetStringFormatAlign")
+	procGdipSetStringFormatLineAlign               = modgdiplus.NewProc("GdipSetStringFormatLineAlign")
+	procGdipSetTextRenderingHint                   = modgdiplus.NewProc("GdipSetTextRenderingHint")
+	procGdipStartPathFigure                        = modgdiplus.NewProc("GdipStartPathFigure")
+	procGdiplusStartup                             = modgdiplus.NewProc("GdiplusStartup")
+	procBeginBufferedPaint                         = moduxtheme.NewProc("BeginBufferedPaint")
+	procBufferedPaintInit                          = moduxtheme.NewProc("BufferedPaintInit")
+	procCloseThemeData                             = moduxtheme.NewProc("CloseThemeData")
+	procDrawThemeBackground                        = moduxtheme.NewProc("DrawThemeBackground")
+	procDrawThemeParentBackground                  = moduxtheme.NewProc("DrawThemeParentBackground")
+	procDrawThemeTextEx                            = moduxtheme.NewProc("DrawThemeTextEx")
+	procEndBufferedPaint                           = moduxtheme.NewProc("EndBufferedPaint")
+	procGetThemeColor                              = moduxtheme.NewProc("GetThemeColor")
+	procGetThemeEnumValue                          = moduxtheme.NewProc("GetThemeEnumValue")
+	procGetThemeFont                               = moduxtheme.NewProc("GetThemeFont")
+	procGetThemeInt                                = moduxtheme.NewProc("GetThemeInt")
+	procGetThemeMargins                            = moduxtheme.NewProc("GetThemeMargins")
+	procGetThemeMetric                             = moduxtheme.NewProc("GetThemeMetric")
+	procGetThemePartSize                           = moduxtheme.NewProc("GetThemePartSize")
+	procGetThemeSysFont                            = moduxtheme.NewProc("GetThemeSysFont")
+	procGetThemeTextExtent                         = moduxtheme.NewProc("GetThemeTextExtent")
+	procIsAppThemed                                = moduxtheme.NewProc("IsAppThemed")
+	procIsThemeBackgroundPartiallyTransparent      = moduxtheme.NewProc("IsThemeBackgroundPartiallyTransparent")
+	procOpenThemeData                              = moduxtheme.NewProc("OpenThemeData")
+	procSetWindowTheme                             = moduxtheme.NewProc("SetWindowTheme")
 )
 
 func TaskDialogIndirect(pTaskConfig *TASKDIALOGCONFIG, pnButton *int32, pnRadioButton *int32, pfVerificationFlagChecked *BOOL) (ret HRESULT) {
@@ -125,6 +148,24 @@ func GdipAddPathEllipseI(path *GpPath, x int32, y int32, width int32, height int
 	return
 }
 
+func GdipAddPathLine2(path *GpPath, points *GpPointF, count int32) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipAddPathLine2.Addr(), 3, uintptr(unsafe.Pointer(path)), uintptr(unsafe.Pointer(points)), uintptr(count))
+	ret = GpStatus(r0)
+	return
+}
+
+func GdipAddPathPolygon(path *GpPath, points *GpPointF, count int32) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipAddPathPolygon.Addr(), 3, uintptr(unsafe.Pointer(path)), uintptr(unsafe.Pointer(points)), uintptr(count))
+	ret = GpStatus(r0)
+	return
+}
+
+func GdipClosePathFigure(path *GpPath) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipClosePathFigure.Addr(), 1, uintptr(unsafe.Pointer(path)), 0, 0)
+	ret = GpStatus(r0)
+	return
+}
+
 func GdipCreateBitmapFromFile(filename *uint16, bitmap **GpBitmap) (ret GpStatus) {
 	r0, _, _ := syscall.Syscall(procGdipCreateBitmapFromFile.Addr(), 2, uintptr(unsafe.Pointer(filename)), uintptr(unsafe.Pointer(bitmap)), 0)
 	ret = GpStatus(r0)
@@ -185,12 +226,24 @@ func GdipCreateHICONFromBitmap(bitmap *GpBitmap, hbmReturn *HICON) (ret GpStatus
 	return
 }
 
+func GdipCreateLineBrush(point1 *GpPointF, point2 *GpPointF, color1 ARGB, color2 ARGB, wrapMode WrapMode, lineGradient **GpLineGradient) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall6(procGdipCreateLineBrush.Addr(), 6, uintptr(unsafe.Pointer(point1)), uintptr(unsafe.Pointer(point2)), uintptr(color1), uintptr(color2), uintptr(wrapMode), uintptr(unsafe.Pointer(lineGradient)))
+	ret = GpStatus(r0)
+	return
+}
+
 func GdipCreatePath(fillMode FillMode, path **GpPath) (ret GpStatus) {
 	r0, _, _ := syscall.Syscall(procGdipCreatePath.Addr(), 2, uintptr(fillMode), uintptr(unsafe.Pointer(path)), 0)
 	ret = GpStatus(r0)
 	return
 }
 
+func GdipCreatePathGradientFromPath(path *GpPath, polyGradient **GpPathGradient) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipCreatePathGradientFromPath.Addr(), 2, uintptr(unsafe.Pointer(path)), uintptr(unsafe.Pointer(polyGradient)), 0)
+	ret = GpStatus(r0)
+	return
+}
+
 func GdipCreateSolidFill(color ARGB, brush **GpSolidFill) (ret GpStatus) {
 	r0, _, _ := syscall.Syscall(procGdipCreateSolidFill.Addr(), 2, uintptr(color), uintptr(unsafe.Pointer(brush)), 0)
 	ret = GpStatus(r0)
@@ -233,6 +286,12 @@ func GdipDeletePath(path *GpPath) (ret GpStatus) {
 	return
 }
 
+func GdipDeletePen(pen *GpPen) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipDeletePen.Addr(), 1, uintptr(unsafe.Pointer(pen)), 0, 0)
+	ret = GpStatus(r0)
+	return
+}
+
 func GdipDeleteStringFormat(format *GpStringFormat) (ret GpStatus) {
 	r0, _, _ := syscall.Syscall(procGdipDeleteStringFormat.Addr(), 1, uintptr(unsafe.Pointer(format)), 0, 0)
 	ret = GpStatus(r0)
@@ -257,6 +316,12 @@ func GdipDrawImageRectRectI(graphics *GpGraphics, image *GpImage, dstX int32, ds
 	return
 }
 
+func GdipDrawPath(graphics *GpGraphics, pen *GpPen, path *GpPath) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipDrawPath.Addr(), 3, uintptr(unsafe.Pointer(graphics)), uintptr(unsafe.Pointer(pen)), uintptr(unsafe.Pointer(path)))
+	ret = GpStatus(r0)
+	return
+}
+
 func GdipDrawString(graphics *GpGraphics, text *uint16, textLength int32, font *GpFont, rectf *GpRectF, strFmt *GpStringFormat, brush *GpBrush) (ret GpStatus) {
 	r0, _, _ := syscall.Syscall9(procGdipDrawString.Addr(), 7, uintptr(unsafe.Pointer(graphics)), uintptr(unsafe.Pointer(text)), uintptr(textLength), uintptr(unsafe.Pointer(font)), uintptr(unsafe.Pointer(rectf)), uintptr(unsafe.Pointer(strFmt)), uintptr(unsafe.Pointer(brush)), 0, 0)
 	ret = GpStatus(r0)
@@ -269,6 +334,12 @@ func GdipFillEllipseI(graphics *GpGraphics, brush *GpBrush, x int32, y int32, wi
 	return
 }
 
+func GdipFillPath(graphics *GpGraphics, brush *GpBrush, path *GpPath) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipFillPath.Addr(), 3, uintptr(unsafe.Pointer(graphics)), uintptr(unsafe.Pointer(brush)), uintptr(unsafe.Pointer(path)))
+	ret = GpStatus(r0)
+	return
+}
+
 func GdipGetCompositingMode(graphics *GpGraphics, compositingMode *CompositingMode) (ret GpStatus) {
 	r0, _, _ := syscall.Syscall(procGdipGetCompositingMode.Addr(), 2, uintptr(unsafe.Pointer(graphics)), uintptr(unsafe.Pointer(compositingMode)), 0)
 	ret = GpStatus(r0)
@@ -335,12 +406,42 @@ func GdipGraphicsClear(graphics *GpGraphics, color ARGB) (ret GpStatus) {
 	return
 }
 
+func GdipMeasureString(graphics *GpGraphics, text *uint16, length int32, font *GpFont, layoutRect *GpRectF, stringFormat *GpStringFormat, boundingBox *GpRectF, codepointsFitted *int32, linesFilled *int32) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall9(procGdipMeasureString.Addr(), 9, uintptr(unsafe.Pointer(graphics)), uintptr(unsafe.Pointer(text)), uintptr(length), uintptr(unsafe.Pointer(font)), uintptr(unsafe.Pointer(layoutRect)), uintptr(unsafe.Pointer(stringFormat)), uintptr(unsafe.Pointer(boundingBox)), uintptr(unsafe.Pointer(codepointsFitted)), uintptr(unsafe.Pointer(linesFilled)))
+	ret = GpStatus(r0)
+	return
+}
+
 func GdipResetClip(graphics *GpGraphics) (ret GpStatus) {
 	r0, _, _ := syscall.Syscall(procGdipResetClip.Addr(), 1, uintptr(unsafe.Pointer(graphics)), 0, 0)
 	ret = GpStatus(r0)
 	return
 }
 
+func GdipResetPath(path *GpPath) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipResetPath.Addr(), 1, uintptr(unsafe.Pointer(path)), 0, 0)
+	ret = GpStatus(r0)
+	return
+}
+
+func GdipResetWorldTransform(graphics *GpGraphics) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipResetWorldTransform.Addr(), 1, uintptr(unsafe.Pointer(graphics)), 0, 0)
+	ret = GpStatus(r0)
+	return
+}
+
+func GdipRestoreGraphics(graphics *GpGraphics, state GraphicsState) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipRestoreGraphics.Addr(), 2, uintptr(unsafe.Pointer(graphics)), uintptr(state), 0)
+	ret = GpStatus(r0)
+	return
+}
+
+func GdipSaveGraphics(graphics *GpGraphics, state *GraphicsState) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipSaveGraphics.Addr(), 2, uintptr(unsafe.Pointer(graphics)), uintptr(unsafe.Pointer(state)), 0)
+	ret = GpStatus(r0)
+	return
+}
+
 func GdipSetClipPath(graphics *GpGraphics, path *GpPath, combineMode CombineMode) (ret GpStatus) {
 	r0, _, _ := syscall.Syscall(procGdipSetClipPath.Addr(), 3, uintptr(unsafe.Pointer(graphics)), uintptr(unsafe.Pointer(path)), uintptr(combineMode))
 	ret = GpStatus(r0)
@@ -365,6 +466,60 @@ func GdipSetInterpolationMode(graphics *GpGraphics, interpolationMode Interpolat
 	return
 }
 
+func GdipSetLinePresetBlend(brush *GpLineGradient, blend *ARGB, positions *float32, count int32) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall6(procGdipSetLinePresetBlend.Addr(), 4, uintptr(unsafe.Pointer(brush)), uintptr(unsafe.Pointer(blend)), uintptr(unsafe.Pointer(positions)), uintptr(count), 0, 0)
+	ret = GpStatus(r0)
+	return
+}
+
+func GdipSetPathGradientCenterColor(brush *GpPathGradient, color ARGB) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipSetPathGradientCenterColor.Addr(), 2, uintptr(unsafe.Pointer(brush)), uintptr(color), 0)
+	ret = GpStatus(r0)
+	return
+}
+
+func GdipSetPathGradientCenterPoint(brush *GpPathGradient, point *GpPointF) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipSetPathGradientCenterPoint.Addr(), 2, uintptr(unsafe.Pointer(brush)), uintptr(unsafe.Pointer(point)), 0)
+	ret = GpStatus(r0)
+	return
+}
+
+func GdipSetPathGradientPresetBlend(brush *GpPathGradient, blend *ARGB, positions *float32, count int32) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall6(procGdipSetPathGradientPresetBlend.Addr(), 4, uintptr(unsafe.Pointer(brush)), uintptr(unsafe.Pointer(blend)), uintptr(unsafe.Pointer(positions)), uintptr(count), 0, 0)
+	ret = GpStatus(r0)
+	return
+}
+
+func GdipSetPathGradientSurroundColorsWithCount(brush *GpPathGradient, colors *ARGB, count *int32) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipSetPathGradientSurroundColorsWithCount.Addr(), 3, uintptr(unsafe.Pointer(brush)), uintptr(unsafe.Pointer(colors)), uintptr(unsafe.Pointer(count)))
+	ret = GpStatus(r0)
+	return
+}
+
+func GdipSetPenDashArray(pen *GpPen, dash *float32, count int32) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipSetPenDashArray.Addr(), 3, uintptr(unsafe.Pointer(pen)), uintptr(unsafe.Pointer(dash)), uintptr(count))
+	ret = GpStatus(r0)
+	return
+}
+
+func GdipSetPenDashStyle(pen *GpPen, dashStyle DashStyle) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipSetPenDashStyle.Addr(), 2, uintptr(unsafe.Pointer(pen)), uintptr(dashStyle), 0)
+	ret = GpStatus(r0)
+	return
+}
+
+func GdipSetPenLineCap197819(pen *GpPen, startCap LineCap, endCap LineCap, dashCap DashCap) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall6(procGdipSetPenLineCap197819.Addr(), 4, uintptr(unsafe.Pointer(pen)), uintptr(startCap), uintptr(endCap), uintptr(dashCap), 0, 0)
+	ret = GpStatus(r0)
+	return
+}
+
+func GdipSetPenLineJoin(pen *GpPen, lineJoin LineJoin) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipSetPenLineJoin.Addr(), 2, uintptr(unsafe.Pointer(pen)), uintptr(lineJoin), 0)
+	ret = GpStatus(r0)
+	return
+}
+
 func GdipSetPixelOffsetMode(graphics *GpGraphics, pixelOffsetMode PixelOffsetMode) (ret GpStatus) {
 	r0, _, _ := syscall.Syscall(procGdipSetPixelOffsetMode.Addr(), 2, uintptr(unsafe.Pointer(graphics)), uintptr(pixelOffsetMode), 0)
 	ret = GpStatus(r0)
@@ -395,6 +550,12 @@ func GdipSetTextRenderingHint(graphics *GpGraphics, mode TextRenderingHint) (ret
 	return
 }
 
+func GdipStartPathFigure(path *GpPath) (ret GpStatus) {
+	r0, _, _ := syscall.Syscall(procGdipStartPathFigure.Addr(), 1, uintptr(unsafe.Pointer(path)), 0, 0)
+	ret = GpStatus(r0)
+	return
+}
+
 func GdiplusStartup(token *uintptr, input *GdiplusStartupInput, output *GdiplusStartupOutput) (ret GpStatus) {
 	r0, _, _ := syscall.Syscall(procGdiplusStartup.Addr(), 3, uintptr(unsafe.Pointer(token)), uintptr(unsafe.Pointer(input)), uintptr(unsafe.Pointer(output)))
 	ret = GpStatus(r0)